@@ -0,0 +1,25 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/attestantio/go-eth2-client/spec"
+
+// BeaconStateDownload is the result of downloading a beacon state.
+type BeaconStateDownload struct {
+	// Version is the version of the state, as provided by the beacon node.
+	// It is required to decode the state, for example with spec.VersionedBeaconState.UnmarshalSSZFrom.
+	Version spec.DataVersion
+	// Size is the size of the SSZ-encoded state, including any offset from which the download resumed.
+	Size int64
+}
@@ -0,0 +1,661 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	utilcapella "github.com/attestantio/go-eth2-client/util/capella"
+)
+
+// ErrPayloadMismatch is returned when an execution payload does not match the
+// execution payload header of a blinded proposal.
+var ErrPayloadMismatch = errors.New("execution payload does not match header")
+
+// ErrBlobsMismatch is returned when a blobs bundle does not match the KZG
+// commitments of a blinded proposal.
+var ErrBlobsMismatch = errors.New("blobs bundle does not match commitments")
+
+// Unblind combines the signed blinded proposal with its execution payload, and
+// from Deneb onwards its blobs bundle, to create the full signed proposal.
+// The payload is checked against the execution payload header of the proposal,
+// and the blobs bundle against its KZG commitments.
+func (v *VersionedSignedBlindedProposal) Unblind(payload *spec.VersionedExecutionPayload,
+	blobsBundle *apiv1deneb.BlobsBundle,
+) (
+	*VersionedSignedProposal,
+	error,
+) {
+	if payload == nil {
+		return nil, errors.New("no execution payload")
+	}
+	if payload.Version != v.Version {
+		return nil, fmt.Errorf("execution payload version %v does not match proposal version %v", payload.Version, v.Version)
+	}
+
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || payload.Bellatrix == nil {
+			return nil, ErrDataMissing
+		}
+		header, err := bellatrixPayloadHeader(payload.Bellatrix)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPayloadHeader(header, v.Bellatrix.Message.Body.ExecutionPayloadHeader); err != nil {
+			return nil, err
+		}
+		block := v.Bellatrix.Message
+		body := block.Body
+
+		return &VersionedSignedProposal{
+			Version: v.Version,
+			Bellatrix: &bellatrix.SignedBeaconBlock{
+				Message: &bellatrix.BeaconBlock{
+					Slot:          block.Slot,
+					ProposerIndex: block.ProposerIndex,
+					ParentRoot:    block.ParentRoot,
+					StateRoot:     block.StateRoot,
+					Body: &bellatrix.BeaconBlockBody{
+						RANDAOReveal:      body.RANDAOReveal,
+						ETH1Data:          body.ETH1Data,
+						Graffiti:          body.Graffiti,
+						ProposerSlashings: body.ProposerSlashings,
+						AttesterSlashings: body.AttesterSlashings,
+						Attestations:      body.Attestations,
+						Deposits:          body.Deposits,
+						VoluntaryExits:    body.VoluntaryExits,
+						SyncAggregate:     body.SyncAggregate,
+						ExecutionPayload:  payload.Bellatrix,
+					},
+				},
+				Signature: v.Bellatrix.Signature,
+			},
+		}, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || payload.Capella == nil {
+			return nil, ErrDataMissing
+		}
+		header, err := capellaPayloadHeader(payload.Capella)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPayloadHeader(header, v.Capella.Message.Body.ExecutionPayloadHeader); err != nil {
+			return nil, err
+		}
+		block := v.Capella.Message
+		body := block.Body
+
+		return &VersionedSignedProposal{
+			Version: v.Version,
+			Capella: &capella.SignedBeaconBlock{
+				Message: &capella.BeaconBlock{
+					Slot:          block.Slot,
+					ProposerIndex: block.ProposerIndex,
+					ParentRoot:    block.ParentRoot,
+					StateRoot:     block.StateRoot,
+					Body: &capella.BeaconBlockBody{
+						RANDAOReveal:          body.RANDAOReveal,
+						ETH1Data:              body.ETH1Data,
+						Graffiti:              body.Graffiti,
+						ProposerSlashings:     body.ProposerSlashings,
+						AttesterSlashings:     body.AttesterSlashings,
+						Attestations:          body.Attestations,
+						Deposits:              body.Deposits,
+						VoluntaryExits:        body.VoluntaryExits,
+						SyncAggregate:         body.SyncAggregate,
+						ExecutionPayload:      payload.Capella,
+						BLSToExecutionChanges: body.BLSToExecutionChanges,
+					},
+				},
+				Signature: v.Capella.Signature,
+			},
+		}, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || payload.Deneb == nil {
+			return nil, ErrDataMissing
+		}
+		header, err := denebPayloadHeader(payload.Deneb)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPayloadHeader(header, v.Deneb.Message.Body.ExecutionPayloadHeader); err != nil {
+			return nil, err
+		}
+		if err := checkBlobsBundle(blobsBundle, v.Deneb.Message.Body.BlobKZGCommitments); err != nil {
+			return nil, err
+		}
+		block := v.Deneb.Message
+		body := block.Body
+
+		return &VersionedSignedProposal{
+			Version: v.Version,
+			Deneb: &apiv1deneb.SignedBlockContents{
+				SignedBlock: &deneb.SignedBeaconBlock{
+					Message: &deneb.BeaconBlock{
+						Slot:          block.Slot,
+						ProposerIndex: block.ProposerIndex,
+						ParentRoot:    block.ParentRoot,
+						StateRoot:     block.StateRoot,
+						Body: &deneb.BeaconBlockBody{
+							RANDAOReveal:          body.RANDAOReveal,
+							ETH1Data:              body.ETH1Data,
+							Graffiti:              body.Graffiti,
+							ProposerSlashings:     body.ProposerSlashings,
+							AttesterSlashings:     body.AttesterSlashings,
+							Attestations:          body.Attestations,
+							Deposits:              body.Deposits,
+							VoluntaryExits:        body.VoluntaryExits,
+							SyncAggregate:         body.SyncAggregate,
+							ExecutionPayload:      payload.Deneb,
+							BLSToExecutionChanges: body.BLSToExecutionChanges,
+							BlobKZGCommitments:    body.BlobKZGCommitments,
+						},
+					},
+					Signature: v.Deneb.Signature,
+				},
+				KZGProofs: blobsBundle.Proofs,
+				Blobs:     blobsBundle.Blobs,
+			},
+		}, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || payload.Electra == nil {
+			return nil, ErrDataMissing
+		}
+		header, err := denebPayloadHeader(payload.Electra)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPayloadHeader(header, v.Electra.Message.Body.ExecutionPayloadHeader); err != nil {
+			return nil, err
+		}
+		if err := checkBlobsBundle(blobsBundle, v.Electra.Message.Body.BlobKZGCommitments); err != nil {
+			return nil, err
+		}
+		block := v.Electra.Message
+		body := block.Body
+
+		return &VersionedSignedProposal{
+			Version: v.Version,
+			Electra: &apiv1electra.SignedBlockContents{
+				SignedBlock: &electra.SignedBeaconBlock{
+					Message: &electra.BeaconBlock{
+						Slot:          block.Slot,
+						ProposerIndex: block.ProposerIndex,
+						ParentRoot:    block.ParentRoot,
+						StateRoot:     block.StateRoot,
+						Body: &electra.BeaconBlockBody{
+							RANDAOReveal:          body.RANDAOReveal,
+							ETH1Data:              body.ETH1Data,
+							Graffiti:              body.Graffiti,
+							ProposerSlashings:     body.ProposerSlashings,
+							AttesterSlashings:     body.AttesterSlashings,
+							Attestations:          body.Attestations,
+							Deposits:              body.Deposits,
+							VoluntaryExits:        body.VoluntaryExits,
+							SyncAggregate:         body.SyncAggregate,
+							ExecutionPayload:      payload.Electra,
+							BLSToExecutionChanges: body.BLSToExecutionChanges,
+							BlobKZGCommitments:    body.BlobKZGCommitments,
+							ExecutionRequests:     body.ExecutionRequests,
+						},
+					},
+					Signature: v.Electra.Signature,
+				},
+				KZGProofs: blobsBundle.Proofs,
+				Blobs:     blobsBundle.Blobs,
+			},
+		}, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.Message == nil || v.Fulu.Message.Body == nil || payload.Fulu == nil {
+			return nil, ErrDataMissing
+		}
+		header, err := denebPayloadHeader(payload.Fulu)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPayloadHeader(header, v.Fulu.Message.Body.ExecutionPayloadHeader); err != nil {
+			return nil, err
+		}
+		if err := checkBlobsBundle(blobsBundle, v.Fulu.Message.Body.BlobKZGCommitments); err != nil {
+			return nil, err
+		}
+		block := v.Fulu.Message
+		body := block.Body
+
+		return &VersionedSignedProposal{
+			Version: v.Version,
+			Fulu: &apiv1fulu.SignedBlockContents{
+				SignedBlock: &fulu.SignedBeaconBlock{
+					Message: &fulu.BeaconBlock{
+						Slot:          block.Slot,
+						ProposerIndex: block.ProposerIndex,
+						ParentRoot:    block.ParentRoot,
+						StateRoot:     block.StateRoot,
+						Body: &fulu.BeaconBlockBody{
+							RANDAOReveal:          body.RANDAOReveal,
+							ETH1Data:              body.ETH1Data,
+							Graffiti:              body.Graffiti,
+							ProposerSlashings:     body.ProposerSlashings,
+							AttesterSlashings:     body.AttesterSlashings,
+							Attestations:          body.Attestations,
+							Deposits:              body.Deposits,
+							VoluntaryExits:        body.VoluntaryExits,
+							SyncAggregate:         body.SyncAggregate,
+							ExecutionPayload:      payload.Fulu,
+							BLSToExecutionChanges: body.BLSToExecutionChanges,
+							BlobKZGCommitments:    body.BlobKZGCommitments,
+							ExecutionRequests:     body.ExecutionRequests,
+						},
+					},
+					Signature: v.Fulu.Signature,
+				},
+				KZGProofs: blobsBundle.Proofs,
+				Blobs:     blobsBundle.Blobs,
+			},
+		}, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// Blind replaces the execution payload of the signed proposal with its header
+// to create the signed blinded proposal.  Blobs and proofs are dropped.
+// The signature remains valid, as a block and its blinded equivalent share
+// the same root.
+func (v *VersionedSignedProposal) Blind() (*VersionedSignedBlindedProposal, error) {
+	if v.Blinded {
+		return v.blindedProposal()
+	}
+	if err := v.assertMessagePresent(); err != nil {
+		return nil, err
+	}
+
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		block := v.Bellatrix.Message
+		if block.Body == nil {
+			return nil, ErrDataMissing
+		}
+		body := block.Body
+		header, err := bellatrixPayloadHeader(body.ExecutionPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		return &VersionedSignedBlindedProposal{
+			Version: v.Version,
+			Bellatrix: &apiv1bellatrix.SignedBlindedBeaconBlock{
+				Message: &apiv1bellatrix.BlindedBeaconBlock{
+					Slot:          block.Slot,
+					ProposerIndex: block.ProposerIndex,
+					ParentRoot:    block.ParentRoot,
+					StateRoot:     block.StateRoot,
+					Body: &apiv1bellatrix.BlindedBeaconBlockBody{
+						RANDAOReveal:           body.RANDAOReveal,
+						ETH1Data:               body.ETH1Data,
+						Graffiti:               body.Graffiti,
+						ProposerSlashings:      body.ProposerSlashings,
+						AttesterSlashings:      body.AttesterSlashings,
+						Attestations:           body.Attestations,
+						Deposits:               body.Deposits,
+						VoluntaryExits:         body.VoluntaryExits,
+						SyncAggregate:          body.SyncAggregate,
+						ExecutionPayloadHeader: header,
+					},
+				},
+				Signature: v.Bellatrix.Signature,
+			},
+		}, nil
+	case spec.DataVersionCapella:
+		block := v.Capella.Message
+		if block.Body == nil {
+			return nil, ErrDataMissing
+		}
+		body := block.Body
+		header, err := capellaPayloadHeader(body.ExecutionPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		return &VersionedSignedBlindedProposal{
+			Version: v.Version,
+			Capella: &apiv1capella.SignedBlindedBeaconBlock{
+				Message: &apiv1capella.BlindedBeaconBlock{
+					Slot:          block.Slot,
+					ProposerIndex: block.ProposerIndex,
+					ParentRoot:    block.ParentRoot,
+					StateRoot:     block.StateRoot,
+					Body: &apiv1capella.BlindedBeaconBlockBody{
+						RANDAOReveal:           body.RANDAOReveal,
+						ETH1Data:               body.ETH1Data,
+						Graffiti:               body.Graffiti,
+						ProposerSlashings:      body.ProposerSlashings,
+						AttesterSlashings:      body.AttesterSlashings,
+						Attestations:           body.Attestations,
+						Deposits:               body.Deposits,
+						VoluntaryExits:         body.VoluntaryExits,
+						SyncAggregate:          body.SyncAggregate,
+						ExecutionPayloadHeader: header,
+						BLSToExecutionChanges:  body.BLSToExecutionChanges,
+					},
+				},
+				Signature: v.Capella.Signature,
+			},
+		}, nil
+	case spec.DataVersionDeneb:
+		block := v.Deneb.SignedBlock.Message
+		if block.Body == nil {
+			return nil, ErrDataMissing
+		}
+		body := block.Body
+		header, err := denebPayloadHeader(body.ExecutionPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		return &VersionedSignedBlindedProposal{
+			Version: v.Version,
+			Deneb: &apiv1deneb.SignedBlindedBeaconBlock{
+				Message: &apiv1deneb.BlindedBeaconBlock{
+					Slot:          block.Slot,
+					ProposerIndex: block.ProposerIndex,
+					ParentRoot:    block.ParentRoot,
+					StateRoot:     block.StateRoot,
+					Body: &apiv1deneb.BlindedBeaconBlockBody{
+						RANDAOReveal:           body.RANDAOReveal,
+						ETH1Data:               body.ETH1Data,
+						Graffiti:               body.Graffiti,
+						ProposerSlashings:      body.ProposerSlashings,
+						AttesterSlashings:      body.AttesterSlashings,
+						Attestations:           body.Attestations,
+						Deposits:               body.Deposits,
+						VoluntaryExits:         body.VoluntaryExits,
+						SyncAggregate:          body.SyncAggregate,
+						ExecutionPayloadHeader: header,
+						BLSToExecutionChanges:  body.BLSToExecutionChanges,
+						BlobKZGCommitments:     body.BlobKZGCommitments,
+					},
+				},
+				Signature: v.Deneb.SignedBlock.Signature,
+			},
+		}, nil
+	case spec.DataVersionElectra:
+		block := v.Electra.SignedBlock.Message
+		if block.Body == nil {
+			return nil, ErrDataMissing
+		}
+		body := block.Body
+		header, err := denebPayloadHeader(body.ExecutionPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		return &VersionedSignedBlindedProposal{
+			Version: v.Version,
+			Electra: &apiv1electra.SignedBlindedBeaconBlock{
+				Message: &apiv1electra.BlindedBeaconBlock{
+					Slot:          block.Slot,
+					ProposerIndex: block.ProposerIndex,
+					ParentRoot:    block.ParentRoot,
+					StateRoot:     block.StateRoot,
+					Body: &apiv1electra.BlindedBeaconBlockBody{
+						RANDAOReveal:           body.RANDAOReveal,
+						ETH1Data:               body.ETH1Data,
+						Graffiti:               body.Graffiti,
+						ProposerSlashings:      body.ProposerSlashings,
+						AttesterSlashings:      body.AttesterSlashings,
+						Attestations:           body.Attestations,
+						Deposits:               body.Deposits,
+						VoluntaryExits:         body.VoluntaryExits,
+						SyncAggregate:          body.SyncAggregate,
+						ExecutionPayloadHeader: header,
+						BLSToExecutionChanges:  body.BLSToExecutionChanges,
+						BlobKZGCommitments:     body.BlobKZGCommitments,
+						ExecutionRequests:      body.ExecutionRequests,
+					},
+				},
+				Signature: v.Electra.SignedBlock.Signature,
+			},
+		}, nil
+	case spec.DataVersionFulu:
+		block := v.Fulu.SignedBlock.Message
+		if block.Body == nil {
+			return nil, ErrDataMissing
+		}
+		body := block.Body
+		header, err := denebPayloadHeader(body.ExecutionPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		return &VersionedSignedBlindedProposal{
+			Version: v.Version,
+			Fulu: &apiv1fulu.SignedBlindedBeaconBlock{
+				Message: &apiv1fulu.BlindedBeaconBlock{
+					Slot:          block.Slot,
+					ProposerIndex: block.ProposerIndex,
+					ParentRoot:    block.ParentRoot,
+					StateRoot:     block.StateRoot,
+					Body: &apiv1fulu.BlindedBeaconBlockBody{
+						RANDAOReveal:           body.RANDAOReveal,
+						ETH1Data:               body.ETH1Data,
+						Graffiti:               body.Graffiti,
+						ProposerSlashings:      body.ProposerSlashings,
+						AttesterSlashings:      body.AttesterSlashings,
+						Attestations:           body.Attestations,
+						Deposits:               body.Deposits,
+						VoluntaryExits:         body.VoluntaryExits,
+						SyncAggregate:          body.SyncAggregate,
+						ExecutionPayloadHeader: header,
+						BLSToExecutionChanges:  body.BLSToExecutionChanges,
+						BlobKZGCommitments:     body.BlobKZGCommitments,
+						ExecutionRequests:      body.ExecutionRequests,
+					},
+				},
+				Signature: v.Fulu.SignedBlock.Signature,
+			},
+		}, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// blindedProposal returns the blinded data of an already-blinded signed proposal.
+func (v *VersionedSignedProposal) blindedProposal() (*VersionedSignedBlindedProposal, error) {
+	if err := v.AssertPresent(); err != nil {
+		return nil, err
+	}
+
+	return &VersionedSignedBlindedProposal{
+		Version:   v.Version,
+		Bellatrix: v.BellatrixBlinded,
+		Capella:   v.CapellaBlinded,
+		Deneb:     v.DenebBlinded,
+		Electra:   v.ElectraBlinded,
+		Fulu:      v.FuluBlinded,
+	}, nil
+}
+
+// checkPayloadHeader checks that an execution payload header matches that of a proposal.
+func checkPayloadHeader(header payloadHeader, expected payloadHeader) error {
+	if expected == nil {
+		return ErrDataMissing
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain execution payload header root"), err)
+	}
+	expectedRoot, err := expected.HashTreeRoot()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain execution payload header root"), err)
+	}
+	if root != expectedRoot {
+		return ErrPayloadMismatch
+	}
+
+	return nil
+}
+
+// checkBlobsBundle checks that a blobs bundle matches the commitments of a proposal.
+func checkBlobsBundle(blobsBundle *apiv1deneb.BlobsBundle, commitments []deneb.KZGCommitment) error {
+	if blobsBundle == nil {
+		return errors.New("no blobs bundle")
+	}
+	if len(blobsBundle.Commitments) != len(commitments) || len(blobsBundle.Blobs) != len(commitments) {
+		return ErrBlobsMismatch
+	}
+	for i := range commitments {
+		if blobsBundle.Commitments[i] != commitments[i] {
+			return ErrBlobsMismatch
+		}
+	}
+
+	return nil
+}
+
+// payloadHeader is the interface common to execution payload headers.
+type payloadHeader interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// bellatrixPayloadHeader creates the header for a bellatrix execution payload.
+func bellatrixPayloadHeader(payload *bellatrix.ExecutionPayload) (*bellatrix.ExecutionPayloadHeader, error) {
+	if payload == nil {
+		return nil, ErrDataMissing
+	}
+	transactionsRoot, err := transactionsRoot(payload.Transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bellatrix.ExecutionPayloadHeader{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        payload.ExtraData,
+		BaseFeePerGas:    payload.BaseFeePerGas,
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: transactionsRoot,
+	}, nil
+}
+
+// capellaPayloadHeader creates the header for a capella execution payload.
+func capellaPayloadHeader(payload *capella.ExecutionPayload) (*capella.ExecutionPayloadHeader, error) {
+	if payload == nil {
+		return nil, ErrDataMissing
+	}
+	transactionsRoot, err := transactionsRoot(payload.Transactions)
+	if err != nil {
+		return nil, err
+	}
+	withdrawalsRoot, err := withdrawalsRoot(payload.Withdrawals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &capella.ExecutionPayloadHeader{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        payload.ExtraData,
+		BaseFeePerGas:    payload.BaseFeePerGas,
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: transactionsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+	}, nil
+}
+
+// denebPayloadHeader creates the header for a deneb execution payload.
+func denebPayloadHeader(payload *deneb.ExecutionPayload) (*deneb.ExecutionPayloadHeader, error) {
+	if payload == nil {
+		return nil, ErrDataMissing
+	}
+	transactionsRoot, err := transactionsRoot(payload.Transactions)
+	if err != nil {
+		return nil, err
+	}
+	withdrawalsRoot, err := withdrawalsRoot(payload.Withdrawals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deneb.ExecutionPayloadHeader{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        payload.ExtraData,
+		BaseFeePerGas:    payload.BaseFeePerGas,
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: transactionsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+		BlobGasUsed:      payload.BlobGasUsed,
+		ExcessBlobGas:    payload.ExcessBlobGas,
+	}, nil
+}
+
+// transactionsRoot calculates the root of a list of transactions.
+func transactionsRoot(transactions []bellatrix.Transaction) (phase0.Root, error) {
+	root, err := (&utilbellatrix.ExecutionPayloadTransactions{Transactions: transactions}).HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate transactions root"), err)
+	}
+
+	return root, nil
+}
+
+// withdrawalsRoot calculates the root of a list of withdrawals.
+func withdrawalsRoot(withdrawals []*capella.Withdrawal) (phase0.Root, error) {
+	root, err := (&utilcapella.ExecutionPayloadWithdrawals{Withdrawals: withdrawals}).HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate withdrawals root"), err)
+	}
+
+	return root, nil
+}
@@ -0,0 +1,155 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// signedProposal generates a full signed proposal, its block and its blobs bundle.
+func signedProposal(t *testing.T,
+	version spec.DataVersion,
+) (
+	*api.VersionedSignedProposal,
+	*spec.VersionedSignedBeaconBlock,
+	*apiv1deneb.BlobsBundle,
+) {
+	t.Helper()
+
+	block, err := testutil.NewGenerator(int64(version)).SignedBeaconBlock(version)
+	require.NoError(t, err)
+
+	var commitments []deneb.KZGCommitment
+	if version >= spec.DataVersionDeneb {
+		commitments, err = block.BlobKZGCommitments()
+		require.NoError(t, err)
+	}
+	bundle := &apiv1deneb.BlobsBundle{
+		Commitments: commitments,
+		Proofs:      make([]deneb.KZGProof, len(commitments)),
+		Blobs:       make([]deneb.Blob, len(commitments)),
+	}
+
+	proposal := &api.VersionedSignedProposal{
+		Version:   version,
+		Bellatrix: block.Bellatrix,
+		Capella:   block.Capella,
+	}
+	switch version {
+	case spec.DataVersionDeneb:
+		proposal.Deneb = &apiv1deneb.SignedBlockContents{SignedBlock: block.Deneb, KZGProofs: bundle.Proofs, Blobs: bundle.Blobs}
+	case spec.DataVersionElectra:
+		proposal.Electra = &apiv1electra.SignedBlockContents{SignedBlock: block.Electra, KZGProofs: bundle.Proofs, Blobs: bundle.Blobs}
+	case spec.DataVersionFulu:
+		proposal.Fulu = &apiv1fulu.SignedBlockContents{SignedBlock: block.Fulu, KZGProofs: bundle.Proofs, Blobs: bundle.Blobs}
+	}
+
+	return proposal, block, bundle
+}
+
+// executionPayload obtains the execution payload of a full signed proposal.
+func executionPayload(proposal *api.VersionedSignedProposal) *spec.VersionedExecutionPayload {
+	payload := &spec.VersionedExecutionPayload{Version: proposal.Version}
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		payload.Bellatrix = proposal.Bellatrix.Message.Body.ExecutionPayload
+	case spec.DataVersionCapella:
+		payload.Capella = proposal.Capella.Message.Body.ExecutionPayload
+	case spec.DataVersionDeneb:
+		payload.Deneb = proposal.Deneb.SignedBlock.Message.Body.ExecutionPayload
+	case spec.DataVersionElectra:
+		payload.Electra = proposal.Electra.SignedBlock.Message.Body.ExecutionPayload
+	case spec.DataVersionFulu:
+		payload.Fulu = proposal.Fulu.SignedBlock.Message.Body.ExecutionPayload
+	}
+
+	return payload
+}
+
+func TestBlindUnblind(t *testing.T) {
+	for version := spec.DataVersionBellatrix; version <= spec.DataVersionFulu; version++ {
+		t.Run(version.String(), func(t *testing.T) {
+			proposal, block, bundle := signedProposal(t, version)
+
+			blinded, err := proposal.Blind()
+			require.NoError(t, err)
+			require.Equal(t, version, blinded.Version)
+
+			// The blinded block must have the same root as the full block.
+			root, err := block.Root()
+			require.NoError(t, err)
+			blindedRoot, err := blinded.Root()
+			require.NoError(t, err)
+			require.Equal(t, root, blindedRoot)
+
+			unblinded, err := blinded.Unblind(executionPayload(proposal), bundle)
+			require.NoError(t, err)
+			require.Equal(t, proposal, unblinded)
+		})
+	}
+}
+
+func TestUnblindErrors(t *testing.T) {
+	proposal, _, bundle := signedProposal(t, spec.DataVersionDeneb)
+	blinded, err := proposal.Blind()
+	require.NoError(t, err)
+	payload := executionPayload(proposal)
+
+	_, err = blinded.Unblind(nil, bundle)
+	require.EqualError(t, err, "no execution payload")
+
+	_, err = blinded.Unblind(&spec.VersionedExecutionPayload{Version: spec.DataVersionCapella}, bundle)
+	require.EqualError(t, err, "execution payload version capella does not match proposal version deneb")
+
+	_, err = blinded.Unblind(payload, nil)
+	require.EqualError(t, err, "no blobs bundle")
+
+	_, err = blinded.Unblind(payload, &apiv1deneb.BlobsBundle{})
+	require.ErrorIs(t, err, api.ErrBlobsMismatch)
+
+	mismatchedPayload := *payload.Deneb
+	mismatchedPayload.BlockHash = phase0.Hash32{0x01}
+	_, err = blinded.Unblind(&spec.VersionedExecutionPayload{Version: spec.DataVersionDeneb, Deneb: &mismatchedPayload}, bundle)
+	require.ErrorIs(t, err, api.ErrPayloadMismatch)
+}
+
+func TestBlindAlreadyBlinded(t *testing.T) {
+	proposal, _, _ := signedProposal(t, spec.DataVersionElectra)
+	blinded, err := proposal.Blind()
+	require.NoError(t, err)
+
+	blindedProposal := &api.VersionedSignedProposal{
+		Version:        spec.DataVersionElectra,
+		Blinded:        true,
+		ElectraBlinded: blinded.Electra,
+	}
+	res, err := blindedProposal.Blind()
+	require.NoError(t, err)
+	require.Equal(t, blinded, res)
+}
+
+func TestBlindUnsupported(t *testing.T) {
+	_, err := (&api.VersionedSignedProposal{Version: spec.DataVersionPhase0}).Blind()
+	require.ErrorIs(t, err, api.ErrUnsupportedVersion)
+}
@@ -13,10 +13,15 @@
 
 package api
 
+import "github.com/attestantio/go-eth2-client/spec/deneb"
+
 // BlobSidecarsOpts are the options for obtaining blob sidecars.
 type BlobSidecarsOpts struct {
 	Common CommonOpts
 
 	// Block is the ID of the block for which the data is obtained.
 	Block string
+	// Indices is a list of blob indices to restrict the returned values.
+	// If no indices are supplied then all blobs are returned.
+	Indices []deneb.BlobIndex
 }
@@ -0,0 +1,77 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"time"
+)
+
+type contextKey int
+
+const (
+	retryBudgetKey contextKey = iota
+	timeoutKey
+	headersKey
+)
+
+// WithRetryBudget returns a context that sets the maximum number of retries
+// for calls made with it, overriding the service-wide setting.  A budget of 0
+// disables retries.
+func WithRetryBudget(ctx context.Context, retries int) context.Context {
+	return context.WithValue(ctx, retryBudgetKey, retries)
+}
+
+// RetryBudget returns the retry budget set on the context, if any.
+func RetryBudget(ctx context.Context) (int, bool) {
+	retries, exists := ctx.Value(retryBudgetKey).(int)
+
+	return retries, exists
+}
+
+// WithTimeout returns a context that sets the timeout for calls made with it,
+// overriding the service-wide setting.  A timeout supplied in a call's options
+// takes precedence over this.
+func WithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey, timeout)
+}
+
+// Timeout returns the timeout set on the context, if any.
+func Timeout(ctx context.Context) (time.Duration, bool) {
+	timeout, exists := ctx.Value(timeoutKey).(time.Duration)
+
+	return timeout, exists
+}
+
+// WithHeader returns a context that adds the given header to calls made with
+// it.  Headers set on the context override those set by the service, so for
+// example can be used to supply an authorization token or to override the
+// Eth-Consensus-Version header on a submission.
+func WithHeader(ctx context.Context, key string, value string) context.Context {
+	existing := Headers(ctx)
+	headers := make(map[string]string, len(existing)+1)
+	for k, v := range existing {
+		headers[k] = v
+	}
+	headers[key] = value
+
+	return context.WithValue(ctx, headersKey, headers)
+}
+
+// Headers returns the headers set on the context, if any.
+func Headers(ctx context.Context) map[string]string {
+	headers, _ := ctx.Value(headersKey).(map[string]string)
+
+	return headers
+}
@@ -0,0 +1,42 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// DataColumnSidecars is an API construct to allow decoding an array of data column sidecars.
+type DataColumnSidecars struct {
+	Sidecars []*fulu.DataColumnSidecar `ssz-max:"128"`
+}
+
+// UnmarshalSSZ ssz unmarshals the DataColumnSidecars object.
+// This is a hand-crafted function, as automatic generation does not support immediate arrays.
+func (d *DataColumnSidecars) UnmarshalSSZ(buf []byte) error {
+	num, err := ssz.DecodeDynamicLength(buf, 128)
+	if err != nil {
+		return err
+	}
+	d.Sidecars = make([]*fulu.DataColumnSidecar, num)
+
+	return ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) error {
+		if d.Sidecars[indx] == nil {
+			d.Sidecars[indx] = new(fulu.DataColumnSidecar)
+		}
+
+		return d.Sidecars[indx].UnmarshalSSZ(buf)
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataColumnSidecarsUnmarshalSSZ(t *testing.T) {
+	sidecars := []*fulu.DataColumnSidecar{
+		{
+			Index:          1,
+			Column:         []fulu.Cell{{0x01}},
+			KZGCommitments: []deneb.KZGCommitment{{0x02}},
+			KZGProofs:      []deneb.KZGProof{{0x03}},
+			SignedBlockHeader: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: 10,
+				},
+			},
+		},
+		{
+			Index:          2,
+			Column:         []fulu.Cell{},
+			KZGCommitments: []deneb.KZGCommitment{},
+			KZGProofs:      []deneb.KZGProof{},
+			SignedBlockHeader: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: 10,
+				},
+			},
+		},
+	}
+
+	// Build the SSZ list manually: offsets followed by the variable-sized items.
+	items := make([][]byte, len(sidecars))
+	for i := range sidecars {
+		var err error
+		items[i], err = sidecars[i].MarshalSSZ()
+		require.NoError(t, err)
+	}
+	buf := make([]byte, 0)
+	offset := 4 * len(items)
+	for i := range items {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(offset))
+		offset += len(items[i])
+	}
+	for i := range items {
+		buf = append(buf, items[i]...)
+	}
+
+	res := &api.DataColumnSidecars{}
+	require.NoError(t, res.UnmarshalSSZ(buf))
+	require.Equal(t, sidecars, res.Sidecars)
+
+	require.Error(t, res.UnmarshalSSZ(buf[:3]))
+}
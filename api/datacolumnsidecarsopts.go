@@ -0,0 +1,27 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/attestantio/go-eth2-client/spec/fulu"
+
+// DataColumnSidecarsOpts are the options for obtaining data column sidecars.
+type DataColumnSidecarsOpts struct {
+	Common CommonOpts
+
+	// Block is the ID of the block for which the data is obtained.
+	Block string
+	// Indices is a list of column indices to restrict the returned values.
+	// If no indices are supplied then all columns held by the node are returned.
+	Indices []fulu.ColumnIndex
+}
@@ -0,0 +1,35 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "io"
+
+// DownloadBeaconStateOpts are the options for downloading the beacon state.
+type DownloadBeaconStateOpts struct {
+	Common CommonOpts
+
+	// State is the state at which the data is obtained.
+	// It can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// As a download can span multiple requests this should be a slot number or state root, which always refer to the same
+	// state, rather than one of the special values.
+	State string
+	// Writer is the writer to which the SSZ-encoded state is written.
+	Writer io.Writer
+	// Offset is the number of bytes of the state that have already been written, for example by an earlier
+	// download that was interrupted.  The download resumes from this point.
+	Offset int64
+	// ChunkSize is the maximum number of bytes of the state obtained in each request.
+	// If this is 0 then the state is obtained in a single request.
+	ChunkSize int64
+}
@@ -15,14 +15,27 @@ package api
 
 import (
 	"fmt"
+	"time"
 )
 
 // Error represents an API error.
 type Error struct {
-	Method     string
-	Endpoint   string
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the endpoint of the request.
+	Endpoint string
+	// StatusCode is the HTTP status code of the response.
 	StatusCode int
-	Data       []byte
+	// Data is the raw body of the response.
+	Data []byte
+	// Code is the error code supplied in the body of the response, if present.
+	Code int
+	// Message is the error message supplied in the body of the response, if present.
+	Message string
+	// Stacktraces are the stack traces supplied in the body of the response, if present.
+	Stacktraces []string
+	// Duration is the time taken for the request.
+	Duration time.Duration
 }
 
 func (e Error) Error() string {
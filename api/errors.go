@@ -22,3 +22,7 @@ var ErrUnsupportedVersion = errors.New("unsupported version")
 // ErrDataMissing is returned when the data requested is missing from the versioned
 // struct.
 var ErrDataMissing = errors.New("data missing")
+
+// ErrMethodNotAllowed is returned when a method call is refused because the
+// client has been configured to restrict the methods that it allows.
+var ErrMethodNotAllowed = errors.New("method not allowed")
@@ -0,0 +1,48 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndexedSubmissionError is returned when a submission of multiple items is
+// only partially accepted, and the beacon node reports the items that failed.
+type IndexedSubmissionError struct {
+	// FailedIndices are the indices of the failed items in the submission.
+	FailedIndices []int
+	// Messages are the reasons for failure, one per failed index.
+	Messages []string
+	// Err is the underlying error, if the submission returned an error status.
+	Err error
+}
+
+func (e *IndexedSubmissionError) Error() string {
+	failures := make([]string, 0, len(e.FailedIndices))
+	for i, index := range e.FailedIndices {
+		if i < len(e.Messages) && e.Messages[i] != "" {
+			failures = append(failures, fmt.Sprintf("%d: %s", index, e.Messages[i]))
+		} else {
+			failures = append(failures, fmt.Sprintf("%d", index))
+		}
+	}
+
+	return fmt.Sprintf("%d submitted items failed (%s)", len(e.FailedIndices), strings.Join(failures, "; "))
+}
+
+// Unwrap returns the underlying error.
+func (e *IndexedSubmissionError) Unwrap() error {
+	return e.Err
+}
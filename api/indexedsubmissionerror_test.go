@@ -0,0 +1,39 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexedSubmissionError(t *testing.T) {
+	apiErr := &api.Error{
+		Method:     "POST",
+		StatusCode: 400,
+	}
+	err := &api.IndexedSubmissionError{
+		FailedIndices: []int{1, 3},
+		Messages:      []string{"bad signature", ""},
+		Err:           apiErr,
+	}
+	require.EqualError(t, err, "2 submitted items failed (1: bad signature; 3)")
+
+	var unwrapped *api.Error
+	require.True(t, errors.As(err, &unwrapped))
+	require.Equal(t, 400, unwrapped.StatusCode)
+}
@@ -0,0 +1,24 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// LightClientUpdatesOpts are the options for obtaining light client updates.
+type LightClientUpdatesOpts struct {
+	Common CommonOpts
+
+	// StartPeriod is the first sync committee period for which to obtain updates.
+	StartPeriod uint64
+	// Count is the maximum number of updates to obtain.
+	Count uint64
+}
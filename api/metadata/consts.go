@@ -23,4 +23,9 @@ const (
 	ExecutionOptimistic = "execution_optimistic"
 	// DependentRoot is the block root on which the returned data is based.
 	DependentRoot = "dependent_root"
+	// Version is the consensus version of the data in the response, as provided in its body.
+	Version = "version"
+	// ConsensusVersion is the consensus version of the data in the response, as provided in
+	// the Eth-Consensus-Version header.
+	ConsensusVersion = "Eth-Consensus-Version"
 )
@@ -0,0 +1,111 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ProposalMetadata is the metadata returned alongside a proposal.
+type ProposalMetadata struct {
+	// Blinded is true if the proposal contains a blinded execution payload.
+	Blinded bool
+	// ExecutionValue is the value of the execution payload, in Wei.
+	ExecutionValue *big.Int
+	// ConsensusValue is the value of the consensus rewards for the block, in Wei.
+	ConsensusValue *big.Int
+}
+
+// proposalMetadataJSON is the spec representation of the struct.
+type proposalMetadataJSON struct {
+	Blinded        *bool  `json:"execution_payload_blinded"`
+	ExecutionValue string `json:"execution_payload_value"`
+	ConsensusValue string `json:"consensus_block_value"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m *ProposalMetadata) MarshalJSON() ([]byte, error) {
+	executionValue := "0"
+	if m.ExecutionValue != nil {
+		executionValue = m.ExecutionValue.String()
+	}
+	consensusValue := "0"
+	if m.ConsensusValue != nil {
+		consensusValue = m.ConsensusValue.String()
+	}
+
+	return json.Marshal(&proposalMetadataJSON{
+		Blinded:        &m.Blinded,
+		ExecutionValue: executionValue,
+		ConsensusValue: consensusValue,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *ProposalMetadata) UnmarshalJSON(input []byte) error {
+	var data proposalMetadataJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Join(errors.New("invalid JSON"), err)
+	}
+
+	if data.Blinded == nil {
+		return errors.New("execution payload blinded missing")
+	}
+	m.Blinded = *data.Blinded
+
+	if data.ExecutionValue == "" {
+		return errors.New("execution payload value missing")
+	}
+	var success bool
+	m.ExecutionValue, success = new(big.Int).SetString(data.ExecutionValue, 10)
+	if !success {
+		return fmt.Errorf("invalid value for execution payload value: %s", data.ExecutionValue)
+	}
+
+	if data.ConsensusValue == "" {
+		return errors.New("consensus block value missing")
+	}
+	m.ConsensusValue, success = new(big.Int).SetString(data.ConsensusValue, 10)
+	if !success {
+		return fmt.Errorf("invalid value for consensus block value: %s", data.ConsensusValue)
+	}
+
+	return nil
+}
+
+// Value returns the total value of the proposal, in Wei.
+func (m *ProposalMetadata) Value() *big.Int {
+	value := big.NewInt(0)
+	if m.ConsensusValue != nil {
+		value = value.Add(value, m.ConsensusValue)
+	}
+	if m.ExecutionValue != nil {
+		value = value.Add(value, m.ExecutionValue)
+	}
+
+	return value
+}
+
+// String returns a string version of the structure.
+func (m *ProposalMetadata) String() string {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalMetadataJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON\njson: cannot unmarshal array into Go value of type api.proposalMetadataJSON",
+		},
+		{
+			name:  "BlindedMissing",
+			input: []byte(`{"execution_payload_value":"12345","consensus_block_value":"678"}`),
+			err:   "execution payload blinded missing",
+		},
+		{
+			name:  "ExecutionValueMissing",
+			input: []byte(`{"execution_payload_blinded":true,"consensus_block_value":"678"}`),
+			err:   "execution payload value missing",
+		},
+		{
+			name:  "ExecutionValueInvalid",
+			input: []byte(`{"execution_payload_blinded":true,"execution_payload_value":"invalid","consensus_block_value":"678"}`),
+			err:   "invalid value for execution payload value: invalid",
+		},
+		{
+			name:  "ConsensusValueMissing",
+			input: []byte(`{"execution_payload_blinded":true,"execution_payload_value":"12345"}`),
+			err:   "consensus block value missing",
+		},
+		{
+			name:  "ConsensusValueInvalid",
+			input: []byte(`{"execution_payload_blinded":true,"execution_payload_value":"12345","consensus_block_value":"invalid"}`),
+			err:   "invalid value for consensus block value: invalid",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"execution_payload_blinded":true,"execution_payload_value":"12345","consensus_block_value":"678"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ProposalMetadata
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestProposalMetadataValue(t *testing.T) {
+	proposal := &api.VersionedProposal{
+		Blinded:        true,
+		ExecutionValue: big.NewInt(12345),
+		ConsensusValue: big.NewInt(678),
+	}
+
+	metadata := proposal.ProposalMetadata()
+	require.True(t, metadata.Blinded)
+	require.Equal(t, big.NewInt(13023), metadata.Value())
+	require.Equal(t, proposal.Value(), metadata.Value())
+	require.Equal(t, big.NewInt(0), (&api.ProposalMetadata{}).Value())
+}
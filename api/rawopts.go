@@ -0,0 +1,40 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// RawGETOpts are the options for a raw GET request.
+type RawGETOpts struct {
+	Common CommonOpts
+
+	// Query is the query string of the request, without the leading '?'.
+	Query string
+	// SSZ is true if the endpoint can return SSZ, in which case the response
+	// may be SSZ depending on the service's content type preferences.
+	SSZ bool
+}
+
+// RawPOSTOpts are the options for a raw POST request.
+type RawPOSTOpts struct {
+	Common CommonOpts
+
+	// Query is the query string of the request, without the leading '?'.
+	Query string
+	// Body is the body of the request.
+	Body []byte
+	// ContentType is the media type of the body, for example "application/octet-stream".
+	// Defaults to "application/json".
+	ContentType string
+	// Headers are additional headers to send with the request.
+	Headers map[string]string
+}
@@ -0,0 +1,26 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// RawResponse is the raw response to a request.
+type RawResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Headers are the headers of the response.
+	Headers map[string]string
+	// ContentType is the media type of the body.
+	ContentType string
+	// Body is the body of the response.
+	Body []byte
+}
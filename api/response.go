@@ -13,8 +13,85 @@
 
 package api
 
+import (
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api/metadata"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
 // Response is a response from the beacon API which may contain metadata.
 type Response[T any] struct {
 	Data     T
 	Metadata map[string]any
 }
+
+// ExecutionOptimistic returns true if the response contains execution data
+// that had not been fully verified at the time of the response.  The second
+// value is false if the beacon node did not say.
+func (r *Response[T]) ExecutionOptimistic() (bool, bool) {
+	return r.metadataBool(metadata.ExecutionOptimistic)
+}
+
+// Finalized returns true if the response contains finalized data.  The second
+// value is false if the beacon node did not say.
+func (r *Response[T]) Finalized() (bool, bool) {
+	return r.metadataBool(metadata.Finalized)
+}
+
+// DependentRoot returns the block root on which the response is based.  The
+// second value is false if the beacon node did not provide it.
+func (r *Response[T]) DependentRoot() (phase0.Root, bool) {
+	switch val := r.Metadata[metadata.DependentRoot].(type) {
+	case phase0.Root:
+		return val, true
+	case string:
+		var root phase0.Root
+		if err := root.UnmarshalJSON([]byte(strconv.Quote(val))); err != nil {
+			return phase0.Root{}, false
+		}
+
+		return root, true
+	default:
+		return phase0.Root{}, false
+	}
+}
+
+// ConsensusVersion returns the consensus version of the data in the
+// response, taken from the Eth-Consensus-Version header or failing that the
+// version in the body.  The second value is false if the beacon node did not
+// provide it.
+func (r *Response[T]) ConsensusVersion() (spec.DataVersion, bool) {
+	for _, key := range []string{metadata.ConsensusVersion, metadata.Version} {
+		val, isString := r.Metadata[key].(string)
+		if !isString {
+			continue
+		}
+		var version spec.DataVersion
+		if err := version.UnmarshalJSON([]byte(strconv.Quote(val))); err != nil {
+			continue
+		}
+
+		return version, true
+	}
+
+	return spec.DataVersionUnknown, false
+}
+
+// metadataBool returns the boolean metadata value for the given key, if present.
+func (r *Response[T]) metadataBool(key string) (bool, bool) {
+	switch val := r.Metadata[key].(type) {
+	case bool:
+		return val, true
+	case string:
+		parsed, err := strconv.ParseBool(val)
+		if err != nil {
+			return false, false
+		}
+
+		return parsed, true
+	default:
+		return false, false
+	}
+}
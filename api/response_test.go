@@ -0,0 +1,116 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseMetadata(t *testing.T) {
+	root := phase0.Root{0x01, 0x02}
+
+	tests := []struct {
+		name                string
+		metadata            map[string]any
+		executionOptimistic *bool
+		finalized           *bool
+		dependentRoot       *phase0.Root
+		version             spec.DataVersion
+	}{
+		{
+			name:    "Nil",
+			version: spec.DataVersionUnknown,
+		},
+		{
+			name: "JSON",
+			metadata: map[string]any{
+				"execution_optimistic": true,
+				"finalized":            false,
+				"dependent_root":       root,
+				"version":              "deneb",
+			},
+			executionOptimistic: boolPtr(true),
+			finalized:           boolPtr(false),
+			dependentRoot:       &root,
+			version:             spec.DataVersionDeneb,
+		},
+		{
+			name: "Strings",
+			metadata: map[string]any{
+				"execution_optimistic": "false",
+				"finalized":            "true",
+				"dependent_root":       "0x0102000000000000000000000000000000000000000000000000000000000000",
+			},
+			executionOptimistic: boolPtr(false),
+			finalized:           boolPtr(true),
+			dependentRoot:       &root,
+			version:             spec.DataVersionUnknown,
+		},
+		{
+			name: "Invalid",
+			metadata: map[string]any{
+				"execution_optimistic": "maybe",
+				"finalized":            1,
+				"dependent_root":       "0x01",
+				"version":              "unknown",
+			},
+			version: spec.DataVersionUnknown,
+		},
+		{
+			name: "HeaderPreferred",
+			metadata: map[string]any{
+				"Eth-Consensus-Version": "electra",
+				"version":               "deneb",
+			},
+			version: spec.DataVersionElectra,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := &api.Response[any]{Metadata: test.metadata}
+
+			executionOptimistic, exists := response.ExecutionOptimistic()
+			require.Equal(t, test.executionOptimistic != nil, exists)
+			if exists {
+				require.Equal(t, *test.executionOptimistic, executionOptimistic)
+			}
+
+			finalized, exists := response.Finalized()
+			require.Equal(t, test.finalized != nil, exists)
+			if exists {
+				require.Equal(t, *test.finalized, finalized)
+			}
+
+			dependentRoot, exists := response.DependentRoot()
+			require.Equal(t, test.dependentRoot != nil, exists)
+			if exists {
+				require.Equal(t, *test.dependentRoot, dependentRoot)
+			}
+
+			version, exists := response.ConsensusVersion()
+			require.Equal(t, test.version != spec.DataVersionUnknown, exists)
+			require.Equal(t, test.version, version)
+		})
+	}
+}
+
+func boolPtr(val bool) *bool {
+	return &val
+}
@@ -0,0 +1,30 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	apiv2 "github.com/attestantio/go-eth2-client/api/v2"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// SubmitBeaconBlockOpts are the options for submitting beacon blocks.
+type SubmitBeaconBlockOpts struct {
+	Common CommonOpts
+
+	// Block is the block to submit.
+	Block *spec.VersionedSignedBeaconBlock
+
+	// BroadcastValidation is the validation required of the consensus node before broadcasting the block.
+	BroadcastValidation *apiv2.BroadcastValidation
+}
@@ -0,0 +1,51 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "sort"
+
+// SupportedMethods is the set of methods supported by a client.
+type SupportedMethods struct {
+	methods map[string]struct{}
+}
+
+// NewSupportedMethods creates a set of supported methods.
+func NewSupportedMethods(methods []string) *SupportedMethods {
+	s := &SupportedMethods{
+		methods: make(map[string]struct{}, len(methods)),
+	}
+	for _, method := range methods {
+		s.methods[method] = struct{}{}
+	}
+
+	return s
+}
+
+// Supports returns true if the method, for example "AttestationRewards", is supported.
+func (s *SupportedMethods) Supports(method string) bool {
+	_, exists := s.methods[method]
+
+	return exists
+}
+
+// Methods returns the supported methods, sorted by name.
+func (s *SupportedMethods) Methods() []string {
+	methods := make([]string, 0, len(s.methods))
+	for method := range s.methods {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	return methods
+}
@@ -28,6 +28,16 @@ type AttestationRewards struct {
 	TotalRewards []ValidatorAttestationRewards `json:"total_rewards"`
 }
 
+// String returns a string version of the structure.
+func (a *AttestationRewards) String() string {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
 // IdealAttestationRewards are the ideal attestation rewards for an attestation.
 type IdealAttestationRewards struct {
 	EffectiveBalance phase0.Gwei
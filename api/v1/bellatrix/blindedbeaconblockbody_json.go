@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -84,13 +85,14 @@ func (b *BlindedBeaconBlockBody) unpack(data *blindedBeaconBlockBodyJSON) error
 	if data.Graffiti == "" {
 		return errors.New("graffiti missing")
 	}
-	graffiti, err := hex.DecodeString(strings.TrimPrefix(data.Graffiti, "0x"))
+	graffiti, err := codecs.DecodeGraffiti(data.Graffiti)
 	if err != nil {
 		return errors.Wrap(err, "invalid value for graffiti")
 	}
-	if len(graffiti) != phase0.GraffitiLength {
+	if len(graffiti) > phase0.GraffitiLength {
 		return errors.New("incorrect length for graffiti")
 	}
+	b.Graffiti = [32]byte{}
 	copy(b.Graffiti[:], graffiti)
 	if data.ProposerSlashings == nil {
 		return errors.New("proposer slashings missing")
@@ -0,0 +1,61 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// builderBidYAML is the spec representation of the struct.
+type builderBidYAML struct {
+	Header *bellatrix.ExecutionPayloadHeader `yaml:"header"`
+	Value  string                            `yaml:"value"`
+	Pubkey phase0.BLSPubKey                  `yaml:"pubkey"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (b *BuilderBid) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&builderBidYAML{
+		Header: b.Header,
+		Value:  b.Value.Dec(),
+		Pubkey: b.Pubkey,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (b *BuilderBid) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var unmarshaled builderBidJSON
+	if err := yaml.Unmarshal(input, &unmarshaled); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	marshaled, err := json.Marshal(&unmarshaled)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return b.UnmarshalJSON(marshaled)
+}
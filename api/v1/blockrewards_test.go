@@ -0,0 +1,101 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.blockRewardsJSON",
+		},
+		{
+			name:  "ProposerIndexMissing",
+			input: []byte(`{"total":"100","attestations":"60","sync_aggregate":"30","proposer_slashings":"6","attester_slashings":"4"}`),
+			err:   "proposer index missing",
+		},
+		{
+			name:  "ProposerIndexInvalid",
+			input: []byte(`{"proposer_index":"-1","total":"100","attestations":"60","sync_aggregate":"30","proposer_slashings":"6","attester_slashings":"4"}`),
+			err:   "invalid value for proposer index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "TotalMissing",
+			input: []byte(`{"proposer_index":"1","attestations":"60","sync_aggregate":"30","proposer_slashings":"6","attester_slashings":"4"}`),
+			err:   "total missing",
+		},
+		{
+			name:  "AttestationsMissing",
+			input: []byte(`{"proposer_index":"1","total":"100","sync_aggregate":"30","proposer_slashings":"6","attester_slashings":"4"}`),
+			err:   "attestations missing",
+		},
+		{
+			name:  "SyncAggregateMissing",
+			input: []byte(`{"proposer_index":"1","total":"100","attestations":"60","proposer_slashings":"6","attester_slashings":"4"}`),
+			err:   "sync aggregate missing",
+		},
+		{
+			name:  "ProposerSlashingsMissing",
+			input: []byte(`{"proposer_index":"1","total":"100","attestations":"60","sync_aggregate":"30","attester_slashings":"4"}`),
+			err:   "proposer slashings missing",
+		},
+		{
+			name:  "AttesterSlashingsMissing",
+			input: []byte(`{"proposer_index":"1","total":"100","attestations":"60","sync_aggregate":"30","proposer_slashings":"6"}`),
+			err:   "attester slashings missing",
+		},
+		{
+			name:  "AttesterSlashingsInvalid",
+			input: []byte(`{"proposer_index":"1","total":"100","attestations":"60","sync_aggregate":"30","proposer_slashings":"6","attester_slashings":"invalid"}`),
+			err:   "invalid value for attester slashings: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"proposer_index":"1","total":"100","attestations":"60","sync_aggregate":"30","proposer_slashings":"6","attester_slashings":"4"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.BlockRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
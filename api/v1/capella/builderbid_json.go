@@ -0,0 +1,68 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// builderBidJSON is the spec representation of the struct.
+type builderBidJSON struct {
+	Header *capella.ExecutionPayloadHeader `json:"header"`
+	Value  string                          `json:"value"`
+	Pubkey phase0.BLSPubKey                `json:"pubkey"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BuilderBid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&builderBidJSON{
+		Header: b.Header,
+		Value:  b.Value.Dec(),
+		Pubkey: b.Pubkey,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BuilderBid) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&builderBidJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	b.Header = &capella.ExecutionPayloadHeader{}
+	if err := b.Header.UnmarshalJSON(raw["header"]); err != nil {
+		return errors.Wrap(err, "header")
+	}
+
+	var value string
+	if err := json.Unmarshal(raw["value"], &value); err != nil {
+		return errors.Wrap(err, "value")
+	}
+	b.Value, err = uint256.FromDecimal(value)
+	if err != nil {
+		return errors.Wrap(err, "value")
+	}
+
+	if err := b.Pubkey.UnmarshalJSON(raw["pubkey"]); err != nil {
+		return errors.Wrap(err, "pubkey")
+	}
+
+	return nil
+}
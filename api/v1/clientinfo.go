@@ -0,0 +1,176 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ClientInfo is structured information about the implementation of a node,
+// obtained from its version string.
+type ClientInfo struct {
+	// Implementation is the name of the implementation in lower case, for
+	// example "lighthouse".
+	Implementation string `json:"implementation"`
+	// Version is the version of the implementation, for example "v5.1.3".
+	Version string `json:"version,omitempty"`
+	// Commit is the commit from which the implementation was built, if known.
+	Commit string `json:"commit,omitempty"`
+	// Platform is the platform on which the implementation is running, if known.
+	Platform string `json:"platform,omitempty"`
+	// Quirks are the known quirks of the implementation.
+	Quirks ClientQuirks `json:"quirks"`
+}
+
+// ClientQuirks are known behaviours of an implementation that differ from
+// those expected by the beacon API, and require workarounds by clients.
+type ClientQuirks struct {
+	// AltersProposalRANDAO is true if proposals can be returned with a RANDAO
+	// reveal other than that requested, as is the case for distributed
+	// validator middleware that decides proposals across its nodes.
+	AltersProposalRANDAO bool `json:"alters_proposal_randao,omitempty"`
+	// JSONOnlyEndpoints are endpoints that must be requested as JSON, as the
+	// implementation's SSZ responses for them cannot be used.
+	JSONOnlyEndpoints []string `json:"json_only_endpoints,omitempty"`
+}
+
+// knownQuirks are the quirks of known implementations.
+var knownQuirks = map[string]ClientQuirks{
+	"charon": {
+		AltersProposalRANDAO: true,
+	},
+}
+
+// knownImplementations are the implementations that can be identified in a
+// version string, which may be prefixed by an organisation.
+var knownImplementations = map[string]bool{
+	"charon":     true,
+	"grandine":   true,
+	"lighthouse": true,
+	"lodestar":   true,
+	"nimbus":     true,
+	"prysm":      true,
+	"teku":       true,
+}
+
+// ParseClientInfo parses a node version string, for example
+// "Lighthouse/v5.1.3-3058b96/x86_64-linux", into client information.
+func ParseClientInfo(version string) *ClientInfo {
+	info := &ClientInfo{}
+
+	version = strings.TrimSpace(version)
+	// Some implementations supply the platform in parentheses at the end of the string.
+	if start := strings.LastIndex(version, "("); start != -1 && strings.HasSuffix(version, ")") {
+		info.Platform = strings.TrimSpace(version[start+1 : len(version)-1])
+		version = strings.TrimSpace(version[:start])
+	}
+
+	parts := strings.Split(version, "/")
+	implementation, name := findImplementation(parts)
+	info.Implementation = name
+	parts = parts[implementation+1:]
+
+	if len(parts) > 0 {
+		info.Version, info.Commit = splitVersionCommit(parts[0])
+		parts = parts[1:]
+	}
+	platform := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if info.Commit == "" && isCommit(part) {
+			info.Commit = part
+
+			continue
+		}
+		platform = append(platform, part)
+	}
+	if info.Platform == "" {
+		info.Platform = strings.Join(platform, "/")
+	}
+
+	info.Quirks = knownQuirks[info.Implementation]
+
+	return info
+}
+
+// findImplementation finds the part of a version string that names the
+// implementation, returning its index and name.  If no known implementation is
+// found the first part is used.
+func findImplementation(parts []string) (int, string) {
+	for i, part := range parts {
+		if knownImplementations[strings.ToLower(part)] {
+			return i, strings.ToLower(part)
+		}
+	}
+	// Known implementations may also be embedded in a part, for example as
+	// "charon-v1.1.0".
+	for i, part := range parts {
+		for name := range knownImplementations {
+			if strings.Contains(strings.ToLower(part), name) {
+				return i, name
+			}
+		}
+	}
+
+	return 0, strings.ToLower(parts[0])
+}
+
+// splitVersionCommit splits a version such as "v5.1.3-3058b96" in to its version
+// and commit.
+func splitVersionCommit(input string) (string, string) {
+	segments := strings.Split(input, "-")
+	for i := len(segments) - 1; i > 0; i-- {
+		if isCommit(segments[i]) {
+			return strings.Join(segments[:i], "-"), segments[i]
+		}
+	}
+
+	return input, ""
+}
+
+// isCommit returns true if the input looks like an abbreviated or full commit hash.
+func isCommit(input string) bool {
+	if len(input) < 6 || len(input) > 40 {
+		return false
+	}
+	hasDigit := false
+	for _, c := range input {
+		switch {
+		case c >= '0' && c <= '9':
+			hasDigit = true
+		case c >= 'a' && c <= 'f':
+		default:
+			return false
+		}
+	}
+
+	return hasDigit
+}
+
+// UsesJSONOnly returns true if the endpoint must be requested as JSON.
+func (q *ClientQuirks) UsesJSONOnly(endpoint string) bool {
+	return slices.Contains(q.JSONOnlyEndpoints, endpoint)
+}
+
+// String returns a string version of the structure.
+func (c *ClientInfo) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
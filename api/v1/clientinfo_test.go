@@ -0,0 +1,136 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClientInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *api.ClientInfo
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			expected: &api.ClientInfo{},
+		},
+		{
+			name:  "Lighthouse",
+			input: "Lighthouse/v5.1.3-3058b96/x86_64-linux",
+			expected: &api.ClientInfo{
+				Implementation: "lighthouse",
+				Version:        "v5.1.3",
+				Commit:         "3058b96",
+				Platform:       "x86_64-linux",
+			},
+		},
+		{
+			name:  "Teku",
+			input: "teku/v24.4.0/linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-21",
+			expected: &api.ClientInfo{
+				Implementation: "teku",
+				Version:        "v24.4.0",
+				Platform:       "linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-21",
+			},
+		},
+		{
+			name:  "Prysm",
+			input: "Prysm/v5.0.3/5c8d8f7d1b4a6b1e (linux amd64)",
+			expected: &api.ClientInfo{
+				Implementation: "prysm",
+				Version:        "v5.0.3",
+				Commit:         "5c8d8f7d1b4a6b1e",
+				Platform:       "linux amd64",
+			},
+		},
+		{
+			name:  "Nimbus",
+			input: "Nimbus/v24.4.0-bc4a2b-stateofus",
+			expected: &api.ClientInfo{
+				Implementation: "nimbus",
+				Version:        "v24.4.0",
+				Commit:         "bc4a2b",
+			},
+		},
+		{
+			name:  "Lodestar",
+			input: "Lodestar/v1.18.1/5b23a8f",
+			expected: &api.ClientInfo{
+				Implementation: "lodestar",
+				Version:        "v1.18.1",
+				Commit:         "5b23a8f",
+			},
+		},
+		{
+			name:  "PreRelease",
+			input: "Lighthouse/v6.0.0-rc.1-1a2b3c4d",
+			expected: &api.ClientInfo{
+				Implementation: "lighthouse",
+				Version:        "v6.0.0-rc.1",
+				Commit:         "1a2b3c4d",
+			},
+		},
+		{
+			name:  "Charon",
+			input: "obolnetwork/charon/v1.1.0-8a2c1e3/linux-amd64",
+			expected: &api.ClientInfo{
+				Implementation: "charon",
+				Version:        "v1.1.0",
+				Commit:         "8a2c1e3",
+				Platform:       "linux-amd64",
+				Quirks: api.ClientQuirks{
+					AltersProposalRANDAO: true,
+				},
+			},
+		},
+		{
+			name:  "Embedded",
+			input: "charon-v1.1.0",
+			expected: &api.ClientInfo{
+				Implementation: "charon",
+				Quirks: api.ClientQuirks{
+					AltersProposalRANDAO: true,
+				},
+			},
+		},
+		{
+			name:  "Unknown",
+			input: "Other/1.2.3",
+			expected: &api.ClientInfo{
+				Implementation: "other",
+				Version:        "1.2.3",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, api.ParseClientInfo(test.input))
+		})
+	}
+}
+
+func TestClientQuirksUsesJSONOnly(t *testing.T) {
+	quirks := &api.ClientQuirks{
+		JSONOnlyEndpoints: []string{"/eth/v2/debug/beacon/states/head"},
+	}
+	require.True(t, quirks.UsesJSONOnly("/eth/v2/debug/beacon/states/head"))
+	require.False(t, quirks.UsesJSONOnly("/eth/v2/beacon/blocks/head"))
+}
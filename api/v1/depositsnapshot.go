@@ -0,0 +1,134 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DepositSnapshot is a snapshot of the deposit tree, as defined in EIP-4881.
+type DepositSnapshot struct {
+	Finalized            []phase0.Root `ssz-max:"32" ssz-size:"?,32"`
+	DepositRoot          phase0.Root   `ssz-size:"32"`
+	DepositCount         uint64
+	ExecutionBlockHash   phase0.Hash32 `ssz-size:"32"`
+	ExecutionBlockHeight uint64
+}
+
+// depositSnapshotJSON is the spec representation of the struct.
+type depositSnapshotJSON struct {
+	Finalized            []string `json:"finalized"`
+	DepositRoot          string   `json:"deposit_root"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DepositSnapshot) MarshalJSON() ([]byte, error) {
+	finalized := make([]string, len(d.Finalized))
+	for i := range d.Finalized {
+		finalized[i] = fmt.Sprintf("%#x", d.Finalized[i])
+	}
+
+	return json.Marshal(&depositSnapshotJSON{
+		Finalized:            finalized,
+		DepositRoot:          fmt.Sprintf("%#x", d.DepositRoot),
+		DepositCount:         strconv.FormatUint(d.DepositCount, 10),
+		ExecutionBlockHash:   fmt.Sprintf("%#x", d.ExecutionBlockHash),
+		ExecutionBlockHeight: strconv.FormatUint(d.ExecutionBlockHeight, 10),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DepositSnapshot) UnmarshalJSON(input []byte) error {
+	var data depositSnapshotJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Finalized == nil {
+		return errors.New("finalized missing")
+	}
+	d.Finalized = make([]phase0.Root, len(data.Finalized))
+	for i := range data.Finalized {
+		root, err := hex.DecodeString(strings.TrimPrefix(data.Finalized[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid value for finalized root %d", i))
+		}
+		if len(root) != rootLength {
+			return fmt.Errorf("incorrect length %d for finalized root %d", len(root), i)
+		}
+		copy(d.Finalized[i][:], root)
+	}
+
+	if data.DepositRoot == "" {
+		return errors.New("deposit root missing")
+	}
+	depositRoot, err := hex.DecodeString(strings.TrimPrefix(data.DepositRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for deposit root")
+	}
+	if len(depositRoot) != rootLength {
+		return fmt.Errorf("incorrect length %d for deposit root", len(depositRoot))
+	}
+	copy(d.DepositRoot[:], depositRoot)
+
+	if data.DepositCount == "" {
+		return errors.New("deposit count missing")
+	}
+	d.DepositCount, err = strconv.ParseUint(data.DepositCount, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for deposit count")
+	}
+
+	if data.ExecutionBlockHash == "" {
+		return errors.New("execution block hash missing")
+	}
+	executionBlockHash, err := hex.DecodeString(strings.TrimPrefix(data.ExecutionBlockHash, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for execution block hash")
+	}
+	if len(executionBlockHash) != rootLength {
+		return fmt.Errorf("incorrect length %d for execution block hash", len(executionBlockHash))
+	}
+	copy(d.ExecutionBlockHash[:], executionBlockHash)
+
+	if data.ExecutionBlockHeight == "" {
+		return errors.New("execution block height missing")
+	}
+	d.ExecutionBlockHeight, err = strconv.ParseUint(data.ExecutionBlockHeight, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for execution block height")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (d *DepositSnapshot) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
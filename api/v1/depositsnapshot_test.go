@@ -0,0 +1,142 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositSnapshotJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.depositSnapshotJSON",
+		},
+		{
+			name:  "FinalizedMissing",
+			input: []byte(`{"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+			err:   "finalized missing",
+		},
+		{
+			name:  "FinalizedInvalid",
+			input: []byte(`{"finalized":["invalid"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+			err:   "invalid value for finalized root 0: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "FinalizedShort",
+			input: []byte(`{"finalized":["0x0101"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+			err:   "incorrect length 2 for finalized root 0",
+		},
+		{
+			name:  "DepositRootMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+			err:   "deposit root missing",
+		},
+		{
+			name:  "DepositRootShort",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_root":"0x0303","deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+			err:   "incorrect length 2 for deposit root",
+		},
+		{
+			name:  "DepositCountMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+			err:   "deposit count missing",
+		},
+		{
+			name:  "DepositCountInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"-1","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+			err:   "invalid value for deposit count: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ExecutionBlockHashMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_height":"100"}`),
+			err:   "execution block hash missing",
+		},
+		{
+			name:  "ExecutionBlockHashShort",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_hash":"0x0404","execution_block_height":"100"}`),
+			err:   "incorrect length 2 for execution block hash",
+		},
+		{
+			name:  "ExecutionBlockHeightMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404"}`),
+			err:   "execution block height missing",
+		},
+		{
+			name:  "ExecutionBlockHeightInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"invalid"}`),
+			err:   "invalid value for execution block height: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "GoodEmptyFinalized",
+			input: []byte(`{"finalized":[],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"0","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.DepositSnapshot
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestDepositSnapshotSSZ(t *testing.T) {
+	var snapshot api.DepositSnapshot
+	require.NoError(t, json.Unmarshal([]byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0303030303030303030303030303030303030303030303030303030303030303","deposit_count":"5","execution_block_hash":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_height":"100"}`), &snapshot))
+
+	data, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, snapshot.SizeSSZ())
+	require.Len(t, data, 84+2*32)
+
+	var res api.DepositSnapshot
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, snapshot, res)
+
+	root1, err := snapshot.HashTreeRoot()
+	require.NoError(t, err)
+	root2, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root1, root2)
+
+	require.Error(t, res.UnmarshalSSZ(data[:83]))
+}
@@ -0,0 +1,183 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ENR is a decoded Ethereum node record, as defined in EIP-778.
+//
+// The record's signature is not verified.
+type ENR struct {
+	// Signature is the signature of the record.
+	Signature []byte
+	// Seq is the sequence number of the record.
+	Seq uint64
+	// Pairs are the key/value pairs of the record; values are the raw RLP
+	// string contents, or the full RLP encoding for values that are lists.
+	Pairs map[string][]byte
+}
+
+// ParseENR parses the textual form of an Ethereum node record.
+func ParseENR(input string) (*ENR, error) {
+	if !strings.HasPrefix(input, "enr:") {
+		return nil, errors.New("record must start with enr:")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(input[4:])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid base64 encoding")
+	}
+
+	list, isList, rest, err := rlpItem(data)
+	if err != nil {
+		return nil, err
+	}
+	if !isList || len(rest) != 0 {
+		return nil, errors.New("record is not a single list")
+	}
+
+	items := make([][]byte, 0)
+	for len(list) > 0 {
+		var item []byte
+		var itemIsList bool
+		start := list
+		item, itemIsList, list, err = rlpItem(list)
+		if err != nil {
+			return nil, err
+		}
+		if itemIsList {
+			item = start[:len(start)-len(list)]
+		}
+		items = append(items, item)
+	}
+	if len(items) < 2 || len(items)%2 != 0 {
+		return nil, fmt.Errorf("invalid number of record items %d", len(items))
+	}
+	if len(items[1]) > 8 {
+		return nil, errors.New("sequence number too large")
+	}
+
+	res := &ENR{
+		Signature: items[0],
+		Seq:       bigEndianUint(items[1]),
+		Pairs:     make(map[string][]byte, (len(items)-2)/2),
+	}
+	for i := 2; i < len(items); i += 2 {
+		res.Pairs[string(items[i])] = items[i+1]
+	}
+
+	return res, nil
+}
+
+// IP returns the IPv4 address of the record, if present.
+func (e *ENR) IP() (net.IP, bool) {
+	ip, exists := e.Pairs["ip"]
+	if !exists || len(ip) != net.IPv4len {
+		return nil, false
+	}
+
+	return net.IP(ip), true
+}
+
+// IP6 returns the IPv6 address of the record, if present.
+func (e *ENR) IP6() (net.IP, bool) {
+	ip, exists := e.Pairs["ip6"]
+	if !exists || len(ip) != net.IPv6len {
+		return nil, false
+	}
+
+	return net.IP(ip), true
+}
+
+// TCP returns the TCP port of the record, if present.
+func (e *ENR) TCP() (uint16, bool) {
+	return e.port("tcp")
+}
+
+// UDP returns the UDP port of the record, if present.
+func (e *ENR) UDP() (uint16, bool) {
+	return e.port("udp")
+}
+
+// Eth2 returns the eth2 field of the record, if present.
+func (e *ENR) Eth2() ([]byte, bool) {
+	eth2, exists := e.Pairs["eth2"]
+
+	return eth2, exists
+}
+
+func (e *ENR) port(key string) (uint16, bool) {
+	port, exists := e.Pairs[key]
+	if !exists || len(port) > 2 {
+		return 0, false
+	}
+
+	return uint16(bigEndianUint(port)), true
+}
+
+// rlpItem decodes the first RLP item in the input, returning its contents,
+// whether it is a list, and the remaining input.
+func rlpItem(input []byte) ([]byte, bool, []byte, error) {
+	if len(input) == 0 {
+		return nil, false, nil, errors.New("unexpected end of RLP input")
+	}
+
+	prefix := input[0]
+	var offset, length uint64
+	var isList bool
+	switch {
+	case prefix < 0x80:
+		return input[:1], false, input[1:], nil
+	case prefix <= 0xb7:
+		offset, length = 1, uint64(prefix-0x80)
+	case prefix <= 0xbf:
+		lenLen := uint64(prefix - 0xb7)
+		if uint64(len(input)) < 1+lenLen {
+			return nil, false, nil, errors.New("unexpected end of RLP input")
+		}
+		offset, length = 1+lenLen, bigEndianUint(input[1:1+lenLen])
+	case prefix <= 0xf7:
+		offset, length, isList = 1, uint64(prefix-0xc0), true
+	default:
+		lenLen := uint64(prefix - 0xf7)
+		if uint64(len(input)) < 1+lenLen {
+			return nil, false, nil, errors.New("unexpected end of RLP input")
+		}
+		offset, length, isList = 1+lenLen, bigEndianUint(input[1:1+lenLen]), true
+	}
+
+	if length > uint64(len(input))-offset {
+		return nil, false, nil, errors.New("RLP item larger than input")
+	}
+
+	return input[offset : offset+length], isList, input[offset+length:], nil
+}
+
+// bigEndianUint decodes up to 8 big-endian bytes as an unsigned integer.
+func bigEndianUint(input []byte) uint64 {
+	if len(input) > 8 {
+		input = input[len(input)-8:]
+	}
+	buf := make([]byte, 8)
+	copy(buf[8-len(input):], input)
+
+	return binary.BigEndian.Uint64(buf)
+}
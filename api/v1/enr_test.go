@@ -0,0 +1,77 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"net"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseENR(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		seq   uint64
+		ip    net.IP
+		udp   uint16
+		err   string
+	}{
+		{
+			name:  "NoPrefix",
+			input: "-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8",
+			err:   "record must start with enr:",
+		},
+		{
+			name:  "InvalidBase64",
+			input: "enr:!!!",
+			err:   "invalid base64 encoding: illegal base64 data at input byte 0",
+		},
+		{
+			name:  "Truncated",
+			input: "enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCC",
+			err:   "RLP item larger than input",
+		},
+		{
+			name:  "Good",
+			input: "enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8",
+			seq:   1,
+			ip:    net.IPv4(127, 0, 0, 1).To4(),
+			udp:   30303,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			record, err := api.ParseENR(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.seq, record.Seq)
+				ip, exists := record.IP()
+				require.True(t, exists)
+				require.Equal(t, test.ip, ip)
+				udp, exists := record.UDP()
+				require.True(t, exists)
+				require.Equal(t, test.udp, udp)
+				_, exists = record.TCP()
+				require.False(t, exists)
+				require.Equal(t, []byte("v4"), record.Pairs["id"])
+			}
+		})
+	}
+}
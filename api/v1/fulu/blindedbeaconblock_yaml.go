@@ -0,0 +1,57 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+)
+
+// blindedBeaconBlockYAML is the spec representation of the struct.
+type blindedBeaconBlockYAML struct {
+	Slot          uint64                  `yaml:"slot"`
+	ProposerIndex uint64                  `yaml:"proposer_index"`
+	ParentRoot    string                  `yaml:"parent_root"`
+	StateRoot     string                  `yaml:"state_root"`
+	Body          *BlindedBeaconBlockBody `yaml:"body"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (b *BlindedBeaconBlock) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&blindedBeaconBlockYAML{
+		Slot:          uint64(b.Slot),
+		ProposerIndex: uint64(b.ProposerIndex),
+		ParentRoot:    fmt.Sprintf("%#x", b.ParentRoot),
+		StateRoot:     fmt.Sprintf("%#x", b.StateRoot),
+		Body:          b.Body,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (b *BlindedBeaconBlock) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var data blindedBeaconBlockJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return err
+	}
+
+	return b.unpack(&data)
+}
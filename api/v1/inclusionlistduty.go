@@ -0,0 +1,115 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// InclusionListDuty is the data regarding which validators are members of the inclusion list committee in a slot.
+type InclusionListDuty struct {
+	// PubKey is the public key of the validator that should build an inclusion list.
+	PubKey phase0.BLSPubKey
+	// ValidatorIndex is the index of the validator that should build an inclusion list.
+	ValidatorIndex phase0.ValidatorIndex
+	// Slot is the slot in which the validator should build an inclusion list.
+	Slot phase0.Slot
+	// InclusionListCommitteeRoot is the root of the inclusion list committee for the slot.
+	InclusionListCommitteeRoot phase0.Root
+}
+
+// inclusionListDutyJSON is the spec representation of the struct.
+type inclusionListDutyJSON struct {
+	PubKey                     string `json:"pubkey"`
+	ValidatorIndex             string `json:"validator_index"`
+	Slot                       string `json:"slot"`
+	InclusionListCommitteeRoot string `json:"inclusion_list_committee_root"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *InclusionListDuty) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&inclusionListDutyJSON{
+		PubKey:                     fmt.Sprintf("%#x", i.PubKey),
+		ValidatorIndex:             fmt.Sprintf("%d", i.ValidatorIndex),
+		Slot:                       fmt.Sprintf("%d", i.Slot),
+		InclusionListCommitteeRoot: fmt.Sprintf("%#x", i.InclusionListCommitteeRoot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *InclusionListDuty) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var inclusionListDutyJSON inclusionListDutyJSON
+	if err = json.Unmarshal(input, &inclusionListDutyJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if inclusionListDutyJSON.PubKey == "" {
+		return errors.New("public key missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(inclusionListDutyJSON.PubKey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+	if len(pubKey) != publicKeyLength {
+		return errors.New("incorrect length for public key")
+	}
+	copy(i.PubKey[:], pubKey)
+	if inclusionListDutyJSON.ValidatorIndex == "" {
+		return errors.New("validator index missing")
+	}
+	validatorIndex, err := strconv.ParseUint(inclusionListDutyJSON.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validator index")
+	}
+	i.ValidatorIndex = phase0.ValidatorIndex(validatorIndex)
+	if inclusionListDutyJSON.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(inclusionListDutyJSON.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	i.Slot = phase0.Slot(slot)
+	if inclusionListDutyJSON.InclusionListCommitteeRoot == "" {
+		return errors.New("inclusion list committee root missing")
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(inclusionListDutyJSON.InclusionListCommitteeRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for inclusion list committee root")
+	}
+	if len(root) != rootLength {
+		return errors.New("incorrect length for inclusion list committee root")
+	}
+	copy(i.InclusionListCommitteeRoot[:], root)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (i *InclusionListDuty) String() string {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,116 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Multiaddr is the decoded form of a textual libp2p multiaddress such as
+// /ip4/10.0.20.8/tcp/9000/p2p/16Uiu2HAm....
+type Multiaddr struct {
+	// Network is the network protocol: one of "ip4", "ip6", "dns", "dns4" or "dns6".
+	Network string
+	// Host is the IP address or DNS name.
+	Host string
+	// Transport is the transport protocol: one of "tcp" or "udp".
+	Transport string
+	// Port is the transport port.
+	Port uint16
+	// QUIC is true if the address uses QUIC over UDP.
+	QUIC bool
+	// PeerID is the ID of the peer, if present.
+	PeerID string
+}
+
+// ParseMultiaddr parses a textual multiaddress.
+func ParseMultiaddr(input string) (*Multiaddr, error) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, errors.New("multiaddress must start with /")
+	}
+
+	res := &Multiaddr{}
+	parts := strings.Split(input[1:], "/")
+	for i := 0; i < len(parts); i++ {
+		protocol := parts[i]
+		switch protocol {
+		case "quic", "quic-v1":
+			res.QUIC = true
+
+			continue
+		case "ip4", "ip6", "dns", "dns4", "dns6", "tcp", "udp", "p2p":
+		default:
+			return nil, fmt.Errorf("unsupported protocol %q", protocol)
+		}
+
+		if i+1 >= len(parts) || parts[i+1] == "" {
+			return nil, fmt.Errorf("missing value for protocol %q", protocol)
+		}
+		i++
+		value := parts[i]
+
+		switch protocol {
+		case "ip4", "ip6":
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", value)
+			}
+			if (protocol == "ip4") != (ip.To4() != nil) {
+				return nil, fmt.Errorf("IP address %q does not match protocol %s", value, protocol)
+			}
+			res.Network = protocol
+			res.Host = value
+		case "dns", "dns4", "dns6":
+			res.Network = protocol
+			res.Host = value
+		case "tcp", "udp":
+			port, err := strconv.ParseUint(value, 10, 16)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid value for port")
+			}
+			res.Transport = protocol
+			res.Port = uint16(port)
+		case "p2p":
+			res.PeerID = value
+		}
+	}
+
+	if res.Network == "" {
+		return nil, errors.New("multiaddress has no network protocol")
+	}
+
+	return res, nil
+}
+
+// String returns the textual multiaddress.
+func (m *Multiaddr) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("/%s/%s", m.Network, m.Host))
+	if m.Transport != "" {
+		sb.WriteString(fmt.Sprintf("/%s/%d", m.Transport, m.Port))
+	}
+	if m.QUIC {
+		sb.WriteString("/quic-v1")
+	}
+	if m.PeerID != "" {
+		sb.WriteString(fmt.Sprintf("/p2p/%s", m.PeerID))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,101 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMultiaddr(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *api.Multiaddr
+		err      string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   "multiaddress must start with /",
+		},
+		{
+			name:  "UnsupportedProtocol",
+			input: "/ip4/10.0.20.8/sctp/9000",
+			err:   "unsupported protocol \"sctp\"",
+		},
+		{
+			name:  "MissingValue",
+			input: "/ip4/10.0.20.8/tcp",
+			err:   "missing value for protocol \"tcp\"",
+		},
+		{
+			name:  "InvalidIP",
+			input: "/ip4/invalid/tcp/9000",
+			err:   "invalid IP address \"invalid\"",
+		},
+		{
+			name:  "MismatchedIP",
+			input: "/ip4/::1/tcp/9000",
+			err:   "IP address \"::1\" does not match protocol ip4",
+		},
+		{
+			name:  "InvalidPort",
+			input: "/ip4/10.0.20.8/tcp/70000",
+			err:   "invalid value for port: strconv.ParseUint: parsing \"70000\": value out of range",
+		},
+		{
+			name:  "NoNetwork",
+			input: "/tcp/9000",
+			err:   "multiaddress has no network protocol",
+		},
+		{
+			name:  "TCP",
+			input: "/ip4/10.0.20.8/tcp/43402",
+			expected: &api.Multiaddr{
+				Network:   "ip4",
+				Host:      "10.0.20.8",
+				Transport: "tcp",
+				Port:      43402,
+			},
+		},
+		{
+			name:  "QUICWithPeer",
+			input: "/ip6/::1/udp/9001/quic-v1/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96",
+			expected: &api.Multiaddr{
+				Network:   "ip6",
+				Host:      "::1",
+				Transport: "udp",
+				Port:      9001,
+				QUIC:      true,
+				PeerID:    "16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := api.ParseMultiaddr(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+				require.Equal(t, test.input, res.String())
+			}
+		})
+	}
+}
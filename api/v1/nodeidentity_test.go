@@ -0,0 +1,166 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeIdentityJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.nodeIdentityJSON",
+		},
+		{
+			name:  "PeerIDMissing",
+			input: []byte(`{"enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/127.0.0.1/tcp/9000"],"discovery_addresses":["/ip4/127.0.0.1/udp/9000"],"metadata":{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x05"}}`),
+			err:   "peer ID missing",
+		},
+		{
+			name:  "ENRMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","p2p_addresses":["/ip4/127.0.0.1/tcp/9000"],"discovery_addresses":["/ip4/127.0.0.1/udp/9000"],"metadata":{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x05"}}`),
+			err:   "ENR missing",
+		},
+		{
+			name:  "P2PAddressesMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","discovery_addresses":["/ip4/127.0.0.1/udp/9000"],"metadata":{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x05"}}`),
+			err:   "p2p addresses missing",
+		},
+		{
+			name:  "DiscoveryAddressesMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/127.0.0.1/tcp/9000"],"metadata":{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x05"}}`),
+			err:   "discovery addresses missing",
+		},
+		{
+			name:  "MetadataMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/127.0.0.1/tcp/9000"],"discovery_addresses":["/ip4/127.0.0.1/udp/9000"]}`),
+			err:   "metadata missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/127.0.0.1/tcp/9000"],"discovery_addresses":["/ip4/127.0.0.1/udp/9000"],"metadata":{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x05"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.NodeIdentity
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestNodeMetadataJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SeqNumberMissing",
+			input: []byte(`{"attnets":"0x0100000000000080"}`),
+			err:   "seq number missing",
+		},
+		{
+			name:  "SeqNumberInvalid",
+			input: []byte(`{"seq_number":"-1","attnets":"0x0100000000000080"}`),
+			err:   "invalid value for seq number: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "AttnetsMissing",
+			input: []byte(`{"seq_number":"4"}`),
+			err:   "attnets missing",
+		},
+		{
+			name:  "AttnetsShort",
+			input: []byte(`{"seq_number":"4","attnets":"0x01000000000000"}`),
+			err:   "incorrect length 7 for attnets",
+		},
+		{
+			name:  "SyncnetsInvalid",
+			input: []byte(`{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x10"}`),
+			err:   "invalid value for syncnets",
+		},
+		{
+			name:  "CustodyGroupCountInvalid",
+			input: []byte(`{"seq_number":"4","attnets":"0x0100000000000080","custody_group_count":"invalid"}`),
+			err:   "invalid value for custody group count: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "GoodNoSyncnets",
+			input: []byte(`{"seq_number":"4","attnets":"0x0100000000000080"}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x05"}`),
+		},
+		{
+			name:  "GoodCustodyGroupCount",
+			input: []byte(`{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x05","custody_group_count":"8"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.NodeMetadata
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestNodeMetadataBitfields(t *testing.T) {
+	var res api.NodeMetadata
+	require.NoError(t, json.Unmarshal([]byte(`{"seq_number":"4","attnets":"0x0100000000000080","syncnets":"0x05"}`), &res))
+
+	require.Equal(t, uint64(2), res.Attnets.Count())
+	require.True(t, res.Attnets.BitAt(0))
+	require.True(t, res.Attnets.BitAt(63))
+	require.Equal(t, []int{0, 63}, res.Attnets.BitIndices())
+	require.True(t, res.Syncnets.BitAt(0))
+	require.False(t, res.Syncnets.BitAt(1))
+	require.True(t, res.Syncnets.BitAt(2))
+}
@@ -96,3 +96,21 @@ func (p *Peer) String() string {
 
 	return string(data)
 }
+
+// ENRRecord returns the decoded Ethereum node record of the peer.
+func (p *Peer) ENRRecord() (*ENR, error) {
+	if p.Enr == "" {
+		return nil, errors.New("peer has no ENR")
+	}
+
+	return ParseENR(p.Enr)
+}
+
+// Multiaddr returns the decoded last seen libp2p address of the peer.
+func (p *Peer) Multiaddr() (*Multiaddr, error) {
+	if p.LastSeenP2PAddress == "" {
+		return nil, errors.New("peer has no last seen address")
+	}
+
+	return ParseMultiaddr(p.LastSeenP2PAddress)
+}
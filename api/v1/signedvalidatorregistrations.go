@@ -0,0 +1,109 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// signedValidatorRegistrationSize is the SSZ size of a signed validator registration.
+const signedValidatorRegistrationSize = 180
+
+// maxValidatorRegistrations is the maximum number of registrations in a list,
+// given by VALIDATOR_REGISTRY_LIMIT.
+const maxValidatorRegistrations = 1099511627776
+
+// SignedValidatorRegistrations is a list of signed validator registrations,
+// as submitted to the register_validator endpoint.
+type SignedValidatorRegistrations []*SignedValidatorRegistration
+
+// MarshalSSZ ssz marshals the SignedValidatorRegistrations object.
+func (s SignedValidatorRegistrations) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+// MarshalSSZTo ssz marshals the SignedValidatorRegistrations object to a target array.
+func (s SignedValidatorRegistrations) MarshalSSZTo(buf []byte) ([]byte, error) {
+	if uint64(len(s)) > maxValidatorRegistrations {
+		return nil, ssz.ErrListTooBig
+	}
+
+	dst := buf
+	var err error
+	for i := range s {
+		if s[i] == nil {
+			s[i] = new(SignedValidatorRegistration)
+		}
+		if dst, err = s[i].MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+
+	return dst, nil
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedValidatorRegistrations object.
+func (s *SignedValidatorRegistrations) UnmarshalSSZ(buf []byte) error {
+	num, err := ssz.DivideInt2(len(buf), signedValidatorRegistrationSize, maxValidatorRegistrations)
+	if err != nil {
+		return err
+	}
+
+	registrations := make(SignedValidatorRegistrations, num)
+	for i := range registrations {
+		registrations[i] = new(SignedValidatorRegistration)
+		if err := registrations[i].UnmarshalSSZ(buf[i*signedValidatorRegistrationSize : (i+1)*signedValidatorRegistrationSize]); err != nil {
+			return err
+		}
+	}
+	*s = registrations
+
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedValidatorRegistrations object.
+func (s SignedValidatorRegistrations) SizeSSZ() int {
+	return len(s) * signedValidatorRegistrationSize
+}
+
+// HashTreeRoot ssz hashes the SignedValidatorRegistrations object.
+func (s SignedValidatorRegistrations) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedValidatorRegistrations object with a hasher.
+func (s SignedValidatorRegistrations) HashTreeRootWith(hh ssz.HashWalker) error {
+	num := uint64(len(s))
+	if num > maxValidatorRegistrations {
+		return ssz.ErrIncorrectListSize
+	}
+
+	indx := hh.Index()
+	for i := range s {
+		if s[i] == nil {
+			s[i] = new(SignedValidatorRegistration)
+		}
+		if err := s[i].HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+	hh.MerkleizeWithMixin(indx, num, maxValidatorRegistrations)
+
+	return nil
+}
+
+// GetTree ssz hashes the SignedValidatorRegistrations object.
+func (s SignedValidatorRegistrations) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedValidatorRegistrationsSSZ(t *testing.T) {
+	registrations := api.SignedValidatorRegistrations{
+		{
+			Message: &api.ValidatorRegistration{
+				FeeRecipient: bellatrix.ExecutionAddress{0x01},
+				GasLimit:     30000000,
+				Pubkey:       phase0.BLSPubKey{0x02},
+			},
+			Signature: phase0.BLSSignature{0x03},
+		},
+		{
+			Message: &api.ValidatorRegistration{
+				FeeRecipient: bellatrix.ExecutionAddress{0x04},
+				GasLimit:     36000000,
+				Pubkey:       phase0.BLSPubKey{0x05},
+			},
+			Signature: phase0.BLSSignature{0x06},
+		},
+	}
+
+	data, err := registrations.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, registrations.SizeSSZ())
+
+	// The list encoding is the concatenation of its items.
+	first, err := registrations[0].MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, first, data[:len(first)])
+
+	var decoded api.SignedValidatorRegistrations
+	require.NoError(t, decoded.UnmarshalSSZ(data))
+	require.Equal(t, registrations, decoded)
+
+	root, err := registrations.HashTreeRoot()
+	require.NoError(t, err)
+	decodedRoot, err := decoded.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, decodedRoot)
+
+	var empty api.SignedValidatorRegistrations
+	emptyRoot, err := empty.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, root, emptyRoot)
+
+	require.EqualError(t, decoded.UnmarshalSSZ(data[:len(data)-1]), "failed to divide int 359 by 180")
+}
@@ -0,0 +1,211 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SpecConfig provides typed access to the values returned by Spec().
+type SpecConfig struct {
+	data map[string]any
+}
+
+// NewSpecConfig creates a spec config from the values returned by Spec().
+func NewSpecConfig(data map[string]any) *SpecConfig {
+	return &SpecConfig{
+		data: data,
+	}
+}
+
+// Has returns true if the spec config contains the given key.
+func (c *SpecConfig) Has(key string) bool {
+	_, exists := c.data[key]
+
+	return exists
+}
+
+// Uint64 returns the given value as an unsigned integer.
+func (c *SpecConfig) Uint64(key string) (uint64, error) {
+	value, exists := c.data[key]
+	if !exists {
+		return 0, fmt.Errorf("%s missing", key)
+	}
+
+	switch v := value.(type) {
+	case uint64:
+		return v, nil
+	case int:
+		if v < 0 {
+			return 0, fmt.Errorf("invalid value for %s", key)
+		}
+
+		return uint64(v), nil
+	case time.Duration:
+		// Durations are provided in seconds.
+		return uint64(v / time.Second), nil
+	case string:
+		res, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return 0, errors.Wrap(err, fmt.Sprintf("invalid value for %s", key))
+		}
+
+		return res, nil
+	default:
+		return 0, fmt.Errorf("invalid type %T for %s", value, key)
+	}
+}
+
+// Duration returns the given value as a duration.  Integer values are
+// considered to be a number of seconds.
+func (c *SpecConfig) Duration(key string) (time.Duration, error) {
+	if value, isDuration := c.data[key].(time.Duration); isDuration {
+		return value, nil
+	}
+
+	seconds, err := c.Uint64(key)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// Gwei returns the given value as an amount of Gwei.
+func (c *SpecConfig) Gwei(key string) (phase0.Gwei, error) {
+	value, err := c.Uint64(key)
+	if err != nil {
+		return 0, err
+	}
+
+	return phase0.Gwei(value), nil
+}
+
+// Epoch returns the given value as an epoch.
+func (c *SpecConfig) Epoch(key string) (phase0.Epoch, error) {
+	value, err := c.Uint64(key)
+	if err != nil {
+		return 0, err
+	}
+
+	return phase0.Epoch(value), nil
+}
+
+// DomainType returns the given value as a domain type.
+func (c *SpecConfig) DomainType(key string) (phase0.DomainType, error) {
+	var res phase0.DomainType
+	data, err := c.bytes(key)
+	if err != nil {
+		return res, err
+	}
+	if len(data) != len(res) {
+		return res, fmt.Errorf("incorrect length %d for %s", len(data), key)
+	}
+	copy(res[:], data)
+
+	return res, nil
+}
+
+// Version returns the given value as a fork version.
+func (c *SpecConfig) Version(key string) (phase0.Version, error) {
+	var res phase0.Version
+	data, err := c.bytes(key)
+	if err != nil {
+		return res, err
+	}
+	if len(data) != len(res) {
+		return res, fmt.Errorf("incorrect length %d for %s", len(data), key)
+	}
+	copy(res[:], data)
+
+	return res, nil
+}
+
+// SecondsPerSlot returns the duration of a slot.
+func (c *SpecConfig) SecondsPerSlot() (time.Duration, error) {
+	return c.Duration("SECONDS_PER_SLOT")
+}
+
+// SlotsPerEpoch returns the number of slots in an epoch.
+func (c *SpecConfig) SlotsPerEpoch() (uint64, error) {
+	return c.Uint64("SLOTS_PER_EPOCH")
+}
+
+// MaxEffectiveBalance returns the maximum effective balance of a validator prior to Electra.
+func (c *SpecConfig) MaxEffectiveBalance() (phase0.Gwei, error) {
+	return c.Gwei("MAX_EFFECTIVE_BALANCE")
+}
+
+// MaxEffectiveBalanceElectra returns the maximum effective balance of a validator from Electra.
+func (c *SpecConfig) MaxEffectiveBalanceElectra() (phase0.Gwei, error) {
+	return c.Gwei("MAX_EFFECTIVE_BALANCE_ELECTRA")
+}
+
+// MinActivationBalance returns the minimum balance for a validator to become active from Electra.
+func (c *SpecConfig) MinActivationBalance() (phase0.Gwei, error) {
+	return c.Gwei("MIN_ACTIVATION_BALANCE")
+}
+
+// ShardCommitteePeriod returns the number of epochs a validator must be active before it can exit.
+func (c *SpecConfig) ShardCommitteePeriod() (uint64, error) {
+	return c.Uint64("SHARD_COMMITTEE_PERIOD")
+}
+
+// GenesisForkVersion returns the genesis fork version.
+func (c *SpecConfig) GenesisForkVersion() (phase0.Version, error) {
+	return c.Version("GENESIS_FORK_VERSION")
+}
+
+// ForkEpoch returns the epoch of the named fork, for example "ELECTRA".
+func (c *SpecConfig) ForkEpoch(fork string) (phase0.Epoch, error) {
+	return c.Epoch(strings.ToUpper(fork) + "_FORK_EPOCH")
+}
+
+// ForkVersion returns the version of the named fork, for example "ELECTRA".
+func (c *SpecConfig) ForkVersion(fork string) (phase0.Version, error) {
+	return c.Version(strings.ToUpper(fork) + "_FORK_VERSION")
+}
+
+// bytes returns the given value as a byte slice.
+func (c *SpecConfig) bytes(key string) ([]byte, error) {
+	value, exists := c.data[key]
+	if !exists {
+		return nil, fmt.Errorf("%s missing", key)
+	}
+
+	switch v := value.(type) {
+	case phase0.DomainType:
+		return v[:], nil
+	case phase0.Version:
+		return v[:], nil
+	case []byte:
+		return v, nil
+	case string:
+		res, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid value for %s", key))
+		}
+
+		return res, nil
+	default:
+		return nil, fmt.Errorf("invalid type %T for %s", value, key)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecConfig(t *testing.T) {
+	config := apiv1.NewSpecConfig(map[string]any{
+		"SECONDS_PER_SLOT":              12 * time.Second,
+		"SLOTS_PER_EPOCH":               uint64(32),
+		"MAX_EFFECTIVE_BALANCE":         uint64(32000000000),
+		"MAX_EFFECTIVE_BALANCE_ELECTRA": "2048000000000",
+		"MIN_ACTIVATION_BALANCE":        uint64(32000000000),
+		"SHARD_COMMITTEE_PERIOD":        "256",
+		"GENESIS_FORK_VERSION":          phase0.Version{0x00, 0x00, 0x10, 0x20},
+		"ELECTRA_FORK_VERSION":          "0x05001020",
+		"ELECTRA_FORK_EPOCH":            uint64(222464),
+		"DOMAIN_BEACON_ATTESTER":        phase0.DomainType{0x01, 0x00, 0x00, 0x00},
+		"DOMAIN_SHORT":                  []byte{0x01},
+		"GENESIS_DELAY":                 uint64(300),
+		"CONFIG_NAME":                   "hoodi",
+		"NEGATIVE":                      -1,
+	})
+
+	require.True(t, config.Has("SLOTS_PER_EPOCH"))
+	require.False(t, config.Has("MISSING"))
+
+	secondsPerSlot, err := config.SecondsPerSlot()
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Second, secondsPerSlot)
+
+	genesisDelay, err := config.Duration("GENESIS_DELAY")
+	require.NoError(t, err)
+	require.Equal(t, 300*time.Second, genesisDelay)
+
+	slotsPerEpoch, err := config.SlotsPerEpoch()
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), slotsPerEpoch)
+
+	maxEffectiveBalance, err := config.MaxEffectiveBalance()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(32000000000), maxEffectiveBalance)
+
+	maxEffectiveBalanceElectra, err := config.MaxEffectiveBalanceElectra()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(2048000000000), maxEffectiveBalanceElectra)
+
+	minActivationBalance, err := config.MinActivationBalance()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(32000000000), minActivationBalance)
+
+	shardCommitteePeriod, err := config.ShardCommitteePeriod()
+	require.NoError(t, err)
+	require.Equal(t, uint64(256), shardCommitteePeriod)
+
+	genesisForkVersion, err := config.GenesisForkVersion()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x10, 0x20}, genesisForkVersion)
+
+	electraForkVersion, err := config.ForkVersion("electra")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Version{0x05, 0x00, 0x10, 0x20}, electraForkVersion)
+
+	electraForkEpoch, err := config.ForkEpoch("ELECTRA")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(222464), electraForkEpoch)
+
+	domainType, err := config.DomainType("DOMAIN_BEACON_ATTESTER")
+	require.NoError(t, err)
+	require.Equal(t, phase0.DomainType{0x01, 0x00, 0x00, 0x00}, domainType)
+
+	_, err = config.DomainType("DOMAIN_SHORT")
+	require.EqualError(t, err, "incorrect length 1 for DOMAIN_SHORT")
+
+	_, err = config.ForkEpoch("FULU")
+	require.EqualError(t, err, "FULU_FORK_EPOCH missing")
+
+	_, err = config.Uint64("CONFIG_NAME")
+	require.EqualError(t, err, "invalid value for CONFIG_NAME: strconv.ParseUint: parsing \"hoodi\": invalid syntax")
+
+	_, err = config.Uint64("NEGATIVE")
+	require.EqualError(t, err, "invalid value for NEGATIVE")
+
+	_, err = config.Version("SLOTS_PER_EPOCH")
+	require.EqualError(t, err, "invalid type uint64 for SLOTS_PER_EPOCH")
+}
@@ -0,0 +1,91 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommitteeSelectionJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.syncCommitteeSelectionJSON",
+		},
+		{
+			name:  "ValidatorIndexMissing",
+			input: []byte(`{"slot":"2","subcommittee_index":"3","selection_proof":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "validator index missing",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"validator_index":"1","subcommittee_index":"3","selection_proof":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SubcommitteeIndexMissing",
+			input: []byte(`{"validator_index":"1","slot":"2","selection_proof":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "subcommittee index missing",
+		},
+		{
+			name:  "SubcommitteeIndexInvalid",
+			input: []byte(`{"validator_index":"1","slot":"2","subcommittee_index":"-1","selection_proof":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid value for subcommittee index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "SelectionProofMissing",
+			input: []byte(`{"validator_index":"1","slot":"2","subcommittee_index":"3"}`),
+			err:   "selection proof missing",
+		},
+		{
+			name:  "SelectionProofShort",
+			input: []byte(`{"validator_index":"1","slot":"2","subcommittee_index":"3","selection_proof":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e"}`),
+			err:   "incorrect length 95 for selection proof",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"validator_index":"1","slot":"2","subcommittee_index":"3","selection_proof":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.SyncCommitteeSelection
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
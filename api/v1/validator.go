@@ -96,3 +96,57 @@ func (v *Validator) String() string {
 func (v *Validator) PubKey(_ context.Context) (phase0.BLSPubKey, error) {
 	return v.Validator.PublicKey, nil
 }
+
+// HasCompoundingCredentials returns true if the validator has 0x02 withdrawal credentials.
+func (v *Validator) HasCompoundingCredentials() bool {
+	return v.Validator != nil && v.Validator.HasCompoundingCredentials()
+}
+
+// MaxEffectiveBalance returns the maximum effective balance of the validator as
+// defined by EIP-7251: validators with compounding withdrawal credentials can
+// reach MAX_EFFECTIVE_BALANCE_ELECTRA, all others are capped at
+// MIN_ACTIVATION_BALANCE.  If the spec predates Electra then
+// MAX_EFFECTIVE_BALANCE is returned.
+func (v *Validator) MaxEffectiveBalance(spec *SpecConfig) (phase0.Gwei, error) {
+	if !spec.Has("MAX_EFFECTIVE_BALANCE_ELECTRA") {
+		return spec.MaxEffectiveBalance()
+	}
+
+	if v.HasCompoundingCredentials() {
+		return spec.MaxEffectiveBalanceElectra()
+	}
+
+	return spec.MinActivationBalance()
+}
+
+// IsEligibleForConsolidation returns true if the validator can be the source of
+// a consolidation request at the given epoch.  This requires the validator to
+// have execution withdrawal credentials, to be active and not exiting, and to
+// have been active for at least SHARD_COMMITTEE_PERIOD epochs.
+//
+// Note that this does not check for pending partial withdrawals, which also
+// prevent consolidation but are only available from the beacon state.
+func (v *Validator) IsEligibleForConsolidation(spec *SpecConfig,
+	currentEpoch phase0.Epoch,
+	farFutureEpoch phase0.Epoch,
+) (
+	bool,
+	error,
+) {
+	if v.Validator == nil {
+		return false, nil
+	}
+
+	if !v.Validator.HasExecutionWithdrawalCredentials() ||
+		!v.Validator.IsActive(currentEpoch) ||
+		v.Validator.ExitEpoch != farFutureEpoch {
+		return false, nil
+	}
+
+	shardCommitteePeriod, err := spec.ShardCommitteePeriod()
+	if err != nil {
+		return false, err
+	}
+
+	return uint64(currentEpoch) >= uint64(v.Validator.ActivationEpoch)+shardCommitteePeriod, nil
+}
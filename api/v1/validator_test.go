@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	require "github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/assert"
 )
@@ -114,3 +115,127 @@ func TestValidatorJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorMaxEffectiveBalance(t *testing.T) {
+	electraSpec := api.NewSpecConfig(map[string]any{
+		"MAX_EFFECTIVE_BALANCE":         uint64(32000000000),
+		"MIN_ACTIVATION_BALANCE":        uint64(32000000000),
+		"MAX_EFFECTIVE_BALANCE_ELECTRA": uint64(2048000000000),
+	})
+	denebSpec := api.NewSpecConfig(map[string]any{
+		"MAX_EFFECTIVE_BALANCE": uint64(31000000000),
+	})
+
+	tests := []struct {
+		name        string
+		spec        *api.SpecConfig
+		credentials byte
+		expected    phase0.Gwei
+		err         string
+	}{
+		{
+			name:        "ETH1",
+			spec:        electraSpec,
+			credentials: 0x01,
+			expected:    32000000000,
+		},
+		{
+			name:        "Compounding",
+			spec:        electraSpec,
+			credentials: 0x02,
+			expected:    2048000000000,
+		},
+		{
+			name:        "PreElectra",
+			spec:        denebSpec,
+			credentials: 0x02,
+			expected:    31000000000,
+		},
+		{
+			name:        "Missing",
+			spec:        api.NewSpecConfig(map[string]any{"MAX_EFFECTIVE_BALANCE_ELECTRA": uint64(2048000000000)}),
+			credentials: 0x01,
+			err:         "MIN_ACTIVATION_BALANCE missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := &api.Validator{
+				Validator: &phase0.Validator{
+					WithdrawalCredentials: append([]byte{test.credentials}, make([]byte, 31)...),
+				},
+			}
+			res, err := validator.MaxEffectiveBalance(test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestValidatorIsEligibleForConsolidation(t *testing.T) {
+	spec := api.NewSpecConfig(map[string]any{
+		"SHARD_COMMITTEE_PERIOD": uint64(256),
+	})
+	farFutureEpoch := phase0.Epoch(0xffffffffffffffff)
+
+	tests := []struct {
+		name         string
+		validator    *phase0.Validator
+		currentEpoch phase0.Epoch
+		expected     bool
+	}{
+		{
+			name:         "Missing",
+			currentEpoch: 1000,
+		},
+		{
+			name: "BLSCredentials",
+			validator: &phase0.Validator{
+				WithdrawalCredentials: make([]byte, 32),
+				ExitEpoch:             farFutureEpoch,
+			},
+			currentEpoch: 1000,
+		},
+		{
+			name: "Exiting",
+			validator: &phase0.Validator{
+				WithdrawalCredentials: append([]byte{0x01}, make([]byte, 31)...),
+				ExitEpoch:             2000,
+			},
+			currentEpoch: 1000,
+		},
+		{
+			name: "TooRecent",
+			validator: &phase0.Validator{
+				WithdrawalCredentials: append([]byte{0x01}, make([]byte, 31)...),
+				ActivationEpoch:       800,
+				ExitEpoch:             farFutureEpoch,
+			},
+			currentEpoch: 1000,
+		},
+		{
+			name: "Good",
+			validator: &phase0.Validator{
+				WithdrawalCredentials: append([]byte{0x02}, make([]byte, 31)...),
+				ActivationEpoch:       744,
+				ExitEpoch:             farFutureEpoch,
+			},
+			currentEpoch: 1000,
+			expected:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := &api.Validator{Validator: test.validator}
+			res, err := validator.IsEligibleForConsolidation(spec, test.currentEpoch, farFutureEpoch)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ValidatorIdentity is the identity of a validator, without its full state.
+type ValidatorIdentity struct {
+	Index           phase0.ValidatorIndex
+	PubKey          phase0.BLSPubKey
+	ActivationEpoch phase0.Epoch
+}
+
+// validatorIdentityJSON is the standard API representation of the struct.
+type validatorIdentityJSON struct {
+	Index           string `json:"index"`
+	PubKey          string `json:"pubkey"`
+	ActivationEpoch string `json:"activation_epoch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *ValidatorIdentity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&validatorIdentityJSON{
+		Index:           fmt.Sprintf("%d", v.Index),
+		PubKey:          fmt.Sprintf("%#x", v.PubKey),
+		ActivationEpoch: fmt.Sprintf("%d", v.ActivationEpoch),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ValidatorIdentity) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var validatorIdentityJSON validatorIdentityJSON
+	if err = json.Unmarshal(input, &validatorIdentityJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if validatorIdentityJSON.Index == "" {
+		return errors.New("index missing")
+	}
+	index, err := strconv.ParseUint(validatorIdentityJSON.Index, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for index")
+	}
+	v.Index = phase0.ValidatorIndex(index)
+	if validatorIdentityJSON.PubKey == "" {
+		return errors.New("public key missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(validatorIdentityJSON.PubKey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+	if len(pubKey) != publicKeyLength {
+		return fmt.Errorf("incorrect length %d for public key", len(pubKey))
+	}
+	copy(v.PubKey[:], pubKey)
+	if validatorIdentityJSON.ActivationEpoch == "" {
+		return errors.New("activation epoch missing")
+	}
+	activationEpoch, err := strconv.ParseUint(validatorIdentityJSON.ActivationEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for activation epoch")
+	}
+	v.ActivationEpoch = phase0.Epoch(activationEpoch)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (v *ValidatorIdentity) String() string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
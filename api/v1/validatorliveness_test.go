@@ -0,0 +1,90 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestValidatorLivenessJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.validatorLivenessJSON",
+		},
+		{
+			name:  "IndexMissing",
+			input: []byte(`{"is_live":true}`),
+			err:   "index missing",
+		},
+		{
+			name:  "IndexWrongType",
+			input: []byte(`{"index":true,"is_live":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorLivenessJSON.index of type string",
+		},
+		{
+			name:  "IndexInvalid",
+			input: []byte(`{"index":"-1","is_live":true}`),
+			err:   "invalid value for index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "IsLiveMissing",
+			input: []byte(`{"index":"1"}`),
+			err:   "is live missing",
+		},
+		{
+			name:  "IsLiveWrongType",
+			input: []byte(`{"index":"1","is_live":"true"}`),
+			err:   "invalid JSON: json: cannot unmarshal string into Go struct field validatorLivenessJSON.is_live of type bool",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"index":"1","is_live":true}`),
+		},
+		{
+			name:  "GoodNotLive",
+			input: []byte(`{"index":"1","is_live":false}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ValidatorLiveness
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
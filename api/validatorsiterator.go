@@ -0,0 +1,171 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// defaultValidatorsPageSize is the page size used if none is supplied.
+const defaultValidatorsPageSize = 1000
+
+// ValidatorsFunc fetches validators.
+type ValidatorsFunc func(ctx context.Context,
+	opts *ValidatorsOpts,
+) (
+	*Response[map[phase0.ValidatorIndex]*apiv1.Validator],
+	error,
+)
+
+// ValidatorsIterator iterates over validators a page at a time, so that large
+// validator sets do not need to be obtained in a single response.
+//
+// If the iterator is for specific indices or public keys they are requested a
+// page at a time.  Otherwise, pages are contiguous ranges of validator indices,
+// and iteration ends when a page is not full.
+type ValidatorsIterator struct {
+	validators ValidatorsFunc
+	opts       *ValidatorsIteratorOpts
+	pageSize   int
+	// offset is the position of the next page, in the indices and public keys
+	// if supplied, otherwise in the validator registry.
+	offset   int
+	states   map[apiv1.ValidatorState]struct{}
+	page     *Response[map[phase0.ValidatorIndex]*apiv1.Validator]
+	finished bool
+	err      error
+}
+
+// NewValidatorsIterator creates an iterator that uses the supplied function to
+// fetch each page of validators.
+func NewValidatorsIterator(validators ValidatorsFunc, opts *ValidatorsIteratorOpts) *ValidatorsIterator {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultValidatorsPageSize
+	}
+
+	states := make(map[apiv1.ValidatorState]struct{}, len(opts.ValidatorStates))
+	for _, state := range opts.ValidatorStates {
+		states[state] = struct{}{}
+	}
+
+	return &ValidatorsIterator{
+		validators: validators,
+		opts:       opts,
+		pageSize:   pageSize,
+		states:     states,
+	}
+}
+
+// Next fetches the next page of validators.  It returns false when there are no
+// more validators or an error has occurred, in which case it is available from
+// Err.  Pages with no matching validators are skipped.
+func (i *ValidatorsIterator) Next(ctx context.Context) bool {
+	for !i.finished && i.err == nil {
+		var page *Response[map[phase0.ValidatorIndex]*apiv1.Validator]
+		if len(i.opts.Indices) > 0 || len(i.opts.PubKeys) > 0 {
+			page, i.err = i.nextRequestedPage(ctx)
+		} else {
+			page, i.err = i.nextRegistryPage(ctx)
+		}
+		if i.err != nil {
+			i.page = nil
+
+			return false
+		}
+		if page != nil && len(page.Data) > 0 {
+			i.page = page
+
+			return true
+		}
+	}
+	i.page = nil
+
+	return false
+}
+
+// nextRequestedPage fetches the next page of requested indices and public keys.
+func (i *ValidatorsIterator) nextRequestedPage(ctx context.Context) (*Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+	opts := &ValidatorsOpts{
+		Common:          i.opts.Common,
+		State:           i.opts.State,
+		ValidatorStates: i.opts.ValidatorStates,
+	}
+
+	// Indices come first, followed by public keys.
+	end := i.offset + i.pageSize
+	if i.offset < len(i.opts.Indices) {
+		opts.Indices = i.opts.Indices[i.offset:min(end, len(i.opts.Indices))]
+	}
+	if end > len(i.opts.Indices) {
+		start := max(i.offset, len(i.opts.Indices)) - len(i.opts.Indices)
+		opts.PubKeys = i.opts.PubKeys[start:min(end-len(i.opts.Indices), len(i.opts.PubKeys))]
+	}
+	i.offset = end
+	if i.offset >= len(i.opts.Indices)+len(i.opts.PubKeys) {
+		i.finished = true
+	}
+
+	return i.validators(ctx, opts)
+}
+
+// nextRegistryPage fetches the next page of the validator registry.
+func (i *ValidatorsIterator) nextRegistryPage(ctx context.Context) (*Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+	opts := &ValidatorsOpts{
+		Common:  i.opts.Common,
+		State:   i.opts.State,
+		Indices: make([]phase0.ValidatorIndex, 0, i.pageSize),
+	}
+	for index := i.offset; index < i.offset+i.pageSize; index++ {
+		opts.Indices = append(opts.Indices, phase0.ValidatorIndex(index))
+	}
+	i.offset += i.pageSize
+
+	// Validator states are filtered here rather than by the server, as the
+	// size of the unfiltered page shows if the end of the registry is reached.
+	page, err := i.validators(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, errors.New("no validators response")
+	}
+	if len(page.Data) < i.pageSize {
+		i.finished = true
+	}
+
+	if len(i.states) > 0 {
+		for index, validator := range page.Data {
+			if _, exists := i.states[validator.Status]; !exists {
+				delete(page.Data, index)
+			}
+		}
+	}
+
+	return page, nil
+}
+
+// Validators returns the current page of validators.
+func (i *ValidatorsIterator) Validators() *Response[map[phase0.ValidatorIndex]*apiv1.Validator] {
+	return i.page
+}
+
+// Err returns the error that ended iteration, if any.
+func (i *ValidatorsIterator) Err() error {
+	return i.err
+}
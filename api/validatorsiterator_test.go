@@ -0,0 +1,141 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testRegistry returns a validators function serving a registry of the given size,
+// in which odd validators are exited, along with a record of the requests made.
+func testRegistry(size int) (api.ValidatorsFunc, *[]*api.ValidatorsOpts) {
+	requests := make([]*api.ValidatorsOpts, 0)
+
+	return func(_ context.Context, opts *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+		requests = append(requests, opts)
+		if opts.State == "bad" {
+			return nil, errors.New("bad state")
+		}
+		res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+		for _, index := range opts.Indices {
+			if int(index) >= size {
+				continue
+			}
+			status := apiv1.ValidatorStateActiveOngoing
+			if index%2 == 1 {
+				status = apiv1.ValidatorStateExitedUnslashed
+			}
+			res[index] = &apiv1.Validator{Index: index, Status: status}
+		}
+		for _, pubKey := range opts.PubKeys {
+			index := phase0.ValidatorIndex(pubKey[0])
+			res[index] = &apiv1.Validator{Index: index, Status: apiv1.ValidatorStateActiveOngoing}
+		}
+
+		return &api.Response[map[phase0.ValidatorIndex]*apiv1.Validator]{Data: res}, nil
+	}, &requests
+}
+
+func TestValidatorsIterator(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		size     int
+		opts     *api.ValidatorsIteratorOpts
+		pages    []int
+		requests int
+		err      string
+	}{
+		{
+			name:     "Registry",
+			size:     25,
+			opts:     &api.ValidatorsIteratorOpts{State: "head", PageSize: 10},
+			pages:    []int{10, 10, 5},
+			requests: 3,
+		},
+		{
+			name:     "RegistryExactPages",
+			size:     20,
+			opts:     &api.ValidatorsIteratorOpts{State: "head", PageSize: 10},
+			pages:    []int{10, 10},
+			requests: 3,
+		},
+		{
+			name:     "RegistryEmpty",
+			size:     0,
+			opts:     &api.ValidatorsIteratorOpts{State: "head", PageSize: 10},
+			pages:    []int{},
+			requests: 1,
+		},
+		{
+			name: "RegistryStates",
+			size: 25,
+			opts: &api.ValidatorsIteratorOpts{
+				State:           "head",
+				PageSize:        10,
+				ValidatorStates: []apiv1.ValidatorState{apiv1.ValidatorStateExitedUnslashed},
+			},
+			pages:    []int{5, 5, 2},
+			requests: 3,
+		},
+		{
+			name: "Requested",
+			size: 100,
+			opts: &api.ValidatorsIteratorOpts{
+				State:    "head",
+				PageSize: 2,
+				Indices:  []phase0.ValidatorIndex{1, 2, 3},
+				PubKeys:  []phase0.BLSPubKey{{0x04}, {0x05}},
+			},
+			pages:    []int{2, 2, 1},
+			requests: 3,
+		},
+		{
+			name:     "Error",
+			size:     100,
+			opts:     &api.ValidatorsIteratorOpts{State: "bad"},
+			pages:    []int{},
+			requests: 1,
+			err:      "bad state",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validators, requests := testRegistry(test.size)
+			iterator := api.NewValidatorsIterator(validators, test.opts)
+
+			pages := make([]int, 0)
+			for iterator.Next(ctx) {
+				pages = append(pages, len(iterator.Validators().Data))
+			}
+			require.Equal(t, test.pages, pages)
+			require.Len(t, *requests, test.requests)
+			if test.err != "" {
+				require.EqualError(t, iterator.Err(), test.err)
+			} else {
+				require.NoError(t, iterator.Err())
+			}
+			require.Nil(t, iterator.Validators())
+		})
+	}
+}
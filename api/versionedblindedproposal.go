@@ -18,6 +18,7 @@ import (
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
 	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -30,6 +31,7 @@ type VersionedBlindedProposal struct {
 	Capella   *apiv1capella.BlindedBeaconBlock
 	Deneb     *apiv1deneb.BlindedBeaconBlock
 	Electra   *apiv1electra.BlindedBeaconBlock
+	Fulu      *apiv1fulu.BlindedBeaconBlock
 }
 
 // IsEmpty returns true if there is no proposal.
@@ -64,6 +66,12 @@ func (v *VersionedBlindedProposal) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Electra.Slot, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Fulu.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -96,6 +104,12 @@ func (v *VersionedBlindedProposal) ProposerIndex() (phase0.ValidatorIndex, error
 		}
 
 		return v.Electra.ProposerIndex, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Fulu.ProposerIndex, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -132,6 +146,13 @@ func (v *VersionedBlindedProposal) RandaoReveal() (phase0.BLSSignature, error) {
 		}
 
 		return v.Electra.Body.RANDAOReveal, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Body == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Fulu.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, ErrUnsupportedVersion
 	}
@@ -168,6 +189,13 @@ func (v *VersionedBlindedProposal) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Electra.Body.Graffiti, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Body == nil {
+			return [32]byte{}, ErrDataMissing
+		}
+
+		return v.Fulu.Body.Graffiti, nil
 	default:
 		return [32]byte{}, ErrUnsupportedVersion
 	}
@@ -231,6 +259,20 @@ func (v *VersionedBlindedProposal) Attestations() ([]spec.VersionedAttestation,
 			}
 		}
 
+		return versionedAttestations, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		versionedAttestations := make([]spec.VersionedAttestation, len(v.Fulu.Body.Attestations))
+		for i, attestation := range v.Fulu.Body.Attestations {
+			versionedAttestations[i] = spec.VersionedAttestation{
+				Version: spec.DataVersionFulu,
+				Fulu:    attestation,
+			}
+		}
+
 		return versionedAttestations, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -264,6 +306,12 @@ func (v *VersionedBlindedProposal) Root() (phase0.Root, error) {
 		}
 
 		return v.Electra.HashTreeRoot()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -300,6 +348,13 @@ func (v *VersionedBlindedProposal) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Body.HashTreeRoot()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Body == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -332,6 +387,12 @@ func (v *VersionedBlindedProposal) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.ParentRoot, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -364,6 +425,12 @@ func (v *VersionedBlindedProposal) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.StateRoot, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -404,6 +471,14 @@ func (v *VersionedBlindedProposal) TransactionsRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Body.ExecutionPayloadHeader.TransactionsRoot, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Body == nil ||
+			v.Fulu.Body.ExecutionPayloadHeader == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Body.ExecutionPayloadHeader.TransactionsRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -444,6 +519,14 @@ func (v *VersionedBlindedProposal) FeeRecipient() (bellatrix.ExecutionAddress, e
 		}
 
 		return v.Electra.Body.ExecutionPayloadHeader.FeeRecipient, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Body == nil ||
+			v.Fulu.Body.ExecutionPayloadHeader == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Fulu.Body.ExecutionPayloadHeader.FeeRecipient, nil
 	default:
 		return bellatrix.ExecutionAddress{}, ErrUnsupportedVersion
 	}
@@ -484,6 +567,14 @@ func (v *VersionedBlindedProposal) Timestamp() (uint64, error) {
 		}
 
 		return v.Electra.Body.ExecutionPayloadHeader.Timestamp, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Body == nil ||
+			v.Fulu.Body.ExecutionPayloadHeader == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Fulu.Body.ExecutionPayloadHeader.Timestamp, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -516,6 +607,12 @@ func (v *VersionedBlindedProposal) String() string {
 		}
 
 		return v.Electra.String()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
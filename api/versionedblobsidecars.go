@@ -0,0 +1,93 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+)
+
+// VersionedBlobSidecars contains a versioned list of blob sidecars.
+// From Fulu onwards blobs are distributed as data columns, so the data
+// column sidecars of the block are provided instead.
+type VersionedBlobSidecars struct {
+	Version spec.DataVersion
+	Deneb   []*deneb.BlobSidecar
+	Electra []*deneb.BlobSidecar
+	Fulu    []*fulu.DataColumnSidecar
+}
+
+// IsEmpty returns true if there are no sidecars.
+func (v *VersionedBlobSidecars) IsEmpty() bool {
+	return len(v.Deneb) == 0 && len(v.Electra) == 0 && len(v.Fulu) == 0
+}
+
+// BlobSidecars returns the blob sidecars.
+func (v *VersionedBlobSidecars) BlobSidecars() ([]*deneb.BlobSidecar, error) {
+	switch v.Version {
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Deneb, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// DataColumnSidecars returns the data column sidecars.
+func (v *VersionedBlobSidecars) DataColumnSidecars() ([]*fulu.DataColumnSidecar, error) {
+	switch v.Version {
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedBlobSidecars) String() string {
+	var data []byte
+	var err error
+	switch v.Version {
+	case spec.DataVersionDeneb:
+		data, err = json.Marshal(v.Deneb)
+	case spec.DataVersionElectra:
+		data, err = json.Marshal(v.Electra)
+	case spec.DataVersionFulu:
+		data, err = json.Marshal(v.Fulu)
+	default:
+		return "unsupported version"
+	}
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBlobSidecars(t *testing.T) {
+	blobSidecars := []*deneb.BlobSidecar{{Index: 1}}
+	dataColumnSidecars := []*fulu.DataColumnSidecar{{Index: 2}}
+
+	tests := []struct {
+		name        string
+		sidecars    *api.VersionedBlobSidecars
+		blobsErr    error
+		columnsErr  error
+		empty       bool
+		blobs       []*deneb.BlobSidecar
+		dataColumns []*fulu.DataColumnSidecar
+	}{
+		{
+			name:       "Empty",
+			sidecars:   &api.VersionedBlobSidecars{Version: spec.DataVersionDeneb},
+			blobsErr:   api.ErrDataMissing,
+			columnsErr: api.ErrUnsupportedVersion,
+			empty:      true,
+		},
+		{
+			name:       "Unsupported",
+			sidecars:   &api.VersionedBlobSidecars{Version: spec.DataVersionCapella},
+			blobsErr:   api.ErrUnsupportedVersion,
+			columnsErr: api.ErrUnsupportedVersion,
+			empty:      true,
+		},
+		{
+			name:       "Deneb",
+			sidecars:   &api.VersionedBlobSidecars{Version: spec.DataVersionDeneb, Deneb: blobSidecars},
+			columnsErr: api.ErrUnsupportedVersion,
+			blobs:      blobSidecars,
+		},
+		{
+			name:       "Electra",
+			sidecars:   &api.VersionedBlobSidecars{Version: spec.DataVersionElectra, Electra: blobSidecars},
+			columnsErr: api.ErrUnsupportedVersion,
+			blobs:      blobSidecars,
+		},
+		{
+			name:        "Fulu",
+			sidecars:    &api.VersionedBlobSidecars{Version: spec.DataVersionFulu, Fulu: dataColumnSidecars},
+			blobsErr:    api.ErrUnsupportedVersion,
+			dataColumns: dataColumnSidecars,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.empty, test.sidecars.IsEmpty())
+
+			blobs, err := test.sidecars.BlobSidecars()
+			if test.blobsErr != nil {
+				require.ErrorIs(t, err, test.blobsErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.blobs, blobs)
+			}
+
+			dataColumns, err := test.sidecars.DataColumnSidecars()
+			if test.columnsErr != nil {
+				require.ErrorIs(t, err, test.columnsErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.dataColumns, dataColumns)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -30,6 +31,7 @@ type VersionedBlockRequest struct {
 	Capella   *capella.SignedBeaconBlock
 	Deneb     *deneb.SignedBeaconBlock
 	Electra   *electra.SignedBeaconBlock
+	Fulu      *fulu.SignedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -63,6 +65,13 @@ func (v *VersionedBlockRequest) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Electra.Message.Slot, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -107,6 +116,15 @@ func (v *VersionedBlockRequest) ExecutionBlockHash() (phase0.Hash32, error) {
 		}
 
 		return v.Electra.Message.Body.ExecutionPayload.BlockHash, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Body == nil ||
+			v.Fulu.Message.Body.ExecutionPayload == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Body.ExecutionPayload.BlockHash, nil
 	default:
 		return phase0.Hash32{}, ErrUnsupportedVersion
 	}
@@ -178,6 +196,22 @@ func (v *VersionedBlockRequest) Attestations() ([]spec.VersionedAttestation, err
 			}
 		}
 
+		return versionedAttestations, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		versionedAttestations := make([]spec.VersionedAttestation, len(v.Fulu.Message.Body.Attestations))
+		for i, attestation := range v.Fulu.Message.Body.Attestations {
+			versionedAttestations[i] = spec.VersionedAttestation{
+				Version: spec.DataVersionFulu,
+				Fulu:    attestation,
+			}
+		}
+
 		return versionedAttestations, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -215,6 +249,13 @@ func (v *VersionedBlockRequest) Root() (phase0.Root, error) {
 		}
 
 		return v.Electra.Message.HashTreeRoot()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -255,6 +296,14 @@ func (v *VersionedBlockRequest) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Message.Body.HashTreeRoot()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Body == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -291,6 +340,13 @@ func (v *VersionedBlockRequest) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Message.ParentRoot, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -327,6 +383,13 @@ func (v *VersionedBlockRequest) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Message.StateRoot, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -398,6 +461,22 @@ func (v *VersionedBlockRequest) AttesterSlashings() ([]spec.VersionedAttesterSla
 			}
 		}
 
+		return versionedAttesterSlashings, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		versionedAttesterSlashings := make([]spec.VersionedAttesterSlashing, len(v.Fulu.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Fulu.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = spec.VersionedAttesterSlashing{
+				Version: spec.DataVersionFulu,
+				Fulu:    attesterSlashing,
+			}
+		}
+
 		return versionedAttesterSlashings, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -439,6 +518,14 @@ func (v *VersionedBlockRequest) ProposerSlashings() ([]*phase0.ProposerSlashing,
 		}
 
 		return v.Electra.Message.Body.ProposerSlashings, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -479,6 +566,14 @@ func (v *VersionedBlockRequest) SyncAggregate() (*altair.SyncAggregate, error) {
 		}
 
 		return v.Electra.Message.Body.SyncAggregate, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Body.SyncAggregate, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -511,6 +606,12 @@ func (v *VersionedBlockRequest) String() string {
 		}
 
 		return v.Electra.String()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unsupported version"
 	}
@@ -17,6 +17,7 @@ import (
 	"math/big"
 
 	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
 
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
@@ -26,6 +27,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -45,6 +47,8 @@ type VersionedProposal struct {
 	DenebBlinded     *apiv1deneb.BlindedBeaconBlock
 	Electra          *apiv1electra.BlockContents
 	ElectraBlinded   *apiv1electra.BlindedBeaconBlock
+	Fulu             *apiv1fulu.BlockContents
+	FuluBlinded      *apiv1fulu.BlindedBeaconBlock
 }
 
 // IsEmpty returns true if there is no proposal.
@@ -96,6 +100,12 @@ func (v *VersionedProposal) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Block.Body.HashTreeRoot()
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Body.HashTreeRoot()
+		}
+
+		return v.Fulu.Block.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -136,6 +146,12 @@ func (v *VersionedProposal) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Block.ParentRoot, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.ParentRoot, nil
+		}
+
+		return v.Fulu.Block.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -176,6 +192,12 @@ func (v *VersionedProposal) ProposerIndex() (phase0.ValidatorIndex, error) {
 		}
 
 		return v.Electra.Block.ProposerIndex, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.ProposerIndex, nil
+		}
+
+		return v.Fulu.Block.ProposerIndex, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -216,6 +238,12 @@ func (v *VersionedProposal) Root() (phase0.Root, error) {
 		}
 
 		return v.Electra.Block.HashTreeRoot()
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.HashTreeRoot()
+		}
+
+		return v.Fulu.Block.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -256,6 +284,12 @@ func (v *VersionedProposal) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Electra.Block.Slot, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Slot, nil
+		}
+
+		return v.Fulu.Block.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -296,6 +330,12 @@ func (v *VersionedProposal) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Block.StateRoot, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.StateRoot, nil
+		}
+
+		return v.Fulu.Block.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -415,6 +455,28 @@ func (v *VersionedProposal) Attestations() ([]spec.VersionedAttestation, error)
 			}
 		}
 
+		return versionedAttestations, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			versionedAttestations := make([]spec.VersionedAttestation, len(v.FuluBlinded.Body.Attestations))
+			for i, attestation := range v.FuluBlinded.Body.Attestations {
+				versionedAttestations[i] = spec.VersionedAttestation{
+					Version: spec.DataVersionFulu,
+					Fulu:    attestation,
+				}
+			}
+
+			return versionedAttestations, nil
+		}
+
+		versionedAttestations := make([]spec.VersionedAttestation, len(v.Fulu.Block.Body.Attestations))
+		for i, attestation := range v.Fulu.Block.Body.Attestations {
+			versionedAttestations[i] = spec.VersionedAttestation{
+				Version: spec.DataVersionFulu,
+				Fulu:    attestation,
+			}
+		}
+
 		return versionedAttestations, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -456,6 +518,12 @@ func (v *VersionedProposal) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Electra.Block.Body.Graffiti, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Body.Graffiti, nil
+		}
+
+		return v.Fulu.Block.Body.Graffiti, nil
 	default:
 		return [32]byte{}, ErrUnsupportedVersion
 	}
@@ -496,6 +564,12 @@ func (v *VersionedProposal) RandaoReveal() (phase0.BLSSignature, error) {
 		}
 
 		return v.Electra.Block.Body.RANDAOReveal, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Body.RANDAOReveal, nil
+		}
+
+		return v.Fulu.Block.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, ErrUnsupportedVersion
 	}
@@ -532,6 +606,12 @@ func (v *VersionedProposal) Transactions() ([]bellatrix.Transaction, error) {
 		}
 
 		return v.Electra.Block.Body.ExecutionPayload.Transactions, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.Block.Body.ExecutionPayload.Transactions, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -568,6 +648,12 @@ func (v *VersionedProposal) FeeRecipient() (bellatrix.ExecutionAddress, error) {
 		}
 
 		return v.Electra.Block.Body.ExecutionPayload.FeeRecipient, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Body.ExecutionPayloadHeader.FeeRecipient, nil
+		}
+
+		return v.Fulu.Block.Body.ExecutionPayload.FeeRecipient, nil
 	default:
 		return bellatrix.ExecutionAddress{}, ErrUnsupportedVersion
 	}
@@ -604,6 +690,12 @@ func (v *VersionedProposal) Timestamp() (uint64, error) {
 		}
 
 		return v.Electra.Block.Body.ExecutionPayload.Timestamp, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Body.ExecutionPayloadHeader.Timestamp, nil
+		}
+
+		return v.Fulu.Block.Body.ExecutionPayload.Timestamp, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -658,6 +750,12 @@ func (v *VersionedProposal) Blobs() ([]deneb.Blob, error) {
 		}
 
 		return v.Electra.Blobs, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.Blobs, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -682,6 +780,36 @@ func (v *VersionedProposal) KZGProofs() ([]deneb.KZGProof, error) {
 		}
 
 		return v.Electra.KZGProofs, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.KZGProofs, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// ExecutionRequests returns the execution requests of the proposal.
+func (v *VersionedProposal) ExecutionRequests() (*electra.ExecutionRequests, error) {
+	if v.Version >= spec.DataVersionElectra && !v.bodyPresent() {
+		return nil, ErrDataMissing
+	}
+
+	switch v.Version {
+	case spec.DataVersionElectra:
+		if v.Blinded {
+			return v.ElectraBlinded.Body.ExecutionRequests, nil
+		}
+
+		return v.Electra.Block.Body.ExecutionRequests, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Body.ExecutionRequests, nil
+		}
+
+		return v.Fulu.Block.Body.ExecutionRequests, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -700,6 +828,15 @@ func (v *VersionedProposal) Value() *big.Int {
 	return value
 }
 
+// ProposalMetadata returns the metadata of the proposal.
+func (v *VersionedProposal) ProposalMetadata() *ProposalMetadata {
+	return &ProposalMetadata{
+		Blinded:        v.Blinded,
+		ExecutionValue: v.ExecutionValue,
+		ConsensusValue: v.ConsensusValue,
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedProposal) String() string {
 	switch v.Version {
@@ -739,6 +876,12 @@ func (v *VersionedProposal) String() string {
 		}
 
 		return v.Electra.String()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
@@ -774,6 +917,12 @@ func (v *VersionedProposal) proposalPresent() bool {
 		}
 
 		return v.Electra.Block != nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded != nil
+		}
+
+		return v.Fulu.Block != nil
 	}
 
 	return false
@@ -809,6 +958,12 @@ func (v *VersionedProposal) bodyPresent() bool {
 		}
 
 		return v.Electra != nil && v.Electra.Block != nil && v.Electra.Block.Body != nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded != nil && v.FuluBlinded.Body != nil
+		}
+
+		return v.Fulu != nil && v.Fulu.Block != nil && v.Fulu.Block.Body != nil
 	}
 
 	return false
@@ -849,6 +1004,15 @@ func (v *VersionedProposal) payloadPresent() bool {
 			v.Electra.Block != nil &&
 			v.Electra.Block.Body != nil &&
 			v.Electra.Block.Body.ExecutionPayload != nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded != nil && v.FuluBlinded.Body != nil && v.FuluBlinded.Body.ExecutionPayloadHeader != nil
+		}
+
+		return v.Fulu != nil &&
+			v.Fulu.Block != nil &&
+			v.Fulu.Block.Body != nil &&
+			v.Fulu.Block.Body.ExecutionPayload != nil
 	}
 
 	return false
@@ -0,0 +1,93 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedProposalExecutionRequests(t *testing.T) {
+	requests := &electra.ExecutionRequests{
+		Deposits:       []*electra.DepositRequest{},
+		Withdrawals:    []*electra.WithdrawalRequest{},
+		Consolidations: []*electra.ConsolidationRequest{},
+	}
+
+	tests := []struct {
+		name     string
+		proposal *api.VersionedProposal
+		res      *electra.ExecutionRequests
+		err      error
+	}{
+		{
+			name: "Deneb",
+			proposal: &api.VersionedProposal{
+				Version: spec.DataVersionDeneb,
+			},
+			err: api.ErrUnsupportedVersion,
+		},
+		{
+			name: "ElectraMissing",
+			proposal: &api.VersionedProposal{
+				Version: spec.DataVersionElectra,
+			},
+			err: api.ErrDataMissing,
+		},
+		{
+			name: "Electra",
+			proposal: &api.VersionedProposal{
+				Version: spec.DataVersionElectra,
+				Electra: &apiv1electra.BlockContents{
+					Block: &electra.BeaconBlock{
+						Body: &electra.BeaconBlockBody{
+							ExecutionRequests: requests,
+						},
+					},
+				},
+			},
+			res: requests,
+		},
+		{
+			name: "ElectraBlinded",
+			proposal: &api.VersionedProposal{
+				Version: spec.DataVersionElectra,
+				Blinded: true,
+				ElectraBlinded: &apiv1electra.BlindedBeaconBlock{
+					Body: &apiv1electra.BlindedBeaconBlockBody{
+						ExecutionRequests: requests,
+					},
+				},
+			},
+			res: requests,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.proposal.ExecutionRequests()
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+		})
+	}
+}
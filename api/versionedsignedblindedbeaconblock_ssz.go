@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: b276d9aad6cbae7805608c3adb43fa696d04a0acf5d2b67b74e90f4fdcbd4cc0
+// Hash: 29b82803f96b59b526fdf54d5f10189136629f2838f349abcb2cebb83f15bad3
 // Version: 0.1.3
 package api
 
@@ -8,6 +8,7 @@ import (
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
 	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
 	"github.com/attestantio/go-eth2-client/spec"
 	ssz "github.com/ferranbt/fastssz"
 )
@@ -20,7 +21,7 @@ func (v *VersionedSignedBlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the VersionedSignedBlindedBeaconBlock object to a target array
 func (v *VersionedSignedBlindedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(24)
+	offset := int(28)
 
 	// Field (0) 'Version'
 	dst = ssz.MarshalUint64(dst, uint64(v.Version))
@@ -53,6 +54,9 @@ func (v *VersionedSignedBlindedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte
 	}
 	offset += v.Electra.SizeSSZ()
 
+	// Offset (5) 'Fulu'
+	dst = ssz.WriteOffset(dst, offset)
+
 	// Field (1) 'Bellatrix'
 	if dst, err = v.Bellatrix.MarshalSSZTo(dst); err != nil {
 		return
@@ -73,6 +77,11 @@ func (v *VersionedSignedBlindedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte
 		return
 	}
 
+	// Field (5) 'Fulu'
+	if dst, err = v.Fulu.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
 	return
 }
 
@@ -80,12 +89,12 @@ func (v *VersionedSignedBlindedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte
 func (v *VersionedSignedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 24 {
+	if size < 28 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o1, o2, o3, o4 uint64
+	var o1, o2, o3, o4, o5 uint64
 
 	// Field (0) 'Version'
 	v.Version = spec.DataVersion(ssz.UnmarshallUint64(buf[0:8]))
@@ -95,7 +104,7 @@ func (v *VersionedSignedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 24 {
+	if o1 != 28 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -114,6 +123,11 @@ func (v *VersionedSignedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (5) 'Fulu'
+	if o5 = ssz.ReadOffset(buf[24:28]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
 	// Field (1) 'Bellatrix'
 	{
 		buf = tail[o1:o2]
@@ -149,7 +163,7 @@ func (v *VersionedSignedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 
 	// Field (4) 'Electra'
 	{
-		buf = tail[o4:]
+		buf = tail[o4:o5]
 		if v.Electra == nil {
 			v.Electra = new(apiv1electra.SignedBlindedBeaconBlock)
 		}
@@ -157,12 +171,23 @@ func (v *VersionedSignedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 	}
+
+	// Field (5) 'Fulu'
+	{
+		buf = tail[o5:]
+		if v.Fulu == nil {
+			v.Fulu = new(apiv1fulu.SignedBlindedBeaconBlock)
+		}
+		if err = v.Fulu.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the VersionedSignedBlindedBeaconBlock object
 func (v *VersionedSignedBlindedBeaconBlock) SizeSSZ() (size int) {
-	size = 24
+	size = 28
 
 	// Field (1) 'Bellatrix'
 	if v.Bellatrix == nil {
@@ -188,6 +213,12 @@ func (v *VersionedSignedBlindedBeaconBlock) SizeSSZ() (size int) {
 	}
 	size += v.Electra.SizeSSZ()
 
+	// Field (5) 'Fulu'
+	if v.Fulu == nil {
+		v.Fulu = new(apiv1fulu.SignedBlindedBeaconBlock)
+	}
+	size += v.Fulu.SizeSSZ()
+
 	return
 }
 
@@ -223,6 +254,11 @@ func (v *VersionedSignedBlindedBeaconBlock) HashTreeRootWith(hh ssz.HashWalker)
 		return
 	}
 
+	// Field (5) 'Fulu'
+	if err = v.Fulu.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
 	hh.Merkleize(indx)
 	return
 }
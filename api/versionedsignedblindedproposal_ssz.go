@@ -0,0 +1,117 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// MarshalSSZ ssz marshals the signed blinded proposal of the given version.
+func (v *VersionedSignedBlindedProposal) MarshalSSZ() ([]byte, error) {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.MarshalSSZ()
+}
+
+// UnmarshalSSZ ssz unmarshals the signed blinded proposal.
+// The version must be set prior to calling this function.
+func (v *VersionedSignedBlindedProposal) UnmarshalSSZ(buf []byte) error {
+	obj, err := v.sszObject(true)
+	if err != nil {
+		return err
+	}
+
+	return obj.UnmarshalSSZ(buf)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the signed blinded proposal.
+func (v *VersionedSignedBlindedProposal) SizeSSZ() int {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return 0
+	}
+
+	return obj.SizeSSZ()
+}
+
+// HashTreeRoot ssz hashes the signed blinded proposal of the given version.
+func (v *VersionedSignedBlindedProposal) HashTreeRoot() ([32]byte, error) {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return obj.HashTreeRoot()
+}
+
+// sszObject returns the versioned data, allocating it if required.
+func (v *VersionedSignedBlindedProposal) sszObject(allocate bool) (sszObject, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Bellatrix = &apiv1bellatrix.SignedBlindedBeaconBlock{}
+		}
+
+		return v.Bellatrix, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Capella = &apiv1capella.SignedBlindedBeaconBlock{}
+		}
+
+		return v.Capella, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Deneb = &apiv1deneb.SignedBlindedBeaconBlock{}
+		}
+
+		return v.Deneb, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Electra = &apiv1electra.SignedBlindedBeaconBlock{}
+		}
+
+		return v.Electra, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Fulu = &apiv1fulu.SignedBlindedBeaconBlock{}
+		}
+
+		return v.Fulu, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// VersionedSignedBuilderBid contains a versioned signed builder bid.
+type VersionedSignedBuilderBid struct {
+	Version   spec.DataVersion
+	Bellatrix *apiv1bellatrix.SignedBuilderBid
+	Capella   *apiv1capella.SignedBuilderBid
+	Deneb     *apiv1deneb.SignedBuilderBid
+	Electra   *apiv1electra.SignedBuilderBid
+	Fulu      *apiv1electra.SignedBuilderBid
+}
+
+// IsEmpty returns true if there is no bid.
+func (v *VersionedSignedBuilderBid) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Fulu == nil
+}
+
+// Builder returns the builder public key of the bid.
+func (v *VersionedSignedBuilderBid) Builder() (phase0.BLSPubKey, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Pubkey, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Pubkey, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Pubkey, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Pubkey, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil {
+			return phase0.BLSPubKey{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Pubkey, nil
+	default:
+		return phase0.BLSPubKey{}, ErrUnsupportedVersion
+	}
+}
+
+// Value returns the value of the bid.
+func (v *VersionedSignedBuilderBid) Value() (*uint256.Int, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Value == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Value, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Value == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Capella.Message.Value, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Value == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Value, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Value == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Message.Value, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Value == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Value, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// ParentHash returns the parent hash of the payload in the bid.
+func (v *VersionedSignedBuilderBid) ParentHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.ParentHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.ParentHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.ParentHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.ParentHash, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.ParentHash, nil
+	default:
+		return phase0.Hash32{}, ErrUnsupportedVersion
+	}
+}
+
+// BlockHash returns the block hash of the payload in the bid.
+func (v *VersionedSignedBuilderBid) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.BlockHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.BlockHash, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.BlockHash, nil
+	default:
+		return phase0.Hash32{}, ErrUnsupportedVersion
+	}
+}
+
+// BlockNumber returns the block number of the payload in the bid.
+func (v *VersionedSignedBuilderBid) BlockNumber() (uint64, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.BlockNumber, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.BlockNumber, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.BlockNumber, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.BlockNumber, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.BlockNumber, nil
+	default:
+		return 0, ErrUnsupportedVersion
+	}
+}
+
+// FeeRecipient returns the fee recipient of the payload in the bid.
+func (v *VersionedSignedBuilderBid) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Message.Header.FeeRecipient, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Capella.Message.Header.FeeRecipient, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Deneb.Message.Header.FeeRecipient, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Header.FeeRecipient, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil ||
+			v.Fulu.Message == nil ||
+			v.Fulu.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Fulu.Message.Header.FeeRecipient, nil
+	default:
+		return bellatrix.ExecutionAddress{}, ErrUnsupportedVersion
+	}
+}
+
+// Signature returns the signature of the bid.
+func (v *VersionedSignedBuilderBid) Signature() (phase0.BLSSignature, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.Signature, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Capella.Signature, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Deneb.Signature, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Electra.Signature, nil
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Fulu.Signature, nil
+	default:
+		return phase0.BLSSignature{}, ErrUnsupportedVersion
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedSignedBuilderBid) String() string {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	case spec.DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
+	default:
+		return "unsupported version"
+	}
+}
@@ -15,9 +15,11 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
 	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
 
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
@@ -26,6 +28,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -45,6 +48,8 @@ type VersionedSignedProposal struct {
 	DenebBlinded     *apiv1deneb.SignedBlindedBeaconBlock
 	Electra          *apiv1electra.SignedBlockContents
 	ElectraBlinded   *apiv1electra.SignedBlindedBeaconBlock
+	Fulu             *apiv1fulu.SignedBlockContents
+	FuluBlinded      *apiv1fulu.SignedBlindedBeaconBlock
 }
 
 // AssertPresent throws an error if the expected proposal
@@ -87,6 +92,13 @@ func (v *VersionedSignedProposal) AssertPresent() error {
 		if v.ElectraBlinded == nil && v.Blinded {
 			return errors.New("blinded electra proposal not present")
 		}
+	case spec.DataVersionFulu:
+		if v.Fulu == nil && !v.Blinded {
+			return errors.New("fulu proposal not present")
+		}
+		if v.FuluBlinded == nil && v.Blinded {
+			return errors.New("blinded fulu proposal not present")
+		}
 	default:
 		return errors.New("unsupported version")
 	}
@@ -129,6 +141,12 @@ func (v *VersionedSignedProposal) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Electra.SignedBlock.Message.Slot, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Message.Slot, nil
+		}
+
+		return v.Fulu.SignedBlock.Message.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -169,6 +187,12 @@ func (v *VersionedSignedProposal) ProposerIndex() (phase0.ValidatorIndex, error)
 		}
 
 		return v.Electra.SignedBlock.Message.ProposerIndex, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Message.ProposerIndex, nil
+		}
+
+		return v.Fulu.SignedBlock.Message.ProposerIndex, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -205,11 +229,132 @@ func (v *VersionedSignedProposal) ExecutionBlockHash() (phase0.Hash32, error) {
 		}
 
 		return v.Electra.SignedBlock.Message.Body.ExecutionPayload.BlockHash, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			return v.FuluBlinded.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+		}
+
+		return v.Fulu.SignedBlock.Message.Body.ExecutionPayload.BlockHash, nil
 	default:
 		return phase0.Hash32{}, ErrUnsupportedVersion
 	}
 }
 
+// blockBody is a beacon block body that can be hashed and proved.
+type blockBody interface {
+	deneb.ProvableBody
+	HashTreeRoot() ([32]byte, error)
+}
+
+// BlobSidecars returns the blob sidecars for the proposal, built from the supplied KZG
+// proofs and blobs, including the inclusion proofs for their KZG commitments.
+// If the proposal is not blinded and neither KZG proofs nor blobs are supplied then
+// those in the proposal's block contents are used.
+func (v *VersionedSignedProposal) BlobSidecars(kzgProofs []deneb.KZGProof, blobs []deneb.Blob) ([]*deneb.BlobSidecar, error) {
+	if err := v.assertMessagePresent(); err != nil {
+		return nil, err
+	}
+
+	var slot phase0.Slot
+	var proposerIndex phase0.ValidatorIndex
+	var parentRoot phase0.Root
+	var stateRoot phase0.Root
+	var signature phase0.BLSSignature
+	var body blockBody
+	var commitments []deneb.KZGCommitment
+	switch v.Version {
+	case spec.DataVersionDeneb:
+		if v.Blinded {
+			block := v.DenebBlinded
+			if block.Message.Body == nil {
+				return nil, ErrDataMissing
+			}
+			slot, proposerIndex = block.Message.Slot, block.Message.ProposerIndex
+			parentRoot, stateRoot = block.Message.ParentRoot, block.Message.StateRoot
+			signature = block.Signature
+			body, commitments = block.Message.Body, block.Message.Body.BlobKZGCommitments
+		} else {
+			block := v.Deneb.SignedBlock
+			if block.Message.Body == nil {
+				return nil, ErrDataMissing
+			}
+			slot, proposerIndex = block.Message.Slot, block.Message.ProposerIndex
+			parentRoot, stateRoot = block.Message.ParentRoot, block.Message.StateRoot
+			signature = block.Signature
+			body, commitments = block.Message.Body, block.Message.Body.BlobKZGCommitments
+			if kzgProofs == nil && blobs == nil {
+				kzgProofs, blobs = v.Deneb.KZGProofs, v.Deneb.Blobs
+			}
+		}
+	case spec.DataVersionElectra:
+		if v.Blinded {
+			block := v.ElectraBlinded
+			if block.Message.Body == nil {
+				return nil, ErrDataMissing
+			}
+			slot, proposerIndex = block.Message.Slot, block.Message.ProposerIndex
+			parentRoot, stateRoot = block.Message.ParentRoot, block.Message.StateRoot
+			signature = block.Signature
+			body, commitments = block.Message.Body, block.Message.Body.BlobKZGCommitments
+		} else {
+			block := v.Electra.SignedBlock
+			if block.Message.Body == nil {
+				return nil, ErrDataMissing
+			}
+			slot, proposerIndex = block.Message.Slot, block.Message.ProposerIndex
+			parentRoot, stateRoot = block.Message.ParentRoot, block.Message.StateRoot
+			signature = block.Signature
+			body, commitments = block.Message.Body, block.Message.Body.BlobKZGCommitments
+			if kzgProofs == nil && blobs == nil {
+				kzgProofs, blobs = v.Electra.KZGProofs, v.Electra.Blobs
+			}
+		}
+	default:
+		// Blob sidecars were replaced by data column sidecars in fulu.
+		return nil, ErrUnsupportedVersion
+	}
+
+	if len(kzgProofs) != len(commitments) {
+		return nil, fmt.Errorf("proposal has %d KZG commitments but %d KZG proofs supplied", len(commitments), len(kzgProofs))
+	}
+	if len(blobs) != len(commitments) {
+		return nil, fmt.Errorf("proposal has %d KZG commitments but %d blobs supplied", len(commitments), len(blobs))
+	}
+
+	bodyRoot, err := body.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate body root"), err)
+	}
+	header := &phase0.SignedBeaconBlockHeader{
+		Message: &phase0.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    parentRoot,
+			StateRoot:     stateRoot,
+			BodyRoot:      bodyRoot,
+		},
+		Signature: signature,
+	}
+
+	sidecars := make([]*deneb.BlobSidecar, len(commitments))
+	for i := range commitments {
+		inclusionProof, err := deneb.GenerateKZGCommitmentInclusionProof(body, deneb.BlobIndex(i))
+		if err != nil {
+			return nil, err
+		}
+		sidecars[i] = &deneb.BlobSidecar{
+			Index:                       deneb.BlobIndex(i),
+			Blob:                        blobs[i],
+			KZGCommitment:               commitments[i],
+			KZGProof:                    kzgProofs[i],
+			SignedBlockHeader:           header,
+			KZGCommitmentInclusionProof: inclusionProof,
+		}
+	}
+
+	return sidecars, nil
+}
+
 // String returns a string version of the structure.
 func (v *VersionedSignedProposal) String() string {
 	switch v.Version {
@@ -281,6 +426,20 @@ func (v *VersionedSignedProposal) String() string {
 		}
 
 		return v.Electra.String()
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			if v.FuluBlinded == nil {
+				return ""
+			}
+
+			return v.FuluBlinded.String()
+		}
+
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unsupported version"
 	}
@@ -340,6 +499,19 @@ func (v *VersionedSignedProposal) assertMessagePresent() error {
 				return ErrDataMissing
 			}
 		}
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			if v.FuluBlinded == nil ||
+				v.FuluBlinded.Message == nil {
+				return ErrDataMissing
+			}
+		} else {
+			if v.Fulu == nil ||
+				v.Fulu.SignedBlock == nil ||
+				v.Fulu.SignedBlock.Message == nil {
+				return ErrDataMissing
+			}
+		}
 	default:
 		return ErrUnsupportedVersion
 	}
@@ -419,6 +591,23 @@ func (v *VersionedSignedProposal) assertExecutionPayloadPresent() error {
 				return ErrDataMissing
 			}
 		}
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			if v.FuluBlinded == nil ||
+				v.FuluBlinded.Message == nil ||
+				v.FuluBlinded.Message.Body == nil ||
+				v.FuluBlinded.Message.Body.ExecutionPayloadHeader == nil {
+				return ErrDataMissing
+			}
+		} else {
+			if v.Fulu == nil ||
+				v.Fulu.SignedBlock == nil ||
+				v.Fulu.SignedBlock.Message == nil ||
+				v.Fulu.SignedBlock.Message.Body == nil ||
+				v.Fulu.SignedBlock.Message.Body.ExecutionPayload == nil {
+				return ErrDataMissing
+			}
+		}
 	default:
 		return ErrUnsupportedVersion
 	}
@@ -0,0 +1,202 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// sszObject is an object that can be SSZ encoded, decoded and hashed.
+type sszObject interface {
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+	SizeSSZ() int
+	HashTreeRoot() ([32]byte, error)
+}
+
+// MarshalSSZ ssz marshals the signed proposal of the given version.
+func (v *VersionedSignedProposal) MarshalSSZ() ([]byte, error) {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.MarshalSSZ()
+}
+
+// UnmarshalSSZ ssz unmarshals the signed proposal.
+// The version and blinded flag must be set prior to calling this function.
+func (v *VersionedSignedProposal) UnmarshalSSZ(buf []byte) error {
+	obj, err := v.sszObject(true)
+	if err != nil {
+		return err
+	}
+
+	return obj.UnmarshalSSZ(buf)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the signed proposal.
+func (v *VersionedSignedProposal) SizeSSZ() int {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return 0
+	}
+
+	return obj.SizeSSZ()
+}
+
+// HashTreeRoot ssz hashes the signed proposal of the given version.
+func (v *VersionedSignedProposal) HashTreeRoot() ([32]byte, error) {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return obj.HashTreeRoot()
+}
+
+// sszObject returns the versioned data, allocating it if required.
+func (v *VersionedSignedProposal) sszObject(allocate bool) (sszObject, error) {
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		if v.Phase0 == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Phase0 = &phase0.SignedBeaconBlock{}
+		}
+
+		return v.Phase0, nil
+	case spec.DataVersionAltair:
+		if v.Altair == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Altair = &altair.SignedBeaconBlock{}
+		}
+
+		return v.Altair, nil
+	case spec.DataVersionBellatrix:
+		if v.Blinded {
+			if v.BellatrixBlinded == nil {
+				if !allocate {
+					return nil, ErrDataMissing
+				}
+				v.BellatrixBlinded = &apiv1bellatrix.SignedBlindedBeaconBlock{}
+			}
+
+			return v.BellatrixBlinded, nil
+		}
+
+		if v.Bellatrix == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Bellatrix = &bellatrix.SignedBeaconBlock{}
+		}
+
+		return v.Bellatrix, nil
+	case spec.DataVersionCapella:
+		if v.Blinded {
+			if v.CapellaBlinded == nil {
+				if !allocate {
+					return nil, ErrDataMissing
+				}
+				v.CapellaBlinded = &apiv1capella.SignedBlindedBeaconBlock{}
+			}
+
+			return v.CapellaBlinded, nil
+		}
+
+		if v.Capella == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Capella = &capella.SignedBeaconBlock{}
+		}
+
+		return v.Capella, nil
+	case spec.DataVersionDeneb:
+		if v.Blinded {
+			if v.DenebBlinded == nil {
+				if !allocate {
+					return nil, ErrDataMissing
+				}
+				v.DenebBlinded = &apiv1deneb.SignedBlindedBeaconBlock{}
+			}
+
+			return v.DenebBlinded, nil
+		}
+
+		if v.Deneb == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Deneb = &apiv1deneb.SignedBlockContents{}
+		}
+
+		return v.Deneb, nil
+	case spec.DataVersionElectra:
+		if v.Blinded {
+			if v.ElectraBlinded == nil {
+				if !allocate {
+					return nil, ErrDataMissing
+				}
+				v.ElectraBlinded = &apiv1electra.SignedBlindedBeaconBlock{}
+			}
+
+			return v.ElectraBlinded, nil
+		}
+
+		if v.Electra == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Electra = &apiv1electra.SignedBlockContents{}
+		}
+
+		return v.Electra, nil
+	case spec.DataVersionFulu:
+		if v.Blinded {
+			if v.FuluBlinded == nil {
+				if !allocate {
+					return nil, ErrDataMissing
+				}
+				v.FuluBlinded = &apiv1fulu.SignedBlindedBeaconBlock{}
+			}
+
+			return v.FuluBlinded, nil
+		}
+
+		if v.Fulu == nil {
+			if !allocate {
+				return nil, ErrDataMissing
+			}
+			v.Fulu = &apiv1fulu.SignedBlockContents{}
+		}
+
+		return v.Fulu, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
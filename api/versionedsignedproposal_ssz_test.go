@@ -0,0 +1,160 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func blindedBellatrixBlock() *apiv1bellatrix.SignedBlindedBeaconBlock {
+	return &apiv1bellatrix.SignedBlindedBeaconBlock{
+		Message: &apiv1bellatrix.BlindedBeaconBlock{
+			Slot:          5,
+			ProposerIndex: 6,
+			Body: &apiv1bellatrix.BlindedBeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: bitfield.NewBitvector512(),
+				},
+			},
+		},
+	}
+}
+
+func TestVersionedSignedProposalSSZ(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal *api.VersionedSignedProposal
+		inner    interface {
+			MarshalSSZ() ([]byte, error)
+			HashTreeRoot() ([32]byte, error)
+		}
+		err string
+	}{
+		{
+			name:     "VersionUnknown",
+			proposal: &api.VersionedSignedProposal{},
+			err:      api.ErrUnsupportedVersion.Error(),
+		},
+		{
+			name:     "DataMissing",
+			proposal: &api.VersionedSignedProposal{Version: spec.DataVersionPhase0},
+			err:      api.ErrDataMissing.Error(),
+		},
+		{
+			name: "BlindedDataMissing",
+			proposal: &api.VersionedSignedProposal{
+				Version: spec.DataVersionBellatrix,
+				Blinded: true,
+			},
+			err: api.ErrDataMissing.Error(),
+		},
+		{
+			name: "Phase0",
+			proposal: &api.VersionedSignedProposal{
+				Version: spec.DataVersionPhase0,
+				Phase0: &phase0.SignedBeaconBlock{
+					Message: &phase0.BeaconBlock{
+						Slot:          1,
+						ProposerIndex: 2,
+						Body: &phase0.BeaconBlockBody{
+							ETH1Data: &phase0.ETH1Data{
+								BlockHash: make([]byte, 32),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BellatrixBlinded",
+			proposal: &api.VersionedSignedProposal{
+				Version:          spec.DataVersionBellatrix,
+				Blinded:          true,
+				BellatrixBlinded: blindedBellatrixBlock(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := test.proposal.MarshalSSZ()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				_, err = test.proposal.HashTreeRoot()
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, encoded, test.proposal.SizeSSZ())
+
+			root, err := test.proposal.HashTreeRoot()
+			require.NoError(t, err)
+
+			decoded := &api.VersionedSignedProposal{
+				Version: test.proposal.Version,
+				Blinded: test.proposal.Blinded,
+			}
+			require.NoError(t, decoded.UnmarshalSSZ(encoded))
+			reencoded, err := decoded.MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, encoded, reencoded)
+			decodedRoot, err := decoded.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, root, decodedRoot)
+		})
+	}
+}
+
+func TestVersionedSignedBlindedProposalSSZ(t *testing.T) {
+	block := blindedBellatrixBlock()
+	proposal := &api.VersionedSignedBlindedProposal{
+		Version:   spec.DataVersionBellatrix,
+		Bellatrix: block,
+	}
+
+	encoded, err := proposal.MarshalSSZ()
+	require.NoError(t, err)
+	expected, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	root, err := proposal.HashTreeRoot()
+	require.NoError(t, err)
+	expectedRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expectedRoot), phase0.Root(root))
+
+	decoded := &api.VersionedSignedBlindedProposal{Version: spec.DataVersionBellatrix}
+	require.NoError(t, decoded.UnmarshalSSZ(encoded))
+	slot, err := decoded.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(5), slot)
+
+	_, err = (&api.VersionedSignedBlindedProposal{Version: spec.DataVersionPhase0}).MarshalSSZ()
+	require.ErrorIs(t, err, api.ErrUnsupportedVersion)
+	_, err = (&api.VersionedSignedBlindedProposal{Version: spec.DataVersionDeneb}).MarshalSSZ()
+	require.ErrorIs(t, err, api.ErrDataMissing)
+}
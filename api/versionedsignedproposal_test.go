@@ -0,0 +1,199 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedSignedProposalBlobSidecars(t *testing.T) {
+	commitments := []deneb.KZGCommitment{{0x01}, {0x02}, {0x03}}
+	kzgProofs := []deneb.KZGProof{{0x11}, {0x12}, {0x13}}
+	blobs := []deneb.Blob{{0x21}, {0x22}, {0x23}}
+
+	denebBlock := &deneb.SignedBeaconBlock{
+		Message: &deneb.BeaconBlock{
+			Slot:          10,
+			ProposerIndex: 20,
+			ParentRoot:    phase0.Root{0x30},
+			StateRoot:     phase0.Root{0x40},
+			Body: &deneb.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: bitfield.NewBitvector512(),
+				},
+				ExecutionPayload: &deneb.ExecutionPayload{
+					BaseFeePerGas: uint256.NewInt(7),
+				},
+				BlobKZGCommitments: commitments,
+			},
+		},
+		Signature: phase0.BLSSignature{0x50},
+	}
+	electraBlindedBlock := &apiv1electra.SignedBlindedBeaconBlock{
+		Message: &apiv1electra.BlindedBeaconBlock{
+			Slot: 11,
+			Body: &apiv1electra.BlindedBeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: bitfield.NewBitvector512(),
+				},
+				ExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+					BaseFeePerGas: uint256.NewInt(7),
+				},
+				BlobKZGCommitments: commitments[:2],
+				ExecutionRequests:  &electra.ExecutionRequests{},
+			},
+		},
+	}
+
+	denebRoot, err := denebBlock.Message.HashTreeRoot()
+	require.NoError(t, err)
+	electraBlindedRoot, err := electraBlindedBlock.Message.HashTreeRoot()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		proposal  *api.VersionedSignedProposal
+		kzgProofs []deneb.KZGProof
+		blobs     []deneb.Blob
+		sidecars  int
+		root      phase0.Root
+		err       string
+	}{
+		{
+			name:     "Empty",
+			proposal: &api.VersionedSignedProposal{Version: spec.DataVersionDeneb},
+			err:      "data missing",
+		},
+		{
+			name: "Fulu",
+			proposal: &api.VersionedSignedProposal{
+				Version: spec.DataVersionFulu,
+				Fulu: &apiv1fulu.SignedBlockContents{
+					SignedBlock: &fulu.SignedBeaconBlock{
+						Message: &fulu.BeaconBlock{},
+					},
+				},
+			},
+			err: "unsupported version",
+		},
+		{
+			name: "DenebFromContents",
+			proposal: &api.VersionedSignedProposal{
+				Version: spec.DataVersionDeneb,
+				Deneb: &apiv1deneb.SignedBlockContents{
+					SignedBlock: denebBlock,
+					KZGProofs:   kzgProofs,
+					Blobs:       blobs,
+				},
+			},
+			sidecars: 3,
+			root:     denebRoot,
+		},
+		{
+			name: "DenebSupplied",
+			proposal: &api.VersionedSignedProposal{
+				Version: spec.DataVersionDeneb,
+				Deneb: &apiv1deneb.SignedBlockContents{
+					SignedBlock: denebBlock,
+				},
+			},
+			kzgProofs: kzgProofs,
+			blobs:     blobs,
+			sidecars:  3,
+			root:      denebRoot,
+		},
+		{
+			name: "DenebProofsMismatch",
+			proposal: &api.VersionedSignedProposal{
+				Version: spec.DataVersionDeneb,
+				Deneb: &apiv1deneb.SignedBlockContents{
+					SignedBlock: denebBlock,
+				},
+			},
+			kzgProofs: kzgProofs[:1],
+			blobs:     blobs,
+			err:       "proposal has 3 KZG commitments but 1 KZG proofs supplied",
+		},
+		{
+			name: "DenebBlobsMismatch",
+			proposal: &api.VersionedSignedProposal{
+				Version: spec.DataVersionDeneb,
+				Deneb: &apiv1deneb.SignedBlockContents{
+					SignedBlock: denebBlock,
+				},
+			},
+			kzgProofs: kzgProofs,
+			blobs:     blobs[:2],
+			err:       "proposal has 3 KZG commitments but 2 blobs supplied",
+		},
+		{
+			name: "ElectraBlinded",
+			proposal: &api.VersionedSignedProposal{
+				Version:        spec.DataVersionElectra,
+				Blinded:        true,
+				ElectraBlinded: electraBlindedBlock,
+			},
+			kzgProofs: kzgProofs[:2],
+			blobs:     blobs[:2],
+			sidecars:  2,
+			root:      electraBlindedRoot,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sidecars, err := test.proposal.BlobSidecars(test.kzgProofs, test.blobs)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, sidecars, test.sidecars)
+
+			for i, sidecar := range sidecars {
+				require.Equal(t, deneb.BlobIndex(i), sidecar.Index)
+				require.Equal(t, commitments[i], sidecar.KZGCommitment)
+				require.Equal(t, kzgProofs[i], sidecar.KZGProof)
+				require.Equal(t, blobs[i], sidecar.Blob)
+				headerRoot, err := sidecar.SignedBlockHeader.Message.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, test.root, phase0.Root(headerRoot))
+				valid, err := deneb.VerifyKZGCommitmentInclusionProof(sidecar)
+				require.NoError(t, err)
+				require.True(t, valid)
+			}
+		})
+	}
+}
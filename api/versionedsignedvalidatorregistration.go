@@ -97,3 +97,17 @@ func (v *VersionedSignedValidatorRegistration) Root() (phase0.Root, error) {
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
 }
+
+// String returns a string version of the structure.
+func (v *VersionedSignedValidatorRegistration) String() string {
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return ""
+		}
+
+		return v.V1.String()
+	default:
+		return "unsupported version"
+	}
+}
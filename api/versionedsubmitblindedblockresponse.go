@@ -0,0 +1,137 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedSubmitBlindedBlockResponse contains a versioned response to submitting a blinded block to a builder;
+// this is the unblinded execution payload, along with its blobs from Deneb onwards.
+type VersionedSubmitBlindedBlockResponse struct {
+	Version   spec.DataVersion
+	Bellatrix *bellatrix.ExecutionPayload
+	Capella   *capella.ExecutionPayload
+	Deneb     *apiv1deneb.ExecutionPayloadAndBlobsBundle
+	Electra   *apiv1deneb.ExecutionPayloadAndBlobsBundle
+}
+
+// IsEmpty returns true if there is no payload.
+func (v *VersionedSubmitBlindedBlockResponse) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+}
+
+// BlockHash returns the block hash of the payload.
+func (v *VersionedSubmitBlindedBlockResponse) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Bellatrix.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Capella.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.ExecutionPayload == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Deneb.ExecutionPayload.BlockHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.ExecutionPayload == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Electra.ExecutionPayload.BlockHash, nil
+	default:
+		return phase0.Hash32{}, ErrUnsupportedVersion
+	}
+}
+
+// Transactions returns the transactions of the payload.
+func (v *VersionedSubmitBlindedBlockResponse) Transactions() ([]bellatrix.Transaction, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Bellatrix.Transactions, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Capella.Transactions, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.ExecutionPayload == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Deneb.ExecutionPayload.Transactions, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.ExecutionPayload == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.ExecutionPayload.Transactions, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedSubmitBlindedBlockResponse) String() string {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	default:
+		return "unsupported version"
+	}
+}
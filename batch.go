@@ -0,0 +1,73 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// DefaultSubmissionBatchSize is a batch size for submissions that keeps
+// request bodies well within the limits imposed by beacon nodes.
+const DefaultSubmissionBatchSize = 512
+
+// SubmitProposalPreparationsInBatches submits proposal preparations in
+// batches of at most the given size, to avoid oversized request bodies when
+// there are a large number of validators.
+func SubmitProposalPreparationsInBatches(ctx context.Context,
+	submitter ProposalPreparationsSubmitter,
+	preparations []*apiv1.ProposalPreparation,
+	batchSize int,
+) error {
+	return submitInBatches(ctx, preparations, batchSize, submitter.SubmitProposalPreparations)
+}
+
+// SubmitValidatorRegistrationsInBatches submits validator registrations in
+// batches of at most the given size, to avoid oversized request bodies when
+// there are a large number of validators.
+func SubmitValidatorRegistrationsInBatches(ctx context.Context,
+	submitter ValidatorRegistrationsSubmitter,
+	registrations []*api.VersionedSignedValidatorRegistration,
+	batchSize int,
+) error {
+	return submitInBatches(ctx, registrations, batchSize, submitter.SubmitValidatorRegistrations)
+}
+
+// submitInBatches submits items in batches of at most the given size,
+// stopping at the first batch that fails.
+func submitInBatches[T any](ctx context.Context,
+	items []T,
+	batchSize int,
+	submit func(context.Context, []T) error,
+) error {
+	if batchSize <= 0 {
+		return errors.Join(errors.New("batch size must be positive"), ErrInvalidOptions)
+	}
+
+	for start := 0; start < len(items); start += batchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		end := min(start+batchSize, len(items))
+		if err := submit(ctx, items[start:end]); err != nil {
+			return errors.Join(fmt.Errorf("failed to submit batch of items %d to %d", start, end-1), err)
+		}
+	}
+
+	return nil
+}
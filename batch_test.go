@@ -0,0 +1,114 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitProposalPreparationsInBatches(t *testing.T) {
+	ctx := context.Background()
+
+	preparations := make([]*apiv1.ProposalPreparation, 0, 10)
+	for i := 0; i < 10; i++ {
+		preparations = append(preparations, &apiv1.ProposalPreparation{ValidatorIndex: phase0.ValidatorIndex(i)})
+	}
+
+	tests := []struct {
+		name      string
+		batchSize int
+		failAt    int
+		batches   []int
+		err       string
+	}{
+		{
+			name:      "BatchSizeZero",
+			batchSize: 0,
+			batches:   []int{},
+			err:       "batch size must be positive\ninvalid options",
+		},
+		{
+			name:      "SingleBatch",
+			batchSize: 10,
+			failAt:    -1,
+			batches:   []int{10},
+		},
+		{
+			name:      "MultipleBatches",
+			batchSize: 4,
+			failAt:    -1,
+			batches:   []int{4, 4, 2},
+		},
+		{
+			name:      "BatchFails",
+			batchSize: 4,
+			failAt:    1,
+			batches:   []int{4, 4},
+			err:       "failed to submit batch of items 4 to 7\nmock error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			batches := make([]int, 0)
+			service, err := mock.New(ctx,
+				mock.WithSubmitProposalPreparationsFunc(func(_ context.Context, batch []*apiv1.ProposalPreparation) error {
+					batches = append(batches, len(batch))
+					if len(batches)-1 == test.failAt {
+						return errors.New("mock error")
+					}
+
+					return nil
+				}),
+			)
+			require.NoError(t, err)
+
+			err = client.SubmitProposalPreparationsInBatches(ctx, service, preparations, test.batchSize)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.batches, batches)
+		})
+	}
+}
+
+func TestSubmitValidatorRegistrationsInBatches(t *testing.T) {
+	ctx := context.Background()
+
+	registrations := make([]*api.VersionedSignedValidatorRegistration, 5)
+	submitted := 0
+	service, err := mock.New(ctx,
+		mock.WithSubmitValidatorRegistrationsFunc(func(_ context.Context, batch []*api.VersionedSignedValidatorRegistration) error {
+			require.LessOrEqual(t, len(batch), 2)
+			submitted += len(batch)
+
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, client.SubmitValidatorRegistrationsInBatches(ctx, service, registrations, 2))
+	require.Equal(t, 5, submitted)
+}
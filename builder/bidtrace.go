@@ -0,0 +1,141 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// BidTrace is the trace of a bid, as provided by the relay data API.
+type BidTrace struct {
+	Slot                 phase0.Slot
+	ParentHash           phase0.Hash32
+	BlockHash            phase0.Hash32
+	BuilderPubkey        phase0.BLSPubKey
+	ProposerPubkey       phase0.BLSPubKey
+	ProposerFeeRecipient bellatrix.ExecutionAddress
+	GasLimit             uint64
+	GasUsed              uint64
+	Value                *uint256.Int
+	BlockNumber          uint64
+	NumTx                uint64
+	// Timestamp is the time at which the relay received the bid.
+	// It is only provided for received bids, and is zero otherwise.
+	Timestamp time.Time
+}
+
+// bidTraceJSON is the API representation of the struct.
+type bidTraceJSON struct {
+	Slot                 string                     `json:"slot"`
+	ParentHash           phase0.Hash32              `json:"parent_hash"`
+	BlockHash            phase0.Hash32              `json:"block_hash"`
+	BuilderPubkey        phase0.BLSPubKey           `json:"builder_pubkey"`
+	ProposerPubkey       phase0.BLSPubKey           `json:"proposer_pubkey"`
+	ProposerFeeRecipient bellatrix.ExecutionAddress `json:"proposer_fee_recipient"`
+	GasLimit             string                     `json:"gas_limit"`
+	GasUsed              string                     `json:"gas_used"`
+	Value                string                     `json:"value"`
+	BlockNumber          string                     `json:"block_number"`
+	NumTx                string                     `json:"num_tx"`
+	TimestampMs          string                     `json:"timestamp_ms,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BidTrace) MarshalJSON() ([]byte, error) {
+	value := "0"
+	if b.Value != nil {
+		value = b.Value.Dec()
+	}
+	timestampMs := ""
+	if !b.Timestamp.IsZero() {
+		timestampMs = strconv.FormatInt(b.Timestamp.UnixMilli(), 10)
+	}
+
+	return json.Marshal(&bidTraceJSON{
+		Slot:                 fmt.Sprintf("%d", b.Slot),
+		ParentHash:           b.ParentHash,
+		BlockHash:            b.BlockHash,
+		BuilderPubkey:        b.BuilderPubkey,
+		ProposerPubkey:       b.ProposerPubkey,
+		ProposerFeeRecipient: b.ProposerFeeRecipient,
+		GasLimit:             strconv.FormatUint(b.GasLimit, 10),
+		GasUsed:              strconv.FormatUint(b.GasUsed, 10),
+		Value:                value,
+		BlockNumber:          strconv.FormatUint(b.BlockNumber, 10),
+		NumTx:                strconv.FormatUint(b.NumTx, 10),
+		TimestampMs:          timestampMs,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BidTrace) UnmarshalJSON(input []byte) error {
+	var data bidTraceJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	b.Slot = phase0.Slot(slot)
+	b.ParentHash = data.ParentHash
+	b.BlockHash = data.BlockHash
+	b.BuilderPubkey = data.BuilderPubkey
+	b.ProposerPubkey = data.ProposerPubkey
+	b.ProposerFeeRecipient = data.ProposerFeeRecipient
+	if b.GasLimit, err = strconv.ParseUint(data.GasLimit, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for gas limit")
+	}
+	if b.GasUsed, err = strconv.ParseUint(data.GasUsed, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for gas used")
+	}
+	if b.Value, err = uint256.FromDecimal(data.Value); err != nil {
+		return errors.Wrap(err, "invalid value for value")
+	}
+	if b.BlockNumber, err = strconv.ParseUint(data.BlockNumber, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for block number")
+	}
+	if b.NumTx, err = strconv.ParseUint(data.NumTx, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for number of transactions")
+	}
+	b.Timestamp = time.Time{}
+	if data.TimestampMs != "" {
+		timestampMs, err := strconv.ParseInt(data.TimestampMs, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for timestamp")
+		}
+		b.Timestamp = time.UnixMilli(timestampMs)
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BidTrace) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
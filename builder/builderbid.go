@@ -0,0 +1,90 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// BuilderBid obtains a bid for the payload of a block from the builder.
+// If the builder does not have a bid then ErrNoBid is returned.
+func (s *Service) BuilderBid(ctx context.Context,
+	opts *BuilderBidOpts,
+) (
+	*api.Response[*api.VersionedSignedBuilderBid],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/builder/header/%d/%#x/%#x", opts.Slot, opts.ParentHash, opts.Pubkey)
+	httpResponse, err := s.call(ctx, http.MethodGet, endpoint, "", &opts.Common, nil, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to request builder bid"), err)
+	}
+	if httpResponse.statusCode == http.StatusNoContent || len(httpResponse.body) == 0 {
+		return nil, ErrNoBid
+	}
+
+	var data versionedJSON
+	if err := json.Unmarshal(httpResponse.body, &data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse builder bid"), err)
+	}
+
+	res := &api.VersionedSignedBuilderBid{
+		Version: data.Version,
+	}
+	switch data.Version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &apiv1bellatrix.SignedBuilderBid{}
+		err = json.Unmarshal(data.Data, res.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella = &apiv1capella.SignedBuilderBid{}
+		err = json.Unmarshal(data.Data, res.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.SignedBuilderBid{}
+		err = json.Unmarshal(data.Data, res.Deneb)
+	case spec.DataVersionElectra:
+		res.Electra = &apiv1electra.SignedBuilderBid{}
+		err = json.Unmarshal(data.Data, res.Electra)
+	case spec.DataVersionFulu:
+		res.Fulu = &apiv1electra.SignedBuilderBid{}
+		err = json.Unmarshal(data.Data, res.Fulu)
+	default:
+		return nil, fmt.Errorf("unsupported builder bid version %s", data.Version)
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to parse builder bid"), err)
+	}
+
+	return &api.Response[*api.VersionedSignedBuilderBid]{
+		Data: res,
+		Metadata: map[string]any{
+			"version": data.Version,
+		},
+	}, nil
+}
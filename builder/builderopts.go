@@ -0,0 +1,53 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// StatusOpts are the options for checking the status of a builder.
+type StatusOpts struct {
+	Common api.CommonOpts
+}
+
+// RegisterValidatorsOpts are the options for registering validators with a builder.
+type RegisterValidatorsOpts struct {
+	Common api.CommonOpts
+
+	// Registrations are the signed validator registrations.
+	Registrations []*apiv1.SignedValidatorRegistration
+}
+
+// BuilderBidOpts are the options for obtaining a bid from a builder.
+type BuilderBidOpts struct {
+	Common api.CommonOpts
+
+	// Slot is the slot for which the bid is requested.
+	Slot phase0.Slot
+	// ParentHash is the execution block hash of the parent of the requested payload.
+	ParentHash phase0.Hash32
+	// Pubkey is the public key of the proposer.
+	Pubkey phase0.BLSPubKey
+}
+
+// SubmitBlindedBlockOpts are the options for submitting a signed blinded block to a builder.
+type SubmitBlindedBlockOpts struct {
+	Common api.CommonOpts
+
+	// Proposal is the signed blinded proposal.
+	Proposal *api.VersionedSignedBlindedProposal
+}
@@ -0,0 +1,160 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// defaultUserAgent is sent with requests if no other user agent has been supplied.
+const defaultUserAgent = "go-eth2-client/0.23.1"
+
+type httpResponse struct {
+	statusCode int
+	body       []byte
+}
+
+// call sends an HTTP request with an optional JSON body and returns the response.
+func (s *Service) call(ctx context.Context,
+	method string,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	body any,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.builder").Start(ctx, method)
+	defer span.End()
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to marshal JSON"), err)
+		}
+		if e := log.Trace(); e.Enabled() {
+			e.RawJSON("body", data).Str("method", method).Msg("Request")
+		}
+		reqBody = bytes.NewReader(data)
+	} else {
+		log.Trace().Str("method", method).Msg("Request")
+	}
+
+	callURL := urlForCall(s.base, endpoint, query)
+	span.SetAttributes(attribute.String("url", callURL.String()))
+
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, method, callURL.String(), reqBody)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to create request")
+
+		return nil, errors.Join(fmt.Errorf("failed to create %s request", method), err)
+	}
+
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+	req.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", defaultUserAgent)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, errors.Join(fmt.Errorf("failed to call %s endpoint", method), err)
+	}
+	defer resp.Body.Close()
+	log = log.With().Int("status_code", resp.StatusCode).Logger()
+
+	res := &httpResponse{
+		statusCode: resp.StatusCode,
+	}
+	res.body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, errors.Join(fmt.Errorf("failed to read %s response", method), err)
+	}
+
+	if resp.StatusCode/100 != 2 {
+		log.Debug().Str("response", string(res.body)).Msg(method + " failed")
+		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
+
+		return nil, &api.Error{
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       res.body,
+		}
+	}
+
+	log.Trace().Msg("Request succeeded")
+
+	return res, nil
+}
+
+func urlForCall(base *url.URL,
+	endpoint string,
+	query string,
+) *url.URL {
+	callURL := *base
+	callURL.Path += endpoint
+	if callURL.RawQuery == "" {
+		callURL.RawQuery = query
+	} else if query != "" {
+		callURL.RawQuery = fmt.Sprintf("%s&%s", callURL.RawQuery, query)
+	}
+
+	return &callURL
+}
+
+// versionedJSON is the representation of a versioned builder API response.
+type versionedJSON struct {
+	Version spec.DataVersion `json:"version"`
+	Data    json.RawMessage  `json:"data"`
+}
@@ -0,0 +1,40 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// RegisterValidators registers validators with the builder.
+func (s *Service) RegisterValidators(ctx context.Context,
+	opts *RegisterValidatorsOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+	if len(opts.Registrations) == 0 {
+		return errors.Join(errors.New("no registrations supplied"), client.ErrInvalidOptions)
+	}
+
+	if _, err := s.call(ctx, http.MethodPost, "/eth/v1/builder/validators", "", &opts.Common, opts.Registrations, nil); err != nil {
+		return errors.Join(errors.New("failed to register validators"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// DeliveredPayloads obtains the payloads delivered by the relay to proposers.
+func (s *Service) DeliveredPayloads(ctx context.Context,
+	opts *DeliveredPayloadsOpts,
+) (
+	*api.Response[[]*BidTrace],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	query := url.Values{}
+	if opts.Slot != nil {
+		query.Set("slot", fmt.Sprintf("%d", *opts.Slot))
+	}
+	if opts.Cursor != nil {
+		query.Set("cursor", fmt.Sprintf("%d", *opts.Cursor))
+	}
+	if opts.Limit != 0 {
+		query.Set("limit", strconv.FormatUint(opts.Limit, 10))
+	}
+	if opts.BlockHash != nil {
+		query.Set("block_hash", opts.BlockHash.String())
+	}
+	if opts.BlockNumber != nil {
+		query.Set("block_number", strconv.FormatUint(*opts.BlockNumber, 10))
+	}
+	if opts.ProposerPubkey != nil {
+		query.Set("proposer_pubkey", opts.ProposerPubkey.String())
+	}
+	if opts.BuilderPubkey != nil {
+		query.Set("builder_pubkey", opts.BuilderPubkey.String())
+	}
+	if opts.OrderBy != "" {
+		query.Set("order_by", opts.OrderBy)
+	}
+
+	return s.bidTraces(ctx, "/relay/v1/data/bidtraces/proposer_payload_delivered", query, &opts.Common)
+}
+
+// ReceivedBids obtains the bids received by the relay from builders.
+func (s *Service) ReceivedBids(ctx context.Context,
+	opts *ReceivedBidsOpts,
+) (
+	*api.Response[[]*BidTrace],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Slot == nil && opts.BlockHash == nil && opts.BlockNumber == nil && opts.BuilderPubkey == nil {
+		return nil, errors.Join(errors.New("no slot, block hash, block number or builder public key specified"), client.ErrInvalidOptions)
+	}
+
+	query := url.Values{}
+	if opts.Slot != nil {
+		query.Set("slot", fmt.Sprintf("%d", *opts.Slot))
+	}
+	if opts.BlockHash != nil {
+		query.Set("block_hash", opts.BlockHash.String())
+	}
+	if opts.BlockNumber != nil {
+		query.Set("block_number", strconv.FormatUint(*opts.BlockNumber, 10))
+	}
+	if opts.BuilderPubkey != nil {
+		query.Set("builder_pubkey", opts.BuilderPubkey.String())
+	}
+	if opts.Limit != 0 {
+		query.Set("limit", strconv.FormatUint(opts.Limit, 10))
+	}
+
+	return s.bidTraces(ctx, "/relay/v1/data/bidtraces/builder_blocks_received", query, &opts.Common)
+}
+
+func (s *Service) bidTraces(ctx context.Context,
+	endpoint string,
+	query url.Values,
+	opts *api.CommonOpts,
+) (
+	*api.Response[[]*BidTrace],
+	error,
+) {
+	httpResponse, err := s.call(ctx, http.MethodGet, endpoint, query.Encode(), opts, nil, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to request bid traces"), err)
+	}
+
+	data := make([]*BidTrace, 0)
+	if err := json.Unmarshal(httpResponse.body, &data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse bid traces"), err)
+	}
+
+	return &api.Response[[]*BidTrace]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+// ValidatorRegistration obtains the latest registration of a validator held by the relay.
+func (s *Service) ValidatorRegistration(ctx context.Context,
+	opts *ValidatorRegistrationOpts,
+) (
+	*api.Response[*apiv1.SignedValidatorRegistration],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	query := "pubkey=" + opts.Pubkey.String()
+	httpResponse, err := s.call(ctx, http.MethodGet, "/relay/v1/data/validator_registration", query, &opts.Common, nil, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to request validator registration"), err)
+	}
+
+	data := &apiv1.SignedValidatorRegistration{}
+	if err := json.Unmarshal(httpResponse.body, data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse validator registration"), err)
+	}
+
+	return &api.Response[*apiv1.SignedValidatorRegistration]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// DeliveredPayloadsOpts are the options for obtaining payloads delivered by a relay to proposers.
+// All filters are optional.
+type DeliveredPayloadsOpts struct {
+	Common api.CommonOpts
+
+	// Slot filters payloads to the given slot.
+	Slot *phase0.Slot
+	// Cursor returns payloads from the given slot and earlier.
+	Cursor *phase0.Slot
+	// Limit is the maximum number of payloads to return; 0 uses the relay's default.
+	Limit uint64
+	// BlockHash filters payloads to the given execution block hash.
+	BlockHash *phase0.Hash32
+	// BlockNumber filters payloads to the given execution block number.
+	BlockNumber *uint64
+	// ProposerPubkey filters payloads to the given proposer.
+	ProposerPubkey *phase0.BLSPubKey
+	// BuilderPubkey filters payloads to the given builder.
+	BuilderPubkey *phase0.BLSPubKey
+	// OrderBy orders the payloads; "value" for ascending value, "-value" for descending value.
+	OrderBy string
+}
+
+// ReceivedBidsOpts are the options for obtaining bids received by a relay from builders.
+// At least one of slot, block hash, block number or builder public key is required.
+type ReceivedBidsOpts struct {
+	Common api.CommonOpts
+
+	// Slot filters bids to the given slot.
+	Slot *phase0.Slot
+	// BlockHash filters bids to the given execution block hash.
+	BlockHash *phase0.Hash32
+	// BlockNumber filters bids to the given execution block number.
+	BlockNumber *uint64
+	// BuilderPubkey filters bids to the given builder.
+	BuilderPubkey *phase0.BLSPubKey
+	// Limit is the maximum number of bids to return; 0 uses the relay's default.
+	Limit uint64
+}
+
+// ValidatorRegistrationOpts are the options for obtaining the registration of a validator held by a relay.
+type ValidatorRegistrationOpts struct {
+	Common api.CommonOpts
+
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+}
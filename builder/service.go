@@ -0,0 +1,126 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a client for the builder API, including the relay data API.
+type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
+	base         *url.URL
+	address      string
+	client       *http.Client
+	timeout      time.Duration
+	extraHeaders map[string]string
+}
+
+// New creates a new builder API client service, connecting with a standard HTTP.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "builder").Str("impl", "http").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	httpClient := parameters.client
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				DialContext: (&net.Dialer{
+					Timeout:   parameters.timeout,
+					KeepAlive: 30 * time.Second,
+				}).DialContext,
+				MaxIdleConns:        16,
+				MaxConnsPerHost:     16,
+				MaxIdleConnsPerHost: 16,
+				IdleConnTimeout:     600 * time.Second,
+			},
+		}
+	}
+
+	base, address, err := parseAddress(parameters.address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		log:          log,
+		base:         base,
+		address:      address.String(),
+		client:       httpClient,
+		timeout:      parameters.timeout,
+		extraHeaders: parameters.extraHeaders,
+	}, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "Builder (HTTP)"
+}
+
+// Address provides the address for the connection.
+func (s *Service) Address() string {
+	return s.address
+}
+
+func parseAddress(address string) (*url.URL, *url.URL, error) {
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	base, err := url.Parse(address)
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("invalid URL"), err)
+	}
+	// Remove any trailing slash from the path.
+	base.Path = strings.TrimSuffix(base.Path, "/")
+
+	// Attempt to mask any sensitive information in the URL, for logging purposes.
+	baseAddress := *base
+	if _, pwExists := baseAddress.User.Password(); pwExists {
+		// Mask the password.
+		user := baseAddress.User.Username()
+		baseAddress.User = url.UserPassword(user, "xxxxx")
+	}
+	if baseAddress.Path != "" {
+		// Mask the path.
+		baseAddress.Path = "xxxxx"
+	}
+	if baseAddress.RawQuery != "" {
+		// Mask all query values.
+		sensitiveRegex := regexp.MustCompile("=([^&]*)(&)?")
+		baseAddress.RawQuery = sensitiveRegex.ReplaceAllString(baseAddress.RawQuery, "=xxxxx$2")
+	}
+
+	return base, &baseAddress, nil
+}
@@ -0,0 +1,174 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func testBid() *apiv1deneb.SignedBuilderBid {
+	return &apiv1deneb.SignedBuilderBid{
+		Message: &apiv1deneb.BuilderBid{
+			Header: &deneb.ExecutionPayloadHeader{
+				ParentHash:    phase0.Hash32{0x01},
+				BlockHash:     phase0.Hash32{0x02},
+				BlockNumber:   12345,
+				BaseFeePerGas: uint256.NewInt(7),
+			},
+			BlobKZGCommitments: []deneb.KZGCommitment{},
+			Value:              uint256.NewInt(1000000000),
+			Pubkey:             phase0.BLSPubKey{0x03},
+		},
+		Signature: phase0.BLSSignature{0x04},
+	}
+}
+
+func testServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	bid, err := json.Marshal(testBid())
+	require.NoError(t, err)
+
+	handlers := map[string]http.HandlerFunc{}
+	handlers["GET /eth/v1/builder/status"] = func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	handlers["POST /eth/v1/builder/validators"] = func(w http.ResponseWriter, r *http.Request) {
+		var registrations []*apiv1.SignedValidatorRegistration
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&registrations))
+		require.Len(t, registrations, 1)
+		w.WriteHeader(http.StatusOK)
+	}
+	handlers[fmt.Sprintf("GET /eth/v1/builder/header/1/%#x/%#x", phase0.Hash32{0x01}, phase0.BLSPubKey{0x05})] = func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"version":"deneb","data":` + string(bid) + `}`))
+	}
+	handlers[fmt.Sprintf("GET /eth/v1/builder/header/2/%#x/%#x", phase0.Hash32{0x01}, phase0.BLSPubKey{0x05})] = func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+	handlers["GET /relay/v1/data/bidtraces/proposer_payload_delivered"] = func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "limit=1&slot=10", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`[{"slot":"10","parent_hash":"0x0100000000000000000000000000000000000000000000000000000000000000","block_hash":"0x0200000000000000000000000000000000000000000000000000000000000000","builder_pubkey":"0x030000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","proposer_pubkey":"0x050000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","proposer_fee_recipient":"0x0000000000000000000000000000000000000000","gas_limit":"30000000","gas_used":"15000000","value":"1000000000","block_number":"12345","num_tx":"100"}]`))
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler, exists := handlers[r.Method+" "+r.URL.Path]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := builder.New(ctx)
+	require.EqualError(t, err, "problem with parameters\nno address specified")
+
+	_, err = builder.New(ctx, builder.WithAddress("localhost:18550"), builder.WithTimeout(0))
+	require.EqualError(t, err, "problem with parameters\nno timeout specified")
+
+	s, err := builder.New(ctx, builder.WithAddress("localhost:18550"))
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:18550", s.Address())
+}
+
+func TestCalls(t *testing.T) {
+	ctx := context.Background()
+	server := testServer(t)
+
+	s, err := builder.New(ctx, builder.WithAddress(server.URL))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Status(ctx, &builder.StatusOpts{}))
+
+	require.ErrorIs(t, s.RegisterValidators(ctx, &builder.RegisterValidatorsOpts{}), client.ErrInvalidOptions)
+	require.NoError(t, s.RegisterValidators(ctx, &builder.RegisterValidatorsOpts{
+		Registrations: []*apiv1.SignedValidatorRegistration{
+			{
+				Message: &apiv1.ValidatorRegistration{
+					GasLimit: 30000000,
+				},
+			},
+		},
+	}))
+
+	bid, err := s.BuilderBid(ctx, &builder.BuilderBidOpts{
+		Slot:       1,
+		ParentHash: phase0.Hash32{0x01},
+		Pubkey:     phase0.BLSPubKey{0x05},
+	})
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionDeneb, bid.Data.Version)
+	value, err := bid.Data.Value()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000000000), value.Uint64())
+	blockHash, err := bid.Data.BlockHash()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Hash32{0x02}, blockHash)
+	require.Equal(t, testBid().String(), bid.Data.Deneb.String())
+
+	_, err = s.BuilderBid(ctx, &builder.BuilderBidOpts{
+		Slot:       2,
+		ParentHash: phase0.Hash32{0x01},
+		Pubkey:     phase0.BLSPubKey{0x05},
+	})
+	require.ErrorIs(t, err, builder.ErrNoBid)
+
+	_, err = s.SubmitBlindedBlock(ctx, &builder.SubmitBlindedBlockOpts{
+		Proposal: &api.VersionedSignedBlindedProposal{
+			Version: spec.DataVersionFulu,
+		},
+	})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+
+	slot := phase0.Slot(10)
+	payloads, err := s.DeliveredPayloads(ctx, &builder.DeliveredPayloadsOpts{
+		Slot:  &slot,
+		Limit: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, payloads.Data, 1)
+	require.Equal(t, uint64(100), payloads.Data[0].NumTx)
+	require.True(t, payloads.Data[0].Timestamp.IsZero())
+
+	_, err = s.ReceivedBids(ctx, &builder.ReceivedBidsOpts{})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+
+	_, err = s.ValidatorRegistration(ctx, &builder.ValidatorRegistrationOpts{})
+	var apiErr *api.Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
@@ -0,0 +1,37 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// Status checks that the builder is healthy, returning an error if not.
+func (s *Service) Status(ctx context.Context,
+	opts *StatusOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	if _, err := s.call(ctx, http.MethodGet, "/eth/v1/builder/status", "", &opts.Common, nil, nil); err != nil {
+		return errors.Join(errors.New("builder status check failed"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+// SubmitBlindedBlock submits a signed blinded block to the builder, returning the unblinded payload.
+// Fulu and later blocks must be submitted with SubmitBlindedBlockV2.
+func (s *Service) SubmitBlindedBlock(ctx context.Context,
+	opts *SubmitBlindedBlockOpts,
+) (
+	*api.Response[*api.VersionedSubmitBlindedBlockResponse],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Proposal == nil {
+		return nil, errors.Join(errors.New("no proposal supplied"), client.ErrInvalidOptions)
+	}
+	if opts.Proposal.Version >= spec.DataVersionFulu {
+		return nil, errors.Join(fmt.Errorf("%s blinded blocks must be submitted with SubmitBlindedBlockV2", opts.Proposal.Version), client.ErrInvalidOptions)
+	}
+
+	body, err := blindedProposalBody(opts.Proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResponse, err := s.call(ctx,
+		http.MethodPost,
+		"/eth/v1/builder/blinded_blocks",
+		"",
+		&opts.Common,
+		body,
+		map[string]string{
+			"Eth-Consensus-Version": strings.ToLower(opts.Proposal.Version.String()),
+		},
+	)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to submit blinded block"), err)
+	}
+
+	var data versionedJSON
+	if err := json.Unmarshal(httpResponse.body, &data); err != nil {
+		return nil, errors.Join(errors.New("failed to parse unblinded payload"), err)
+	}
+
+	res := &api.VersionedSubmitBlindedBlockResponse{
+		Version: data.Version,
+	}
+	switch data.Version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.ExecutionPayload{}
+		err = json.Unmarshal(data.Data, res.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella = &capella.ExecutionPayload{}
+		err = json.Unmarshal(data.Data, res.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = json.Unmarshal(data.Data, res.Deneb)
+	case spec.DataVersionElectra:
+		res.Electra = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = json.Unmarshal(data.Data, res.Electra)
+	default:
+		return nil, fmt.Errorf("unsupported unblinded payload version %s", data.Version)
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to parse unblinded payload"), err)
+	}
+
+	return &api.Response[*api.VersionedSubmitBlindedBlockResponse]{
+		Data: res,
+		Metadata: map[string]any{
+			"version": data.Version,
+		},
+	}, nil
+}
+
+// SubmitBlindedBlockV2 submits a signed blinded block to the builder.
+// Unlike SubmitBlindedBlock the builder does not return the unblinded payload, instead
+// broadcasting the unblinded block itself.
+func (s *Service) SubmitBlindedBlockV2(ctx context.Context,
+	opts *SubmitBlindedBlockOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+	if opts.Proposal == nil {
+		return errors.Join(errors.New("no proposal supplied"), client.ErrInvalidOptions)
+	}
+
+	body, err := blindedProposalBody(opts.Proposal)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.call(ctx,
+		http.MethodPost,
+		"/eth/v2/builder/blinded_blocks",
+		"",
+		&opts.Common,
+		body,
+		map[string]string{
+			"Eth-Consensus-Version": strings.ToLower(opts.Proposal.Version.String()),
+		},
+	); err != nil {
+		return errors.Join(errors.New("failed to submit blinded block"), err)
+	}
+
+	return nil
+}
+
+func blindedProposalBody(proposal *api.VersionedSignedBlindedProposal) (any, error) {
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		if proposal.Bellatrix == nil {
+			return nil, errors.Join(errors.New("no bellatrix proposal supplied"), client.ErrInvalidOptions)
+		}
+
+		return proposal.Bellatrix, nil
+	case spec.DataVersionCapella:
+		if proposal.Capella == nil {
+			return nil, errors.Join(errors.New("no capella proposal supplied"), client.ErrInvalidOptions)
+		}
+
+		return proposal.Capella, nil
+	case spec.DataVersionDeneb:
+		if proposal.Deneb == nil {
+			return nil, errors.Join(errors.New("no deneb proposal supplied"), client.ErrInvalidOptions)
+		}
+
+		return proposal.Deneb, nil
+	case spec.DataVersionElectra:
+		if proposal.Electra == nil {
+			return nil, errors.Join(errors.New("no electra proposal supplied"), client.ErrInvalidOptions)
+		}
+
+		return proposal.Electra, nil
+	case spec.DataVersionFulu:
+		if proposal.Fulu == nil {
+			return nil, errors.Join(errors.New("no fulu proposal supplied"), client.ErrInvalidOptions)
+		}
+
+		return proposal.Fulu, nil
+	default:
+		return nil, errors.Join(fmt.Errorf("unsupported proposal version %s", proposal.Version), client.ErrInvalidOptions)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// entry is a single cached response.
+type entry[T any] struct {
+	mu       sync.Mutex
+	response *api.Response[T]
+	expiry   time.Time
+}
+
+// get returns the cached response, fetching it if it is not present or has
+// expired.  A TTL of 0 means that the response never expires.  Errors are not
+// cached.
+func (e *entry[T]) get(ctx context.Context,
+	now time.Time,
+	ttl time.Duration,
+	fetch func(ctx context.Context) (*api.Response[T], error),
+) (
+	*api.Response[T],
+	error,
+) {
+	// The lock is held whilst fetching so that concurrent callers share a single fetch.
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.response != nil && (ttl == 0 || now.Before(e.expiry)) {
+		return e.response, nil
+	}
+
+	response, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.response = response
+	e.expiry = now.Add(ttl)
+
+	return response, nil
+}
+
+// rootCache is a bounded cache of responses keyed by root, evicting the
+// oldest response when full.
+type rootCache[T any] struct {
+	mu        sync.Mutex
+	maxItems  int
+	responses map[string]*api.Response[T]
+	order     []string
+}
+
+func newRootCache[T any](maxItems int) *rootCache[T] {
+	return &rootCache[T]{
+		maxItems:  maxItems,
+		responses: make(map[string]*api.Response[T], maxItems),
+		order:     make([]string, 0, maxItems),
+	}
+}
+
+// get returns the response for the root, if present.
+func (c *rootCache[T]) get(root string) (*api.Response[T], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	response, exists := c.responses[root]
+
+	return response, exists
+}
+
+// set stores the response for the root.
+func (c *rootCache[T]) set(root string, response *api.Response[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.responses[root]; exists {
+		c.responses[root] = response
+
+		return
+	}
+	if len(c.order) >= c.maxItems {
+		delete(c.responses, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.responses[root] = response
+	c.order = append(c.order, root)
+}
@@ -0,0 +1,85 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+type parameters struct {
+	service    consensusclient.Service
+	mutableTTL time.Duration
+	maxBlocks  int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithService sets the underlying service whose responses are cached.
+func WithService(service consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithMutableTTL sets the time for which mutable responses, such as the head
+// block header, are cached.  If not supplied this is the slot duration of the chain.
+func WithMutableTTL(ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.mutableTTL = ttl
+	})
+}
+
+// WithMaxBlocks sets the maximum number of blocks, and separately block
+// headers, that are cached by root once finalized.  Defaults to 64.
+func WithMaxBlocks(maxBlocks int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxBlocks = maxBlocks
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		maxBlocks: 64,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	if parameters.mutableTTL < 0 {
+		return nil, errors.New("mutable TTL cannot be negative")
+	}
+	if parameters.maxBlocks <= 0 {
+		return nil, errors.New("no maximum blocks specified")
+	}
+
+	return &parameters, nil
+}
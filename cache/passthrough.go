@@ -0,0 +1,1122 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/focil"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EpochFromStateID converts a state ID to its epoch.
+//
+// Deprecated: use chaintime.
+func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (phase0.Epoch, error) {
+	next, isNext := s.next.(consensusclient.EpochFromStateIDProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.EpochFromStateID(ctx, stateID)
+}
+
+// SlotFromStateID converts a state ID to its slot.
+//
+// Deprecated: use chaintime.
+func (s *Service) SlotFromStateID(ctx context.Context, stateID string) (phase0.Slot, error) {
+	next, isNext := s.next.(consensusclient.SlotFromStateIDProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SlotFromStateID(ctx, stateID)
+}
+
+// NodeVersion returns a free-text string with the node version.
+func (s *Service) NodeVersion(ctx context.Context,
+	opts *api.NodeVersionOpts,
+) (
+	*api.Response[string],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.NodeVersionProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeVersion(ctx, opts)
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+//
+// Deprecated: use Spec().
+func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
+	next, isNext := s.next.(consensusclient.SlotDurationProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SlotDuration(ctx)
+}
+
+// SlotsPerEpoch provides the slots per epoch of the chain.
+//
+// Deprecated: use Spec().
+func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	next, isNext := s.next.(consensusclient.SlotsPerEpochProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SlotsPerEpoch(ctx)
+}
+
+// FarFutureEpoch provides the far future epoch of the chain.
+func (s *Service) FarFutureEpoch(ctx context.Context) (phase0.Epoch, error) {
+	next, isNext := s.next.(consensusclient.FarFutureEpochProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.FarFutureEpoch(ctx)
+}
+
+// TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
+//
+// Deprecated: use Spec().
+func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	next, isNext := s.next.(consensusclient.TargetAggregatorsPerCommitteeProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.TargetAggregatorsPerCommittee(ctx)
+}
+
+// AggregateAttestation fetches the aggregate attestation for the given options.
+func (s *Service) AggregateAttestation(ctx context.Context,
+	opts *api.AggregateAttestationOpts,
+) (
+	*api.Response[*spec.VersionedAttestation],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.AggregateAttestationProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AggregateAttestation(ctx, opts)
+}
+
+// SubmitAggregateAttestations submits aggregate attestations.
+func (s *Service) SubmitAggregateAttestations(ctx context.Context, opts *api.SubmitAggregateAttestationsOpts) error {
+	next, isNext := s.next.(consensusclient.AggregateAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitAggregateAttestations(ctx, opts)
+}
+
+// AttestationData fetches the attestation data for the given slot and committee index.
+func (s *Service) AttestationData(ctx context.Context,
+	opts *api.AttestationDataOpts,
+) (
+	*api.Response[*phase0.AttestationData],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.AttestationDataProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttestationData(ctx, opts)
+}
+
+// AttestationPool fetches the attestation pool for the given slot.
+func (s *Service) AttestationPool(ctx context.Context,
+	opts *api.AttestationPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.AttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttestationPool(ctx, opts)
+}
+
+// SubmitAttestations submits attestations.
+func (s *Service) SubmitAttestations(ctx context.Context, attestations *api.SubmitAttestationsOpts) error {
+	next, isNext := s.next.(consensusclient.AttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitAttestations(ctx, attestations)
+}
+
+// SubmitProposalPreparations submits proposal preparations.
+func (s *Service) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error {
+	next, isNext := s.next.(consensusclient.ProposalPreparationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitProposalPreparations(ctx, preparations)
+}
+
+// SubmitSyncCommitteeContributions submits sync committee contributions.
+func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context,
+	contributionAndProofs []*altair.SignedContributionAndProof,
+) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeContributionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitSyncCommitteeContributions(ctx, contributionAndProofs)
+}
+
+// SubmitSyncCommitteeMessages submits sync committee messages.
+func (s *Service) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeMessagesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitSyncCommitteeMessages(ctx, messages)
+}
+
+// AttesterDuties obtains attester duties.
+// If validatorIndices is nil it will return all duties for the given epoch.
+func (s *Service) AttesterDuties(ctx context.Context,
+	opts *api.AttesterDutiesOpts,
+) (
+	*api.Response[[]*apiv1.AttesterDuty],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.AttesterDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttesterDuties(ctx, opts)
+}
+
+// BeaconBlockRoot fetches a block's root given a block ID.
+func (s *Service) BeaconBlockRoot(ctx context.Context,
+	opts *api.BeaconBlockRootOpts,
+) (
+	*api.Response[*phase0.Root],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BeaconBlockRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconBlockRoot(ctx, opts)
+}
+
+// BeaconCommittees fetches all beacon committees for the epoch at the given state.
+func (s *Service) BeaconCommittees(ctx context.Context,
+	opts *api.BeaconCommitteesOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommittee],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BeaconCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconCommittees(ctx, opts)
+}
+
+// Proposal fetches a proposal for signing.
+func (s *Service) Proposal(ctx context.Context,
+	opts *api.ProposalOpts,
+) (
+	*api.Response[*api.VersionedProposal],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.Proposal(ctx, opts)
+}
+
+// SubmitBeaconBlock submits a beacon block.
+//
+// Deprecated: this will not work from the deneb hard-fork onwards.  Use SubmitProposal() instead.
+func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
+	next, isNext := s.next.(consensusclient.BeaconBlockSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBeaconBlock(ctx, block)
+}
+
+// SubmitBeaconBlockWithOpts submits a beacon block with the given options.
+func (s *Service) SubmitBeaconBlockWithOpts(ctx context.Context, opts *api.SubmitBeaconBlockOpts) error {
+	next, isNext := s.next.(consensusclient.BeaconBlockWithOptsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBeaconBlockWithOpts(ctx, opts)
+}
+
+// SubmitProposal submits a proposal.
+func (s *Service) SubmitProposal(ctx context.Context, opts *api.SubmitProposalOpts) error {
+	next, isNext := s.next.(consensusclient.ProposalSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitProposal(ctx, opts)
+}
+
+// BeaconCommitteeSelections combines partial beacon committee selection proofs into aggregated selection proofs.
+func (s *Service) BeaconCommitteeSelections(ctx context.Context,
+	opts *api.BeaconCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommitteeSelection],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconCommitteeSelections(ctx, opts)
+}
+
+// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
+func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context,
+	subscriptions []*apiv1.BeaconCommitteeSubscription,
+) error {
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSubscriptionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBeaconCommitteeSubscriptions(ctx, subscriptions)
+}
+
+// SubmitBlindedBeaconBlock submits a blinded beacon block.
+//
+// Deprecated: this will not work from the deneb hard-fork onwards.  Use SubmitBlindedProposal() instead.
+func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+) error {
+	next, isNext := s.next.(consensusclient.BlindedBeaconBlockSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBlindedBeaconBlock(ctx, block)
+}
+
+// SubmitBlindedProposal submits a blinded proposal.
+func (s *Service) SubmitBlindedProposal(ctx context.Context, opts *api.SubmitBlindedProposalOpts) error {
+	next, isNext := s.next.(consensusclient.BlindedProposalSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBlindedProposal(ctx, opts)
+}
+
+// SubmitValidatorRegistrations submits a validator registration.
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context,
+	registrations []*api.VersionedSignedValidatorRegistration,
+) error {
+	next, isNext := s.next.(consensusclient.ValidatorRegistrationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitValidatorRegistrations(ctx, registrations)
+}
+
+// SyncCommitteeSelections combines partial sync committee selection proofs into aggregated selection proofs.
+func (s *Service) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommitteeSelections(ctx, opts)
+}
+
+// SubmitSyncCommitteeSubscriptions subscribes to sync committees.
+func (s *Service) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.SyncCommitteeSubscription) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeSubscriptionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitSyncCommitteeSubscriptions(ctx, subscriptions)
+}
+
+// BeaconState fetches a beacon state.
+func (s *Service) BeaconState(ctx context.Context,
+	opts *api.BeaconStateOpts,
+) (
+	*api.Response[*spec.VersionedBeaconState],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BeaconStateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconState(ctx, opts)
+}
+
+// DownloadBeaconState downloads a beacon state.
+func (s *Service) DownloadBeaconState(ctx context.Context,
+	opts *api.DownloadBeaconStateOpts,
+) (
+	*api.Response[*api.BeaconStateDownload],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BeaconStateDownloader)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DownloadBeaconState(ctx, opts)
+}
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Service) BeaconStateRandao(ctx context.Context,
+	opts *api.BeaconStateRandaoOpts,
+) (
+	*api.Response[*phase0.Root],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconStateRandao(ctx, opts)
+}
+
+// DepositSnapshot provides a snapshot of the deposit tree.
+func (s *Service) DepositSnapshot(ctx context.Context,
+	opts *api.DepositSnapshotOpts,
+) (
+	*api.Response[*apiv1.DepositSnapshot],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DepositSnapshot(ctx, opts)
+}
+
+// Events feeds requested events with the given topics to the supplied handler.
+func (s *Service) Events(ctx context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
+	next, isNext := s.next.(consensusclient.EventsProvider)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.Events(ctx, topics, handler)
+}
+
+// EventsWithHandlers feeds events to the supplied typed handlers.
+func (s *Service) EventsWithHandlers(ctx context.Context, handlers *consensusclient.EventHandlers) error {
+	next, isNext := s.next.(consensusclient.EventsWithHandlersProvider)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.EventsWithHandlers(ctx, handlers)
+}
+
+// ExpectedWithdrawals provides the withdrawals expected to be included in a proposal built on the given state.
+func (s *Service) ExpectedWithdrawals(ctx context.Context,
+	opts *api.ExpectedWithdrawalsOpts,
+) (
+	*api.Response[[]*capella.Withdrawal],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ExpectedWithdrawals(ctx, opts)
+}
+
+// Finality provides the finality given a state ID.
+func (s *Service) Finality(ctx context.Context,
+	opts *api.FinalityOpts,
+) (
+	*api.Response[*apiv1.Finality],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.FinalityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.Finality(ctx, opts)
+}
+
+// Fork fetches fork information for the given state.
+func (s *Service) Fork(ctx context.Context,
+	opts *api.ForkOpts,
+) (
+	*api.Response[*phase0.Fork],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ForkProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.Fork(ctx, opts)
+}
+
+// NodeSyncing provides the state of the node's synchronization with the chain.
+func (s *Service) NodeSyncing(ctx context.Context,
+	opts *api.NodeSyncingOpts,
+) (
+	*api.Response[*apiv1.SyncState],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.NodeSyncingProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeSyncing(ctx, opts)
+}
+
+// NodeIdentity provides the network identity of the node.
+func (s *Service) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
+// NodePeer provides a single peer of the node.
+func (s *Service) NodePeer(ctx context.Context,
+	opts *api.NodePeerOpts,
+) (
+	*api.Response[*apiv1.Peer],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.NodePeerProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodePeer(ctx, opts)
+}
+
+// NodePeerCount provides the number of peers of the node in each connection state.
+func (s *Service) NodePeerCount(ctx context.Context,
+	opts *api.NodePeerCountOpts,
+) (
+	*api.Response[*apiv1.PeerCount],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodePeerCount(ctx, opts)
+}
+
+// NodePeers provides the peers of the node.
+func (s *Service) NodePeers(ctx context.Context,
+	opts *api.NodePeersOpts,
+) (
+	*api.Response[[]*apiv1.Peer],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.NodePeersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodePeers(ctx, opts)
+}
+
+// ProposerDuties obtains proposer duties for the given epoch.
+func (s *Service) ProposerDuties(ctx context.Context,
+	opts *api.ProposerDutiesOpts,
+) (
+	*api.Response[[]*apiv1.ProposerDuty],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ProposerDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ProposerDuties(ctx, opts)
+}
+
+// SyncCommittee fetches the sync committee for the given state.
+func (s *Service) SyncCommittee(ctx context.Context,
+	opts *api.SyncCommitteeOpts,
+) (
+	*api.Response[*apiv1.SyncCommittee],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.SyncCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommittee(ctx, opts)
+}
+
+// SyncCommitteeContribution provides a sync committee contribution.
+func (s *Service) SyncCommitteeContribution(ctx context.Context,
+	opts *api.SyncCommitteeContributionOpts,
+) (
+	*api.Response[*altair.SyncCommitteeContribution],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeContributionProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommitteeContribution(ctx, opts)
+}
+
+// SyncCommitteeDuties obtains sync committee duties.
+// If validatorIndices is nil it will return all duties for the given epoch.
+func (s *Service) SyncCommitteeDuties(ctx context.Context,
+	opts *api.SyncCommitteeDutiesOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeDuty],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommitteeDuties(ctx, opts)
+}
+
+// ValidatorBalances provides the validator balances for a given state.
+func (s *Service) ValidatorBalances(ctx context.Context,
+	opts *api.ValidatorBalancesOpts,
+) (
+	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ValidatorBalancesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorBalances(ctx, opts)
+}
+
+// Validators provides the validators, with their balance and status, for a given state.
+func (s *Service) Validators(ctx context.Context,
+	opts *api.ValidatorsOpts,
+) (
+	*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ValidatorsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.Validators(ctx, opts)
+}
+
+// ValidatorIdentities provides the identities of validators for the given options.
+func (s *Service) ValidatorIdentities(ctx context.Context,
+	opts *api.ValidatorIdentitiesOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorIdentity],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ValidatorIdentitiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorIdentities(ctx, opts)
+}
+
+// ValidatorLiveness provides the liveness of validators for the given options.
+func (s *Service) ValidatorLiveness(ctx context.Context,
+	opts *api.ValidatorLivenessOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorLiveness],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorLiveness(ctx, opts)
+}
+
+// ValidatorsIterator provides an iterator over the validators, with their balance and status, for the given options.
+func (s *Service) ValidatorsIterator(ctx context.Context,
+	opts *api.ValidatorsIteratorOpts,
+) (
+	*api.ValidatorsIterator,
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ValidatorsIteratorProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorsIterator(ctx, opts)
+}
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Service) SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitAttesterSlashing(ctx, slashing)
+}
+
+// SubmitAttesterSlashings submits versioned attester slashings.
+func (s *Service) SubmitAttesterSlashings(ctx context.Context, opts *api.SubmitAttesterSlashingsOpts) error {
+	next, isNext := s.next.(consensusclient.AttesterSlashingsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitAttesterSlashings(ctx, opts)
+}
+
+// SubmitProposalSlashing submits a proposer slashing.
+func (s *Service) SubmitProposalSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	next, isNext := s.next.(consensusclient.ProposalSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitProposalSlashing(ctx, slashing)
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Service) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBLSToExecutionChanges(ctx, blsToExecutionChanges)
+}
+
+// SubmitVoluntaryExit submits a voluntary exit.
+func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
+	next, isNext := s.next.(consensusclient.VoluntaryExitSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitVoluntaryExit(ctx, voluntaryExit)
+}
+
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Service) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttesterSlashing],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttesterSlashingPool(ctx, opts)
+}
+
+// BLSToExecutionChangePool fetches the BLS to execution change pool.
+func (s *Service) BLSToExecutionChangePool(ctx context.Context,
+	opts *api.BLSToExecutionChangePoolOpts,
+) (
+	*api.Response[[]*capella.SignedBLSToExecutionChange],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BLSToExecutionChangePool(ctx, opts)
+}
+
+// ProposerSlashingPool fetches the proposer slashing pool.
+func (s *Service) ProposerSlashingPool(ctx context.Context,
+	opts *api.ProposerSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.ProposerSlashing],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ProposerSlashingPool(ctx, opts)
+}
+
+// VoluntaryExitPool fetches the voluntary exit pool.
+func (s *Service) VoluntaryExitPool(ctx context.Context,
+	opts *api.VoluntaryExitPoolOpts,
+) (
+	*api.Response[[]*phase0.SignedVoluntaryExit],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.VoluntaryExitPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.VoluntaryExitPool(ctx, opts)
+}
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	next, isNext := s.next.(consensusclient.DomainProvider)
+	if !isNext {
+		return phase0.Domain{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.Domain(ctx, domainType, epoch)
+}
+
+// GenesisDomain provides a domain for a given domain type.
+func (s *Service) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	next, isNext := s.next.(consensusclient.DomainProvider)
+	if !isNext {
+		return phase0.Domain{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.GenesisDomain(ctx, domainType)
+}
+
+// GenesisTime provides the genesis time of the chain.
+//
+// Deprecated: use Genesis().
+func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
+	next, isNext := s.next.(consensusclient.GenesisTimeProvider)
+	if !isNext {
+		return time.Time{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.GenesisTime(ctx)
+}
+
+// InclusionList fetches an inclusion list for the given options.
+func (s *Service) InclusionList(ctx context.Context,
+	opts *api.InclusionListOpts,
+) (
+	*api.Response[*focil.InclusionList],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.InclusionListProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.InclusionList(ctx, opts)
+}
+
+// InclusionListDuties obtains inclusion list duties for the given options.
+func (s *Service) InclusionListDuties(ctx context.Context,
+	opts *api.InclusionListDutiesOpts,
+) (
+	*api.Response[[]*apiv1.InclusionListDuty],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.InclusionListDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.InclusionListDuties(ctx, opts)
+}
+
+// SubmitInclusionLists submits signed inclusion lists.
+func (s *Service) SubmitInclusionLists(ctx context.Context, opts *api.SubmitInclusionListsOpts) error {
+	next, isNext := s.next.(consensusclient.InclusionListsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitInclusionLists(ctx, opts)
+}
+
+// ClientInfo provides structured information about the implementation of the node.
+func (s *Service) ClientInfo(ctx context.Context) (*api.Response[*apiv1.ClientInfo], error) {
+	next, isNext := s.next.(consensusclient.ClientInfoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ClientInfo(ctx)
+}
+
+// NodeClient provides the client for the node.
+func (s *Service) NodeClient(ctx context.Context) (*api.Response[string], error) {
+	next, isNext := s.next.(consensusclient.NodeClientProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeClient(ctx)
+}
+
+// SupportedMethods provides the methods supported by the client.
+func (s *Service) SupportedMethods(ctx context.Context) (*api.SupportedMethods, error) {
+	next, isNext := s.next.(consensusclient.SupportedMethodsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SupportedMethods(ctx)
+}
+
+// BlobSidecars fetches the blobs given a block ID.
+func (s *Service) BlobSidecars(ctx context.Context,
+	opts *api.BlobSidecarsOpts,
+) (
+	*api.Response[[]*deneb.BlobSidecar],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BlobSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BlobSidecars(ctx, opts)
+}
+
+// VersionedBlobSidecars fetches the versioned blob sidecars given a block ID.
+func (s *Service) VersionedBlobSidecars(ctx context.Context,
+	opts *api.BlobSidecarsOpts,
+) (
+	*api.Response[*api.VersionedBlobSidecars],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.VersionedBlobSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.VersionedBlobSidecars(ctx, opts)
+}
+
+// DataColumnSidecars fetches the data column sidecars given a block ID.
+func (s *Service) DataColumnSidecars(ctx context.Context,
+	opts *api.DataColumnSidecarsOpts,
+) (
+	*api.Response[[]*fulu.DataColumnSidecar],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.DataColumnSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DataColumnSidecars(ctx, opts)
+}
+
+// BeaconStateRoot fetches a beacon state root given a state ID.
+func (s *Service) BeaconStateRoot(ctx context.Context,
+	opts *api.BeaconStateRootOpts,
+) (
+	*api.Response[*phase0.Root],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BeaconStateRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconStateRoot(ctx, opts)
+}
+
+// ForkChoice fetches the node's current fork choice context.
+func (s *Service) ForkChoice(ctx context.Context,
+	opts *api.ForkChoiceOpts,
+) (
+	*api.Response[*apiv1.ForkChoice],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ForkChoiceProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ForkChoice(ctx, opts)
+}
+
+// AttestationRewards provides rewards to the given validators for attesting.
+func (s *Service) AttestationRewards(ctx context.Context,
+	opts *api.AttestationRewardsOpts,
+) (
+	*api.Response[*apiv1.AttestationRewards],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttestationRewards(ctx, opts)
+}
+
+// BlockRewards provides rewards for proposing a block.
+func (s *Service) BlockRewards(ctx context.Context,
+	opts *api.BlockRewardsOpts,
+) (
+	*api.Response[*apiv1.BlockRewards],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BlockRewards(ctx, opts)
+}
+
+// SyncCommitteeRewards provides rewards to the given validators for being members of a sync committee.
+func (s *Service) SyncCommitteeRewards(ctx context.Context,
+	opts *api.SyncCommitteeRewardsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeReward],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommitteeRewards(ctx, opts)
+}
+
+// LightClientBootstrap fetches the light client bootstrap given options.
+func (s *Service) LightClientBootstrap(ctx context.Context,
+	opts *api.LightClientBootstrapOpts,
+) (
+	*api.Response[*spec.VersionedLightClientBootstrap],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.LightClientBootstrapProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientBootstrap(ctx, opts)
+}
+
+// LightClientUpdates fetches the light client updates given options.
+func (s *Service) LightClientUpdates(ctx context.Context,
+	opts *api.LightClientUpdatesOpts,
+) (
+	*api.Response[[]*spec.VersionedLightClientUpdate],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.LightClientUpdatesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientUpdates(ctx, opts)
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update given options.
+func (s *Service) LightClientFinalityUpdate(ctx context.Context,
+	opts *api.LightClientFinalityUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientFinalityUpdate],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientFinalityUpdate(ctx, opts)
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update given options.
+func (s *Service) LightClientOptimisticUpdate(ctx context.Context,
+	opts *api.LightClientOptimisticUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientOptimisticUpdate],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientOptimisticUpdate(ctx, opts)
+}
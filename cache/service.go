@@ -0,0 +1,260 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a consensus client service that caches the responses
+// of an underlying service.  Immutable responses, such as the genesis, spec and
+// finalized blocks by root, are cached indefinitely; mutable responses, such as the head
+// block header, are cached for a limited time.  All other calls are passed
+// directly to the underlying service.
+//
+// Cached responses are shared between callers, so must not be modified.
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// defaultMutableTTL is the time for which mutable responses are cached if the
+// slot duration of the chain is not available.
+const defaultMutableTTL = 12 * time.Second
+
+// Service is a consensus client service that caches responses.
+type Service struct {
+	next       consensusclient.Service
+	mutableTTL time.Duration
+	now        func() time.Time
+
+	genesis         entry[*apiv1.Genesis]
+	spec            entry[map[string]any]
+	depositContract entry[*apiv1.DepositContract]
+	forkSchedule    entry[[]*phase0.Fork]
+	headHeader      entry[*apiv1.BeaconBlockHeader]
+	blocks          *rootCache[*spec.VersionedSignedBeaconBlock]
+	headers         *rootCache[*apiv1.BeaconBlockHeader]
+}
+
+// New creates a new caching service.
+func New(ctx context.Context, params ...Parameter) (consensusclient.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	s := &Service{
+		next:       parameters.service,
+		mutableTTL: parameters.mutableTTL,
+		now:        time.Now,
+		blocks:     newRootCache[*spec.VersionedSignedBeaconBlock](parameters.maxBlocks),
+		headers:    newRootCache[*apiv1.BeaconBlockHeader](parameters.maxBlocks),
+	}
+
+	if s.mutableTTL == 0 {
+		s.mutableTTL = defaultMutableTTL
+		if _, isProvider := s.next.(consensusclient.SpecProvider); isProvider {
+			specResponse, err := s.Spec(ctx, &api.SpecOpts{})
+			if err != nil {
+				return nil, errors.Join(errors.New("failed to obtain spec"), err)
+			}
+			slotDuration, err := apiv1.NewSpecConfig(specResponse.Data).SecondsPerSlot()
+			if err == nil {
+				s.mutableTTL = slotDuration
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// Name returns the name of the client implementation.
+func (s *Service) Name() string {
+	return fmt.Sprintf("cache(%s)", s.next.Name())
+}
+
+// Address returns the address of the client.
+func (s *Service) Address() string {
+	return s.next.Address()
+}
+
+// IsActive returns true if the client is active.
+func (s *Service) IsActive() bool {
+	return s.next.IsActive()
+}
+
+// IsSynced returns true if the client is synced.
+func (s *Service) IsSynced() bool {
+	return s.next.IsSynced()
+}
+
+// Genesis provides the genesis information of the chain.
+func (s *Service) Genesis(ctx context.Context,
+	opts *api.GenesisOpts,
+) (
+	*api.Response[*apiv1.Genesis],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.GenesisProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return s.genesis.get(ctx, s.now(), 0, func(ctx context.Context) (*api.Response[*apiv1.Genesis], error) {
+		return next.Genesis(ctx, opts)
+	})
+}
+
+// Spec provides the spec information of the chain.
+func (s *Service) Spec(ctx context.Context,
+	opts *api.SpecOpts,
+) (
+	*api.Response[map[string]any],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.SpecProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return s.spec.get(ctx, s.now(), 0, func(ctx context.Context) (*api.Response[map[string]any], error) {
+		return next.Spec(ctx, opts)
+	})
+}
+
+// DepositContract provides details of the execution deposit contract for the chain.
+func (s *Service) DepositContract(ctx context.Context,
+	opts *api.DepositContractOpts,
+) (
+	*api.Response[*apiv1.DepositContract],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.DepositContractProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return s.depositContract.get(ctx, s.now(), 0, func(ctx context.Context) (*api.Response[*apiv1.DepositContract], error) {
+		return next.DepositContract(ctx, opts)
+	})
+}
+
+// ForkSchedule provides details of past and future changes in the chain's fork version.
+func (s *Service) ForkSchedule(ctx context.Context,
+	opts *api.ForkScheduleOpts,
+) (
+	*api.Response[[]*phase0.Fork],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.ForkScheduleProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return s.forkSchedule.get(ctx, s.now(), 0, func(ctx context.Context) (*api.Response[[]*phase0.Fork], error) {
+		return next.ForkSchedule(ctx, opts)
+	})
+}
+
+// SignedBeaconBlock fetches a signed beacon block given a block ID.
+// Finalized blocks requested by root are cached.
+func (s *Service) SignedBeaconBlock(ctx context.Context,
+	opts *api.SignedBeaconBlockOpts,
+) (
+	*api.Response[*spec.VersionedSignedBeaconBlock],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.SignedBeaconBlockProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	if opts == nil || !isRoot(opts.Block) {
+		return next.SignedBeaconBlock(ctx, opts)
+	}
+
+	root := strings.ToLower(opts.Block)
+	if response, exists := s.blocks.get(root); exists {
+		return response, nil
+	}
+	response, err := next.SignedBeaconBlock(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if isFinalized(response.Metadata) {
+		s.blocks.set(root, response)
+	}
+
+	return response, nil
+}
+
+// BeaconBlockHeader provides the block header of a given block ID.
+// Finalized headers requested by root are cached, and the head header is
+// cached for the mutable TTL.
+func (s *Service) BeaconBlockHeader(ctx context.Context,
+	opts *api.BeaconBlockHeaderOpts,
+) (
+	*api.Response[*apiv1.BeaconBlockHeader],
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	if opts == nil {
+		return next.BeaconBlockHeader(ctx, opts)
+	}
+
+	switch {
+	case opts.Block == "head":
+		return s.headHeader.get(ctx, s.now(), s.mutableTTL, func(ctx context.Context) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+			return next.BeaconBlockHeader(ctx, opts)
+		})
+	case isRoot(opts.Block):
+		root := strings.ToLower(opts.Block)
+		if response, exists := s.headers.get(root); exists {
+			return response, nil
+		}
+		response, err := next.BeaconBlockHeader(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		if isFinalized(response.Metadata) {
+			s.headers.set(root, response)
+		}
+
+		return response, nil
+	default:
+		return next.BeaconBlockHeader(ctx, opts)
+	}
+}
+
+// isFinalized returns true if the response metadata states that the data is
+// finalized and not optimistic, and so will not change.
+func isFinalized(metadata map[string]any) bool {
+	finalized, _ := metadata["finalized"].(bool)
+	optimistic, _ := metadata["execution_optimistic"].(bool)
+
+	return finalized && !optimistic
+}
+
+// isRoot returns true if the block ID is a block root.
+func isRoot(blockID string) bool {
+	return len(blockID) == 2+2*phase0.RootLength && strings.HasPrefix(blockID, "0x")
+}
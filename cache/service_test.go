@@ -0,0 +1,354 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	next, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []cache.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			err:  "problem with parameters\nno service specified",
+		},
+		{
+			name: "MutableTTLNegative",
+			params: []cache.Parameter{
+				cache.WithService(next),
+				cache.WithMutableTTL(-1),
+			},
+			err: "problem with parameters\nmutable TTL cannot be negative",
+		},
+		{
+			name: "MaxBlocksZero",
+			params: []cache.Parameter{
+				cache.WithService(next),
+				cache.WithMaxBlocks(0),
+			},
+			err: "problem with parameters\nno maximum blocks specified",
+		},
+		{
+			name: "Good",
+			params: []cache.Parameter{
+				cache.WithService(next),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := cache.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestImmutable(t *testing.T) {
+	ctx := context.Background()
+
+	next, err := mock.New(ctx)
+	require.NoError(t, err)
+	genesisCalls := 0
+	next.GenesisFunc = func(_ context.Context, _ *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+		genesisCalls++
+		if genesisCalls == 1 {
+			return nil, errors.New("transient")
+		}
+
+		return &api.Response[*apiv1.Genesis]{
+			Data:     &apiv1.Genesis{GenesisTime: time.Unix(1606824023, 0)},
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	s, err := cache.New(ctx, cache.WithService(next))
+	require.NoError(t, err)
+	provider := s.(consensusclient.GenesisProvider)
+
+	// Errors are not cached.
+	_, err = provider.Genesis(ctx, &api.GenesisOpts{})
+	require.EqualError(t, err, "transient")
+	for i := 0; i < 3; i++ {
+		response, err := provider.Genesis(ctx, &api.GenesisOpts{})
+		require.NoError(t, err)
+		require.Equal(t, int64(1606824023), response.Data.GenesisTime.Unix())
+	}
+	require.Equal(t, 2, genesisCalls)
+}
+
+func TestBlocksByRoot(t *testing.T) {
+	ctx := context.Background()
+
+	next, err := mock.New(ctx)
+	require.NoError(t, err)
+	calls := make(map[string]int)
+	next.SignedBeaconBlockFunc = func(_ context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+		calls[opts.Block]++
+
+		return &api.Response[*spec.VersionedSignedBeaconBlock]{
+			Data: &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionPhase0},
+			Metadata: map[string]any{
+				"execution_optimistic": false,
+				"finalized":            true,
+			},
+		}, nil
+	}
+
+	s, err := cache.New(ctx, cache.WithService(next), cache.WithMaxBlocks(2))
+	require.NoError(t, err)
+	provider := s.(consensusclient.SignedBeaconBlockProvider)
+
+	root1 := phase0.Root{0x01}.String()
+	root2 := phase0.Root{0x02}.String()
+	root3 := phase0.Root{0x03}.String()
+	for _, blockID := range []string{root1, root1, root2, root1, "head", "head", root3, root1} {
+		_, err := provider.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: blockID})
+		require.NoError(t, err)
+	}
+	// Root 1 is evicted by root 3, so obtained twice.
+	require.Equal(t, 2, calls[root1])
+	require.Equal(t, 1, calls[root2])
+	require.Equal(t, 1, calls[root3])
+	// Head is never cached.
+	require.Equal(t, 2, calls["head"])
+}
+
+func TestUnfinalizedNotCached(t *testing.T) {
+	ctx := context.Background()
+
+	next, err := mock.New(ctx)
+	require.NoError(t, err)
+	metadata := map[string]map[string]any{
+		phase0.Root{0x01}.String(): {"execution_optimistic": false, "finalized": false},
+		phase0.Root{0x02}.String(): {"execution_optimistic": true, "finalized": true},
+		phase0.Root{0x03}.String(): {},
+	}
+	blockCalls := make(map[string]int)
+	next.SignedBeaconBlockFunc = func(_ context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+		blockCalls[opts.Block]++
+
+		return &api.Response[*spec.VersionedSignedBeaconBlock]{
+			Data:     &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionPhase0},
+			Metadata: metadata[opts.Block],
+		}, nil
+	}
+	headerCalls := make(map[string]int)
+	next.BeaconBlockHeaderFunc = func(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+		headerCalls[opts.Block]++
+
+		return &api.Response[*apiv1.BeaconBlockHeader]{
+			Data:     &apiv1.BeaconBlockHeader{},
+			Metadata: metadata[opts.Block],
+		}, nil
+	}
+
+	s, err := cache.New(ctx, cache.WithService(next))
+	require.NoError(t, err)
+	blockProvider := s.(consensusclient.SignedBeaconBlockProvider)
+	headerProvider := s.(consensusclient.BeaconBlockHeadersProvider)
+
+	for root := range metadata {
+		for i := 0; i < 2; i++ {
+			_, err := blockProvider.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: root})
+			require.NoError(t, err)
+			_, err = headerProvider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: root})
+			require.NoError(t, err)
+		}
+		// Responses that are not finalized, or are optimistic, are obtained each time.
+		require.Equal(t, 2, blockCalls[root], root)
+		require.Equal(t, 2, headerCalls[root], root)
+	}
+}
+
+func TestHeadHeader(t *testing.T) {
+	ctx := context.Background()
+
+	next, err := mock.New(ctx)
+	require.NoError(t, err)
+	calls := make(map[string]int)
+	next.BeaconBlockHeaderFunc = func(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+		calls[opts.Block]++
+
+		return &api.Response[*apiv1.BeaconBlockHeader]{
+			Data: &apiv1.BeaconBlockHeader{},
+			Metadata: map[string]any{
+				"execution_optimistic": false,
+				"finalized":            true,
+			},
+		}, nil
+	}
+
+	s, err := cache.New(ctx, cache.WithService(next), cache.WithMutableTTL(50*time.Millisecond))
+	require.NoError(t, err)
+	provider := s.(consensusclient.BeaconBlockHeadersProvider)
+
+	root := phase0.Root{0x01}.String()
+	for _, blockID := range []string{"head", "head", root, root, "finalized", "finalized"} {
+		_, err := provider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: blockID})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, calls["head"])
+	require.Equal(t, 1, calls[root])
+	require.Equal(t, 2, calls["finalized"])
+
+	// The head header expires.
+	time.Sleep(60 * time.Millisecond)
+	_, err = provider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls["head"])
+}
+
+func TestPassthrough(t *testing.T) {
+	ctx := context.Background()
+
+	next, err := mock.New(ctx)
+	require.NoError(t, err)
+	s, err := cache.New(ctx, cache.WithService(next))
+	require.NoError(t, err)
+
+	require.Equal(t, "cache(Mock)", s.Name())
+	require.Equal(t, next.Address(), s.Address())
+
+	response, err := s.(consensusclient.FinalityProvider).Finality(ctx, &api.FinalityOpts{State: "head"})
+	require.NoError(t, err)
+	require.NotNil(t, response.Data)
+}
+
+func TestInterfaces(t *testing.T) {
+	ctx := context.Background()
+
+	next, err := mock.New(ctx)
+	require.NoError(t, err)
+	s, err := cache.New(ctx, cache.WithService(next))
+	require.NoError(t, err)
+
+	require.Implements(t, (*consensusclient.EpochFromStateIDProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SlotFromStateIDProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SlotDurationProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SlotsPerEpochProvider)(nil), s)
+	require.Implements(t, (*consensusclient.FarFutureEpochProvider)(nil), s)
+	require.Implements(t, (*consensusclient.TargetAggregatorsPerCommitteeProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SignedBeaconBlockProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BlobSidecarsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.VersionedBlobSidecarsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.DataColumnSidecarsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.LightClientBootstrapProvider)(nil), s)
+	require.Implements(t, (*consensusclient.LightClientUpdatesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.LightClientFinalityUpdateProvider)(nil), s)
+	require.Implements(t, (*consensusclient.LightClientOptimisticUpdateProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconCommitteesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SyncCommitteesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.AggregateAttestationProvider)(nil), s)
+	require.Implements(t, (*consensusclient.AggregateAttestationsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.AttestationDataProvider)(nil), s)
+	require.Implements(t, (*consensusclient.AttestationPoolProvider)(nil), s)
+	require.Implements(t, (*consensusclient.AttestationRewardsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.AttestationsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.AttesterSlashingSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.AttesterSlashingsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.BLSToExecutionChangePoolProvider)(nil), s)
+	require.Implements(t, (*consensusclient.AttesterDutiesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.AttesterSlashingPoolProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BlockRewardsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.DepositContractProvider)(nil), s)
+	require.Implements(t, (*consensusclient.DepositSnapshotProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SyncCommitteeDutiesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SyncCommitteeMessagesSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.SyncCommitteeSelectionsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SyncCommitteeSubscriptionsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.SyncCommitteeContributionProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SyncCommitteeContributionsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.SyncCommitteeRewardsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BLSToExecutionChangesSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconBlockHeadersProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ProposalProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ProposalSlashingSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconBlockRootProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconBlockSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconBlockWithOptsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.ProposalSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconCommitteeSelectionsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconStateProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconStateDownloader)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconStateRandaoProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BeaconStateRootProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BlindedBeaconBlockSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.BlindedProposalSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.ValidatorRegistrationsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.EventsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.EventsWithHandlersProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ExpectedWithdrawalsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.FinalityProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ForkChoiceProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ForkProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ForkScheduleProvider)(nil), s)
+	require.Implements(t, (*consensusclient.GenesisProvider)(nil), s)
+	require.Implements(t, (*consensusclient.InclusionListProvider)(nil), s)
+	require.Implements(t, (*consensusclient.InclusionListDutiesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.InclusionListsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.NodeIdentityProvider)(nil), s)
+	require.Implements(t, (*consensusclient.NodePeerProvider)(nil), s)
+	require.Implements(t, (*consensusclient.NodePeerCountProvider)(nil), s)
+	require.Implements(t, (*consensusclient.NodePeersProvider)(nil), s)
+	require.Implements(t, (*consensusclient.NodeSyncingProvider)(nil), s)
+	require.Implements(t, (*consensusclient.NodeVersionProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ProposalPreparationsSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.ProposerDutiesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ProposerSlashingPoolProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SpecProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ValidatorBalancesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ValidatorsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ValidatorIdentitiesProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ValidatorLivenessProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ValidatorsIteratorProvider)(nil), s)
+	require.Implements(t, (*consensusclient.VoluntaryExitSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.VoluntaryExitPoolProvider)(nil), s)
+	require.Implements(t, (*consensusclient.ClientInfoProvider)(nil), s)
+	require.Implements(t, (*consensusclient.DomainProvider)(nil), s)
+	require.Implements(t, (*consensusclient.SupportedMethodsProvider)(nil), s)
+	require.Implements(t, (*consensusclient.GenesisTimeProvider)(nil), s)
+	require.Implements(t, (*consensusclient.NodeClientProvider)(nil), s)
+
+	// Composite interfaces.
+	require.Implements(t, (*consensusclient.IndexerRequirements)(nil), s)
+	require.Implements(t, (*consensusclient.MEVRequirements)(nil), s)
+	require.Implements(t, (*consensusclient.ValidatorClientRequirements)(nil), s)
+}
@@ -0,0 +1,69 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+type parameters struct {
+	genesisProvider consensusclient.GenesisProvider
+	specProvider    consensusclient.SpecProvider
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithGenesisProvider sets the provider of the genesis of the chain.
+func WithGenesisProvider(provider consensusclient.GenesisProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisProvider = provider
+	})
+}
+
+// WithSpecProvider sets the provider of the specification of the chain.
+func WithSpecProvider(provider consensusclient.SpecProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.specProvider = provider
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.genesisProvider == nil {
+		return nil, errors.New("no genesis provider specified")
+	}
+	if parameters.specProvider == nil {
+		return nil, errors.New("no spec provider specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,193 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaintime converts between wall-clock time, slots and epochs.
+package chaintime
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// farFutureEpoch is the epoch used for forks that are not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// Service provides chain time information.
+type Service struct {
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+	// forkEpochs are the epochs at which each fork activates, indexed by data version.
+	forkEpochs map[spec.DataVersion]phase0.Epoch
+	now        func() time.Time
+}
+
+// New creates a new chain time service, obtaining the genesis and specification
+// of the chain from the supplied providers.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	genesisResponse, err := parameters.genesisProvider.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain genesis"), err)
+	}
+	specResponse, err := parameters.specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+
+	config := apiv1.NewSpecConfig(specResponse.Data)
+	slotDuration, err := config.SecondsPerSlot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain slot duration"), err)
+	}
+	if slotDuration == 0 {
+		return nil, errors.New("slot duration cannot be zero")
+	}
+	slotsPerEpoch, err := config.SlotsPerEpoch()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain slots per epoch"), err)
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be zero")
+	}
+
+	forkEpochs := map[spec.DataVersion]phase0.Epoch{
+		spec.DataVersionPhase0: 0,
+	}
+	for version := spec.DataVersionAltair; version <= spec.DataVersionFulu; version++ {
+		epoch, err := config.ForkEpoch(strings.ToUpper(version.String()))
+		if err != nil {
+			// Fork not known to the node.
+			continue
+		}
+		forkEpochs[version] = epoch
+	}
+
+	return &Service{
+		genesisTime:   genesisResponse.Data.GenesisTime,
+		slotDuration:  slotDuration,
+		slotsPerEpoch: slotsPerEpoch,
+		forkEpochs:    forkEpochs,
+		now:           time.Now,
+	}, nil
+}
+
+// GenesisTime provides the time of the chain's genesis.
+func (s *Service) GenesisTime() time.Time {
+	return s.genesisTime
+}
+
+// SlotDuration provides the duration of a slot.
+func (s *Service) SlotDuration() time.Duration {
+	return s.slotDuration
+}
+
+// SlotsPerEpoch provides the number of slots in an epoch.
+func (s *Service) SlotsPerEpoch() uint64 {
+	return s.slotsPerEpoch
+}
+
+// SlotStartTime provides the time at which the given slot starts.
+func (s *Service) SlotStartTime(slot phase0.Slot) time.Time {
+	return s.genesisTime.Add(time.Duration(slot) * s.slotDuration)
+}
+
+// EpochStartTime provides the time at which the given epoch starts.
+func (s *Service) EpochStartTime(epoch phase0.Epoch) time.Time {
+	return s.SlotStartTime(s.FirstSlotOfEpoch(epoch))
+}
+
+// SlotAtTime provides the slot at the given time.  Times before
+// genesis return slot 0.
+func (s *Service) SlotAtTime(t time.Time) phase0.Slot {
+	if t.Before(s.genesisTime) {
+		return 0
+	}
+
+	return phase0.Slot(t.Sub(s.genesisTime) / s.slotDuration)
+}
+
+// EpochAtTime provides the epoch at the given time.  Times before
+// genesis return epoch 0.
+func (s *Service) EpochAtTime(t time.Time) phase0.Epoch {
+	return s.EpochOfSlot(s.SlotAtTime(t))
+}
+
+// CurrentSlot provides the current slot.
+func (s *Service) CurrentSlot() phase0.Slot {
+	return s.SlotAtTime(s.now())
+}
+
+// CurrentEpoch provides the current epoch.
+func (s *Service) CurrentEpoch() phase0.Epoch {
+	return s.EpochAtTime(s.now())
+}
+
+// EpochOfSlot provides the epoch of the given slot.
+func (s *Service) EpochOfSlot(slot phase0.Slot) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / s.slotsPerEpoch)
+}
+
+// FirstSlotOfEpoch provides the first slot of the given epoch.
+func (s *Service) FirstSlotOfEpoch(epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
+
+// LastSlotOfEpoch provides the last slot of the given epoch.
+func (s *Service) LastSlotOfEpoch(epoch phase0.Epoch) phase0.Slot {
+	return s.FirstSlotOfEpoch(epoch+1) - 1
+}
+
+// ForkEpoch provides the epoch at which the given fork activates, and
+// false if the fork is not scheduled.
+func (s *Service) ForkEpoch(version spec.DataVersion) (phase0.Epoch, bool) {
+	epoch, exists := s.forkEpochs[version]
+	if !exists || epoch == farFutureEpoch {
+		return 0, false
+	}
+
+	return epoch, true
+}
+
+// ForkAtEpoch provides the fork in force at the given epoch.
+func (s *Service) ForkAtEpoch(epoch phase0.Epoch) spec.DataVersion {
+	fork := spec.DataVersionPhase0
+	for version, forkEpoch := range s.forkEpochs {
+		if forkEpoch != farFutureEpoch && forkEpoch <= epoch && version > fork {
+			fork = version
+		}
+	}
+
+	return fork
+}
+
+// ForkAtSlot provides the fork in force at the given slot.
+func (s *Service) ForkAtSlot(slot phase0.Slot) spec.DataVersion {
+	return s.ForkAtEpoch(s.EpochOfSlot(slot))
+}
+
+// CurrentFork provides the fork in force at the current time.
+func (s *Service) CurrentFork() spec.DataVersion {
+	return s.ForkAtEpoch(s.CurrentEpoch())
+}
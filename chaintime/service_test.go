@@ -0,0 +1,197 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testService(ctx context.Context, t *testing.T, genesisTime time.Time) *chaintime.Service {
+	t.Helper()
+
+	client, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+	client.SpecFunc = func(_ context.Context, _ *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: map[string]any{
+				"SECONDS_PER_SLOT":     12 * time.Second,
+				"SLOTS_PER_EPOCH":      uint64(32),
+				"ALTAIR_FORK_EPOCH":    uint64(10),
+				"BELLATRIX_FORK_EPOCH": uint64(20),
+				"CAPELLA_FORK_EPOCH":   uint64(20),
+				"DENEB_FORK_EPOCH":     uint64(30),
+				"ELECTRA_FORK_EPOCH":   uint64(0xffffffffffffffff),
+			},
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	service, err := chaintime.New(ctx,
+		chaintime.WithGenesisProvider(client),
+		chaintime.WithSpecProvider(client),
+	)
+	require.NoError(t, err)
+
+	return service
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []chaintime.Parameter
+		err    string
+	}{
+		{
+			name: "GenesisProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithSpecProvider(client),
+			},
+			err: "problem with parameters\nno genesis provider specified",
+		},
+		{
+			name: "SpecProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisProvider(client),
+			},
+			err: "problem with parameters\nno spec provider specified",
+		},
+		{
+			name: "Good",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisProvider(client),
+				chaintime.WithSpecProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := chaintime.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1606824023, 0)
+	service := testService(ctx, t, genesisTime)
+
+	require.Equal(t, genesisTime, service.GenesisTime())
+	require.Equal(t, 12*time.Second, service.SlotDuration())
+	require.Equal(t, uint64(32), service.SlotsPerEpoch())
+
+	require.Equal(t, genesisTime, service.SlotStartTime(0))
+	require.Equal(t, genesisTime.Add(120*time.Second), service.SlotStartTime(10))
+	require.Equal(t, genesisTime.Add(384*time.Second), service.EpochStartTime(1))
+
+	require.Equal(t, phase0.Slot(0), service.SlotAtTime(genesisTime.Add(-time.Hour)))
+	require.Equal(t, phase0.Slot(0), service.SlotAtTime(genesisTime.Add(11*time.Second)))
+	require.Equal(t, phase0.Slot(1), service.SlotAtTime(genesisTime.Add(12*time.Second)))
+	require.Equal(t, phase0.Epoch(1), service.EpochAtTime(genesisTime.Add(384*time.Second)))
+
+	require.Equal(t, phase0.Epoch(0), service.EpochOfSlot(31))
+	require.Equal(t, phase0.Epoch(1), service.EpochOfSlot(32))
+	require.Equal(t, phase0.Slot(64), service.FirstSlotOfEpoch(2))
+	require.Equal(t, phase0.Slot(95), service.LastSlotOfEpoch(2))
+}
+
+func TestCurrent(t *testing.T) {
+	ctx := context.Background()
+
+	service := testService(ctx, t, time.Now().Add(-time.Duration(100*12+6)*time.Second))
+	require.Equal(t, phase0.Slot(100), service.CurrentSlot())
+	require.Equal(t, phase0.Epoch(3), service.CurrentEpoch())
+	require.Equal(t, spec.DataVersionPhase0, service.CurrentFork())
+
+	service = testService(ctx, t, time.Now().Add(time.Hour))
+	require.Equal(t, phase0.Slot(0), service.CurrentSlot())
+	require.Equal(t, phase0.Epoch(0), service.CurrentEpoch())
+}
+
+func TestForks(t *testing.T) {
+	ctx := context.Background()
+	service := testService(ctx, t, time.Unix(1606824023, 0))
+
+	tests := []struct {
+		name     string
+		epoch    phase0.Epoch
+		expected spec.DataVersion
+	}{
+		{
+			name:     "Genesis",
+			epoch:    0,
+			expected: spec.DataVersionPhase0,
+		},
+		{
+			name:     "BeforeAltair",
+			epoch:    9,
+			expected: spec.DataVersionPhase0,
+		},
+		{
+			name:     "Altair",
+			epoch:    10,
+			expected: spec.DataVersionAltair,
+		},
+		{
+			name:     "SharedEpoch",
+			epoch:    20,
+			expected: spec.DataVersionCapella,
+		},
+		{
+			name:     "Deneb",
+			epoch:    30,
+			expected: spec.DataVersionDeneb,
+		},
+		{
+			name:     "FarFuture",
+			epoch:    0xfffffffffffffffe,
+			expected: spec.DataVersionDeneb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, service.ForkAtEpoch(test.epoch))
+		})
+	}
+
+	require.Equal(t, spec.DataVersionAltair, service.ForkAtSlot(320))
+
+	epoch, scheduled := service.ForkEpoch(spec.DataVersionDeneb)
+	require.True(t, scheduled)
+	require.Equal(t, phase0.Epoch(30), epoch)
+	_, scheduled = service.ForkEpoch(spec.DataVersionElectra)
+	require.False(t, scheduled)
+	_, scheduled = service.ForkEpoch(spec.DataVersionFulu)
+	require.False(t, scheduled)
+}
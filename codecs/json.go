@@ -14,16 +14,70 @@
 package codecs
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"reflect"
+	"sort"
 	"strings"
+	"sync/atomic"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 )
 
+// JSONMode defines how strictly spec types are decoded from JSON.
+type JSONMode int32
+
+const (
+	// JSONModeStandard ignores unknown fields and requires all fields to be present.
+	JSONModeStandard JSONMode = iota
+	// JSONModeStrict rejects unknown fields and requires all fields to be present.
+	JSONModeStrict
+	// JSONModeLenient ignores unknown fields and, for types decoded with RawJSON,
+	// treats missing fields as holding their zero value.
+	JSONModeLenient
+)
+
+var jsonMode atomic.Int32
+
+// SetJSONMode sets the mode with which spec types are decoded from JSON.
+// This applies to all decoding in the process.
+func SetJSONMode(mode JSONMode) {
+	jsonMode.Store(int32(mode))
+}
+
+// CurrentJSONMode returns the mode with which spec types are decoded from JSON.
+func CurrentJSONMode() JSONMode {
+	return JSONMode(jsonMode.Load())
+}
+
+// UnmarshalJSON unmarshals JSON in to the supplied value, rejecting unknown
+// fields if in strict mode.
+func UnmarshalJSON(input []byte, v any) error {
+	if CurrentJSONMode() != JSONModeStrict {
+		return json.Unmarshal(input, v)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("invalid data after top-level value")
+	}
+
+	return nil
+}
+
 // RawJSON generates raw JSON for a struct,
 // ensuring that all values are present.
+//
+// In strict mode unknown values are rejected.  In lenient mode missing values
+// are not rejected, and are instead set to the JSON of their zero value.
 func RawJSON(b any, input []byte) (map[string]json.RawMessage, error) {
 	// Make generic map from input.
 	base := make(map[string]json.RawMessage)
@@ -31,6 +85,9 @@ func RawJSON(b any, input []byte) (map[string]json.RawMessage, error) {
 		return nil, errors.Wrap(err, "invalid JSON")
 	}
 
+	mode := CurrentJSONMode()
+	known := make(map[string]bool)
+
 	// Ensure all values are present.
 	elem := reflect.TypeOf(b).Elem()
 	fields := elem.NumField()
@@ -40,6 +97,7 @@ func RawJSON(b any, input []byte) (map[string]json.RawMessage, error) {
 			return nil, fmt.Errorf("no json tags for field %d", i)
 		}
 		tags := strings.Split(jsonTags, ",")
+		known[tags[0]] = true
 		var emptyAllowed bool
 		for i := range tags {
 			if tags[i] == "allowempty" {
@@ -53,10 +111,57 @@ func RawJSON(b any, input []byte) (map[string]json.RawMessage, error) {
 			continue
 		}
 		if _, exists := base[tags[0]]; !exists {
+			if mode == JSONModeLenient {
+				base[tags[0]] = zeroJSON(elem.Field(i).Type)
+
+				continue
+			}
 			// This should be present but is not.
 			return nil, fmt.Errorf("%s: missing", tags[0])
 		}
 	}
 
+	if mode == JSONModeStrict {
+		unknown := make([]string, 0)
+		for key := range base {
+			if !known[key] {
+				unknown = append(unknown, key)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+
+			return nil, fmt.Errorf("%s: unknown", unknown[0])
+		}
+	}
+
 	return base, nil
 }
+
+// zeroJSON returns the JSON of the zero value of the given type.
+func zeroJSON(t reflect.Type) json.RawMessage {
+	//nolint:exhaustive
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return json.RawMessage("null")
+	default:
+		data, err := json.Marshal(reflect.New(t).Interface())
+		if err != nil {
+			return json.RawMessage("null")
+		}
+
+		return data
+	}
+}
+
+// DecodeGraffiti decodes graffiti from its JSON string representation.
+// Graffiti is hex-encoded with a 0x prefix, although it can be shorter than
+// the full 32 bytes.  In lenient mode a string without the prefix is treated
+// as UTF-8 text.
+func DecodeGraffiti(input string) ([]byte, error) {
+	if CurrentJSONMode() == JSONModeLenient && !strings.HasPrefix(input, "0x") && utf8.ValidString(input) {
+		return []byte(input), nil
+	}
+
+	return hex.DecodeString(strings.TrimPrefix(input, "0x"))
+}
@@ -0,0 +1,113 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONModes(t *testing.T) {
+	defer codecs.SetJSONMode(codecs.JSONModeStandard)
+
+	tests := []struct {
+		name     string
+		mode     codecs.JSONMode
+		input    string
+		expected *electra.Consolidation
+		err      string
+	}{
+		{
+			name:     "StandardGood",
+			mode:     codecs.JSONModeStandard,
+			input:    `{"source_index":"1","target_index":"2","epoch":"3"}`,
+			expected: &electra.Consolidation{SourceIndex: 1, TargetIndex: 2, Epoch: 3},
+		},
+		{
+			name:     "StandardUnknown",
+			mode:     codecs.JSONModeStandard,
+			input:    `{"source_index":"1","target_index":"2","epoch":"3","extra":"4"}`,
+			expected: &electra.Consolidation{SourceIndex: 1, TargetIndex: 2, Epoch: 3},
+		},
+		{
+			name:  "StandardMissing",
+			mode:  codecs.JSONModeStandard,
+			input: `{"source_index":"1","target_index":"2"}`,
+			err:   "epoch: missing",
+		},
+		{
+			name:  "StrictUnknown",
+			mode:  codecs.JSONModeStrict,
+			input: `{"source_index":"1","target_index":"2","epoch":"3","extra":"4"}`,
+			err:   "extra: unknown",
+		},
+		{
+			name:  "StrictMissing",
+			mode:  codecs.JSONModeStrict,
+			input: `{"source_index":"1","target_index":"2"}`,
+			err:   "epoch: missing",
+		},
+		{
+			name:     "LenientUnknown",
+			mode:     codecs.JSONModeLenient,
+			input:    `{"source_index":"1","target_index":"2","epoch":"3","extra":"4"}`,
+			expected: &electra.Consolidation{SourceIndex: 1, TargetIndex: 2, Epoch: 3},
+		},
+		{
+			name:     "LenientMissing",
+			mode:     codecs.JSONModeLenient,
+			input:    `{"source_index":"1","target_index":"2"}`,
+			expected: &electra.Consolidation{SourceIndex: 1, TargetIndex: 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			codecs.SetJSONMode(test.mode)
+			var res electra.Consolidation
+			err := json.Unmarshal([]byte(test.input), &res)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, &res)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONStrict(t *testing.T) {
+	defer codecs.SetJSONMode(codecs.JSONModeStandard)
+
+	input := []byte(`{"epoch":"1","root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","extra":true}`)
+
+	var checkpoint phase0.Checkpoint
+	require.NoError(t, json.Unmarshal(input, &checkpoint))
+	require.Equal(t, phase0.Epoch(1), checkpoint.Epoch)
+
+	codecs.SetJSONMode(codecs.JSONModeStrict)
+	require.Equal(t, codecs.JSONModeStrict, codecs.CurrentJSONMode())
+	require.ErrorContains(t, json.Unmarshal(input, &checkpoint), `unknown field "extra"`)
+
+	var value struct {
+		A int `json:"a"`
+	}
+	require.NoError(t, codecs.UnmarshalJSON([]byte(`{"a":1}`), &value))
+	require.Error(t, codecs.UnmarshalJSON([]byte(`{"a":1}{"a":2}`), &value))
+}
@@ -0,0 +1,130 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// snappyFramedMagic is the stream identifier that starts snappy-framed data.
+var snappyFramedMagic = []byte{0xff, 0x06, 0x00, 0x00, 's', 'N', 'a', 'P', 'p', 'Y'}
+
+// MarshalSSZSnappy marshals the object to SSZ and compresses
+// it using the snappy framing format.
+func MarshalSSZSnappy(obj ssz.Marshaler) ([]byte, error) {
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal SSZ")
+	}
+
+	buf := new(bytes.Buffer)
+	writer := snappy.NewBufferedWriter(buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, errors.Wrap(err, "failed to compress SSZ")
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to compress SSZ")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalSSZSnappy decompresses snappy data, in either the framed
+// or block format, and unmarshals the result as SSZ in to the object.
+func UnmarshalSSZSnappy(data []byte, obj ssz.Unmarshaler) error {
+	decompressed, err := DecodeSnappy(data)
+	if err != nil {
+		return err
+	}
+
+	if err := obj.UnmarshalSSZ(decompressed); err != nil {
+		return errors.Wrap(err, "failed to unmarshal SSZ")
+	}
+
+	return nil
+}
+
+// DecodeSnappy decompresses snappy data in either the framed or block format.
+func DecodeSnappy(data []byte) ([]byte, error) {
+	if bytes.HasPrefix(data, snappyFramedMagic) {
+		res, err := io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress framed snappy data")
+		}
+
+		return res, nil
+	}
+
+	res, err := snappy.Decode(nil, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decompress snappy data")
+	}
+
+	return res, nil
+}
+
+// NewSnappyReader returns a reader that decompresses snappy data in either
+// the framed or block format.  Framed data is decompressed as it is read; block
+// data is read and decompressed in full on the first read.
+func NewSnappyReader(r io.Reader) io.Reader {
+	return &snappyReader{
+		source: bufio.NewReader(r),
+	}
+}
+
+type snappyReader struct {
+	source *bufio.Reader
+	reader io.Reader
+}
+
+// Read implements io.Reader.
+func (r *snappyReader) Read(p []byte) (int, error) {
+	if r.reader == nil {
+		if err := r.init(); err != nil {
+			return 0, err
+		}
+	}
+
+	return r.reader.Read(p)
+}
+
+func (r *snappyReader) init() error {
+	prefix, err := r.source.Peek(len(snappyFramedMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if bytes.Equal(prefix, snappyFramedMagic) {
+		r.reader = snappy.NewReader(r.source)
+
+		return nil
+	}
+
+	data, err := io.ReadAll(r.source)
+	if err != nil {
+		return err
+	}
+	res, err := snappy.Decode(nil, data)
+	if err != nil {
+		return errors.Wrap(err, "failed to decompress snappy data")
+	}
+	r.reader = bytes.NewReader(res)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSZSnappy(t *testing.T) {
+	checkpoint := &phase0.Checkpoint{
+		Epoch: 12,
+		Root:  phase0.Root{0x01, 0x02},
+	}
+	data, err := checkpoint.MarshalSSZ()
+	require.NoError(t, err)
+
+	compressed, err := codecs.MarshalSSZSnappy(checkpoint)
+	require.NoError(t, err)
+
+	t.Run("Framed", func(t *testing.T) {
+		res := &phase0.Checkpoint{}
+		require.NoError(t, codecs.UnmarshalSSZSnappy(compressed, res))
+		require.Equal(t, checkpoint, res)
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		res := &phase0.Checkpoint{}
+		require.NoError(t, codecs.UnmarshalSSZSnappy(snappy.Encode(nil, data), res))
+		require.Equal(t, checkpoint, res)
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		for _, input := range [][]byte{compressed, snappy.Encode(nil, data)} {
+			res, err := io.ReadAll(codecs.NewSnappyReader(bytes.NewReader(input)))
+			require.NoError(t, err)
+			require.Equal(t, data, res)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.EqualError(t, codecs.UnmarshalSSZSnappy([]byte{0x01}, &phase0.Checkpoint{}), "failed to decompress snappy data: snappy: corrupt input")
+		require.EqualError(t, codecs.UnmarshalSSZSnappy(snappy.Encode(nil, []byte{0x01}), &phase0.Checkpoint{}), "failed to unmarshal SSZ: incorrect size")
+	})
+}
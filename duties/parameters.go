@@ -0,0 +1,87 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package duties
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+type parameters struct {
+	service   consensusclient.Service
+	maxEpochs int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithService sets the service from which duties are obtained.  The service
+// must provide attester, proposer and sync committee duties and the spec, and
+// if it also provides events then cached duties are invalidated on reorgs.
+// Ranges of proposer duties also require the service to provide genesis.
+func WithService(service consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithMaxEpochs sets the number of epochs for which attester and proposer
+// duties are cached.  Defaults to 4.
+func WithMaxEpochs(maxEpochs int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxEpochs = maxEpochs
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		maxEpochs: 4,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	if _, isProvider := parameters.service.(consensusclient.AttesterDutiesProvider); !isProvider {
+		return nil, errors.New("service does not provide attester duties")
+	}
+	if _, isProvider := parameters.service.(consensusclient.ProposerDutiesProvider); !isProvider {
+		return nil, errors.New("service does not provide proposer duties")
+	}
+	if _, isProvider := parameters.service.(consensusclient.SyncCommitteeDutiesProvider); !isProvider {
+		return nil, errors.New("service does not provide sync committee duties")
+	}
+	if _, isProvider := parameters.service.(consensusclient.SpecProvider); !isProvider {
+		return nil, errors.New("service does not provide spec")
+	}
+	if parameters.maxEpochs <= 0 {
+		return nil, errors.New("no maximum epochs specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,505 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package duties resolves attester, proposer and sync committee duties for
+// sets of validators.  Duties are cached by epoch (or sync committee period),
+// along with the dependent root that determined them.  Cached duties are
+// refreshed when the beacon node reports a different dependent root, and
+// invalidated when head and chain reorg events show that the chain has
+// moved to a different branch.
+//
+// Cached duties are shared between callers, so must not be modified.
+package duties
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Service resolves validator duties.
+type Service struct {
+	attesterDutiesProvider      consensusclient.AttesterDutiesProvider
+	proposerDutiesProvider      consensusclient.ProposerDutiesProvider
+	syncCommitteeDutiesProvider consensusclient.SyncCommitteeDutiesProvider
+	genesisProvider             consensusclient.GenesisProvider
+	slotDuration                time.Duration
+	slotsPerEpoch               uint64
+	epochsPerSyncCommittee      uint64
+	maxEpochs                   phase0.Epoch
+
+	mu                  sync.Mutex
+	attesterDuties      map[phase0.Epoch]*entry[*apiv1.AttesterDuty]
+	proposerDuties      map[phase0.Epoch]*proposerEntry
+	syncCommitteeDuties map[uint64]*entry[*apiv1.SyncCommitteeDuty]
+}
+
+// entry is a cached set of per-validator duties.
+type entry[T any] struct {
+	dependentRoot phase0.Root
+	// queried are the validators for which duties have been requested;
+	// not all validators have duties.
+	queried map[phase0.ValidatorIndex]bool
+	duties  map[phase0.ValidatorIndex]T
+}
+
+// proposerEntry is a cached set of proposer duties for an epoch.
+type proposerEntry struct {
+	dependentRoot phase0.Root
+	duties        []*apiv1.ProposerDuty
+}
+
+// New creates a new duties service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	specResponse, err := parameters.service.(consensusclient.SpecProvider).Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+	config := apiv1.NewSpecConfig(specResponse.Data)
+	slotsPerEpoch, err := config.SlotsPerEpoch()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain slots per epoch"), err)
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be zero")
+	}
+	// Not present on chains without sync committees.
+	epochsPerSyncCommittee, _ := config.Uint64("EPOCHS_PER_SYNC_COMMITTEE_PERIOD")
+	// Only required for ranges of proposer duties.
+	slotDuration, _ := config.SecondsPerSlot()
+
+	s := &Service{
+		attesterDutiesProvider:      parameters.service.(consensusclient.AttesterDutiesProvider),
+		proposerDutiesProvider:      parameters.service.(consensusclient.ProposerDutiesProvider),
+		syncCommitteeDutiesProvider: parameters.service.(consensusclient.SyncCommitteeDutiesProvider),
+		slotDuration:                slotDuration,
+		slotsPerEpoch:               slotsPerEpoch,
+		epochsPerSyncCommittee:      epochsPerSyncCommittee,
+		maxEpochs:                   phase0.Epoch(parameters.maxEpochs),
+		attesterDuties:              make(map[phase0.Epoch]*entry[*apiv1.AttesterDuty]),
+		proposerDuties:              make(map[phase0.Epoch]*proposerEntry),
+		syncCommitteeDuties:         make(map[uint64]*entry[*apiv1.SyncCommitteeDuty]),
+	}
+
+	if genesisProvider, isProvider := parameters.service.(consensusclient.GenesisProvider); isProvider {
+		s.genesisProvider = genesisProvider
+	}
+
+	if eventsProvider, isProvider := parameters.service.(consensusclient.EventsProvider); isProvider {
+		if err := eventsProvider.Events(ctx, []string{"head", "chain_reorg"}, s.handleEvent); err != nil {
+			return nil, errors.Join(errors.New("failed to subscribe to events"), err)
+		}
+	}
+
+	return s, nil
+}
+
+// AttesterDuties provides the attester duties of the given validators for the given epoch.
+func (s *Service) AttesterDuties(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	[]*apiv1.AttesterDuty,
+	error,
+) {
+	return resolve(ctx, &s.mu, s.attesterDuties, epoch, indices,
+		func(ctx context.Context, indices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, phase0.Root, error) {
+			response, err := s.attesterDutiesProvider.AttesterDuties(ctx, &api.AttesterDutiesOpts{
+				Epoch:   epoch,
+				Indices: indices,
+			})
+			if err != nil {
+				return nil, phase0.Root{}, errors.Join(errors.New("failed to obtain attester duties"), err)
+			}
+
+			return response.Data, dependentRoot(response), nil
+		},
+		func(duty *apiv1.AttesterDuty) phase0.ValidatorIndex { return duty.ValidatorIndex },
+		func() { s.prune(epoch) },
+	)
+}
+
+// SyncCommitteeDuties provides the sync committee duties of the given
+// validators for the sync committee period containing the given epoch.
+func (s *Service) SyncCommitteeDuties(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	[]*apiv1.SyncCommitteeDuty,
+	error,
+) {
+	if s.epochsPerSyncCommittee == 0 {
+		return nil, errors.New("chain does not have sync committees")
+	}
+	period := uint64(epoch) / s.epochsPerSyncCommittee
+
+	return resolve(ctx, &s.mu, s.syncCommitteeDuties, period, indices,
+		func(ctx context.Context, indices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeDuty, phase0.Root, error) {
+			response, err := s.syncCommitteeDutiesProvider.SyncCommitteeDuties(ctx, &api.SyncCommitteeDutiesOpts{
+				Epoch:   epoch,
+				Indices: indices,
+			})
+			if err != nil {
+				return nil, phase0.Root{}, errors.Join(errors.New("failed to obtain sync committee duties"), err)
+			}
+
+			// Sync committees are fixed for the period, so have no dependent root.
+			return response.Data, phase0.Root{}, nil
+		},
+		func(duty *apiv1.SyncCommitteeDuty) phase0.ValidatorIndex { return duty.ValidatorIndex },
+		func() {
+			for cachedPeriod := range s.syncCommitteeDuties {
+				if cachedPeriod+1 < period {
+					delete(s.syncCommitteeDuties, cachedPeriod)
+				}
+			}
+		},
+	)
+}
+
+// ProposerDuties provides the proposer duties of the given validators for the
+// given epoch.  If no validators are supplied then all duties are returned.
+func (s *Service) ProposerDuties(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	[]*apiv1.ProposerDuty,
+	error,
+) {
+	s.mu.Lock()
+	cached, exists := s.proposerDuties[epoch]
+	s.mu.Unlock()
+
+	if !exists {
+		response, err := s.proposerDutiesProvider.ProposerDuties(ctx, &api.ProposerDutiesOpts{
+			Epoch: epoch,
+		})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain proposer duties"), err)
+		}
+		cached = &proposerEntry{
+			dependentRoot: dependentRoot(response),
+			duties:        response.Data,
+		}
+
+		s.mu.Lock()
+		s.proposerDuties[epoch] = cached
+		s.prune(epoch)
+		s.mu.Unlock()
+	}
+
+	if len(indices) == 0 {
+		return cached.duties, nil
+	}
+	required := make(map[phase0.ValidatorIndex]bool, len(indices))
+	for _, index := range indices {
+		required[index] = true
+	}
+	res := make([]*apiv1.ProposerDuty, 0)
+	for _, duty := range cached.duties {
+		if required[duty.ValidatorIndex] {
+			res = append(res, duty)
+		}
+	}
+
+	return res, nil
+}
+
+// ProposerDutiesRange provides the proposer duties of the given validators for
+// the epochs from start up to but not including end, in slot order.  If no
+// validators are supplied then all duties are returned.  Beacon nodes only
+// provide proposer duties up to the epoch after the current epoch, so later
+// epochs in the range are skipped.
+func (s *Service) ProposerDutiesRange(ctx context.Context,
+	start phase0.Epoch,
+	end phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	[]*apiv1.ProposerDuty,
+	error,
+) {
+	if end < start {
+		return nil, fmt.Errorf("end epoch %d before start epoch %d", end, start)
+	}
+	currentEpoch, err := s.currentEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	end = min(end, currentEpoch+2)
+
+	required := make(map[phase0.ValidatorIndex]bool, len(indices))
+	for _, index := range indices {
+		required[index] = true
+	}
+	res := make([]*apiv1.ProposerDuty, 0)
+	for epoch := start; epoch < end; epoch++ {
+		epochDuties, err := s.ProposerDuties(ctx, epoch, nil)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to obtain proposer duties for epoch %d", epoch), err)
+		}
+		if err := s.checkProposerDuties(epoch, epochDuties); err != nil {
+			// Do not keep the invalid duties.
+			s.mu.Lock()
+			delete(s.proposerDuties, epoch)
+			s.mu.Unlock()
+
+			return nil, errors.Join(fmt.Errorf("invalid proposer duties for epoch %d", epoch), err)
+		}
+		for _, duty := range epochDuties {
+			if len(required) == 0 || required[duty.ValidatorIndex] {
+				res = append(res, duty)
+			}
+		}
+	}
+	slices.SortFunc(res, func(a, b *apiv1.ProposerDuty) int {
+		return cmp.Compare(a.Slot, b.Slot)
+	})
+
+	return res, nil
+}
+
+// checkProposerDuties checks that the proposer duties for an epoch are for
+// distinct slots within the epoch.
+func (s *Service) checkProposerDuties(epoch phase0.Epoch, duties []*apiv1.ProposerDuty) error {
+	firstSlot := phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+	lastSlot := firstSlot + phase0.Slot(s.slotsPerEpoch) - 1
+	slots := make(map[phase0.Slot]bool, len(duties))
+	for _, duty := range duties {
+		if duty == nil {
+			return errors.New("missing duty")
+		}
+		if duty.Slot < firstSlot || duty.Slot > lastSlot {
+			return fmt.Errorf("duty for slot %d outside of epoch", duty.Slot)
+		}
+		if slots[duty.Slot] {
+			return fmt.Errorf("multiple duties for slot %d", duty.Slot)
+		}
+		slots[duty.Slot] = true
+	}
+
+	return nil
+}
+
+// currentEpoch returns the current epoch of the chain.
+func (s *Service) currentEpoch(ctx context.Context) (phase0.Epoch, error) {
+	if s.genesisProvider == nil {
+		return 0, errors.New("service does not provide genesis")
+	}
+	if s.slotDuration == 0 {
+		return 0, errors.New("slot duration not known")
+	}
+	response, err := s.genesisProvider.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return 0, errors.Join(errors.New("failed to obtain genesis"), err)
+	}
+
+	elapsed := time.Since(response.Data.GenesisTime)
+	if elapsed < 0 {
+		return 0, nil
+	}
+
+	return phase0.Epoch(uint64(elapsed/s.slotDuration) / s.slotsPerEpoch), nil
+}
+
+// resolve provides duties for the given validators, using cached duties where
+// possible and fetching the remainder.  If the dependent root of the fetched
+// duties differs from that of the cached duties then the cache is replaced and
+// the duties fetched afresh.
+func resolve[K comparable, T any](ctx context.Context,
+	mu *sync.Mutex,
+	cache map[K]*entry[T],
+	key K,
+	indices []phase0.ValidatorIndex,
+	fetch func(context.Context, []phase0.ValidatorIndex) ([]T, phase0.Root, error),
+	indexOf func(T) phase0.ValidatorIndex,
+	prune func(),
+) (
+	[]T,
+	error,
+) {
+	if len(indices) == 0 {
+		return []T{}, nil
+	}
+
+	// Two attempts are sufficient: if the dependent root of the fetched duties
+	// differs from that of the cached duties then the second attempt fetches
+	// duties for all of the validators.
+	for attempt := 0; attempt < 2; attempt++ {
+		mu.Lock()
+		cached := cache[key]
+		if res, complete := cached.collect(indices); complete {
+			mu.Unlock()
+
+			return res, nil
+		}
+		missing := make([]phase0.ValidatorIndex, 0)
+		for _, index := range indices {
+			if cached == nil || !cached.queried[index] {
+				missing = append(missing, index)
+			}
+		}
+		mu.Unlock()
+
+		duties, root, err := fetch(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+
+		mu.Lock()
+		current := cache[key]
+		if current == nil || current.dependentRoot != root {
+			current = &entry[T]{
+				dependentRoot: root,
+				queried:       make(map[phase0.ValidatorIndex]bool),
+				duties:        make(map[phase0.ValidatorIndex]T),
+			}
+			cache[key] = current
+			prune()
+		}
+		for _, index := range missing {
+			current.queried[index] = true
+		}
+		for _, duty := range duties {
+			current.duties[indexOf(duty)] = duty
+		}
+		if res, complete := current.collect(indices); complete {
+			mu.Unlock()
+
+			return res, nil
+		}
+		mu.Unlock()
+	}
+
+	return nil, errors.New("dependent root changed whilst obtaining duties")
+}
+
+// collect returns the cached duties for the given validators, and true if
+// all of the validators have been queried.
+func (e *entry[T]) collect(indices []phase0.ValidatorIndex) ([]T, bool) {
+	if e == nil {
+		return nil, false
+	}
+	res := make([]T, 0, len(indices))
+	for _, index := range indices {
+		if !e.queried[index] {
+			return nil, false
+		}
+		if duty, exists := e.duties[index]; exists {
+			res = append(res, duty)
+		}
+	}
+
+	return res, true
+}
+
+// prune removes cached attester and proposer duties that are too old given
+// the supplied epoch.  It must be called with the lock held.
+func (s *Service) prune(epoch phase0.Epoch) {
+	if epoch < s.maxEpochs {
+		return
+	}
+	minEpoch := epoch - s.maxEpochs + 1
+	for cachedEpoch := range s.attesterDuties {
+		if cachedEpoch < minEpoch {
+			delete(s.attesterDuties, cachedEpoch)
+		}
+	}
+	for cachedEpoch := range s.proposerDuties {
+		if cachedEpoch < minEpoch {
+			delete(s.proposerDuties, cachedEpoch)
+		}
+	}
+}
+
+// handleEvent invalidates cached duties on head and chain reorg events.
+func (s *Service) handleEvent(event *apiv1.Event) {
+	switch data := event.Data.(type) {
+	case *apiv1.HeadEvent:
+		s.handleHead(data)
+	case *apiv1.ChainReorgEvent:
+		s.handleChainReorg(data)
+	}
+}
+
+// handleHead invalidates cached duties whose dependent roots are not those of the new head.
+func (s *Service) handleHead(event *apiv1.HeadEvent) {
+	epoch := phase0.Epoch(uint64(event.Slot) / s.slotsPerEpoch)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Proposer duties for the current epoch, and attester duties for the
+	// next epoch, are dependent on the last block of the previous epoch.
+	if cached, exists := s.proposerDuties[epoch]; exists && !rootsMatch(cached.dependentRoot, event.CurrentDutyDependentRoot) {
+		delete(s.proposerDuties, epoch)
+	}
+	if cached, exists := s.attesterDuties[epoch+1]; exists && !rootsMatch(cached.dependentRoot, event.CurrentDutyDependentRoot) {
+		delete(s.attesterDuties, epoch+1)
+	}
+	// Attester duties for the current epoch are dependent on the last block
+	// of the epoch before the previous epoch.
+	if cached, exists := s.attesterDuties[epoch]; exists && !rootsMatch(cached.dependentRoot, event.PreviousDutyDependentRoot) {
+		delete(s.attesterDuties, epoch)
+	}
+}
+
+// handleChainReorg invalidates cached duties from the epoch of the common
+// ancestor of the reorg onwards.
+func (s *Service) handleChainReorg(event *apiv1.ChainReorgEvent) {
+	ancestorSlot := uint64(0)
+	if uint64(event.Slot) > event.Depth {
+		ancestorSlot = uint64(event.Slot) - event.Depth
+	}
+	ancestorEpoch := phase0.Epoch(ancestorSlot / s.slotsPerEpoch)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for epoch := range s.attesterDuties {
+		if epoch >= ancestorEpoch {
+			delete(s.attesterDuties, epoch)
+		}
+	}
+	for epoch := range s.proposerDuties {
+		if epoch >= ancestorEpoch {
+			delete(s.proposerDuties, epoch)
+		}
+	}
+}
+
+// dependentRoot returns the dependent root of a duties response, or the zero
+// root if it is not present.
+func dependentRoot[T any](response *api.Response[T]) phase0.Root {
+	root, _ := response.DependentRoot()
+
+	return root
+}
+
+// rootsMatch returns true if the roots match, or if either is unknown.
+func rootsMatch(cached phase0.Root, current phase0.Root) bool {
+	return cached.IsZero() || current.IsZero() || cached == current
+}
@@ -0,0 +1,373 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package duties_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/duties"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []duties.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			err:  "problem with parameters\nno service specified",
+		},
+		{
+			name: "MaxEpochsZero",
+			params: []duties.Parameter{
+				duties.WithService(service),
+				duties.WithMaxEpochs(0),
+			},
+			err: "problem with parameters\nno maximum epochs specified",
+		},
+		{
+			name: "Good",
+			params: []duties.Parameter{
+				duties.WithService(service),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := duties.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// testService is a mock service with scripted duties.
+type testService struct {
+	*mock.Service
+	attesterCalls atomic.Int32
+	proposerCalls atomic.Int32
+	dependentRoot atomic.Value
+	requested     [][]phase0.ValidatorIndex
+	eventHandler  consensusclient.EventHandlerFunc
+	// invalidEpochs are the epochs for which proposer duties are duplicated.
+	invalidEpochs map[phase0.Epoch]bool
+}
+
+func newTestService(ctx context.Context, t *testing.T) *testService {
+	t.Helper()
+
+	s := &testService{
+		invalidEpochs: make(map[phase0.Epoch]bool),
+	}
+	s.dependentRoot.Store(phase0.Root{0x01})
+	service, err := mock.New(ctx,
+		// Current epoch is 10.
+		mock.WithGenesisTime(time.Now().Add(-10*32*12*time.Second-time.Minute)),
+		mock.WithAttesterDutiesFunc(func(_ context.Context, opts *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error) {
+			s.attesterCalls.Add(1)
+			s.requested = append(s.requested, opts.Indices)
+			data := make([]*apiv1.AttesterDuty, 0, len(opts.Indices))
+			for _, index := range opts.Indices {
+				// Odd validators have no duties.
+				if index%2 == 0 {
+					data = append(data, &apiv1.AttesterDuty{ValidatorIndex: index})
+				}
+			}
+
+			return &api.Response[[]*apiv1.AttesterDuty]{
+				Data:     data,
+				Metadata: map[string]any{"dependent_root": s.dependentRoot.Load()},
+			}, nil
+		}),
+		mock.WithProposerDutiesFunc(func(_ context.Context, opts *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error) {
+			s.proposerCalls.Add(1)
+			firstSlot := phase0.Slot(uint64(opts.Epoch) * 32)
+			data := make([]*apiv1.ProposerDuty, 0, 32)
+			for i := phase0.Slot(0); i < 32; i++ {
+				data = append(data, &apiv1.ProposerDuty{Slot: firstSlot + i, ValidatorIndex: phase0.ValidatorIndex(i)})
+			}
+			if s.invalidEpochs[opts.Epoch] {
+				data = append(data, data[0])
+			}
+
+			return &api.Response[[]*apiv1.ProposerDuty]{
+				Data:     data,
+				Metadata: map[string]any{"dependent_root": s.dependentRoot.Load()},
+			}, nil
+		}),
+		mock.WithSpecFunc(func(_ context.Context, _ *api.SpecOpts) (*api.Response[map[string]any], error) {
+			return &api.Response[map[string]any]{
+				Data: map[string]any{
+					"SECONDS_PER_SLOT":                 12 * time.Second,
+					"SLOTS_PER_EPOCH":                  uint64(32),
+					"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(256),
+				},
+				Metadata: map[string]any{},
+			}, nil
+		}),
+		mock.WithEventsFunc(func(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+			s.eventHandler = handler
+
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+	s.Service = service
+
+	return s
+}
+
+func TestAttesterDuties(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(ctx, t)
+	s, err := duties.New(ctx, duties.WithService(service))
+	require.NoError(t, err)
+
+	res, err := s.AttesterDuties(ctx, 10, []phase0.ValidatorIndex{1, 2, 4})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, int32(1), service.attesterCalls.Load())
+
+	// Cached, including the validator without duties.
+	res, err = s.AttesterDuties(ctx, 10, []phase0.ValidatorIndex{4, 1})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, phase0.ValidatorIndex(4), res[0].ValidatorIndex)
+	require.Equal(t, int32(1), service.attesterCalls.Load())
+
+	// Only the additional validators are fetched.
+	res, err = s.AttesterDuties(ctx, 10, []phase0.ValidatorIndex{2, 6})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, int32(2), service.attesterCalls.Load())
+	require.Equal(t, []phase0.ValidatorIndex{6}, service.requested[1])
+
+	// A change in dependent root discards the cached duties and refetches.
+	service.dependentRoot.Store(phase0.Root{0x02})
+	res, err = s.AttesterDuties(ctx, 10, []phase0.ValidatorIndex{2, 8})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, int32(4), service.attesterCalls.Load())
+	require.Equal(t, []phase0.ValidatorIndex{8}, service.requested[2])
+	require.Equal(t, []phase0.ValidatorIndex{2}, service.requested[3])
+
+	// No validators.
+	res, err = s.AttesterDuties(ctx, 10, nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestProposerDuties(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(ctx, t)
+	s, err := duties.New(ctx, duties.WithService(service))
+	require.NoError(t, err)
+
+	res, err := s.ProposerDuties(ctx, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 32)
+
+	res, err = s.ProposerDuties(ctx, 2, []phase0.ValidatorIndex{3, 100})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, phase0.Slot(67), res[0].Slot)
+	require.Equal(t, int32(1), service.proposerCalls.Load())
+}
+
+func TestProposerDutiesRange(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(ctx, t)
+	s, err := duties.New(ctx, duties.WithService(service), duties.WithMaxEpochs(8))
+	require.NoError(t, err)
+
+	_, err = s.ProposerDutiesRange(ctx, 5, 4, nil)
+	require.EqualError(t, err, "end epoch 4 before start epoch 5")
+
+	res, err := s.ProposerDutiesRange(ctx, 3, 6, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 96)
+	for i, duty := range res {
+		require.Equal(t, phase0.Slot(96+i), duty.Slot)
+	}
+	require.Equal(t, int32(3), service.proposerCalls.Load())
+
+	// Cached epochs are not refetched.
+	res, err = s.ProposerDutiesRange(ctx, 4, 7, []phase0.ValidatorIndex{3})
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	require.Equal(t, []phase0.Slot{131, 163, 195}, []phase0.Slot{res[0].Slot, res[1].Slot, res[2].Slot})
+	require.Equal(t, int32(4), service.proposerCalls.Load())
+
+	// Epochs beyond the next epoch are skipped.
+	res, err = s.ProposerDutiesRange(ctx, 10, 20, []phase0.ValidatorIndex{0})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, phase0.Slot(320), res[0].Slot)
+	require.Equal(t, phase0.Slot(352), res[1].Slot)
+	require.Equal(t, int32(6), service.proposerCalls.Load())
+
+	// Invalid duties are rejected and not cached.
+	service.invalidEpochs[8] = true
+	_, err = s.ProposerDutiesRange(ctx, 7, 9, nil)
+	require.EqualError(t, err, "invalid proposer duties for epoch 8\nmultiple duties for slot 256")
+	delete(service.invalidEpochs, 8)
+	res, err = s.ProposerDutiesRange(ctx, 8, 9, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 32)
+}
+
+func TestHeadEventInvalidation(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(ctx, t)
+	s, err := duties.New(ctx, duties.WithService(service))
+	require.NoError(t, err)
+	require.NotNil(t, service.eventHandler)
+
+	_, err = s.ProposerDuties(ctx, 5, nil)
+	require.NoError(t, err)
+	_, err = s.AttesterDuties(ctx, 5, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+
+	// Head with matching dependent roots retains the duties.
+	service.eventHandler(&apiv1.Event{
+		Topic: "head",
+		Data: &apiv1.HeadEvent{
+			Slot:                      160,
+			CurrentDutyDependentRoot:  phase0.Root{0x01},
+			PreviousDutyDependentRoot: phase0.Root{0x01},
+		},
+	})
+	_, err = s.ProposerDuties(ctx, 5, nil)
+	require.NoError(t, err)
+	_, err = s.AttesterDuties(ctx, 5, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), service.proposerCalls.Load())
+	require.Equal(t, int32(1), service.attesterCalls.Load())
+
+	// Head with different dependent roots invalidates the duties.
+	service.eventHandler(&apiv1.Event{
+		Topic: "head",
+		Data: &apiv1.HeadEvent{
+			Slot:                      161,
+			CurrentDutyDependentRoot:  phase0.Root{0x03},
+			PreviousDutyDependentRoot: phase0.Root{0x04},
+		},
+	})
+	_, err = s.ProposerDuties(ctx, 5, nil)
+	require.NoError(t, err)
+	_, err = s.AttesterDuties(ctx, 5, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), service.proposerCalls.Load())
+	require.Equal(t, int32(2), service.attesterCalls.Load())
+}
+
+func TestChainReorgInvalidation(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(ctx, t)
+	s, err := duties.New(ctx, duties.WithService(service))
+	require.NoError(t, err)
+
+	_, err = s.AttesterDuties(ctx, 3, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	_, err = s.AttesterDuties(ctx, 4, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), service.attesterCalls.Load())
+
+	// Reorg with common ancestor in epoch 4.
+	service.eventHandler(&apiv1.Event{
+		Topic: "chain_reorg",
+		Data: &apiv1.ChainReorgEvent{
+			Slot:  130,
+			Depth: 2,
+		},
+	})
+
+	_, err = s.AttesterDuties(ctx, 3, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), service.attesterCalls.Load())
+	_, err = s.AttesterDuties(ctx, 4, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), service.attesterCalls.Load())
+}
+
+func TestSyncCommitteeDuties(t *testing.T) {
+	ctx := context.Background()
+
+	// Default mock spec does not have sync committees.
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+	s, err := duties.New(ctx, duties.WithService(service))
+	require.NoError(t, err)
+	_, err = s.SyncCommitteeDuties(ctx, 1, []phase0.ValidatorIndex{1})
+	require.EqualError(t, err, "chain does not have sync committees")
+
+	var calls atomic.Int32
+	service, err = mock.New(ctx,
+		mock.WithSpecFunc(func(_ context.Context, _ *api.SpecOpts) (*api.Response[map[string]any], error) {
+			return &api.Response[map[string]any]{
+				Data: map[string]any{
+					"SLOTS_PER_EPOCH":                  uint64(32),
+					"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(256),
+				},
+				Metadata: map[string]any{},
+			}, nil
+		}),
+		mock.WithSyncCommitteeDutiesFunc(func(_ context.Context, opts *api.SyncCommitteeDutiesOpts) (*api.Response[[]*apiv1.SyncCommitteeDuty], error) {
+			calls.Add(1)
+			data := make([]*apiv1.SyncCommitteeDuty, 0, len(opts.Indices))
+			for _, index := range opts.Indices {
+				data = append(data, &apiv1.SyncCommitteeDuty{ValidatorIndex: index})
+			}
+
+			return &api.Response[[]*apiv1.SyncCommitteeDuty]{Data: data, Metadata: map[string]any{}}, nil
+		}),
+	)
+	require.NoError(t, err)
+	s, err = duties.New(ctx, duties.WithService(service))
+	require.NoError(t, err)
+
+	res, err := s.SyncCommitteeDuties(ctx, 10, []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	// Same period.
+	res, err = s.SyncCommitteeDuties(ctx, 200, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, int32(1), calls.Load())
+
+	// Next period.
+	_, err = s.SyncCommitteeDuties(ctx, 256, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,101 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockHeadersByRoots fetches the headers of the blocks with the given
+// roots, with at most the concurrency of the service in flight at a time.
+// The result has an entry for each root, which is nil if the beacon node does
+// not have a block with that root.  If any fetch fails the remaining fetches
+// are abandoned and the error returned.
+func (s *Service) BeaconBlockHeadersByRoots(ctx context.Context,
+	roots []phase0.Root,
+) (
+	map[phase0.Root]*apiv1.BeaconBlockHeader,
+	error,
+) {
+	if s.headersProvider == nil {
+		return nil, errors.New("service does not provide beacon block headers")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Populate the result up front, so that duplicate roots are fetched once
+	// and roots that are not found have an entry.
+	headers := make(map[phase0.Root]*apiv1.BeaconBlockHeader, len(roots))
+	uniqueRoots := make([]phase0.Root, 0, len(roots))
+	for _, root := range roots {
+		if _, exists := headers[root]; !exists {
+			headers[root] = nil
+			uniqueRoots = append(uniqueRoots, root)
+		}
+	}
+
+	var mu sync.Mutex
+	var firstErr error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.concurrency)
+	for _, root := range uniqueRoots {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(root phase0.Root) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			response, err := s.headersProvider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
+				Block: fmt.Sprintf("%#x", root),
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if !isNotFound(err) && firstErr == nil {
+					firstErr = errors.Join(fmt.Errorf("failed to obtain header for block %#x", root), err)
+					cancel()
+				}
+
+				// Not found leaves the entry for the root as nil.
+				return
+			}
+			headers[root] = response.Data
+		}(root)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return headers, nil
+}
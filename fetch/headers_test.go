@@ -0,0 +1,118 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/fetch"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockHeadersByRoots(t *testing.T) {
+	ctx := context.Background()
+
+	missing := phase0.Root{0xff}
+	failed := phase0.Root{0xfe}
+
+	var calls atomic.Int32
+	var active atomic.Int32
+	var maxActive atomic.Int32
+	service, err := mock.New(ctx,
+		mock.WithBeaconBlockHeaderFunc(func(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+			calls.Add(1)
+			current := active.Add(1)
+			defer active.Add(-1)
+			for {
+				highest := maxActive.Load()
+				if current <= highest || maxActive.CompareAndSwap(highest, current) {
+					break
+				}
+			}
+			// Hold the request so that concurrent requests overlap.
+			time.Sleep(time.Millisecond)
+
+			var root phase0.Root
+			if err := root.UnmarshalJSON([]byte(fmt.Sprintf("%q", opts.Block))); err != nil {
+				return nil, err
+			}
+			switch root {
+			case missing:
+				return nil, &api.Error{StatusCode: http.StatusNotFound}
+			case failed:
+				return nil, &api.Error{StatusCode: http.StatusInternalServerError}
+			}
+
+			return &api.Response[*apiv1.BeaconBlockHeader]{
+				Data: &apiv1.BeaconBlockHeader{
+					Root:      root,
+					Canonical: true,
+				},
+				Metadata: map[string]any{},
+			}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	s, err := fetch.New(ctx,
+		fetch.WithService(service),
+		fetch.WithConcurrency(4),
+	)
+	require.NoError(t, err)
+
+	roots := make([]phase0.Root, 0)
+	for i := 0; i < 32; i++ {
+		roots = append(roots, phase0.Root{byte(i)})
+	}
+	// Duplicate and missing roots.
+	roots = append(roots, phase0.Root{0x01}, missing)
+
+	headers, err := s.BeaconBlockHeadersByRoots(ctx, roots)
+	require.NoError(t, err)
+	require.Len(t, headers, 33)
+	for i := 0; i < 32; i++ {
+		root := phase0.Root{byte(i)}
+		require.NotNil(t, headers[root])
+		require.Equal(t, root, headers[root].Root)
+	}
+	header, exists := headers[missing]
+	require.True(t, exists)
+	require.Nil(t, header)
+	require.Equal(t, int32(33), calls.Load())
+	require.LessOrEqual(t, maxActive.Load(), int32(4))
+	require.Greater(t, maxActive.Load(), int32(1))
+
+	_, err = s.BeaconBlockHeadersByRoots(ctx, append(roots, failed))
+	require.ErrorContains(t, err, "failed to obtain header for block 0xfe00000000000000000000000000000000000000000000000000000000000000")
+}
+
+func TestBeaconBlockHeadersByRootsCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s, err := fetch.New(ctx, fetch.WithService((&archive{}).service(ctx, t)))
+	require.NoError(t, err)
+
+	_, err = s.BeaconBlockHeadersByRoots(ctx, []phase0.Root{{0x01}})
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,91 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+type parameters struct {
+	service      consensusclient.Service
+	concurrency  int
+	blobSidecars bool
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithService sets the service from which blocks and blob sidecars are
+// fetched.  The service must provide signed beacon blocks, and must also
+// provide beacon block headers for them to be fetched by root.
+func WithService(service consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithConcurrency sets the maximum number of slots fetched concurrently.
+// Defaults to 8.
+func WithConcurrency(concurrency int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.concurrency = concurrency
+	})
+}
+
+// WithBlobSidecars sets whether blob sidecars are fetched alongside blocks.
+// If set, the service must also provide blob sidecars.
+func WithBlobSidecars(blobSidecars bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blobSidecars = blobSidecars
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		concurrency: 8,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	if _, isProvider := parameters.service.(consensusclient.SignedBeaconBlockProvider); !isProvider {
+		return nil, errors.New("service does not provide signed beacon blocks")
+	}
+	if parameters.blobSidecars {
+		if _, isProvider := parameters.service.(consensusclient.BlobSidecarsProvider); !isProvider {
+			return nil, errors.New("service does not provide blob sidecars")
+		}
+	}
+	if parameters.concurrency <= 0 {
+		return nil, errors.New("concurrency must be positive")
+	}
+
+	return &parameters, nil
+}
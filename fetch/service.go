@@ -0,0 +1,182 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fetch retrieves contiguous ranges of blocks, and optionally their
+// blob sidecars, from a beacon node.  Slots are fetched concurrently and the
+// results are provided in slot order.  It also retrieves block headers for
+// sets of block roots.
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Result is the result of fetching a single slot.
+type Result struct {
+	// Slot is the slot that was fetched.
+	Slot phase0.Slot
+	// Block is the block at the slot, or nil if the slot was missed.
+	Block *spec.VersionedSignedBeaconBlock
+	// BlobSidecars are the blob sidecars for the block, if requested.  They
+	// are nil if the block has no blobs, or if the beacon node no longer has
+	// them.
+	BlobSidecars []*deneb.BlobSidecar
+	// Err is the error encountered fetching the slot, if any.
+	Err error
+}
+
+// Service fetches blocks.
+type Service struct {
+	blocksProvider       consensusclient.SignedBeaconBlockProvider
+	blobSidecarsProvider consensusclient.BlobSidecarsProvider
+	headersProvider      consensusclient.BeaconBlockHeadersProvider
+	concurrency          int
+}
+
+// New creates a new fetch service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	s := &Service{
+		blocksProvider: parameters.service.(consensusclient.SignedBeaconBlockProvider),
+		concurrency:    parameters.concurrency,
+	}
+	if parameters.blobSidecars {
+		s.blobSidecarsProvider = parameters.service.(consensusclient.BlobSidecarsProvider)
+	}
+	if provider, isProvider := parameters.service.(consensusclient.BeaconBlockHeadersProvider); isProvider {
+		s.headersProvider = provider
+	}
+
+	return s, nil
+}
+
+// Range fetches the slots from start up to but not including end.  Results
+// are sent on the returned channel in slot order, one for each slot, and the
+// channel is closed once the range is complete or the context is cancelled.
+func (s *Service) Range(ctx context.Context, start phase0.Slot, end phase0.Slot) (<-chan *Result, error) {
+	if end < start {
+		return nil, fmt.Errorf("end slot %d before start slot %d", end, start)
+	}
+
+	results := make(chan *Result)
+	go s.fetchRange(ctx, start, end, results)
+
+	return results, nil
+}
+
+// fetchRange fetches the range, sending results in order.
+func (s *Service) fetchRange(ctx context.Context, start phase0.Slot, end phase0.Slot, results chan<- *Result) {
+	defer close(results)
+
+	// Each slot is fetched in to its own channel, and the channels are queued
+	// in slot order.  A fetch does not start until its channel is queued, so
+	// the queue length bounds both the concurrency and the results held.
+	pending := make(chan chan *Result, s.concurrency-1)
+	go func() {
+		defer close(pending)
+		for slot := start; slot < end; slot++ {
+			res := make(chan *Result, 1)
+			select {
+			case pending <- res:
+			case <-ctx.Done():
+				return
+			}
+			go func(slot phase0.Slot) {
+				res <- s.fetchSlot(ctx, slot)
+			}(slot)
+		}
+	}()
+
+	for res := range pending {
+		var result *Result
+		select {
+		case result = <-res:
+		case <-ctx.Done():
+			return
+		}
+		select {
+		case results <- result:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// fetchSlot fetches the block, and if required the blob sidecars, for a slot.
+func (s *Service) fetchSlot(ctx context.Context, slot phase0.Slot) *Result {
+	result := &Result{
+		Slot: slot,
+	}
+
+	blockID := fmt.Sprintf("%d", slot)
+	blockResponse, err := s.blocksProvider.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{
+		Block: blockID,
+	})
+	if err != nil {
+		if !isNotFound(err) {
+			result.Err = errors.Join(fmt.Errorf("failed to obtain block for slot %d", slot), err)
+		}
+
+		// Not found means a missed slot.
+		return result
+	}
+	result.Block = blockResponse.Data
+
+	if s.blobSidecarsProvider == nil || result.Block.Version < spec.DataVersionDeneb {
+		return result
+	}
+	commitments, err := result.Block.BlobKZGCommitments()
+	if err != nil {
+		result.Err = errors.Join(fmt.Errorf("failed to obtain blob commitments for slot %d", slot), err)
+
+		return result
+	}
+	if len(commitments) == 0 {
+		return result
+	}
+
+	blobSidecarsResponse, err := s.blobSidecarsProvider.BlobSidecars(ctx, &api.BlobSidecarsOpts{
+		Block: blockID,
+	})
+	if err != nil {
+		if !isNotFound(err) {
+			result.Err = errors.Join(fmt.Errorf("failed to obtain blob sidecars for slot %d", slot), err)
+		}
+
+		// Not found means the blobs have been pruned.
+		return result
+	}
+	result.BlobSidecars = blobSidecarsResponse.Data
+
+	return result
+}
+
+// isNotFound returns true if the error is a not found response from the beacon node.
+func isNotFound(err error) bool {
+	var apiErr *api.Error
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,241 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetch_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/fetch"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// archive is a scripted archive of blocks and blob sidecars.
+type archive struct {
+	// missed are the slots without blocks.
+	missed map[phase0.Slot]bool
+	// blobs are the slots with blobs.
+	blobs map[phase0.Slot]bool
+	// pruned are the slots whose blobs are no longer available.
+	pruned map[phase0.Slot]bool
+	// failed are the slots for which fetching the block fails.
+	failed map[phase0.Slot]bool
+
+	active    atomic.Int32
+	maxActive atomic.Int32
+}
+
+func (a *archive) enter() {
+	active := a.active.Add(1)
+	for {
+		maxActive := a.maxActive.Load()
+		if active <= maxActive || a.maxActive.CompareAndSwap(maxActive, active) {
+			break
+		}
+	}
+	// Hold the request so that concurrent requests overlap.
+	time.Sleep(time.Millisecond)
+}
+
+func (a *archive) exit() {
+	a.active.Add(-1)
+}
+
+func (a *archive) service(ctx context.Context, t *testing.T) consensusclient.Service {
+	t.Helper()
+
+	service, err := mock.New(ctx,
+		mock.WithSignedBeaconBlockFunc(func(_ context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+			a.enter()
+			defer a.exit()
+
+			slot, err := strconv.ParseUint(opts.Block, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case a.missed[phase0.Slot(slot)]:
+				return nil, &api.Error{StatusCode: http.StatusNotFound}
+			case a.failed[phase0.Slot(slot)]:
+				return nil, &api.Error{StatusCode: http.StatusInternalServerError}
+			}
+
+			commitments := make([]deneb.KZGCommitment, 0)
+			if a.blobs[phase0.Slot(slot)] {
+				commitments = append(commitments, deneb.KZGCommitment{0x01})
+			}
+
+			return &api.Response[*spec.VersionedSignedBeaconBlock]{
+				Data: &spec.VersionedSignedBeaconBlock{
+					Version: spec.DataVersionDeneb,
+					Deneb: &deneb.SignedBeaconBlock{
+						Message: &deneb.BeaconBlock{
+							Slot: phase0.Slot(slot),
+							Body: &deneb.BeaconBlockBody{
+								BlobKZGCommitments: commitments,
+							},
+						},
+					},
+				},
+				Metadata: map[string]any{},
+			}, nil
+		}),
+		mock.WithBlobSidecarsFunc(func(_ context.Context, opts *api.BlobSidecarsOpts) (*api.Response[[]*deneb.BlobSidecar], error) {
+			slot, err := strconv.ParseUint(opts.Block, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			if a.pruned[phase0.Slot(slot)] {
+				return nil, &api.Error{StatusCode: http.StatusNotFound}
+			}
+
+			return &api.Response[[]*deneb.BlobSidecar]{
+				Data: []*deneb.BlobSidecar{
+					{
+						SignedBlockHeader: &phase0.SignedBeaconBlockHeader{
+							Message: &phase0.BeaconBlockHeader{
+								Slot: phase0.Slot(slot),
+							},
+						},
+					},
+				},
+				Metadata: map[string]any{},
+			}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	return service
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	service := (&archive{}).service(ctx, t)
+
+	tests := []struct {
+		name   string
+		params []fetch.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			err:  "problem with parameters\nno service specified",
+		},
+		{
+			name: "ConcurrencyZero",
+			params: []fetch.Parameter{
+				fetch.WithService(service),
+				fetch.WithConcurrency(0),
+			},
+			err: "problem with parameters\nconcurrency must be positive",
+		},
+		{
+			name: "Good",
+			params: []fetch.Parameter{
+				fetch.WithService(service),
+				fetch.WithBlobSidecars(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := fetch.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRange(t *testing.T) {
+	ctx := context.Background()
+
+	a := &archive{
+		missed: map[phase0.Slot]bool{3: true, 7: true},
+		blobs:  map[phase0.Slot]bool{2: true, 5: true, 9: true},
+		pruned: map[phase0.Slot]bool{2: true},
+		failed: map[phase0.Slot]bool{11: true},
+	}
+	s, err := fetch.New(ctx,
+		fetch.WithService(a.service(ctx, t)),
+		fetch.WithConcurrency(4),
+		fetch.WithBlobSidecars(true),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Range(ctx, 10, 5)
+	require.EqualError(t, err, "end slot 5 before start slot 10")
+
+	results, err := s.Range(ctx, 1, 33)
+	require.NoError(t, err)
+
+	slot := phase0.Slot(1)
+	for result := range results {
+		require.Equal(t, slot, result.Slot)
+		switch {
+		case a.missed[slot]:
+			require.NoError(t, result.Err)
+			require.Nil(t, result.Block)
+		case a.failed[slot]:
+			require.ErrorContains(t, result.Err, fmt.Sprintf("failed to obtain block for slot %d", slot))
+			require.Nil(t, result.Block)
+		case a.blobs[slot] && !a.pruned[slot]:
+			require.NoError(t, result.Err)
+			require.NotNil(t, result.Block)
+			require.Len(t, result.BlobSidecars, 1)
+			require.Equal(t, slot, result.BlobSidecars[0].SignedBlockHeader.Message.Slot)
+		default:
+			require.NoError(t, result.Err)
+			require.NotNil(t, result.Block)
+			require.Nil(t, result.BlobSidecars)
+		}
+		slot++
+	}
+	require.Equal(t, phase0.Slot(33), slot)
+	require.LessOrEqual(t, a.maxActive.Load(), int32(4))
+	require.Greater(t, a.maxActive.Load(), int32(1))
+}
+
+func TestRangeCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s, err := fetch.New(ctx, fetch.WithService((&archive{}).service(ctx, t)))
+	require.NoError(t, err)
+
+	results, err := s.Range(ctx, 0, 1000)
+	require.NoError(t, err)
+	<-results
+	cancel()
+
+	received := 1
+	for range results {
+		received++
+	}
+	require.Less(t, received, 1000)
+}
@@ -0,0 +1,99 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package finalitytracker
+
+import (
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+type parameters struct {
+	service      consensusclient.Service
+	pollInterval time.Duration
+	stallEpochs  uint64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithService sets the service from which finality is obtained.  The service
+// must provide finality, genesis and spec, and if it also provides events
+// then finalized checkpoint events trigger an immediate update.
+func WithService(service consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithPollInterval sets the interval at which finality is polled.  Defaults
+// to 30 seconds.
+func WithPollInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.pollInterval = interval
+	})
+}
+
+// WithStallEpochs sets the number of epochs by which the finalized epoch can
+// trail the current epoch before finality is considered to have stalled.
+// Defaults to 4; the finalized epoch trails the current epoch by 2 on a
+// healthy chain.
+func WithStallEpochs(epochs uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.stallEpochs = epochs
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		pollInterval: 30 * time.Second,
+		stallEpochs:  4,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	if _, isProvider := parameters.service.(consensusclient.FinalityProvider); !isProvider {
+		return nil, errors.New("service does not provide finality")
+	}
+	if _, isProvider := parameters.service.(consensusclient.GenesisProvider); !isProvider {
+		return nil, errors.New("service does not provide genesis")
+	}
+	if _, isProvider := parameters.service.(consensusclient.SpecProvider); !isProvider {
+		return nil, errors.New("service does not provide spec")
+	}
+	if parameters.pollInterval <= 0 {
+		return nil, errors.New("no poll interval specified")
+	}
+	if parameters.stallEpochs < 2 {
+		return nil, errors.New("stall epochs must be at least 2")
+	}
+
+	return &parameters, nil
+}
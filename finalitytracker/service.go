@@ -0,0 +1,295 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package finalitytracker tracks the justification and finalization of the
+// chain.  It polls the finality checkpoints of the head state, and notifies
+// subscribers when the justified or finalized checkpoints advance and when
+// finalization stalls or recovers.
+package finalitytracker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// UpdateType is the type of a finality update.
+type UpdateType int
+
+const (
+	// UpdateTypeJustified is sent when the justified checkpoint advances.
+	UpdateTypeJustified UpdateType = iota
+	// UpdateTypeFinalized is sent when the finalized checkpoint advances.
+	UpdateTypeFinalized
+	// UpdateTypeStalled is sent when finalization stalls.
+	UpdateTypeStalled
+	// UpdateTypeRecovered is sent when finalization recovers from a stall.
+	UpdateTypeRecovered
+)
+
+var updateTypeStrings = [...]string{
+	"justified",
+	"finalized",
+	"stalled",
+	"recovered",
+}
+
+// String returns a string representation of the update type.
+func (u UpdateType) String() string {
+	if int(u) < 0 || int(u) >= len(updateTypeStrings) {
+		return "unknown"
+	}
+
+	return updateTypeStrings[u]
+}
+
+// Update is provided to subscribers when finality changes.
+type Update struct {
+	// Type is the type of the update.
+	Type UpdateType
+	// Finality is the current finality.
+	Finality *apiv1.Finality
+	// Previous is the finality prior to the update.
+	Previous *apiv1.Finality
+	// CurrentEpoch is the current epoch at the time of the update.
+	CurrentEpoch phase0.Epoch
+	// StallDuration is the time since the finalized checkpoint last
+	// advanced, for stalled and recovered updates.
+	StallDuration time.Duration
+}
+
+// Service tracks finality.
+type Service struct {
+	finalityProvider consensusclient.FinalityProvider
+	chainTime        *chaintime.Service
+	stallEpochs      phase0.Epoch
+	now              func() time.Time
+
+	// processMu serialises processing of finality.
+	processMu sync.Mutex
+
+	mu            sync.RWMutex
+	finality      *apiv1.Finality
+	lastFinalized time.Time
+	stalled       bool
+	handlers      map[uint64]func(*Update)
+	nextHandler   uint64
+}
+
+// New creates a new finality tracker service.  The service polls finality
+// until the context is cancelled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithGenesisProvider(parameters.service.(consensusclient.GenesisProvider)),
+		chaintime.WithSpecProvider(parameters.service.(consensusclient.SpecProvider)),
+	)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to create chain time service"), err)
+	}
+
+	s := &Service{
+		finalityProvider: parameters.service.(consensusclient.FinalityProvider),
+		chainTime:        chainTime,
+		stallEpochs:      phase0.Epoch(parameters.stallEpochs),
+		now:              time.Now,
+		handlers:         make(map[uint64]func(*Update)),
+	}
+
+	if err := s.poll(ctx); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain initial finality"), err)
+	}
+
+	if eventsProvider, isProvider := parameters.service.(consensusclient.EventsProvider); isProvider {
+		handler := func(*apiv1.Event) {
+			// The event does not contain the justified checkpoint, so poll for full finality.
+			_ = s.poll(ctx)
+		}
+		if err := eventsProvider.Events(ctx, []string{"finalized_checkpoint"}, handler); err != nil {
+			return nil, errors.Join(errors.New("failed to subscribe to finalized checkpoint events"), err)
+		}
+	}
+
+	go s.run(ctx, parameters.pollInterval)
+
+	return s, nil
+}
+
+// Finality provides the latest finality.
+func (s *Service) Finality() *apiv1.Finality {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.finality
+}
+
+// Stalled returns true if finalization is stalled.
+func (s *Service) Stalled() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stalled
+}
+
+// StallDuration provides the time since the finalized checkpoint last
+// advanced if finalization is stalled, otherwise 0.
+func (s *Service) StallDuration() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.stalled {
+		return 0
+	}
+
+	return s.now().Sub(s.lastFinalized)
+}
+
+// Subscribe registers a handler that is called with each finality update.
+// Handlers should return promptly.  The returned function removes the handler.
+func (s *Service) Subscribe(handler func(*Update)) func() {
+	s.mu.Lock()
+	id := s.nextHandler
+	s.nextHandler++
+	s.handlers[id] = handler
+	s.mu.Unlock()
+
+	return func() {
+		s.mu.Lock()
+		delete(s.handlers, id)
+		s.mu.Unlock()
+	}
+}
+
+// run polls finality until the context is cancelled.
+func (s *Service) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Errors are transient; the next poll will try again.
+			_ = s.poll(ctx)
+		}
+	}
+}
+
+// poll obtains the current finality and notifies subscribers of any changes.
+func (s *Service) poll(ctx context.Context) error {
+	// Hold the lock whilst fetching, so that an older response cannot be
+	// processed after a newer one.
+	s.processMu.Lock()
+	defer s.processMu.Unlock()
+
+	response, err := s.finalityProvider.Finality(ctx, &api.FinalityOpts{State: "head"})
+	if err != nil {
+		return err
+	}
+	if response.Data == nil || response.Data.Finalized == nil || response.Data.Justified == nil {
+		return errors.New("finality response missing data")
+	}
+
+	updates := s.process(response.Data, s.chainTime.CurrentEpoch())
+	if len(updates) == 0 {
+		return nil
+	}
+
+	s.mu.RLock()
+	handlers := make([]func(*Update), 0, len(s.handlers))
+	for _, handler := range s.handlers {
+		handlers = append(handlers, handler)
+	}
+	s.mu.RUnlock()
+
+	for _, update := range updates {
+		for _, handler := range handlers {
+			handler(update)
+		}
+	}
+
+	return nil
+}
+
+// process updates the state with the given finality, returning the resultant updates.
+func (s *Service) process(finality *apiv1.Finality, currentEpoch phase0.Epoch) []*Update {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	previous := s.finality
+	s.finality = finality
+	if previous == nil {
+		// First poll; establish the baseline from the finalized checkpoint, so
+		// that a stall already in progress is reported with its full duration.
+		s.lastFinalized = s.chainTime.EpochStartTime(finality.Finalized.Epoch)
+		if s.lastFinalized.After(now) {
+			s.lastFinalized = now
+		}
+		s.stalled = s.isStalled(finality, currentEpoch)
+
+		return nil
+	}
+
+	updates := make([]*Update, 0)
+	newUpdate := func(updateType UpdateType) *Update {
+		return &Update{
+			Type:         updateType,
+			Finality:     finality,
+			Previous:     previous,
+			CurrentEpoch: currentEpoch,
+		}
+	}
+
+	if finality.Justified.Epoch > previous.Justified.Epoch {
+		updates = append(updates, newUpdate(UpdateTypeJustified))
+	}
+	stallDuration := now.Sub(s.lastFinalized)
+	if finality.Finalized.Epoch > previous.Finalized.Epoch {
+		updates = append(updates, newUpdate(UpdateTypeFinalized))
+		s.lastFinalized = now
+	}
+
+	stalled := s.isStalled(finality, currentEpoch)
+	switch {
+	case stalled && !s.stalled:
+		update := newUpdate(UpdateTypeStalled)
+		update.StallDuration = stallDuration
+		updates = append(updates, update)
+	case !stalled && s.stalled:
+		update := newUpdate(UpdateTypeRecovered)
+		update.StallDuration = stallDuration
+		updates = append(updates, update)
+	}
+	s.stalled = stalled
+
+	return updates
+}
+
+// isStalled returns true if the finalized epoch trails the current epoch by
+// more than the stall epochs.
+func (s *Service) isStalled(finality *apiv1.Finality, currentEpoch phase0.Epoch) bool {
+	return currentEpoch > finality.Finalized.Epoch+s.stallEpochs
+}
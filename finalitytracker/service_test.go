@@ -0,0 +1,308 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package finalitytracker_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/finalitytracker"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// chain is a scripted chain with controllable finality.
+type chain struct {
+	mu        sync.Mutex
+	justified phase0.Epoch
+	finalized phase0.Epoch
+	err       error
+	delay     time.Duration
+	handler   consensusclient.EventHandlerFunc
+}
+
+// set sets the finality of the chain and sends a finalized checkpoint event.
+func (c *chain) set(justified phase0.Epoch, finalized phase0.Epoch) {
+	c.mu.Lock()
+	c.justified = justified
+	c.finalized = finalized
+	c.mu.Unlock()
+	c.handler(&apiv1.Event{
+		Topic: "finalized_checkpoint",
+		Data: &apiv1.FinalizedCheckpointEvent{
+			Epoch: finalized,
+		},
+	})
+}
+
+// newChain creates a chain whose current epoch is the given epoch.
+func newChain(ctx context.Context, t *testing.T, currentEpoch phase0.Epoch) (*chain, consensusclient.Service) {
+	t.Helper()
+
+	c := &chain{
+		justified: currentEpoch - 1,
+		finalized: currentEpoch - 2,
+	}
+
+	// Place genesis half way through an epoch to avoid boundary effects.
+	genesisTime := time.Now().Add(-(time.Duration(currentEpoch)*32 + 16) * 12 * time.Second)
+	service, err := mock.New(ctx,
+		mock.WithGenesisTime(genesisTime),
+		mock.WithFinalityFunc(func(_ context.Context, _ *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+			c.mu.Lock()
+			if c.err != nil {
+				c.mu.Unlock()
+
+				return nil, c.err
+			}
+			finality := &apiv1.Finality{
+				Justified:         &phase0.Checkpoint{Epoch: c.justified},
+				PreviousJustified: &phase0.Checkpoint{Epoch: c.justified - 1},
+				Finalized:         &phase0.Checkpoint{Epoch: c.finalized},
+			}
+			delay := c.delay
+			c.mu.Unlock()
+
+			// Simulate a slow response.
+			time.Sleep(delay)
+
+			return &api.Response[*apiv1.Finality]{
+				Data:     finality,
+				Metadata: map[string]any{},
+			}, nil
+		}),
+		mock.WithEventsFunc(func(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+			c.handler = handler
+
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	return c, service
+}
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, service := newChain(ctx, t, 10)
+
+	tests := []struct {
+		name   string
+		params []finalitytracker.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			err:  "problem with parameters\nno service specified",
+		},
+		{
+			name: "PollIntervalZero",
+			params: []finalitytracker.Parameter{
+				finalitytracker.WithService(service),
+				finalitytracker.WithPollInterval(0),
+			},
+			err: "problem with parameters\nno poll interval specified",
+		},
+		{
+			name: "StallEpochsLow",
+			params: []finalitytracker.Parameter{
+				finalitytracker.WithService(service),
+				finalitytracker.WithStallEpochs(1),
+			},
+			err: "problem with parameters\nstall epochs must be at least 2",
+		},
+		{
+			name: "Good",
+			params: []finalitytracker.Parameter{
+				finalitytracker.WithService(service),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := finalitytracker.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInitialError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, service := newChain(ctx, t, 10)
+	c.err = errors.New("mock error")
+
+	_, err := finalitytracker.New(ctx, finalitytracker.WithService(service))
+	require.EqualError(t, err, "failed to obtain initial finality\nmock error")
+}
+
+func TestTracking(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, service := newChain(ctx, t, 10)
+	s, err := finalitytracker.New(ctx,
+		finalitytracker.WithService(service),
+		finalitytracker.WithPollInterval(time.Hour),
+		finalitytracker.WithStallEpochs(4),
+	)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(8), s.Finality().Finalized.Epoch)
+	require.False(t, s.Stalled())
+	require.Zero(t, s.StallDuration())
+
+	updates := make([]*finalitytracker.Update, 0)
+	unsubscribe := s.Subscribe(func(update *finalitytracker.Update) {
+		updates = append(updates, update)
+	})
+
+	// Unchanged finality sends no updates.
+	c.set(9, 8)
+	require.Empty(t, updates)
+
+	// Justification and finalization advance.
+	c.set(10, 9)
+	require.Len(t, updates, 2)
+	require.Equal(t, finalitytracker.UpdateTypeJustified, updates[0].Type)
+	require.Equal(t, finalitytracker.UpdateTypeFinalized, updates[1].Type)
+	require.Equal(t, phase0.Epoch(8), updates[1].Previous.Finalized.Epoch)
+	require.Equal(t, phase0.Epoch(9), updates[1].Finality.Finalized.Epoch)
+	require.Equal(t, phase0.Epoch(10), updates[1].CurrentEpoch)
+
+	// Finalization regresses far enough to stall.
+	c.set(10, 5)
+	require.Len(t, updates, 3)
+	require.Equal(t, finalitytracker.UpdateTypeStalled, updates[2].Type)
+	require.True(t, s.Stalled())
+	require.Positive(t, s.StallDuration())
+
+	// Finalization recovers.
+	c.set(10, 8)
+	require.Len(t, updates, 5)
+	require.Equal(t, finalitytracker.UpdateTypeFinalized, updates[3].Type)
+	require.Equal(t, finalitytracker.UpdateTypeRecovered, updates[4].Type)
+	require.Positive(t, updates[4].StallDuration)
+	require.False(t, s.Stalled())
+	require.Zero(t, s.StallDuration())
+
+	// Errors leave the state unchanged.
+	c.mu.Lock()
+	c.err = errors.New("mock error")
+	c.mu.Unlock()
+	c.handler(&apiv1.Event{Topic: "finalized_checkpoint"})
+	require.Len(t, updates, 5)
+	require.Equal(t, phase0.Epoch(8), s.Finality().Finalized.Epoch)
+	c.mu.Lock()
+	c.err = nil
+	c.mu.Unlock()
+
+	unsubscribe()
+	c.set(11, 10)
+	require.Len(t, updates, 5)
+}
+
+func TestOverlappingPolls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, service := newChain(ctx, t, 10)
+	s, err := finalitytracker.New(ctx,
+		finalitytracker.WithService(service),
+		finalitytracker.WithPollInterval(time.Hour),
+	)
+	require.NoError(t, err)
+
+	var updatesMu sync.Mutex
+	updates := make([]*finalitytracker.Update, 0)
+	s.Subscribe(func(update *finalitytracker.Update) {
+		updatesMu.Lock()
+		updates = append(updates, update)
+		updatesMu.Unlock()
+	})
+
+	// Start a slow poll, which obtains the current finality.
+	c.mu.Lock()
+	c.delay = 100 * time.Millisecond
+	c.mu.Unlock()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.handler(&apiv1.Event{Topic: "finalized_checkpoint"})
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// Finality advances, and a fast poll starts whilst the slow poll is outstanding.
+	c.mu.Lock()
+	c.delay = 0
+	c.justified = 10
+	c.finalized = 9
+	c.mu.Unlock()
+	c.handler(&apiv1.Event{Topic: "finalized_checkpoint"})
+	wg.Wait()
+
+	// The response from the slow poll does not replace that of the fast poll.
+	require.Equal(t, phase0.Epoch(9), s.Finality().Finalized.Epoch)
+	updatesMu.Lock()
+	defer updatesMu.Unlock()
+	require.Len(t, updates, 2)
+	require.Equal(t, finalitytracker.UpdateTypeJustified, updates[0].Type)
+	require.Equal(t, finalitytracker.UpdateTypeFinalized, updates[1].Type)
+}
+
+func TestInitiallyStalled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, service := newChain(ctx, t, 10)
+	c.justified = 3
+	c.finalized = 2
+
+	s, err := finalitytracker.New(ctx,
+		finalitytracker.WithService(service),
+		finalitytracker.WithPollInterval(time.Hour),
+	)
+	require.NoError(t, err)
+	require.True(t, s.Stalled())
+
+	// The stall is measured from the start of the finalized epoch, some eight
+	// epochs ago, rather than from the start of the service.
+	epochDuration := 32 * 12 * time.Second
+	require.GreaterOrEqual(t, s.StallDuration(), 8*epochDuration)
+	require.Less(t, s.StallDuration(), 9*epochDuration)
+
+	updates := make([]*finalitytracker.Update, 0)
+	s.Subscribe(func(update *finalitytracker.Update) {
+		updates = append(updates, update)
+	})
+	c.set(10, 9)
+	require.Len(t, updates, 3)
+	require.Equal(t, finalitytracker.UpdateTypeRecovered, updates[2].Type)
+	require.GreaterOrEqual(t, updates[2].StallDuration, 8*epochDuration)
+}
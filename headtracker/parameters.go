@@ -0,0 +1,80 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package headtracker
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+type parameters struct {
+	service consensusclient.Service
+	history int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithService sets the service from which heads are obtained.  The service
+// must provide events and beacon block headers.
+func WithService(service consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithHistory sets the number of slots of block history that are retained
+// to find the common ancestor of a reorg.  Reorgs deeper than this are
+// reported without a common ancestor.  Defaults to 64.
+func WithHistory(history int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.history = history
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		history: 64,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	if _, isProvider := parameters.service.(consensusclient.EventsProvider); !isProvider {
+		return nil, errors.New("service does not provide events")
+	}
+	if _, isProvider := parameters.service.(consensusclient.BeaconBlockHeadersProvider); !isProvider {
+		return nil, errors.New("service does not provide beacon block headers")
+	}
+	if parameters.history <= 0 {
+		return nil, errors.New("no history specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,286 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package headtracker tracks the canonical head of the chain.  It follows
+// head events from a beacon node, fetching block headers as required to
+// determine whether each new head extends the previous head or replaces it
+// in a reorg, and notifies subscribers of each change.
+package headtracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Head is a head of the chain.
+type Head struct {
+	Slot       phase0.Slot
+	Root       phase0.Root
+	ParentRoot phase0.Root
+	StateRoot  phase0.Root
+}
+
+// Reorg details a reorg of the chain.
+type Reorg struct {
+	// OldHead is the head prior to the reorg.
+	OldHead *Head
+	// NewHead is the head after the reorg.
+	NewHead *Head
+	// CommonAncestor is the latest block common to both the old and new
+	// heads.  It is nil if the common ancestor is outside of the history.
+	CommonAncestor *Head
+	// Depth is the number of slots between the old head and the common
+	// ancestor.  It is 0 if the common ancestor is not known.
+	Depth uint64
+}
+
+// Update is provided to subscribers when the head changes.
+type Update struct {
+	// Head is the new head.
+	Head *Head
+	// Reorg is set if the new head does not descend from the previous head.
+	// Reorgs cannot be detected if the header of the new head is unavailable.
+	Reorg *Reorg
+}
+
+// Service tracks the head of the chain.
+type Service struct {
+	blockHeadersProvider consensusclient.BeaconBlockHeadersProvider
+	history              phase0.Slot
+
+	// processMu serialises processing of head events.
+	processMu sync.Mutex
+
+	mu          sync.RWMutex
+	head        *Head
+	blocks      map[phase0.Root]*Head
+	handlers    map[uint64]func(*Update)
+	nextHandler uint64
+}
+
+// New creates a new head tracker service, starting from the current head of
+// the chain.  The service tracks the head until the context is cancelled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	s := &Service{
+		blockHeadersProvider: parameters.service.(consensusclient.BeaconBlockHeadersProvider),
+		history:              phase0.Slot(parameters.history),
+		blocks:               make(map[phase0.Root]*Head),
+		handlers:             make(map[uint64]func(*Update)),
+	}
+
+	head, err := s.fetchBlock(ctx, "head")
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain head"), err)
+	}
+	s.head = head
+	s.blocks[head.Root] = head
+
+	handler := func(event *apiv1.Event) {
+		s.handleEvent(ctx, event)
+	}
+	if err := parameters.service.(consensusclient.EventsProvider).Events(ctx, []string{"head"}, handler); err != nil {
+		return nil, errors.Join(errors.New("failed to subscribe to head events"), err)
+	}
+
+	return s, nil
+}
+
+// Head provides the current head of the chain.
+func (s *Service) Head() *Head {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.head
+}
+
+// Subscribe registers a handler that is called with each change of head.
+// Handlers are called in order of head changes, and should return
+// promptly.  The returned function removes the handler.
+func (s *Service) Subscribe(handler func(*Update)) func() {
+	s.mu.Lock()
+	id := s.nextHandler
+	s.nextHandler++
+	s.handlers[id] = handler
+	s.mu.Unlock()
+
+	return func() {
+		s.mu.Lock()
+		delete(s.handlers, id)
+		s.mu.Unlock()
+	}
+}
+
+// handleEvent handles head events.
+func (s *Service) handleEvent(ctx context.Context, event *apiv1.Event) {
+	data, isHeadEvent := event.Data.(*apiv1.HeadEvent)
+	if !isHeadEvent {
+		return
+	}
+
+	s.processMu.Lock()
+	defer s.processMu.Unlock()
+
+	update := s.process(ctx, data)
+	if update == nil {
+		return
+	}
+
+	s.mu.RLock()
+	handlers := make([]func(*Update), 0, len(s.handlers))
+	for _, handler := range s.handlers {
+		handlers = append(handlers, handler)
+	}
+	s.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(update)
+	}
+}
+
+// process processes a head event, returning the resultant update if the head has changed.
+func (s *Service) process(ctx context.Context, event *apiv1.HeadEvent) *Update {
+	oldHead := s.Head()
+	if event.Block == oldHead.Root {
+		return nil
+	}
+
+	update := &Update{}
+	newHead, err := s.block(ctx, event.Block)
+	if err != nil {
+		// Unable to obtain the block, so use the information in the event
+		// without checking for a reorg.
+		newHead = &Head{
+			Slot:      event.Slot,
+			Root:      event.Block,
+			StateRoot: event.State,
+		}
+	}
+	update.Head = newHead
+	if err == nil && newHead.ParentRoot != oldHead.Root {
+		ancestor, err := s.commonAncestor(ctx, oldHead, newHead)
+		switch {
+		case err != nil:
+			update.Reorg = &Reorg{
+				OldHead: oldHead,
+				NewHead: newHead,
+			}
+		case ancestor.Root != oldHead.Root:
+			update.Reorg = &Reorg{
+				OldHead:        oldHead,
+				NewHead:        newHead,
+				CommonAncestor: ancestor,
+				Depth:          uint64(oldHead.Slot - ancestor.Slot),
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.head = newHead
+	s.prune()
+	s.mu.Unlock()
+
+	return update
+}
+
+// commonAncestor finds the latest block common to the chains of the two heads.
+func (s *Service) commonAncestor(ctx context.Context, a *Head, b *Head) (*Head, error) {
+	minSlot := phase0.Slot(0)
+	if b.Slot > s.history {
+		minSlot = b.Slot - s.history
+	}
+
+	var err error
+	for a.Root != b.Root {
+		if a.Slot < minSlot || b.Slot < minSlot {
+			return nil, errors.New("common ancestor outside of history")
+		}
+		if a.Slot >= b.Slot {
+			if a, err = s.block(ctx, a.ParentRoot); err != nil {
+				return nil, err
+			}
+		} else {
+			if b, err = s.block(ctx, b.ParentRoot); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return a, nil
+}
+
+// block provides the block with the given root, fetching it if required.
+func (s *Service) block(ctx context.Context, root phase0.Root) (*Head, error) {
+	s.mu.RLock()
+	block, exists := s.blocks[root]
+	s.mu.RUnlock()
+	if exists {
+		return block, nil
+	}
+
+	block, err := s.fetchBlock(ctx, fmt.Sprintf("%#x", root))
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.blocks[block.Root] = block
+	s.mu.Unlock()
+
+	return block, nil
+}
+
+// fetchBlock fetches the block with the given ID.
+func (s *Service) fetchBlock(ctx context.Context, blockID string) (*Head, error) {
+	response, err := s.blockHeadersProvider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
+		Block: blockID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Data == nil || response.Data.Header == nil || response.Data.Header.Message == nil {
+		return nil, errors.New("header response missing data")
+	}
+
+	return &Head{
+		Slot:       response.Data.Header.Message.Slot,
+		Root:       response.Data.Root,
+		ParentRoot: response.Data.Header.Message.ParentRoot,
+		StateRoot:  response.Data.Header.Message.StateRoot,
+	}, nil
+}
+
+// prune removes blocks that are outside of the history.  It must be called
+// with the lock held.
+func (s *Service) prune() {
+	if s.head.Slot <= s.history {
+		return
+	}
+	minSlot := s.head.Slot - s.history
+	for root, block := range s.blocks {
+		if block.Slot < minSlot {
+			delete(s.blocks, root)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package headtracker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/headtracker"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// chain is a scripted chain of blocks.
+type chain struct {
+	mu      sync.Mutex
+	head    phase0.Root
+	blocks  map[string]*apiv1.BeaconBlockHeader
+	handler consensusclient.EventHandlerFunc
+}
+
+// root returns a deterministic root for a block on a branch.
+func root(branch byte, slot phase0.Slot) phase0.Root {
+	return phase0.Root{branch, byte(slot)}
+}
+
+// add adds a block to the chain.
+func (c *chain) add(branch byte, slot phase0.Slot, parent phase0.Root) phase0.Root {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	blockRoot := root(branch, slot)
+	c.blocks[fmt.Sprintf("%#x", blockRoot)] = &apiv1.BeaconBlockHeader{
+		Root: blockRoot,
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:       slot,
+				ParentRoot: parent,
+			},
+		},
+	}
+
+	return blockRoot
+}
+
+// setHead sets the head of the chain and sends a head event.
+func (c *chain) setHead(blockRoot phase0.Root, slot phase0.Slot) {
+	c.mu.Lock()
+	c.head = blockRoot
+	c.mu.Unlock()
+	c.handler(&apiv1.Event{
+		Topic: "head",
+		Data: &apiv1.HeadEvent{
+			Slot:  slot,
+			Block: blockRoot,
+		},
+	})
+}
+
+func newChain(ctx context.Context, t *testing.T) (*chain, consensusclient.Service) {
+	t.Helper()
+
+	c := &chain{
+		blocks: make(map[string]*apiv1.BeaconBlockHeader),
+	}
+	c.head = c.add(0, 0, phase0.Root{})
+
+	service, err := mock.New(ctx,
+		mock.WithBeaconBlockHeaderFunc(func(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			blockID := opts.Block
+			if blockID == "head" {
+				blockID = fmt.Sprintf("%#x", c.head)
+			}
+			header, exists := c.blocks[blockID]
+			if !exists {
+				return nil, errors.New("not found")
+			}
+
+			return &api.Response[*apiv1.BeaconBlockHeader]{Data: header, Metadata: map[string]any{}}, nil
+		}),
+		mock.WithEventsFunc(func(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+			c.handler = handler
+
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	return c, service
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	_, service := newChain(ctx, t)
+
+	tests := []struct {
+		name   string
+		params []headtracker.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			err:  "problem with parameters\nno service specified",
+		},
+		{
+			name: "HistoryZero",
+			params: []headtracker.Parameter{
+				headtracker.WithService(service),
+				headtracker.WithHistory(0),
+			},
+			err: "problem with parameters\nno history specified",
+		},
+		{
+			name: "Good",
+			params: []headtracker.Parameter{
+				headtracker.WithService(service),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := headtracker.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTracking(t *testing.T) {
+	ctx := context.Background()
+
+	c, service := newChain(ctx, t)
+	s, err := headtracker.New(ctx, headtracker.WithService(service), headtracker.WithHistory(8))
+	require.NoError(t, err)
+	require.Equal(t, root(0, 0), s.Head().Root)
+
+	updates := make([]*headtracker.Update, 0)
+	unsubscribe := s.Subscribe(func(update *headtracker.Update) {
+		updates = append(updates, update)
+	})
+
+	// Extend the chain.
+	block1 := c.add(0, 1, root(0, 0))
+	c.setHead(block1, 1)
+	block2 := c.add(0, 2, block1)
+	c.setHead(block2, 2)
+	require.Len(t, updates, 2)
+	require.Nil(t, updates[1].Reorg)
+	require.Equal(t, block2, s.Head().Root)
+
+	// Repeated head is ignored.
+	c.setHead(block2, 2)
+	require.Len(t, updates, 2)
+
+	// Skipped head events are not reorgs.
+	block3 := c.add(0, 3, block2)
+	block4 := c.add(0, 4, block3)
+	c.setHead(block4, 4)
+	require.Len(t, updates, 3)
+	require.Nil(t, updates[2].Reorg)
+
+	// Reorg from a fork at block 2.
+	fork3 := c.add(1, 3, block2)
+	fork5 := c.add(1, 5, fork3)
+	c.setHead(fork5, 5)
+	require.Len(t, updates, 4)
+	reorg := updates[3].Reorg
+	require.NotNil(t, reorg)
+	require.Equal(t, block4, reorg.OldHead.Root)
+	require.Equal(t, fork5, reorg.NewHead.Root)
+	require.Equal(t, block2, reorg.CommonAncestor.Root)
+	require.Equal(t, uint64(2), reorg.Depth)
+
+	// Reorg deeper than the history.
+	deep := c.add(2, 20, root(0, 0))
+	c.setHead(deep, 20)
+	require.Len(t, updates, 5)
+	require.NotNil(t, updates[4].Reorg)
+	require.Nil(t, updates[4].Reorg.CommonAncestor)
+
+	// Unknown head is tracked without reorg detection.
+	c.setHead(root(3, 21), 21)
+	require.Len(t, updates, 6)
+	require.Nil(t, updates[5].Reorg)
+	require.Equal(t, phase0.Slot(21), s.Head().Slot)
+
+	unsubscribe()
+	c.setHead(block1, 1)
+	require.Len(t, updates, 6)
+}
@@ -50,6 +50,9 @@ func (s *Service) AggregateAttestation(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err := s.ensureConsensusVersion(ctx, httpResponse, &opts.Slot); err != nil {
+		return nil, err
+	}
 
 	data, metadata, err := decodeAggregateAttestation(httpResponse)
 	if err != nil {
@@ -86,7 +89,7 @@ func (s *Service) AggregateAttestation(ctx context.Context,
 	}
 
 	return &api.Response[*spec.VersionedAttestation]{
-		Metadata: metadata,
+		Metadata: consensusVersionMetadata(metadata, httpResponse),
 		Data:     data,
 	}, nil
 }
@@ -150,6 +153,15 @@ func decodeAggregateAttestation(httpResponse *httpResponse) (*spec.VersionedAtte
 			return &spec.VersionedAttestation{}, nil, decodeErr
 		}
 
+		return data, metadata, nil
+	case spec.DataVersionFulu:
+		fuluData, fuluMetadata, decodeErr := decodeJSONResponse(bytes.NewReader(httpResponse.body), &electra.Attestation{})
+		metadata = fuluMetadata
+		data.Fulu = fuluData
+		if decodeErr != nil {
+			return &spec.VersionedAttestation{}, nil, decodeErr
+		}
+
 		return data, metadata, nil
 	default:
 		return &spec.VersionedAttestation{}, nil, errors.New("unknown consensus version")
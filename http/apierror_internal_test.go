@@ -0,0 +1,118 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		post        bool
+		code        int
+		message     string
+		stacktraces []string
+	}{
+		{
+			name:   "NoBody",
+			status: http.StatusInternalServerError,
+		},
+		{
+			name:   "NotJSON",
+			status: http.StatusBadGateway,
+			body:   "bad gateway",
+		},
+		{
+			name:    "Get",
+			status:  http.StatusNotFound,
+			body:    `{"code":404,"message":"State not found"}`,
+			code:    404,
+			message: "State not found",
+		},
+		{
+			name:        "Post",
+			status:      http.StatusBadRequest,
+			body:        `{"code":400,"message":"Invalid attestation","stacktraces":["trace 1","trace 2"]}`,
+			post:        true,
+			code:        400,
+			message:     "Invalid attestation",
+			stacktraces: []string{"trace 1", "trace 2"},
+		},
+		{
+			name:    "CodeString",
+			status:  http.StatusServiceUnavailable,
+			body:    `{"code":"503","message":"Syncing"}`,
+			code:    503,
+			message: "Syncing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      address.String(),
+				client:       http.DefaultClient,
+				timeout:      timeout,
+				extraHeaders: map[string]string{},
+			}
+
+			endpoint := "/eth/v1/beacon/states/head/finality_checkpoints"
+			method := http.MethodGet
+			if test.post {
+				endpoint = "/eth/v1/beacon/pool/attestations"
+				method = http.MethodPost
+				_, err = s.post(ctx, endpoint, "", &api.CommonOpts{}, bytes.NewReader([]byte("[]")), ContentTypeJSON, map[string]string{})
+			} else {
+				_, err = s.get(ctx, endpoint, "", &api.CommonOpts{}, false)
+			}
+
+			var apiErr *api.Error
+			require.True(t, errors.As(err, &apiErr))
+			require.Equal(t, method, apiErr.Method)
+			require.Equal(t, endpoint, apiErr.Endpoint)
+			require.Equal(t, test.status, apiErr.StatusCode)
+			require.Equal(t, test.code, apiErr.Code)
+			require.Equal(t, test.message, apiErr.Message)
+			require.Equal(t, test.stacktraces, apiErr.Stacktraces)
+			require.Greater(t, apiErr.Duration, time.Duration(0))
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -29,7 +31,7 @@ import (
 func (s *Service) AttestationPool(ctx context.Context,
 	opts *api.AttestationPoolOpts,
 ) (
-	*api.Response[[]*phase0.Attestation],
+	*api.Response[[]*spec.VersionedAttestation],
 	error,
 ) {
 	if err := s.assertIsSynced(ctx); err != nil {
@@ -39,7 +41,7 @@ func (s *Service) AttestationPool(ctx context.Context,
 		return nil, client.ErrNoOptions
 	}
 
-	endpoint := "/eth/v1/beacon/pool/attestations"
+	endpoint := "/eth/v2/beacon/pool/attestations"
 	queryItems := make([]string, 0)
 	if opts.Slot != nil {
 		queryItems = append(queryItems, fmt.Sprintf("slot=%d", *opts.Slot))
@@ -51,6 +53,9 @@ func (s *Service) AttestationPool(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err := s.ensureConsensusVersion(ctx, httpResponse, opts.Slot); err != nil {
+		return nil, err
+	}
 
 	switch httpResponse.contentType {
 	case ContentTypeJSON:
@@ -64,10 +69,10 @@ func (*Service) attestationPoolFromJSON(_ context.Context,
 	opts *api.AttestationPoolOpts,
 	httpResponse *httpResponse,
 ) (
-	*api.Response[[]*phase0.Attestation],
+	*api.Response[[]*spec.VersionedAttestation],
 	error,
 ) {
-	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*phase0.Attestation{})
+	data, metadata, err := decodeAttestationPool(httpResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -76,19 +81,79 @@ func (*Service) attestationPoolFromJSON(_ context.Context,
 		return nil, err
 	}
 
-	return &api.Response[[]*phase0.Attestation]{
-		Metadata: metadata,
+	return &api.Response[[]*spec.VersionedAttestation]{
+		Metadata: consensusVersionMetadata(metadata, httpResponse),
 		Data:     data,
 	}, nil
 }
 
-func verifyAttestationPool(opts *api.AttestationPoolOpts, data []*phase0.Attestation) error {
+func decodeAttestationPool(httpResponse *httpResponse) ([]*spec.VersionedAttestation, map[string]any, error) {
+	switch httpResponse.consensusVersion {
+	case spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb:
+		attestations, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*phase0.Attestation{})
+		if err != nil {
+			return nil, nil, err
+		}
+		data := make([]*spec.VersionedAttestation, len(attestations))
+		for i := range attestations {
+			data[i] = &spec.VersionedAttestation{Version: httpResponse.consensusVersion}
+			switch httpResponse.consensusVersion {
+			case spec.DataVersionPhase0:
+				data[i].Phase0 = attestations[i]
+			case spec.DataVersionAltair:
+				data[i].Altair = attestations[i]
+			case spec.DataVersionBellatrix:
+				data[i].Bellatrix = attestations[i]
+			case spec.DataVersionCapella:
+				data[i].Capella = attestations[i]
+			default:
+				data[i].Deneb = attestations[i]
+			}
+		}
+
+		return data, metadata, nil
+	case spec.DataVersionElectra, spec.DataVersionFulu:
+		attestations, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*electra.Attestation{})
+		if err != nil {
+			return nil, nil, err
+		}
+		data := make([]*spec.VersionedAttestation, len(attestations))
+		for i := range attestations {
+			data[i] = &spec.VersionedAttestation{Version: httpResponse.consensusVersion}
+			if httpResponse.consensusVersion == spec.DataVersionElectra {
+				data[i].Electra = attestations[i]
+			} else {
+				data[i].Fulu = attestations[i]
+			}
+		}
+
+		return data, metadata, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported version %s", httpResponse.consensusVersion)
+	}
+}
+
+func verifyAttestationPool(opts *api.AttestationPoolOpts, data []*spec.VersionedAttestation) error {
 	for _, datum := range data {
-		if opts.Slot != nil && datum.Data.Slot != *opts.Slot {
+		attestationData, err := datum.Data()
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain attestation data"), err)
+		}
+		if opts.Slot != nil && attestationData.Slot != *opts.Slot {
 			return errors.New("attestation data not for requested slot")
 		}
-		if opts.CommitteeIndex != nil && datum.Data.Index != *opts.CommitteeIndex {
-			return errors.New("attestation data not for requested committee index")
+		if opts.CommitteeIndex != nil {
+			committeeIndex, err := datum.CommitteeIndex()
+			if err != nil {
+				return errors.Join(errors.New("failed to obtain attestation committee index"), err)
+			}
+			if committeeIndex != *opts.CommitteeIndex {
+				return errors.New("attestation data not for requested committee index")
+			}
 		}
 	}
 
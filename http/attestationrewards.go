@@ -23,7 +23,6 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -34,7 +33,7 @@ func (s *Service) AttestationRewards(ctx context.Context,
 	*api.Response[*apiv1.AttestationRewards],
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "AttestationRewards")
+	ctx, span := s.tracer().Start(ctx, "AttestationRewards")
 	defer span.End()
 
 	if err := s.assertIsActive(ctx); err != nil {
@@ -0,0 +1,108 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttesterSlashingPool obtains the attester slashing pool.
+func (s *Service) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttesterSlashing],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v2/beacon/pool/attester_slashings"
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, false)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to request attester slashing pool"), err)
+	}
+
+	data, metadata, err := decodeAttesterSlashingPool(httpResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*spec.VersionedAttesterSlashing]{
+		Data:     data,
+		Metadata: consensusVersionMetadata(metadata, httpResponse),
+	}, nil
+}
+
+func decodeAttesterSlashingPool(httpResponse *httpResponse) ([]*spec.VersionedAttesterSlashing, map[string]any, error) {
+	switch httpResponse.consensusVersion {
+	case spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb:
+		slashings, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*phase0.AttesterSlashing{})
+		if err != nil {
+			return nil, nil, err
+		}
+		data := make([]*spec.VersionedAttesterSlashing, len(slashings))
+		for i := range slashings {
+			data[i] = &spec.VersionedAttesterSlashing{Version: httpResponse.consensusVersion}
+			switch httpResponse.consensusVersion {
+			case spec.DataVersionPhase0:
+				data[i].Phase0 = slashings[i]
+			case spec.DataVersionAltair:
+				data[i].Altair = slashings[i]
+			case spec.DataVersionBellatrix:
+				data[i].Bellatrix = slashings[i]
+			case spec.DataVersionCapella:
+				data[i].Capella = slashings[i]
+			default:
+				data[i].Deneb = slashings[i]
+			}
+		}
+
+		return data, metadata, nil
+	case spec.DataVersionElectra, spec.DataVersionFulu:
+		slashings, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*electra.AttesterSlashing{})
+		if err != nil {
+			return nil, nil, err
+		}
+		data := make([]*spec.VersionedAttesterSlashing, len(slashings))
+		for i := range slashings {
+			data[i] = &spec.VersionedAttesterSlashing{Version: httpResponse.consensusVersion}
+			if httpResponse.consensusVersion == spec.DataVersionElectra {
+				data[i].Electra = slashings[i]
+			} else {
+				data[i].Fulu = slashings[i]
+			}
+		}
+
+		return data, metadata, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported version %s", httpResponse.consensusVersion)
+	}
+}
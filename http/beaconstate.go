@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -50,13 +51,34 @@ func (s *Service) BeaconState(ctx context.Context,
 	}
 
 	endpoint := fmt.Sprintf("/eth/v2/debug/beacon/states/%s", opts.State)
-	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	var httpResponse *httpResponse
+	var err error
+	if s.customSpecSupport {
+		// Custom specs are decoded dynamically, which requires the full state.
+		httpResponse, err = s.get(ctx, endpoint, "", &opts.Common, true)
+	} else {
+		// States are large, so stream them rather than hold the full response in memory.
+		httpResponse, err = s.getStream(ctx, endpoint, "", &opts.Common)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if err := s.ensureConsensusVersion(ctx, httpResponse, slotFromID(opts.State)); err != nil {
+		if httpResponse.reader != nil {
+			httpResponse.reader.Close()
+		}
+
+		return nil, err
+	}
 
 	switch httpResponse.contentType {
 	case ContentTypeSSZ:
+		if httpResponse.reader != nil {
+			defer httpResponse.reader.Close()
+
+			return s.beaconStateFromSSZStream(httpResponse)
+		}
+
 		return s.beaconStateFromSSZ(ctx, httpResponse)
 	case ContentTypeJSON:
 		return s.beaconStateFromJSON(httpResponse)
@@ -145,6 +167,16 @@ func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*a
 		if err != nil {
 			return nil, errors.Join(errors.New("failed to decode electra beacon state"), err)
 		}
+	case spec.DataVersionFulu:
+		response.Data.Fulu = &fulu.BeaconState{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Fulu, res.body)
+		} else {
+			err = response.Data.Fulu.UnmarshalSSZ(res.body)
+		}
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode fulu beacon state"), err)
+		}
 	default:
 		return nil, fmt.Errorf("unhandled state version %s", res.consensusVersion)
 	}
@@ -152,6 +184,21 @@ func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*a
 	return response, nil
 }
 
+func (*Service) beaconStateFromSSZStream(res *httpResponse) (*api.Response[*spec.VersionedBeaconState], error) {
+	response := &api.Response[*spec.VersionedBeaconState]{
+		Data: &spec.VersionedBeaconState{
+			Version: res.consensusVersion,
+		},
+		Metadata: metadataFromHeaders(res.headers),
+	}
+
+	if err := response.Data.UnmarshalSSZFrom(res.reader); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to decode %v beacon state", res.consensusVersion), err)
+	}
+
+	return response, nil
+}
+
 func (*Service) beaconStateFromJSON(res *httpResponse) (*api.Response[*spec.VersionedBeaconState], error) {
 	response := &api.Response[*spec.VersionedBeaconState]{
 		Data: &spec.VersionedBeaconState{
@@ -173,12 +220,15 @@ func (*Service) beaconStateFromJSON(res *httpResponse) (*api.Response[*spec.Vers
 		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &deneb.BeaconState{})
 	case spec.DataVersionElectra:
 		response.Data.Electra, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &electra.BeaconState{})
+	case spec.DataVersionFulu:
+		response.Data.Fulu, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &fulu.BeaconState{})
 	default:
 		err = fmt.Errorf("unsupported version %s", res.consensusVersion)
 	}
 	if err != nil {
 		return nil, err
 	}
+	response.Metadata = consensusVersionMetadata(response.Metadata, res)
 
 	return response, nil
 }
@@ -0,0 +1,90 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconStateStream(t *testing.T) {
+	ctx := context.Background()
+
+	state := &phase0.BeaconState{
+		Slot:              12,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		ETH1Data: &phase0.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		Validators: []*phase0.Validator{
+			{WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 32000000000},
+		},
+		Balances:                    []phase0.Gwei{32000000001},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+	}
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Eth-Consensus-Version", "phase0")
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	monitor := &testRequestMonitor{}
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          address.String(),
+		client:           http.DefaultClient,
+		timeout:          timeout,
+		monitor:          monitor,
+		extraHeaders:     map[string]string{},
+		preferSSZ:        true,
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	response, err := s.BeaconState(ctx, &api.BeaconStateOpts{State: "head"})
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionPhase0, response.Data.Version)
+	require.Equal(t, phase0.Slot(12), response.Data.Phase0.Slot)
+	require.Equal(t, state.Validators, response.Data.Phase0.Validators)
+	require.Equal(t, state.Balances, response.Data.Phase0.Balances)
+
+	// The request is reported once the stream has been read.
+	require.Len(t, monitor.requests, 1)
+	require.Equal(t, len(data), monitor.requests[0].ResponseSize)
+}
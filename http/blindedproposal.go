@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -27,7 +28,6 @@ import (
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
 	"github.com/attestantio/go-eth2-client/spec"
-	"go.opentelemetry.io/otel"
 )
 
 // BlindedProposal fetches a proposal for signing.
@@ -39,7 +39,7 @@ func (s *Service) BlindedProposal(ctx context.Context,
 	*api.Response[*api.VersionedBlindedProposal],
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "BlindedProposal")
+	ctx, span := s.tracer().Start(ctx, "BlindedProposal")
 	defer span.End()
 
 	if err := s.assertIsSynced(ctx); err != nil {
@@ -69,6 +69,9 @@ func (s *Service) BlindedProposal(ctx context.Context,
 	if err != nil {
 		return nil, errors.Join(errors.New("failed to request blinded beacon block proposal"), err)
 	}
+	if err := s.ensureConsensusVersion(ctx, res, &opts.Slot); err != nil {
+		return nil, err
+	}
 
 	var response *api.Response[*api.VersionedBlindedProposal]
 	switch res.contentType {
@@ -95,9 +98,9 @@ func (s *Service) BlindedProposal(ctx context.Context,
 		)
 	}
 
-	// Only check the RANDAO reveal if we are not connected to DVT middleware,
-	// as the returned values will be decided by the middleware.
-	if !s.connectedToDVTMiddleware {
+	// Only check the RANDAO reveal if the node does not alter it, as is the case
+	// for DVT middleware where the returned values are decided by the middleware.
+	if !s.quirks().AltersProposalRANDAO {
 		blockRandaoReveal, err := response.Data.RandaoReveal()
 		if err != nil {
 			return nil, err
@@ -113,6 +116,7 @@ func (s *Service) BlindedProposal(ctx context.Context,
 			)
 		}
 	}
+	response.Metadata = consensusVersionMetadata(response.Metadata, res)
 
 	return response, nil
 }
@@ -146,6 +150,11 @@ func (*Service) blindedProposalFromSSZ(res *httpResponse) (*api.Response[*api.Ve
 		if err := response.Data.Electra.UnmarshalSSZ(res.body); err != nil {
 			return nil, errors.Join(errors.New("failed to decode electra blinded beacon block proposal"), err)
 		}
+	case spec.DataVersionFulu:
+		response.Data.Fulu = &apiv1fulu.BlindedBeaconBlock{}
+		if err := response.Data.Fulu.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Join(errors.New("failed to decode fulu blinded beacon block proposal"), err)
+		}
 	default:
 		return nil, fmt.Errorf("unhandled block proposal version %s", res.consensusVersion)
 	}
@@ -182,6 +191,11 @@ func (*Service) blindedProposalFromJSON(res *httpResponse) (*api.Response[*api.V
 			bytes.NewReader(res.body),
 			&apiv1electra.BlindedBeaconBlock{},
 		)
+	case spec.DataVersionFulu:
+		response.Data.Fulu, response.Metadata, err = decodeJSONResponse(
+			bytes.NewReader(res.body),
+			&apiv1fulu.BlindedBeaconBlock{},
+		)
 	default:
 		return nil, fmt.Errorf("unsupported version %s", res.consensusVersion)
 	}
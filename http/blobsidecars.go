@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -42,7 +43,7 @@ func (s *Service) BlobSidecars(ctx context.Context,
 	}
 
 	endpoint := fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", opts.Block)
-	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	httpResponse, err := s.get(ctx, endpoint, blobSidecarsQuery(opts), &opts.Common, true)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +64,20 @@ func (s *Service) BlobSidecars(ctx context.Context,
 	return response, nil
 }
 
+// blobSidecarsQuery returns the query for a blob sidecars request.
+func blobSidecarsQuery(opts *api.BlobSidecarsOpts) string {
+	if len(opts.Indices) == 0 {
+		return ""
+	}
+
+	indices := make([]string, len(opts.Indices))
+	for i := range opts.Indices {
+		indices[i] = fmt.Sprintf("%d", opts.Indices[i])
+	}
+
+	return "indices=" + strings.Join(indices, ",")
+}
+
 func (*Service) blobSidecarsFromSSZ(res *httpResponse) (*api.Response[[]*deneb.BlobSidecar], error) {
 	response := &api.Response[[]*deneb.BlobSidecar]{}
 
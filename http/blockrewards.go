@@ -22,7 +22,6 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
-	"go.opentelemetry.io/otel"
 )
 
 // BlockRewards provides rewards for proposing a block.
@@ -32,7 +31,7 @@ func (s *Service) BlockRewards(ctx context.Context,
 	*api.Response[*apiv1.BlockRewards],
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "BlockRewards")
+	ctx, span := s.tracer().Start(ctx, "BlockRewards")
 	defer span.End()
 
 	if err := s.assertIsActive(ctx); err != nil {
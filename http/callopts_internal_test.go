@@ -0,0 +1,75 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextHeaders(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	s := &Service{
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      address.String(),
+		client:       http.DefaultClient,
+		timeout:      timeout,
+		extraHeaders: map[string]string{"X-Extra": "service"},
+	}
+
+	ctx := api.WithHeader(context.Background(), "Authorization", "Bearer token")
+	ctx = api.WithHeader(ctx, "Eth-Consensus-Version", "electra")
+
+	_, err = s.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", "", &api.CommonOpts{}, false)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer token", received.Get("Authorization"))
+	require.Equal(t, "service", received.Get("X-Extra"))
+
+	_, err = s.post(ctx, "/eth/v2/beacon/pool/attestations", "", &api.CommonOpts{}, bytes.NewReader([]byte("[]")), ContentTypeJSON, map[string]string{
+		"Eth-Consensus-Version": "deneb",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Bearer token", received.Get("Authorization"))
+	require.Equal(t, "electra", received.Get("Eth-Consensus-Version"))
+}
+
+func TestCallTimeout(t *testing.T) {
+	s := &Service{
+		timeout: time.Second,
+	}
+
+	ctx := context.Background()
+	require.Equal(t, time.Second, s.callTimeout(ctx, &api.CommonOpts{}))
+	require.Equal(t, 2*time.Second, s.callTimeout(api.WithTimeout(ctx, 2*time.Second), &api.CommonOpts{}))
+	require.Equal(t, 3*time.Second, s.callTimeout(api.WithTimeout(ctx, 2*time.Second), &api.CommonOpts{Timeout: 3 * time.Second}))
+}
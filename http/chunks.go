@@ -0,0 +1,98 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// chunkConcurrency is the maximum number of chunks of a request that are
+// fetched in parallel.
+const chunkConcurrency = 4
+
+// fetchInChunks fetches data for the given IDs in chunks of at most the given
+// size, in parallel, and merges the results.  The metadata returned is that of
+// the first chunk.
+func fetchInChunks[K comparable, V any](ctx context.Context,
+	ids []string,
+	chunkSize int,
+	fetch func(context.Context, []string) (*api.Response[map[K]V], error),
+) (
+	*api.Response[map[K]V],
+	error,
+) {
+	if chunkSize <= 0 || len(ids) <= chunkSize {
+		return fetch(ctx, ids)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chunks := (len(ids) + chunkSize - 1) / chunkSize
+	responses := make([]*api.Response[map[K]V], chunks)
+	errs := make([]error, chunks)
+	sem := make(chan struct{}, chunkConcurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < chunks; i++ {
+		start := i * chunkSize
+		end := min(start+chunkSize, len(ids))
+		wg.Add(1)
+		go func(i int, chunk []string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+
+				return
+			}
+			defer func() { <-sem }()
+
+			responses[i], errs[i] = fetch(ctx, chunk)
+			if errs[i] != nil {
+				// No point continuing with the other chunks.
+				cancel()
+			}
+		}(i, ids[start:end])
+	}
+	wg.Wait()
+
+	// Return the error that caused the cancellation, rather than that of a
+	// chunk that was cancelled.
+	var firstErr error
+	for _, err := range errs {
+		if err != nil && (firstErr == nil || errors.Is(firstErr, context.Canceled)) {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	data := make(map[K]V, len(ids))
+	for _, response := range responses {
+		for k, v := range response.Data {
+			data[k] = v
+		}
+	}
+
+	return &api.Response[map[K]V]{
+		Data:     data,
+		Metadata: responses[0].Metadata,
+	}, nil
+}
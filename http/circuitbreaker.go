@@ -0,0 +1,214 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// CircuitState is the state of the circuit breaker for an endpoint.
+type CircuitState int
+
+const (
+	// CircuitStateClosed means that calls are made as normal.
+	CircuitStateClosed CircuitState = iota
+	// CircuitStateOpen means that calls fail immediately without being made.
+	CircuitStateOpen
+	// CircuitStateHalfOpen means that a single trial call is allowed, to
+	// find out if the endpoint has recovered.
+	CircuitStateHalfOpen
+)
+
+var circuitStateStrings = [...]string{
+	"closed",
+	"open",
+	"half-open",
+}
+
+// String returns a string representation of the state.
+func (c CircuitState) String() string {
+	if int(c) < 0 || int(c) >= len(circuitStateStrings) {
+		return "unknown"
+	}
+
+	return circuitStateStrings[c]
+}
+
+// circuit is the circuit breaker state for a single endpoint.
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// circuitBreaker tracks the circuits for each endpoint.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	coolDown  time.Duration
+	circuits  map[string]*circuit
+}
+
+// newCircuitBreaker creates a circuit breaker that opens after the given
+// number of consecutive failures.
+func newCircuitBreaker(threshold int, coolDown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		coolDown:  coolDown,
+		circuits:  make(map[string]*circuit),
+	}
+}
+
+// circuitOutcome is the outcome of a call, as seen by the circuit breaker.
+type circuitOutcome int
+
+const (
+	circuitOutcomeSuccess circuitOutcome = iota
+	circuitOutcomeFailure
+	circuitOutcomeIgnored
+)
+
+// CircuitState returns the state of the circuit breaker for the endpoint.
+// If the circuit breaker is not enabled the state is always closed.
+func (s *Service) CircuitState(endpoint string) CircuitState {
+	if s.circuitBreaker == nil {
+		return CircuitStateClosed
+	}
+
+	s.circuitBreaker.mu.Lock()
+	defer s.circuitBreaker.mu.Unlock()
+
+	entry, exists := s.circuitBreaker.circuits[reduceEndpoint(endpoint)]
+	if !exists {
+		return CircuitStateClosed
+	}
+
+	return entry.state
+}
+
+// circuitAllow returns an error if calls to the endpoint are not currently allowed.
+func (s *Service) circuitAllow(ctx context.Context, endpoint string) error {
+	if s.circuitBreaker == nil {
+		return nil
+	}
+
+	key := reduceEndpoint(endpoint)
+
+	s.circuitBreaker.mu.Lock()
+	entry, exists := s.circuitBreaker.circuits[key]
+	if !exists || entry.state == CircuitStateClosed {
+		s.circuitBreaker.mu.Unlock()
+
+		return nil
+	}
+	if entry.state == CircuitStateOpen {
+		if time.Since(entry.openedAt) < s.circuitBreaker.coolDown {
+			s.circuitBreaker.mu.Unlock()
+
+			return errors.Join(fmt.Errorf("too many failures calling %s", key), ErrCircuitOpen)
+		}
+		entry.state = CircuitStateHalfOpen
+		entry.trial = false
+		s.circuitStateChanged(ctx, key, CircuitStateHalfOpen)
+	}
+	// Half-open; allow a single trial call at a time.
+	if entry.trial {
+		s.circuitBreaker.mu.Unlock()
+
+		return errors.Join(fmt.Errorf("awaiting trial call of %s", key), ErrCircuitOpen)
+	}
+	entry.trial = true
+	s.circuitBreaker.mu.Unlock()
+
+	return nil
+}
+
+// circuitRecord records the result of a call to the endpoint.
+func (s *Service) circuitRecord(ctx context.Context, endpoint string, err error) {
+	if s.circuitBreaker == nil {
+		return
+	}
+
+	outcome := circuitCallOutcome(ctx, err)
+	key := reduceEndpoint(endpoint)
+
+	s.circuitBreaker.mu.Lock()
+	defer s.circuitBreaker.mu.Unlock()
+
+	entry, exists := s.circuitBreaker.circuits[key]
+	if !exists {
+		if outcome != circuitOutcomeFailure {
+			return
+		}
+		entry = &circuit{}
+		s.circuitBreaker.circuits[key] = entry
+	}
+
+	switch outcome {
+	case circuitOutcomeIgnored:
+		// Allow another trial call, as this one did not tell us anything.
+		entry.trial = false
+	case circuitOutcomeSuccess:
+		entry.failures = 0
+		entry.trial = false
+		if entry.state != CircuitStateClosed {
+			entry.state = CircuitStateClosed
+			s.circuitStateChanged(ctx, key, CircuitStateClosed)
+		}
+	case circuitOutcomeFailure:
+		entry.failures++
+		entry.trial = false
+		if entry.state == CircuitStateHalfOpen ||
+			(entry.state == CircuitStateClosed && entry.failures >= s.circuitBreaker.threshold) {
+			entry.state = CircuitStateOpen
+			entry.openedAt = time.Now()
+			s.circuitStateChanged(ctx, key, CircuitStateOpen)
+		}
+	}
+}
+
+// circuitStateChanged logs the change of state, and calls the relevant hook.
+func (s *Service) circuitStateChanged(ctx context.Context, endpoint string, state CircuitState) {
+	s.log.Debug().Str("endpoint", endpoint).Stringer("state", state).Msg("Circuit breaker state changed")
+	if s.hooks != nil && s.hooks.OnCircuitStateChange != nil {
+		go s.hooks.OnCircuitStateChange(ctx, s, endpoint, state)
+	}
+}
+
+// circuitCallOutcome returns the outcome of a call with the given error.
+func circuitCallOutcome(ctx context.Context, err error) circuitOutcome {
+	if err == nil {
+		return circuitOutcomeSuccess
+	}
+	if errors.Is(err, ErrCircuitOpen) || ctx.Err() != nil {
+		// The call was not made, or the caller gave up on it.
+		return circuitOutcomeIgnored
+	}
+
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError {
+		// The endpoint is responding; the problem is with the request.
+		return circuitOutcomeSuccess
+	}
+
+	return circuitOutcomeFailure
+}
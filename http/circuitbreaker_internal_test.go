@@ -0,0 +1,119 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+
+	var calls atomic.Int32
+	var status atomic.Int32
+	status.Store(http.StatusServiceUnavailable)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		if code := int(status.Load()); code != http.StatusOK {
+			w.WriteHeader(code)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	states := make(chan CircuitState, 8)
+	s := &Service{
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      address.String(),
+		client:       http.DefaultClient,
+		timeout:      timeout,
+		extraHeaders: map[string]string{},
+		hooks: &Hooks{
+			OnCircuitStateChange: func(_ context.Context, _ *Service, endpoint string, state CircuitState) {
+				require.Equal(t, "/eth/v1/beacon/states/{state_id}/finality_checkpoints", endpoint)
+				states <- state
+			},
+		},
+		circuitBreaker: newCircuitBreaker(2, 50*time.Millisecond),
+	}
+	endpoint := "/eth/v1/beacon/states/head/finality_checkpoints"
+
+	// Two failures open the circuit.
+	for i := 0; i < 2; i++ {
+		_, err = s.get(ctx, endpoint, "", &api.CommonOpts{}, false)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+	require.Equal(t, CircuitStateOpen, <-states)
+	require.Equal(t, CircuitStateOpen, s.CircuitState("/eth/v1/beacon/states/finalized/finality_checkpoints"))
+
+	// Calls now fail without reaching the server.
+	_, err = s.get(ctx, endpoint, "", &api.CommonOpts{}, false)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, int32(2), calls.Load())
+
+	// Other endpoints are unaffected.
+	_, err = s.post(ctx, "/eth/v1/beacon/pool/attestations", "", &api.CommonOpts{}, bytes.NewReader([]byte("[]")), ContentTypeJSON, map[string]string{})
+	require.NotErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, int32(3), calls.Load())
+
+	// A failed trial call after the cool down reopens the circuit.
+	time.Sleep(60 * time.Millisecond)
+	_, err = s.get(ctx, endpoint, "", &api.CommonOpts{}, false)
+	require.NotErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, CircuitStateHalfOpen, <-states)
+	require.Equal(t, CircuitStateOpen, <-states)
+
+	// A successful trial call after the cool down closes the circuit.
+	status.Store(http.StatusOK)
+	time.Sleep(60 * time.Millisecond)
+	_, err = s.get(ctx, endpoint, "", &api.CommonOpts{}, false)
+	require.NoError(t, err)
+	require.Equal(t, CircuitStateHalfOpen, <-states)
+	require.Equal(t, CircuitStateClosed, <-states)
+	require.Equal(t, CircuitStateClosed, s.CircuitState(endpoint))
+
+	// Client errors do not count as failures.
+	status.Store(http.StatusNotFound)
+	for i := 0; i < 3; i++ {
+		_, err = s.get(ctx, endpoint, "", &api.CommonOpts{}, false)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+	require.Equal(t, CircuitStateClosed, s.CircuitState(endpoint))
+}
+
+func TestCircuitStateString(t *testing.T) {
+	require.Equal(t, "closed", CircuitStateClosed.String())
+	require.Equal(t, "open", CircuitStateOpen.String())
+	require.Equal(t, "half-open", CircuitStateHalfOpen.String())
+	require.Equal(t, "unknown", CircuitState(99).String())
+}
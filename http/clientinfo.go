@@ -0,0 +1,38 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// ClientInfo provides structured information about the implementation of the node.
+func (s *Service) ClientInfo(ctx context.Context) (*api.Response[*apiv1.ClientInfo], error) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	response, err := s.NodeVersion(ctx, &api.NodeVersionOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*apiv1.ClientInfo]{
+		Data:     apiv1.ParseClientInfo(response.Data),
+		Metadata: make(map[string]any),
+	}, nil
+}
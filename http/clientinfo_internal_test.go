@@ -0,0 +1,79 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientQuirks(t *testing.T) {
+	ctx := context.Background()
+
+	accept := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept[r.URL.Path] = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/node/version":
+			_, _ = w.Write([]byte(`{"data":{"version":"obolnetwork/charon/v1.1.0-8a2c1e3/linux-amd64"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          address.String(),
+		client:           http.DefaultClient,
+		timeout:          time.Second,
+		monitor:          &testRequestMonitor{},
+		extraHeaders:     map[string]string{},
+		preferSSZ:        true,
+		connectionActive: true,
+		connectionSynced: true,
+	}
+	require.False(t, s.quirks().AltersProposalRANDAO)
+
+	require.NoError(t, s.checkClientInfo(ctx))
+	require.True(t, s.quirks().AltersProposalRANDAO)
+
+	info, err := s.ClientInfo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "charon", info.Data.Implementation)
+	require.Equal(t, "v1.1.0", info.Data.Version)
+
+	// Endpoints with a JSON-only quirk do not request SSZ.
+	s.clientQuirks.Store(&apiv1.ClientQuirks{
+		JSONOnlyEndpoints: []string{"/eth/v1/json"},
+	})
+	_, err = s.get(ctx, "/eth/v1/json", "", &api.CommonOpts{}, true)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", accept["/eth/v1/json"])
+	_, err = s.get(ctx, "/eth/v1/ssz", "", &api.CommonOpts{}, true)
+	require.NoError(t, err)
+	require.Equal(t, "application/octet-stream;q=1,application/json;q=0.9", accept["/eth/v1/ssz"])
+}
@@ -0,0 +1,70 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+)
+
+// decodingBody is a response body that decodes its content encoding.
+type decodingBody struct {
+	io.Reader
+	body io.ReadCloser
+}
+
+// Close closes the underlying response body.
+func (b *decodingBody) Close() error {
+	return b.body.Close()
+}
+
+// acceptEncoding is the list of content encodings accepted when compression is enabled.
+const acceptEncoding = "gzip, deflate"
+
+// decodeContentEncoding replaces the body of the response with one that
+// transparently decodes its content encoding.  Responses with an unknown
+// content encoding are left untouched.
+func decodeContentEncoding(resp *http.Response) error {
+	var reader io.Reader
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "gzip", "x-gzip":
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return errors.Join(errors.New("invalid gzip response"), err)
+		}
+		reader = gzipReader
+	case "deflate":
+		reader = flate.NewReader(resp.Body)
+	case "snappy", "x-snappy-framed":
+		reader = codecs.NewSnappyReader(resp.Body)
+	default:
+		return nil
+	}
+
+	resp.Body = &decodingBody{
+		Reader: reader,
+		body:   resp.Body,
+	}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+
+	return nil
+}
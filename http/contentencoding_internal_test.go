@@ -0,0 +1,157 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/golang/snappy"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentEncoding(t *testing.T) {
+	ctx := context.Background()
+
+	body := []byte(`{"data":{"finalized":{"epoch":"1","root":"0x0000000000000000000000000000000000000000000000000000000000000000"}}}`)
+	framed := new(bytes.Buffer)
+	writer := snappy.NewBufferedWriter(framed)
+	_, err := writer.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	gzipped := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(gzipped)
+	_, err = gzipWriter.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+
+	deflated := new(bytes.Buffer)
+	flateWriter, err := flate.NewWriter(deflated, flate.DefaultCompression)
+	require.NoError(t, err)
+	_, err = flateWriter.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, flateWriter.Close())
+
+	tests := []struct {
+		name        string
+		compression bool
+		encoding    string
+		body        []byte
+		post        bool
+		err         string
+	}{
+		{
+			name: "None",
+			body: body,
+		},
+		{
+			name:        "Gzip",
+			compression: true,
+			encoding:    "gzip",
+			body:        gzipped.Bytes(),
+		},
+		{
+			name:        "GzipPost",
+			compression: true,
+			encoding:    "gzip",
+			body:        gzipped.Bytes(),
+			post:        true,
+		},
+		{
+			name:        "GzipInvalid",
+			compression: true,
+			encoding:    "gzip",
+			body:        body,
+			err:         "failed to decode GET response\ninvalid gzip response\ngzip: invalid header",
+		},
+		{
+			name:        "Deflate",
+			compression: true,
+			encoding:    "deflate",
+			body:        deflated.Bytes(),
+		},
+		{
+			name:        "CompressionUncompressed",
+			compression: true,
+			body:        body,
+		},
+		{
+			name:     "SnappyBlock",
+			encoding: "snappy",
+			body:     snappy.Encode(nil, body),
+		},
+		{
+			name:     "SnappyFramed",
+			encoding: "x-snappy-framed",
+			body:     framed.Bytes(),
+		},
+		{
+			name:     "SnappyPost",
+			encoding: "snappy",
+			body:     snappy.Encode(nil, body),
+			post:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if test.compression {
+					require.Equal(t, acceptEncoding, r.Header.Get("Accept-Encoding"))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if test.encoding != "" {
+					w.Header().Set("Content-Encoding", test.encoding)
+				}
+				_, _ = w.Write(test.body)
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      address.String(),
+				client:       http.DefaultClient,
+				timeout:      timeout,
+				extraHeaders: map[string]string{},
+				compression:  test.compression,
+			}
+
+			var res *httpResponse
+			if test.post {
+				res, err = s.post(ctx, "/eth/v1/beacon/pool/attestations", "", &api.CommonOpts{}, bytes.NewReader([]byte("[]")), ContentTypeJSON, map[string]string{})
+			} else {
+				res, err = s.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", "", &api.CommonOpts{}, false)
+			}
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, body, res.body)
+		})
+	}
+}
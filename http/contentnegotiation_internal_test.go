@@ -0,0 +1,108 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentNegotiation(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		enforceJSON   bool
+		preferSSZ     bool
+		supportsSSZ   bool
+		rejectSSZ     bool
+		acceptHeaders []string
+	}{
+		{
+			name:          "JSONOnlyEndpoint",
+			preferSSZ:     true,
+			acceptHeaders: []string{"application/json"},
+		},
+		{
+			name:          "EnforceJSON",
+			enforceJSON:   true,
+			preferSSZ:     true,
+			supportsSSZ:   true,
+			acceptHeaders: []string{"application/json"},
+		},
+		{
+			name:          "PreferSSZ",
+			preferSSZ:     true,
+			supportsSSZ:   true,
+			acceptHeaders: []string{"application/octet-stream;q=1,application/json;q=0.9"},
+		},
+		{
+			name:          "PreferJSON",
+			supportsSSZ:   true,
+			acceptHeaders: []string{"application/json;q=1,application/octet-stream;q=0.9"},
+		},
+		{
+			name:        "Fallback",
+			preferSSZ:   true,
+			supportsSSZ: true,
+			rejectSSZ:   true,
+			acceptHeaders: []string{
+				"application/octet-stream;q=1,application/json;q=0.9",
+				"application/json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			acceptHeaders := make([]string, 0)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				accept := r.Header.Get("Accept")
+				acceptHeaders = append(acceptHeaders, accept)
+				if test.rejectSSZ && accept != "application/json" {
+					w.WriteHeader(http.StatusNotAcceptable)
+
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      address.String(),
+				client:       http.DefaultClient,
+				timeout:      timeout,
+				extraHeaders: map[string]string{},
+				enforceJSON:  test.enforceJSON,
+				preferSSZ:    test.preferSSZ,
+			}
+
+			_, err = s.get(ctx, "/eth/v2/beacon/blocks/head", "", &api.CommonOpts{}, test.supportsSSZ)
+			require.NoError(t, err)
+			require.Equal(t, test.acceptHeaders, acceptHeaders)
+		})
+	}
+}
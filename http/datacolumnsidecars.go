@@ -0,0 +1,99 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+)
+
+// DataColumnSidecars fetches the data column sidecars given options.
+func (s *Service) DataColumnSidecars(ctx context.Context,
+	opts *api.DataColumnSidecarsOpts,
+) (
+	*api.Response[[]*fulu.DataColumnSidecar],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/debug/beacon/data_column_sidecars/%s", opts.Block)
+	query := ""
+	if len(opts.Indices) > 0 {
+		indices := make([]string, len(opts.Indices))
+		for i := range opts.Indices {
+			indices[i] = fmt.Sprintf("%d", opts.Indices[i])
+		}
+		query = "indices=" + strings.Join(indices, ",")
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *api.Response[[]*fulu.DataColumnSidecar]
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.dataColumnSidecarsFromSSZ(httpResponse)
+	case ContentTypeJSON:
+		response, err = s.dataColumnSidecarsFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (*Service) dataColumnSidecarsFromSSZ(res *httpResponse) (*api.Response[[]*fulu.DataColumnSidecar], error) {
+	response := &api.Response[[]*fulu.DataColumnSidecar]{}
+
+	data := &api.DataColumnSidecars{}
+	if err := data.UnmarshalSSZ(res.body); err != nil {
+		return nil, errors.Join(errors.New("failed to decode data column sidecars"), err)
+	}
+
+	response.Data = data.Sidecars
+
+	return response, nil
+}
+
+func (*Service) dataColumnSidecarsFromJSON(res *httpResponse) (*api.Response[[]*fulu.DataColumnSidecar], error) {
+	response := &api.Response[[]*fulu.DataColumnSidecar]{}
+
+	var err error
+	response.Data, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), []*fulu.DataColumnSidecar{})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// DepositSnapshot provides a snapshot of the deposit tree.
+func (s *Service) DepositSnapshot(ctx context.Context,
+	opts *api.DepositSnapshotOpts,
+) (
+	*api.Response[*apiv1.DepositSnapshot],
+	error,
+) {
+	ctx, span := s.tracer().Start(ctx, "DepositSnapshot")
+	defer span.End()
+
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	httpResponse, err := s.get(ctx, "/eth/v1/beacon/deposit_snapshot", "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *api.Response[*apiv1.DepositSnapshot]
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.depositSnapshotFromSSZ(httpResponse)
+	case ContentTypeJSON:
+		response, err = s.depositSnapshotFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (*Service) depositSnapshotFromSSZ(res *httpResponse) (*api.Response[*apiv1.DepositSnapshot], error) {
+	data := &apiv1.DepositSnapshot{}
+	if err := data.UnmarshalSSZ(res.body); err != nil {
+		return nil, errors.Join(errors.New("failed to decode deposit snapshot"), err)
+	}
+
+	return &api.Response[*apiv1.DepositSnapshot]{
+		Data:     data,
+		Metadata: metadataFromHeaders(res.headers),
+	}, nil
+}
+
+func (*Service) depositSnapshotFromJSON(res *httpResponse) (*api.Response[*apiv1.DepositSnapshot], error) {
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(res.body), &apiv1.DepositSnapshot{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*apiv1.DepositSnapshot]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
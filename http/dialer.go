@@ -0,0 +1,43 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+)
+
+// DialContextFunc is a function that dials a network connection, as used by
+// http.Transport.
+type DialContextFunc func(ctx context.Context, network string, address string) (net.Conn, error)
+
+// unixSocketPath returns the path to the socket if the address is a Unix
+// socket address of the form "unix:///path/to/socket".
+func unixSocketPath(address string) (string, bool) {
+	return strings.CutPrefix(address, "unix://")
+}
+
+// unixSocketDialer returns a dialer that connects to the Unix socket at the
+// given path regardless of the address requested.
+func unixSocketDialer(path string, timeout time.Duration) DialContextFunc {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+	}
+
+	return func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+		return dialer.DialContext(ctx, "unix", path)
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
 )
 
 // Domain provides a domain for a given domain type at a given epoch.
@@ -63,10 +64,7 @@ func (s *Service) calculateDomain(ctx context.Context,
 		return phase0.Domain{}, errors.New("fork version is invalid")
 	}
 
-	forkData := &phase0.ForkData{
-		CurrentVersion: forkVersion,
-	}
-
+	var genesisValidatorsRoot phase0.Root
 	if !bytes.Equal(domainType[:], []byte{0x00, 0x00, 0x00, 0x01}) {
 		// Use the chain's genesis validators root for non-application domain types.
 		response, err := s.Genesis(ctx, &api.GenesisOpts{})
@@ -74,18 +72,14 @@ func (s *Service) calculateDomain(ctx context.Context,
 			return phase0.Domain{}, errors.Join(errors.New("failed to obtain genesis"), err)
 		}
 
-		forkData.GenesisValidatorsRoot = response.Data.GenesisValidatorsRoot
+		genesisValidatorsRoot = response.Data.GenesisValidatorsRoot
 	}
 
-	root, err := forkData.HashTreeRoot()
+	domain, err := signing.ComputeDomain(domainType, forkVersion, genesisValidatorsRoot)
 	if err != nil {
 		return phase0.Domain{}, errors.Join(errors.New("failed to calculate signature domain"), err)
 	}
 
-	var domain phase0.Domain
-	copy(domain[:], domainType[:])
-	copy(domain[4:], root[:])
-
 	return domain, nil
 }
 
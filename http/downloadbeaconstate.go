@@ -0,0 +1,283 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// errDownloadInterrupted is returned when a download fails part way through
+// a response, and so can be resumed.
+var errDownloadInterrupted = errors.New("download interrupted")
+
+// stateDownloadWriter is the writer for a state download.  It records write
+// errors, so that they can be told apart from errors reading the response.
+type stateDownloadWriter struct {
+	writer io.Writer
+	err    error
+}
+
+// Write writes to the underlying writer.
+func (w *stateDownloadWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	if err != nil && w.err == nil {
+		w.err = err
+	}
+
+	return n, err
+}
+
+// stateDownload is the progress of a state download.
+type stateDownload struct {
+	writer  *stateDownloadWriter
+	version spec.DataVersion
+	offset  int64
+	// total is the size of the state, or -1 if not yet known.
+	total   int64
+	chunks  int
+	headers map[string]string
+}
+
+// DownloadBeaconState downloads an SSZ-encoded beacon state, writing it to the
+// writer in the options.  If a response is interrupted then the download is
+// resumed from the last byte written, up to the number of retries for the
+// service; if the download still fails then the error states the number of
+// bytes written, from which a later download can resume.
+func (s *Service) DownloadBeaconState(ctx context.Context,
+	opts *api.DownloadBeaconStateOpts,
+) (
+	*api.Response[*api.BeaconStateDownload],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.State == "" {
+		return nil, errors.Join(errors.New("no state specified"), client.ErrInvalidOptions)
+	}
+	if opts.Writer == nil {
+		return nil, errors.Join(errors.New("no writer specified"), client.ErrInvalidOptions)
+	}
+	if opts.Offset < 0 {
+		return nil, errors.Join(errors.New("offset cannot be negative"), client.ErrInvalidOptions)
+	}
+	if opts.ChunkSize < 0 {
+		return nil, errors.Join(errors.New("chunk size cannot be negative"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v2/debug/beacon/states/%s", opts.State)
+	download := &stateDownload{
+		writer: &stateDownloadWriter{
+			writer: opts.Writer,
+		},
+		version: spec.DataVersionUnknown,
+		offset:  opts.Offset,
+		total:   -1,
+	}
+
+	retries := s.retryBudget(ctx)
+	for interruptions := 0; ; {
+		complete, err := s.downloadStateChunk(ctx, endpoint, opts, download)
+		if err != nil {
+			if !errors.Is(err, errDownloadInterrupted) || interruptions >= retries || ctx.Err() != nil {
+				return nil, errors.Join(fmt.Errorf("failed to download beacon state after %d bytes", download.offset), err)
+			}
+			interruptions++
+
+			delay := s.retryDelay(interruptions)
+			s.log.Debug().
+				Str("endpoint", endpoint).
+				Int64("offset", download.offset).
+				Int("attempt", interruptions).
+				Stringer("delay", delay).
+				Err(err).
+				Msg("State download interrupted; resuming")
+
+			select {
+			case <-ctx.Done():
+				return nil, errors.Join(fmt.Errorf("failed to download beacon state after %d bytes", download.offset), err)
+			case <-time.After(delay):
+			}
+
+			continue
+		}
+		if complete {
+			break
+		}
+	}
+
+	return &api.Response[*api.BeaconStateDownload]{
+		Data: &api.BeaconStateDownload{
+			Version: download.version,
+			Size:    download.offset,
+		},
+		Metadata: metadataFromHeaders(download.headers),
+	}, nil
+}
+
+// downloadStateChunk downloads the next chunk of the state, returning true if
+// the state is complete.
+func (s *Service) downloadStateChunk(ctx context.Context,
+	endpoint string,
+	opts *api.DownloadBeaconStateOpts,
+	download *stateDownload,
+) (
+	bool,
+	error,
+) {
+	var rangeHeader string
+	switch {
+	case opts.ChunkSize > 0:
+		rangeHeader = fmt.Sprintf("bytes=%d-%d", download.offset, download.offset+opts.ChunkSize-1)
+	case download.offset > 0:
+		rangeHeader = fmt.Sprintf("bytes=%d-", download.offset)
+	}
+
+	ctx = api.WithHeader(ctx, "Accept", ContentTypeSSZ.MediaType())
+	if rangeHeader != "" {
+		// Ranges are of the encoded body, so ask for it to be unencoded.
+		ctx = api.WithHeader(ctx, "Range", rangeHeader)
+		ctx = api.WithHeader(ctx, "Accept-Encoding", "identity")
+	}
+
+	httpResponse, err := s.getStream(ctx, endpoint, "", &opts.Common)
+	if err != nil {
+		var apiErr *api.Error
+		if download.chunks > 0 && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			// The previous chunk ended at the end of the state.
+			return true, nil
+		}
+
+		return false, err
+	}
+	if httpResponse.reader == nil {
+		return false, fmt.Errorf("unexpected content type %v", httpResponse.contentType)
+	}
+	defer httpResponse.reader.Close()
+
+	if err := s.ensureConsensusVersion(ctx, httpResponse, slotFromID(opts.State)); err != nil {
+		return false, err
+	}
+	if httpResponse.consensusVersion == spec.DataVersionUnknown {
+		return false, errors.New("no consensus version in response")
+	}
+	if download.version != spec.DataVersionUnknown && httpResponse.consensusVersion != download.version {
+		return false, fmt.Errorf("consensus version changed from %v to %v during download", download.version, httpResponse.consensusVersion)
+	}
+	download.version = httpResponse.consensusVersion
+	download.headers = httpResponse.headers
+
+	if httpResponse.statusCode != http.StatusPartialContent {
+		// The beacon node returned the full state, so skip the part that has already been written.
+		if _, err := io.CopyN(io.Discard, httpResponse.reader, download.offset); err != nil {
+			if errors.Is(err, io.EOF) {
+				return false, fmt.Errorf("state is smaller than offset %d", download.offset)
+			}
+
+			return false, errors.Join(errDownloadInterrupted, err)
+		}
+		if err := download.copy(httpResponse.reader); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	start, total, err := parseContentRange(httpResponse.headers["Content-Range"])
+	if err != nil {
+		return false, errors.Join(errors.New("failed to parse content range"), err)
+	}
+	if start != download.offset {
+		return false, fmt.Errorf("content range starts at %d, expected %d", start, download.offset)
+	}
+	if total >= 0 {
+		if download.total >= 0 && total != download.total {
+			return false, fmt.Errorf("state size changed from %d to %d during download", download.total, total)
+		}
+		download.total = total
+	}
+
+	started := download.offset
+	if err := download.copy(httpResponse.reader); err != nil {
+		return false, err
+	}
+	download.chunks++
+
+	if download.total >= 0 {
+		return download.offset >= download.total, nil
+	}
+
+	// The size of the state is not known, so a short chunk marks its end.
+	return opts.ChunkSize == 0 || download.offset-started < opts.ChunkSize, nil
+}
+
+// copy copies the response to the download's writer.
+func (d *stateDownload) copy(r io.Reader) error {
+	written, err := io.Copy(d.writer, r)
+	d.offset += written
+	if err != nil {
+		if d.writer.err != nil {
+			return errors.Join(errors.New("failed to write state"), d.writer.err)
+		}
+
+		return errors.Join(errDownloadInterrupted, err)
+	}
+
+	return nil
+}
+
+// parseContentRange parses a content range header of the form
+// "bytes <start>-<end>/<total>", returning the start and total.  The total
+// is -1 if it is not known.
+func parseContentRange(contentRange string) (int64, int64, error) {
+	byteSpec, found := strings.CutPrefix(contentRange, "bytes ")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid content range %q", contentRange)
+	}
+	byteRange, totalStr, found := strings.Cut(byteSpec, "/")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid content range %q", contentRange)
+	}
+	startStr, _, found := strings.Cut(byteRange, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid content range %q", contentRange)
+	}
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil {
+		return 0, 0, errors.Join(fmt.Errorf("invalid content range start %q", startStr), err)
+	}
+	if totalStr == "*" {
+		return start, -1, nil
+	}
+	total, err := strconv.ParseInt(totalStr, 10, 64)
+	if err != nil {
+		return 0, 0, errors.Join(fmt.Errorf("invalid content range total %q", totalStr), err)
+	}
+
+	return start, total, nil
+}
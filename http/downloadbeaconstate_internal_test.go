@@ -0,0 +1,238 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadBeaconState(t *testing.T) {
+	ctx := context.Background()
+
+	state := &phase0.BeaconState{
+		Slot:              12,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		ETH1Data: &phase0.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		Validators: []*phase0.Validator{
+			{WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 32000000000},
+		},
+		Balances:                    []phase0.Gwei{32000000001},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+	}
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	// serve serves the state, honouring any range requested.
+	serve := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Eth-Consensus-Version", "phase0")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	}
+
+	tests := []struct {
+		name      string
+		handler   func(request int64, w http.ResponseWriter, r *http.Request)
+		offset    int64
+		chunkSize int64
+		err       string
+		requests  int64
+	}{
+		{
+			name: "Full",
+			handler: func(_ int64, w http.ResponseWriter, r *http.Request) {
+				serve(w, r)
+			},
+			requests: 1,
+		},
+		{
+			name: "Chunked",
+			handler: func(_ int64, w http.ResponseWriter, r *http.Request) {
+				serve(w, r)
+			},
+			chunkSize: 1000000,
+			requests:  int64((len(data) + 999999) / 1000000),
+		},
+		{
+			name: "Resumed",
+			handler: func(_ int64, w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "bytes=1000-", r.Header.Get("Range"))
+				serve(w, r)
+			},
+			offset:   1000,
+			requests: 1,
+		},
+		{
+			name: "RangeIgnored",
+			handler: func(_ int64, w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				w.Header().Set("Eth-Consensus-Version", "phase0")
+				_, _ = w.Write(data)
+			},
+			offset:   1000,
+			requests: 1,
+		},
+		{
+			name: "Interrupted",
+			handler: func(request int64, w http.ResponseWriter, r *http.Request) {
+				if request == 1 {
+					// Promise the full state but only send half of it.
+					w.Header().Set("Content-Type", "application/octet-stream")
+					w.Header().Set("Eth-Consensus-Version", "phase0")
+					w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write(data[:len(data)/2])
+
+					return
+				}
+				require.Equal(t, "bytes="+strconv.Itoa(len(data)/2)+"-", r.Header.Get("Range"))
+				serve(w, r)
+			},
+			requests: 2,
+		},
+		{
+			name: "ConsensusVersionMissing",
+			handler: func(_ int64, w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				_, _ = w.Write(data)
+			},
+			err:      "failed to download beacon state after 0 bytes\nno consensus version in response",
+			requests: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests atomic.Int64
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				test.handler(requests.Add(1), w, r)
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:              zerolog.Nop(),
+				base:             base,
+				address:          address.String(),
+				client:           http.DefaultClient,
+				timeout:          timeout,
+				retries:          1,
+				retryBackoff:     time.Millisecond,
+				monitor:          &testRequestMonitor{},
+				extraHeaders:     map[string]string{},
+				connectionActive: true,
+				connectionSynced: true,
+			}
+
+			buf := bytes.NewBuffer(append([]byte{}, data[:test.offset]...))
+			response, err := s.DownloadBeaconState(ctx, &api.DownloadBeaconStateOpts{
+				State:     "0x0c00000000000000000000000000000000000000000000000000000000000000",
+				Writer:    buf,
+				Offset:    test.offset,
+				ChunkSize: test.chunkSize,
+			})
+			require.Equal(t, test.requests, requests.Load())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.DataVersionPhase0, response.Data.Version)
+			require.Equal(t, int64(len(data)), response.Data.Size)
+			require.Equal(t, data, buf.Bytes())
+
+			decoded := &spec.VersionedBeaconState{Version: response.Data.Version}
+			require.NoError(t, decoded.UnmarshalSSZFrom(buf))
+			require.Equal(t, state.Slot, decoded.Phase0.Slot)
+			require.Equal(t, state.Validators, decoded.Phase0.Validators)
+		})
+	}
+}
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		contentRange string
+		start        int64
+		total        int64
+		err          string
+	}{
+		{
+			name:         "Empty",
+			contentRange: "",
+			err:          `invalid content range ""`,
+		},
+		{
+			name:         "UnitInvalid",
+			contentRange: "items 0-9/10",
+			err:          `invalid content range "items 0-9/10"`,
+		},
+		{
+			name:         "StartInvalid",
+			contentRange: "bytes a-9/10",
+			err:          "invalid content range start \"a\"\nstrconv.ParseInt: parsing \"a\": invalid syntax",
+		},
+		{
+			name:         "Good",
+			contentRange: "bytes 10-19/100",
+			start:        10,
+			total:        100,
+		},
+		{
+			name:         "TotalUnknown",
+			contentRange: "bytes 10-19/*",
+			start:        10,
+			total:        -1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			start, total, err := parseContentRange(test.contentRange)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.start, start)
+				require.Equal(t, test.total, total)
+			}
+		})
+	}
+}
@@ -17,3 +17,6 @@ import "errors"
 
 // ErrIncorrectType is returned when the multi client obtain a response type it is not expecting.
 var ErrIncorrectType = errors.New("incorrect response type")
+
+// ErrCircuitOpen is returned when a call is not made because the circuit breaker for its endpoint is open.
+var ErrCircuitOpen = errors.New("circuit breaker open")
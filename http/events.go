@@ -28,12 +28,15 @@ import (
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/r3labs/sse/v2"
 	"github.com/rs/zerolog"
 )
 
 // Events feeds requested events with the given topics to the supplied handler.
+// If the stream drops it is reconnected with exponential backoff, resubscribing to
+// the same topics and resuming from the last event ID if the server supplies one.
 func (s *Service) Events(ctx context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
 	if err := s.assertIsActive(ctx); err != nil {
 		return err
@@ -66,35 +69,108 @@ func (s *Service) Events(ctx context.Context, topics []string, handler consensus
 		client.Headers["User-Agent"] = defaultUserAgent
 	}
 	client.Headers["Accept"] = "text/event-stream"
-	client.Connection.Transport = &http.Transport{
-		Dial: (&net.Dialer{
-			Timeout:   2 * time.Second,
-			KeepAlive: 2 * time.Second,
-		}).Dial,
+	if s.eventsTransport != nil {
+		client.Connection.Transport = s.eventsTransport
+	} else {
+		client.Connection.Transport = &http.Transport{
+			Dial: (&net.Dialer{
+				Timeout:   2 * time.Second,
+				KeepAlive: 2 * time.Second,
+			}).Dial,
+			TLSClientConfig: s.tlsConfig,
+		}
 	}
+	// Reconnection is handled by streamEvents rather than the SSE client, to
+	// provide control over the backoff and visibility of the connection state.
+	client.ReconnectStrategy = &noReconnect{}
 
-	go func() {
-		for {
-			select {
-			case <-time.After(time.Second):
-				log.Trace().Msg("Connecting to events stream")
-				if err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-					s.handleEvent(ctx, msg, handler)
-				}); err != nil {
-					log.Error().Err(err).Msg("Failed to subscribe to event stream")
-				}
-				log.Trace().Msg("Events stream disconnected")
-			case <-ctx.Done():
-				log.Debug().Msg("Context done")
+	go s.streamEvents(ctx, client, topics, handler)
 
-				return
+	return nil
+}
+
+// streamEvents streams events until the context is done, reconnecting with
+// exponential backoff whenever the stream drops.
+func (s *Service) streamEvents(ctx context.Context,
+	client *sse.Client,
+	topics []string,
+	handler consensusclient.EventHandlerFunc,
+) {
+	log := zerolog.Ctx(ctx)
+
+	delay := s.eventsReconnectInitialDelay
+	for {
+		connected := false
+		client.ResponseValidator = func(_ *sse.Client, resp *http.Response) error {
+			if resp.StatusCode != http.StatusOK {
+				resp.Body.Close()
+
+				return fmt.Errorf("could not connect to events stream: %s", http.StatusText(resp.StatusCode))
+			}
+			connected = true
+			delay = s.eventsReconnectInitialDelay
+			log.Trace().Msg("Connected to events stream")
+			if s.hooks.OnEventsConnected != nil {
+				go s.hooks.OnEventsConnected(ctx, s, topics)
 			}
+
+			return nil
 		}
-	}()
 
-	return nil
+		if s.jwtSecret != nil {
+			// Tokens are only valid for a short period, so generate a new one for each connection.
+			client.Headers["Authorization"] = "Bearer " + jwtToken(s.jwtSecret, time.Now())
+		}
+
+		log.Trace().Msg("Connecting to events stream")
+		if err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
+			s.handleEvent(ctx, msg, handler)
+		}); err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("Failed to subscribe to event stream")
+		}
+
+		if connected {
+			log.Trace().Msg("Events stream disconnected")
+			if s.hooks.OnEventsDisconnected != nil {
+				go s.hooks.OnEventsDisconnected(ctx, s, topics)
+			}
+		}
+
+		if ctx.Err() != nil {
+			log.Debug().Msg("Context done")
+
+			return
+		}
+
+		log.Trace().Dur("delay", delay).Msg("Reconnecting to events stream")
+		if s.hooks.OnEventsReconnecting != nil {
+			go s.hooks.OnEventsReconnecting(ctx, s, topics)
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			log.Debug().Msg("Context done")
+
+			return
+		}
+		delay *= 2
+		if delay > s.eventsReconnectMaxDelay {
+			delay = s.eventsReconnectMaxDelay
+		}
+	}
 }
 
+// noReconnect is a reconnection strategy for the SSE client that never reconnects.
+type noReconnect struct{}
+
+// NextBackOff returns the duration to wait before reconnecting; -1 stops reconnection.
+func (*noReconnect) NextBackOff() time.Duration {
+	return -1
+}
+
+// Reset resets the strategy.
+func (*noReconnect) Reset() {}
+
 // handleEvent parses an event and passes it on to the handler.
 func (*Service) handleEvent(ctx context.Context, msg *sse.Event, handler consensusclient.EventHandlerFunc) {
 	log := zerolog.Ctx(ctx)
@@ -115,14 +191,34 @@ func (*Service) handleEvent(ctx context.Context, msg *sse.Event, handler consens
 	}
 	switch string(msg.Event) {
 	case "attestation":
-		data := &phase0.Attestation{}
-		err := json.Unmarshal(msg.Data, data)
-		if err != nil {
+		// Electra attestations are distinguished by the presence of committee bits.
+		var probe struct {
+			CommitteeBits *string `json:"committee_bits"`
+		}
+		if err := json.Unmarshal(msg.Data, &probe); err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attestation")
 
 			return
 		}
-		event.Data = data
+		if probe.CommitteeBits != nil {
+			data := &electra.Attestation{}
+			err := json.Unmarshal(msg.Data, data)
+			if err != nil {
+				log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attestation")
+
+				return
+			}
+			event.Data = data
+		} else {
+			data := &phase0.Attestation{}
+			err := json.Unmarshal(msg.Data, data)
+			if err != nil {
+				log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attestation")
+
+				return
+			}
+			event.Data = data
+		}
 	case "attester_slashing":
 		data := &phase0.AttesterSlashing{}
 		err := json.Unmarshal(msg.Data, data)
@@ -16,7 +16,12 @@ package http
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -98,6 +103,15 @@ func TestEventHandler(t *testing.T) {
 			handler: handler,
 			handled: true,
 		},
+		{
+			name: "AttestationElectraGood",
+			message: &sse.Event{
+				Event: []byte("attestation"),
+				Data:  []byte(`{"aggregation_bits":"0x00002840403040000000020008800040008042800000020220","data":{"slot":"4095945","index":"0","beacon_block_root":"0xff27c7551bf4cfe4dc4cce00920e7a5c5074860d1dbd8aa8b3b5f888523f51ff","source":{"epoch":"127997","root":"0x38758fb180459583bd5e8e1a31711eb09e63eb92be974485397e9a2c57de2783"},"target":{"epoch":"127998","root":"0x46d4629861bd81cfc94007501b4edb1b3ca9444b41d7a98681b6c2f4bdb978bd"}},"signature":"0xacb9f562a28c4ef5b60b88678068ea51573a3237d3331dda3b2d845a0d03bc56ab2994d2deb90d9f074a8bdab59945150d0a7717e74b1bf2627f8971c81091f724c211dfce8fa16fb839c6a1bfd341ddec5e7eb88472682fd1a170e373660534","committee_bits":"0x0010000000000000"}`),
+			},
+			handler: handler,
+			handled: true,
+		},
 		{
 			name: "VoluntaryExitGood",
 			message: &sse.Event{
@@ -161,6 +175,33 @@ func TestEventHandler(t *testing.T) {
 			handler: handler,
 			handled: true,
 		},
+		{
+			name: "PayloadAttributesGood",
+			message: &sse.Event{
+				Event: []byte("payload_attributes"),
+				Data:  []byte(`{"version":"deneb","data":{"proposer_index":"123","proposal_slot":"10","parent_block_number":"9","parent_block_root":"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2","parent_block_hash":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","payload_attributes":{"timestamp":"123456","prev_randao":"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2","suggested_fee_recipient":"0x0000000000000000000000000000000000000000","withdrawals":[{"index":"5","validator_index":"10","address":"0x0000000000000000000000000000000000000000","amount":"15640"}],"parent_beacon_block_root":"0xba4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}}}`),
+			},
+			handler: handler,
+			handled: true,
+		},
+		{
+			name: "BlobSidecarGood",
+			message: &sse.Event{
+				Event: []byte("blob_sidecar"),
+				Data:  []byte(`{"block_root":"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2","slot":"1","index":"1","kzg_commitment":"0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2","versioned_hash":"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"}`),
+			},
+			handler: handler,
+			handled: true,
+		},
+		{
+			name: "BlockGossipGood",
+			message: &sse.Event{
+				Event: []byte("block_gossip"),
+				Data:  []byte(`{"slot":"525277","block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028"}`),
+			},
+			handler: handler,
+			handled: true,
+		},
 	}
 
 	s, err := New(ctx,
@@ -182,3 +223,71 @@ func TestEventHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestEventsReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Server sends a single head event per connection, then drops the stream.
+	var connections atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, []string{"head"}, r.URL.Query()["topics"])
+		connection := connections.Add(1)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "id: %d\nevent: head\ndata: {\"slot\":\"%d\",\"block\":\"0x73d83c5f925716c9bd2d1e9c339fb99b0ec4addef3e93f6f35d4c5f1de7ae092\",\"state\":\"0xead0e6eb4004576546864f10cfa4aeac31afbf96abc405a86c00cbda8f3e8ed0\",\"epoch_transition\":false,\"previous_duty_dependent_root\":\"0xeca94cc9180212a2cff2659289cc7e6f2df08a645120e35e25d09c2ddc7db5f1\",\"current_duty_dependent_root\":\"0xdda286c4a096fc8ec0d6ba9e14e688cbb046bfb33462fdf94953e75d0cea0074\",\"execution_optimistic\":false}\n\n", connection, connection)
+		w.(http.Flusher).Flush()
+		if connection > 1 {
+			require.Equal(t, fmt.Sprintf("%d", connection-1), r.Header.Get("Last-Event-ID"))
+		}
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	var connected, disconnected, reconnecting atomic.Int32
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          address.String(),
+		extraHeaders:     map[string]string{},
+		connectionActive: true,
+		hooks: &Hooks{
+			OnEventsConnected: func(_ context.Context, _ *Service, _ []string) {
+				connected.Add(1)
+			},
+			OnEventsDisconnected: func(_ context.Context, _ *Service, _ []string) {
+				disconnected.Add(1)
+			},
+			OnEventsReconnecting: func(_ context.Context, _ *Service, _ []string) {
+				reconnecting.Add(1)
+			},
+		},
+		eventsReconnectInitialDelay: 10 * time.Millisecond,
+		eventsReconnectMaxDelay:     20 * time.Millisecond,
+	}
+
+	slotsMu := sync.Mutex{}
+	slots := make([]uint64, 0)
+	require.NoError(t, s.Events(ctx, []string{"head"}, func(event *api.Event) {
+		slotsMu.Lock()
+		slots = append(slots, uint64(event.Data.(*api.HeadEvent).Slot))
+		slotsMu.Unlock()
+	}))
+
+	require.Eventually(t, func() bool {
+		slotsMu.Lock()
+		defer slotsMu.Unlock()
+
+		return len(slots) >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+
+	slotsMu.Lock()
+	require.Equal(t, []uint64{1, 2, 3}, slots[:3])
+	slotsMu.Unlock()
+	require.Eventually(t, func() bool {
+		return connected.Load() >= 3 && disconnected.Load() >= 2 && reconnecting.Load() >= 2
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,228 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+// EventsWithHandlers feeds events to the supplied typed handlers, subscribing
+// to each topic for which a handler is present.
+func (s *Service) EventsWithHandlers(ctx context.Context, handlers *client.EventHandlers) error {
+	if err := s.assertIsActive(ctx); err != nil {
+		return err
+	}
+	if handlers == nil {
+		return client.ErrNoOptions
+	}
+	topics := handlers.Topics()
+	if len(topics) == 0 {
+		return errors.Join(errors.New("no handlers supplied"), client.ErrInvalidOptions)
+	}
+
+	// Attestations and attester slashings do not carry their version, so it
+	// is obtained from the fork schedule.
+	var forks *forkVersions
+	if handlers.AttestationHandler != nil || handlers.AttesterSlashingHandler != nil {
+		var err error
+		forks, err = s.forkVersions(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.Events(ctx, topics, func(event *apiv1.Event) {
+		dispatchEvent(ctx, handlers, forks, event)
+	})
+}
+
+// dispatchEvent passes an event on to the relevant typed handler.
+func dispatchEvent(ctx context.Context, handlers *client.EventHandlers, forks *forkVersions, event *apiv1.Event) {
+	log := zerolog.Ctx(ctx)
+
+	switch data := event.Data.(type) {
+	case *phase0.Attestation:
+		if handlers.AttestationHandler == nil || data.Data == nil {
+			return
+		}
+		attestation, err := versionedAttestation(forks.versionAtSlot(data.Data.Slot), data)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to version attestation; ignoring")
+
+			return
+		}
+		handlers.AttestationHandler(attestation)
+	case *electra.Attestation:
+		if handlers.AttestationHandler == nil || data.Data == nil {
+			return
+		}
+		attestation := &spec.VersionedAttestation{
+			Version: forks.versionAtSlot(data.Data.Slot),
+		}
+		switch attestation.Version {
+		case spec.DataVersionElectra:
+			attestation.Electra = data
+		case spec.DataVersionFulu:
+			attestation.Fulu = data
+		default:
+			log.Warn().Stringer("version", attestation.Version).Msg("Electra attestation received for earlier fork; ignoring")
+
+			return
+		}
+		handlers.AttestationHandler(attestation)
+	case *phase0.AttesterSlashing:
+		if handlers.AttesterSlashingHandler == nil || data.Attestation1 == nil || data.Attestation1.Data == nil {
+			return
+		}
+		slashing, err := versionedAttesterSlashing(forks.versionAtSlot(data.Attestation1.Data.Slot), data)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to version attester slashing; ignoring")
+
+			return
+		}
+		handlers.AttesterSlashingHandler(slashing)
+	case *apiv1.BlobSidecarEvent:
+		if handlers.BlobSidecarHandler != nil {
+			handlers.BlobSidecarHandler(data)
+		}
+	case *apiv1.BlockEvent:
+		if handlers.BlockHandler != nil {
+			handlers.BlockHandler(data)
+		}
+	case *apiv1.BlockGossipEvent:
+		if handlers.BlockGossipHandler != nil {
+			handlers.BlockGossipHandler(data)
+		}
+	case *capella.SignedBLSToExecutionChange:
+		if handlers.BLSToExecutionChangeHandler != nil {
+			handlers.BLSToExecutionChangeHandler(data)
+		}
+	case *apiv1.ChainReorgEvent:
+		if handlers.ChainReorgHandler != nil {
+			handlers.ChainReorgHandler(data)
+		}
+	case *altair.SignedContributionAndProof:
+		if handlers.ContributionAndProofHandler != nil {
+			handlers.ContributionAndProofHandler(data)
+		}
+	case *apiv1.FinalizedCheckpointEvent:
+		if handlers.FinalizedCheckpointHandler != nil {
+			handlers.FinalizedCheckpointHandler(data)
+		}
+	case *apiv1.HeadEvent:
+		if handlers.HeadHandler != nil {
+			handlers.HeadHandler(data)
+		}
+	case *apiv1.PayloadAttributesEvent:
+		if handlers.PayloadAttributesHandler != nil {
+			handlers.PayloadAttributesHandler(data)
+		}
+	case *phase0.ProposerSlashing:
+		if handlers.ProposerSlashingHandler != nil {
+			handlers.ProposerSlashingHandler(data)
+		}
+	case *phase0.SignedVoluntaryExit:
+		if handlers.VoluntaryExitHandler != nil {
+			handlers.VoluntaryExitHandler(data)
+		}
+	default:
+		log.Warn().Str("topic", event.Topic).Msg("Received event with unhandled data; ignoring")
+	}
+}
+
+// versionedAttestation wraps a pre-electra attestation in a versioned attestation.
+func versionedAttestation(version spec.DataVersion, data *phase0.Attestation) (*spec.VersionedAttestation, error) {
+	attestation := &spec.VersionedAttestation{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		attestation.Phase0 = data
+	case spec.DataVersionAltair:
+		attestation.Altair = data
+	case spec.DataVersionBellatrix:
+		attestation.Bellatrix = data
+	case spec.DataVersionCapella:
+		attestation.Capella = data
+	case spec.DataVersionDeneb:
+		attestation.Deneb = data
+	default:
+		return nil, fmt.Errorf("pre-electra attestation received for %v", version)
+	}
+
+	return attestation, nil
+}
+
+// versionedAttesterSlashing wraps an attester slashing in a versioned attester slashing.
+func versionedAttesterSlashing(version spec.DataVersion,
+	data *phase0.AttesterSlashing,
+) (
+	*spec.VersionedAttesterSlashing,
+	error,
+) {
+	slashing := &spec.VersionedAttesterSlashing{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		slashing.Phase0 = data
+	case spec.DataVersionAltair:
+		slashing.Altair = data
+	case spec.DataVersionBellatrix:
+		slashing.Bellatrix = data
+	case spec.DataVersionCapella:
+		slashing.Capella = data
+	case spec.DataVersionDeneb:
+		slashing.Deneb = data
+	case spec.DataVersionElectra, spec.DataVersionFulu:
+		// The JSON representation is the same across forks, so the attestations
+		// are copied in to their electra form.
+		electraSlashing := &electra.AttesterSlashing{
+			Attestation1: electraIndexedAttestation(data.Attestation1),
+			Attestation2: electraIndexedAttestation(data.Attestation2),
+		}
+		if version == spec.DataVersionElectra {
+			slashing.Electra = electraSlashing
+		} else {
+			slashing.Fulu = electraSlashing
+		}
+	default:
+		return nil, fmt.Errorf("unhandled attester slashing version %v", version)
+	}
+
+	return slashing, nil
+}
+
+func electraIndexedAttestation(data *phase0.IndexedAttestation) *electra.IndexedAttestation {
+	if data == nil {
+		return nil
+	}
+
+	return &electra.IndexedAttestation{
+		AttestingIndices: data.AttestingIndices,
+		Data:             data.Data,
+		Signature:        data.Signature,
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchEvent(t *testing.T) {
+	ctx := context.Background()
+
+	forks := &forkVersions{
+		forks: []forkVersion{
+			{slot: 320, version: spec.DataVersionElectra},
+			{slot: 160, version: spec.DataVersionDeneb},
+			{slot: 0, version: spec.DataVersionAltair},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		event       *apiv1.Event
+		attestation *spec.VersionedAttestation
+		slashing    *spec.VersionedAttesterSlashing
+		head        *apiv1.HeadEvent
+	}{
+		{
+			name: "AttestationAltair",
+			event: &apiv1.Event{
+				Topic: "attestation",
+				Data:  &phase0.Attestation{Data: &phase0.AttestationData{Slot: 10}},
+			},
+			attestation: &spec.VersionedAttestation{
+				Version: spec.DataVersionAltair,
+				Altair:  &phase0.Attestation{Data: &phase0.AttestationData{Slot: 10}},
+			},
+		},
+		{
+			name: "AttestationDeneb",
+			event: &apiv1.Event{
+				Topic: "attestation",
+				Data:  &phase0.Attestation{Data: &phase0.AttestationData{Slot: 160}},
+			},
+			attestation: &spec.VersionedAttestation{
+				Version: spec.DataVersionDeneb,
+				Deneb:   &phase0.Attestation{Data: &phase0.AttestationData{Slot: 160}},
+			},
+		},
+		{
+			name: "AttestationPhase0AfterElectra",
+			event: &apiv1.Event{
+				Topic: "attestation",
+				Data:  &phase0.Attestation{Data: &phase0.AttestationData{Slot: 400}},
+			},
+		},
+		{
+			name: "AttestationElectra",
+			event: &apiv1.Event{
+				Topic: "attestation",
+				Data:  &electra.Attestation{Data: &phase0.AttestationData{Slot: 400}},
+			},
+			attestation: &spec.VersionedAttestation{
+				Version: spec.DataVersionElectra,
+				Electra: &electra.Attestation{Data: &phase0.AttestationData{Slot: 400}},
+			},
+		},
+		{
+			name: "AttesterSlashingElectra",
+			event: &apiv1.Event{
+				Topic: "attester_slashing",
+				Data: &phase0.AttesterSlashing{
+					Attestation1: &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: &phase0.AttestationData{Slot: 320}},
+					Attestation2: &phase0.IndexedAttestation{AttestingIndices: []uint64{2}, Data: &phase0.AttestationData{Slot: 320}},
+				},
+			},
+			slashing: &spec.VersionedAttesterSlashing{
+				Version: spec.DataVersionElectra,
+				Electra: &electra.AttesterSlashing{
+					Attestation1: &electra.IndexedAttestation{AttestingIndices: []uint64{1}, Data: &phase0.AttestationData{Slot: 320}},
+					Attestation2: &electra.IndexedAttestation{AttestingIndices: []uint64{2}, Data: &phase0.AttestationData{Slot: 320}},
+				},
+			},
+		},
+		{
+			name: "Head",
+			event: &apiv1.Event{
+				Topic: "head",
+				Data:  &apiv1.HeadEvent{Slot: 12},
+			},
+			head: &apiv1.HeadEvent{Slot: 12},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attestation *spec.VersionedAttestation
+			var slashing *spec.VersionedAttesterSlashing
+			var head *apiv1.HeadEvent
+			handlers := &client.EventHandlers{
+				AttestationHandler: func(data *spec.VersionedAttestation) {
+					attestation = data
+				},
+				AttesterSlashingHandler: func(data *spec.VersionedAttesterSlashing) {
+					slashing = data
+				},
+				HeadHandler: func(data *apiv1.HeadEvent) {
+					head = data
+				},
+			}
+			dispatchEvent(ctx, handlers, forks, test.event)
+			require.Equal(t, test.attestation, attestation)
+			require.Equal(t, test.slashing, slashing)
+			require.Equal(t, test.head, head)
+		})
+	}
+}
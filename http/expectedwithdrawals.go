@@ -0,0 +1,106 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+// withdrawalSSZSize is the size of a single SSZ-encoded withdrawal.
+const withdrawalSSZSize = 44
+
+// ExpectedWithdrawals provides the withdrawals expected to be included in a proposal built on the given state.
+func (s *Service) ExpectedWithdrawals(ctx context.Context,
+	opts *api.ExpectedWithdrawalsOpts,
+) (
+	*api.Response[[]*capella.Withdrawal],
+	error,
+) {
+	ctx, span := s.tracer().Start(ctx, "ExpectedWithdrawals")
+	defer span.End()
+
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.State == "" {
+		return nil, errors.Join(errors.New("no state specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/builder/states/%s/expected_withdrawals", opts.State)
+	query := ""
+	if opts.ProposalSlot != nil {
+		query = fmt.Sprintf("proposal_slot=%d", *opts.ProposalSlot)
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *api.Response[[]*capella.Withdrawal]
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.expectedWithdrawalsFromSSZ(httpResponse)
+	case ContentTypeJSON:
+		response, err = s.expectedWithdrawalsFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (*Service) expectedWithdrawalsFromSSZ(res *httpResponse) (*api.Response[[]*capella.Withdrawal], error) {
+	if len(res.body)%withdrawalSSZSize != 0 {
+		return nil, fmt.Errorf("invalid length %d for expected withdrawals", len(res.body))
+	}
+
+	withdrawals := make([]*capella.Withdrawal, len(res.body)/withdrawalSSZSize)
+	for i := range withdrawals {
+		withdrawals[i] = &capella.Withdrawal{}
+		if err := withdrawals[i].UnmarshalSSZ(res.body[i*withdrawalSSZSize : (i+1)*withdrawalSSZSize]); err != nil {
+			return nil, errors.Join(errors.New("failed to decode expected withdrawals"), err)
+		}
+	}
+
+	return &api.Response[[]*capella.Withdrawal]{
+		Data:     withdrawals,
+		Metadata: metadataFromHeaders(res.headers),
+	}, nil
+}
+
+func (*Service) expectedWithdrawalsFromJSON(res *httpResponse) (*api.Response[[]*capella.Withdrawal], error) {
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(res.body), []*capella.Withdrawal{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*capella.Withdrawal]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
@@ -0,0 +1,143 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/api/metadata"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// forkVersion is the first slot of a fork.
+type forkVersion struct {
+	slot    phase0.Slot
+	version spec.DataVersion
+}
+
+// forkVersions provides the data version at a given slot.
+type forkVersions struct {
+	// forks are in descending order of slot.
+	forks []forkVersion
+}
+
+// versionAtSlot returns the data version at the given slot.
+func (f *forkVersions) versionAtSlot(slot phase0.Slot) spec.DataVersion {
+	for _, fork := range f.forks {
+		if slot >= fork.slot {
+			return fork.version
+		}
+	}
+
+	return spec.DataVersionPhase0
+}
+
+// forkVersions obtains the fork schedule from the spec.  As the schedule is
+// taken from the beacon node it includes any custom fork epochs, for example
+// those of a devnet.
+func (s *Service) forkVersions(ctx context.Context) (*forkVersions, error) {
+	response, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+	slotsPerEpoch, isCorrectType := response.Data["SLOTS_PER_EPOCH"].(uint64)
+	if !isCorrectType {
+		return nil, ErrIncorrectType
+	}
+
+	names := map[string]spec.DataVersion{
+		"ALTAIR":    spec.DataVersionAltair,
+		"BELLATRIX": spec.DataVersionBellatrix,
+		"CAPELLA":   spec.DataVersionCapella,
+		"DENEB":     spec.DataVersionDeneb,
+		"ELECTRA":   spec.DataVersionElectra,
+		"FULU":      spec.DataVersionFulu,
+	}
+	forks := make([]forkVersion, 0, len(names))
+	for name, version := range names {
+		epoch, exists := response.Data[name+"_FORK_EPOCH"].(uint64)
+		if !exists {
+			// Fork not known to the node.
+			continue
+		}
+		if slotsPerEpoch == 0 || epoch > math.MaxUint64/slotsPerEpoch {
+			// Fork not scheduled.
+			continue
+		}
+		forks = append(forks, forkVersion{
+			slot:    phase0.Slot(epoch * slotsPerEpoch),
+			version: version,
+		})
+	}
+	sort.Slice(forks, func(i, j int) bool {
+		if forks[i].slot == forks[j].slot {
+			return forks[i].version > forks[j].version
+		}
+
+		return forks[i].slot > forks[j].slot
+	})
+
+	return &forkVersions{forks: forks}, nil
+}
+
+// ensureConsensusVersion sets the consensus version of a response that did not
+// provide one, either in its Eth-Consensus-Version header or in its body, to
+// that of the fork schedule at the given slot.  If the slot is not known then
+// the version is left as-is.
+func (s *Service) ensureConsensusVersion(ctx context.Context, res *httpResponse, slot *phase0.Slot) error {
+	if res.consensusVersion != spec.DataVersionUnknown || slot == nil {
+		return nil
+	}
+
+	forks, err := s.forkVersions(ctx)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to infer consensus version at slot %d", *slot), err)
+	}
+	res.consensusVersion = forks.versionAtSlot(*slot)
+
+	return nil
+}
+
+// slotFromID returns the slot referenced by a block or state ID, or nil if
+// the ID does not reference a slot.
+func slotFromID(id string) *phase0.Slot {
+	slot, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return (*phase0.Slot)(&slot)
+}
+
+// consensusVersionMetadata adds the Eth-Consensus-Version header returned by
+// the beacon node, if any, to response metadata.
+func consensusVersionMetadata(meta map[string]any, res *httpResponse) map[string]any {
+	header, exists := res.headers[metadata.ConsensusVersion]
+	if !exists {
+		return meta
+	}
+	if meta == nil {
+		meta = make(map[string]any)
+	}
+	meta[metadata.ConsensusVersion] = header
+
+	return meta
+}
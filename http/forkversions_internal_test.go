@@ -0,0 +1,176 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/api/metadata"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureConsensusVersion(t *testing.T) {
+	ctx := context.Background()
+
+	s := &Service{
+		log:              zerolog.Nop(),
+		connectionActive: true,
+		connectionSynced: true,
+		spec: map[string]any{
+			"SLOTS_PER_EPOCH":      uint64(32),
+			"ALTAIR_FORK_EPOCH":    uint64(1),
+			"BELLATRIX_FORK_EPOCH": uint64(2),
+			"CAPELLA_FORK_EPOCH":   uint64(2),
+			"DENEB_FORK_EPOCH":     uint64(18446744073709551615),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		version  spec.DataVersion
+		slot     *phase0.Slot
+		expected spec.DataVersion
+	}{
+		{
+			name:     "SlotUnknown",
+			expected: spec.DataVersionUnknown,
+		},
+		{
+			name:     "Supplied",
+			version:  spec.DataVersionDeneb,
+			slot:     slotFromID("10"),
+			expected: spec.DataVersionDeneb,
+		},
+		{
+			name:     "Phase0",
+			slot:     slotFromID("31"),
+			expected: spec.DataVersionPhase0,
+		},
+		{
+			name:     "Altair",
+			slot:     slotFromID("32"),
+			expected: spec.DataVersionAltair,
+		},
+		{
+			name:     "SameEpoch",
+			slot:     slotFromID("64"),
+			expected: spec.DataVersionCapella,
+		},
+		{
+			name:     "Unscheduled",
+			slot:     slotFromID("100000000"),
+			expected: spec.DataVersionCapella,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := &httpResponse{consensusVersion: test.version}
+			require.NoError(t, s.ensureConsensusVersion(ctx, res, test.slot))
+			require.Equal(t, test.expected, res.consensusVersion)
+		})
+	}
+}
+
+func TestSlotFromID(t *testing.T) {
+	require.Nil(t, slotFromID("head"))
+	require.Nil(t, slotFromID("0x0102"))
+	require.Equal(t, phase0.Slot(12), *slotFromID("12"))
+}
+
+func TestSignedBeaconBlockConsensusVersion(t *testing.T) {
+	ctx := context.Background()
+
+	block := &phase0.SignedBeaconBlock{
+		Message: &phase0.BeaconBlock{
+			Slot: 10,
+			Body: &phase0.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				ProposerSlashings: []*phase0.ProposerSlashing{},
+				AttesterSlashings: []*phase0.AttesterSlashing{},
+				Attestations:      []*phase0.Attestation{},
+				Deposits:          []*phase0.Deposit{},
+				VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+			},
+		},
+	}
+	data, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		header  string
+		version spec.DataVersion
+		meta    any
+	}{
+		{
+			name:    "HeaderMissing",
+			version: spec.DataVersionPhase0,
+		},
+		{
+			name:    "HeaderPresent",
+			header:  "phase0",
+			version: spec.DataVersionPhase0,
+			meta:    "phase0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				if test.header != "" {
+					w.Header().Set("Eth-Consensus-Version", test.header)
+				}
+				_, _ = w.Write(data)
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:              zerolog.Nop(),
+				base:             base,
+				address:          address.String(),
+				client:           http.DefaultClient,
+				timeout:          timeout,
+				monitor:          &testRequestMonitor{},
+				extraHeaders:     map[string]string{},
+				preferSSZ:        true,
+				connectionActive: true,
+				connectionSynced: true,
+				spec: map[string]any{
+					"SLOTS_PER_EPOCH":   uint64(32),
+					"ALTAIR_FORK_EPOCH": uint64(1),
+				},
+			}
+
+			response, err := s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: "10"})
+			require.NoError(t, err)
+			require.Equal(t, test.version, response.Data.Version)
+			require.Equal(t, phase0.Slot(10), response.Data.Phase0.Message.Slot)
+			require.Equal(t, test.meta, response.Metadata[metadata.ConsensusVersion])
+		})
+	}
+}
@@ -18,10 +18,26 @@ import "context"
 // HookFunc is a function called when a hook is triggered.
 type HookFunc func(ctx context.Context, s *Service)
 
+// EventsHookFunc is a function called when the connection state of an events stream changes.
+type EventsHookFunc func(ctx context.Context, s *Service, topics []string)
+
+// CircuitHookFunc is a function called when the state of the circuit breaker for an endpoint changes.
+type CircuitHookFunc func(ctx context.Context, s *Service, endpoint string, state CircuitState)
+
 // Hooks provides hooks that will be called when certain events occur.
 type Hooks struct {
 	OnActive   HookFunc
 	OnInactive HookFunc
 	OnSynced   HookFunc
 	OnDesynced HookFunc
+
+	// OnEventsConnected is called when an events stream connects, including reconnections.
+	OnEventsConnected EventsHookFunc
+	// OnEventsDisconnected is called when a connected events stream drops.
+	OnEventsDisconnected EventsHookFunc
+	// OnEventsReconnecting is called before waiting to reconnect an events stream.
+	OnEventsReconnecting EventsHookFunc
+
+	// OnCircuitStateChange is called when the state of the circuit breaker for an endpoint changes.
+	OnCircuitStateChange CircuitHookFunc
 }
@@ -23,12 +23,14 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/rs/zerolog"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -49,7 +51,28 @@ func (s *Service) post(ctx context.Context,
 	*httpResponse,
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "post")
+	if err := s.circuitAllow(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	res, err := s.postOnce(ctx, endpoint, query, opts, body, contentType, headers)
+	s.circuitRecord(ctx, endpoint, err)
+
+	return res, err
+}
+
+// postOnce sends a single HTTP post request and returns the body.
+func (s *Service) postOnce(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	body io.Reader,
+	contentType ContentType,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	ctx, span := s.tracer().Start(ctx, "post", trace.WithAttributes(endpointAttributes(endpoint)...))
 	defer span.End()
 
 	// #nosec G404
@@ -73,10 +96,7 @@ func (s *Service) post(ctx context.Context,
 	log.Trace().Str("url", callURL.String()).Msg("URL to POST")
 	span.SetAttributes(attribute.String("url", callURL.String()))
 
-	timeout := s.timeout
-	if opts.Timeout != 0 {
-		timeout = opts.Timeout
-	}
+	timeout := s.callTimeout(ctx, opts)
 
 	opCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -89,13 +109,28 @@ func (s *Service) post(ctx context.Context,
 	req.Header.Set("Content-Type", contentType.MediaType())
 	// Always take response of POST in JSON, as it's generally small.
 	req.Header.Set("Accept", "application/json")
+	if s.compression {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
 	for k, v := range headers {
 		req.Header.Set(k, v)
 	}
+	addContextHeaders(ctx, req)
 	if req.Header.Get("User-Agent") == "" {
 		req.Header.Set("User-Agent", defaultUserAgent)
 	}
 
+	injectTraceContext(ctx, req)
+
+	release, err := s.acquireRequestSlot(opCtx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+	defer release()
+
+	started := time.Now()
 	resp, err := s.client.Do(req)
 	if err != nil {
 		switch {
@@ -109,12 +144,20 @@ func (s *Service) post(ctx context.Context,
 		}
 
 		span.SetStatus(codes.Error, err.Error())
-		s.monitorPostComplete(ctx, callURL.Path, "failed")
+		s.monitorRequestComplete(ctx, http.MethodPost, callURL.Path, started, 0, 0, requestErrorClass(err, metrics.ErrorClassConnection))
 
 		return nil, errors.Join(errors.New("failed to call POST endpoint"), err)
 	}
+	if err := decodeContentEncoding(resp); err != nil {
+		resp.Body.Close()
+		span.SetStatus(codes.Error, err.Error())
+		s.monitorRequestComplete(ctx, http.MethodPost, callURL.Path, started, resp.StatusCode, 0, requestErrorClass(err, metrics.ErrorClassRead))
+
+		return nil, errors.Join(errors.New("failed to decode POST response"), err)
+	}
 	defer resp.Body.Close()
 	log = log.With().Int("status_code", resp.StatusCode).Logger()
+	span.SetAttributes(attribute.Int("status_code", resp.StatusCode))
 
 	res := &httpResponse{
 		statusCode: resp.StatusCode,
@@ -133,7 +176,7 @@ func (s *Service) post(ctx context.Context,
 		}
 
 		span.SetStatus(codes.Error, err.Error())
-		s.monitorPostComplete(ctx, callURL.Path, "failed")
+		s.monitorRequestComplete(ctx, http.MethodPost, callURL.Path, started, resp.StatusCode, 0, requestErrorClass(err, metrics.ErrorClassRead))
 
 		return nil, errors.Join(errors.New("failed to read POST response"), err)
 	}
@@ -142,7 +185,7 @@ func (s *Service) post(ctx context.Context,
 		// Nothing returned.  This is not considered an error.
 		span.AddEvent("Received empty response")
 		log.Trace().Msg("Endpoint returned no content")
-		s.monitorPostComplete(ctx, callURL.Path, "succeeded")
+		s.monitorRequestComplete(ctx, http.MethodPost, callURL.Path, started, resp.StatusCode, 0, metrics.ErrorClassNone)
 
 		return res, nil
 	}
@@ -152,6 +195,7 @@ func (s *Service) post(ctx context.Context,
 		log.Debug().Err(err).Msg("Failed to obtain content type; assuming JSON")
 		res.contentType = ContentTypeJSON
 	}
+	span.SetAttributes(attribute.String("content_type", res.contentType.String()))
 	span.AddEvent("Received response", trace.WithAttributes(
 		attribute.Int("size", len(res.body)),
 		attribute.String("content-type", res.contentType.String()),
@@ -169,21 +213,47 @@ func (s *Service) post(ctx context.Context,
 		s.logBadStatus(ctx, "POST", res, log)
 
 		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
-		s.monitorPostComplete(ctx, callURL.Path, "failed")
+		s.monitorRequestComplete(ctx, http.MethodPost, callURL.Path, started, resp.StatusCode, len(res.body), statusErrorClass(resp.StatusCode))
 
-		return nil, &api.Error{
-			Method:     http.MethodPost,
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
-			Data:       res.body,
-		}
+		return nil, apiError(http.MethodPost, endpoint, res, time.Since(started))
 	}
 
-	s.monitorPostComplete(ctx, callURL.Path, "succeeded")
+	s.monitorRequestComplete(ctx, http.MethodPost, callURL.Path, started, resp.StatusCode, len(res.body), metrics.ErrorClassNone)
 
 	return res, nil
 }
 
+// errorJSON is the body of an error response as defined by the beacon API.
+type errorJSON struct {
+	Code        json.RawMessage `json:"code"`
+	Message     string          `json:"message"`
+	Stacktraces []string        `json:"stacktraces"`
+}
+
+// apiError creates an API error from a response, populating the beacon API error
+// information if it is present in the body of the response.
+func apiError(method string, endpoint string, res *httpResponse, duration time.Duration) *api.Error {
+	apiErr := &api.Error{
+		Method:     method,
+		StatusCode: res.statusCode,
+		Endpoint:   endpoint,
+		Data:       res.body,
+		Duration:   duration,
+	}
+
+	var data errorJSON
+	if bytes.HasPrefix(bytes.TrimSpace(res.body), []byte("{")) && json.Unmarshal(res.body, &data) == nil {
+		// Some beacon nodes return the code as a string rather than a number.
+		if code, err := strconv.Atoi(string(bytes.Trim(data.Code, "\""))); err == nil {
+			apiErr.Code = code
+		}
+		apiErr.Message = data.Message
+		apiErr.Stacktraces = data.Stacktraces
+	}
+
+	return apiErr
+}
+
 func (*Service) logBadStatus(_ context.Context,
 	method string,
 	res *httpResponse,
@@ -205,6 +275,31 @@ func (s *Service) addExtraHeaders(req *http.Request) {
 	for k, v := range s.extraHeaders {
 		req.Header.Add(k, v)
 	}
+	if s.jwtSecret != nil {
+		req.Header.Set("Authorization", "Bearer "+jwtToken(s.jwtSecret, time.Now()))
+	}
+}
+
+// addContextHeaders adds headers set on the context with api.WithHeader,
+// overriding any existing values.
+func addContextHeaders(ctx context.Context, req *http.Request) {
+	for k, v := range api.Headers(ctx) {
+		req.Header.Set(k, v)
+	}
+}
+
+// callTimeout returns the timeout for a call.  The timeout in the call's options
+// takes precedence, followed by any timeout set on the context with api.WithTimeout,
+// followed by the service-wide timeout.
+func (s *Service) callTimeout(ctx context.Context, opts *api.CommonOpts) time.Duration {
+	if opts != nil && opts.Timeout != 0 {
+		return opts.Timeout
+	}
+	if timeout, exists := api.Timeout(ctx); exists && timeout != 0 {
+		return timeout
+	}
+
+	return s.timeout
 }
 
 // responseMetadata returns metadata related to responses.
@@ -218,21 +313,60 @@ type httpResponse struct {
 	headers          map[string]string
 	consensusVersion spec.DataVersion
 	body             []byte
+	// reader provides the body of a streamed response, in place of body.
+	// It must be closed by the caller.
+	reader io.ReadCloser
+}
+
+// responseReader is the body of a streamed response.  It releases the resources
+// of the request and reports its completion when closed.
+type responseReader struct {
+	body    io.ReadCloser
+	size    int
+	err     error
+	closed  bool
+	onClose func(size int, err error)
 }
 
-// get sends an HTTP get request and returns the response.
+// Read reads from the body of the response.
+func (r *responseReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	r.size += n
+	if err != nil && !errors.Is(err, io.EOF) && r.err == nil {
+		r.err = err
+	}
+
+	return n, err
+}
+
+// Close closes the body of the response.
+func (r *responseReader) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	err := r.body.Close()
+	r.onClose(r.size, r.err)
+
+	return err
+}
+
+// getOnce sends a single HTTP get request and returns the response.  If stream
+// is set and the response is SSZ then the body is not read, and is instead
+// available from the response's reader.
 //
 //nolint:revive
-func (s *Service) get(ctx context.Context,
+func (s *Service) getOnce(ctx context.Context,
 	endpoint string,
 	query string,
 	opts *api.CommonOpts,
 	supportsSSZ bool,
+	stream bool,
 ) (
 	*httpResponse,
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "get")
+	ctx, span := s.tracer().Start(ctx, "get", trace.WithAttributes(endpointAttributes(endpoint)...))
 	defer span.End()
 
 	// #nosec G404
@@ -243,13 +377,16 @@ func (s *Service) get(ctx context.Context,
 	log.Trace().Str("url", callURL.String()).Msg("URL to GET")
 	span.SetAttributes(attribute.String("url", callURL.String()))
 
-	timeout := s.timeout
-	if opts.Timeout != 0 {
-		timeout = opts.Timeout
-	}
+	timeout := s.callTimeout(ctx, opts)
 
+	// If the response is streamed then its resources are released when its reader is closed.
+	streaming := false
 	opCtx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
+	defer func() {
+		if !streaming {
+			cancel()
+		}
+	}()
 	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, callURL.String(), nil)
 	if err != nil {
 		span.SetStatus(codes.Error, "Failed to create request")
@@ -258,14 +395,37 @@ func (s *Service) get(ctx context.Context,
 	}
 
 	s.addExtraHeaders(req)
-	if s.enforceJSON || !supportsSSZ {
+	switch {
+	case s.enforceJSON || !supportsSSZ || s.quirks().UsesJSONOnly(endpoint):
 		// JSON only.
 		req.Header.Set("Accept", "application/json")
-	} else {
+	case s.preferSSZ:
 		// Prefer SSZ, JSON if not.
 		req.Header.Set("Accept", "application/octet-stream;q=1,application/json;q=0.9")
+	default:
+		// Prefer JSON, SSZ if not.
+		req.Header.Set("Accept", "application/json;q=1,application/octet-stream;q=0.9")
+	}
+	if s.compression {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
 	}
+	addContextHeaders(ctx, req)
+
+	injectTraceContext(ctx, req)
 
+	release, err := s.acquireRequestSlot(opCtx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+	defer func() {
+		if !streaming {
+			release()
+		}
+	}()
+
+	started := time.Now()
 	resp, err := s.client.Do(req)
 	if err != nil {
 		switch {
@@ -282,18 +442,57 @@ func (s *Service) get(ctx context.Context,
 		}
 
 		span.SetStatus(codes.Error, err.Error())
-		s.monitorGetComplete(ctx, callURL.Path, "failed")
+		s.monitorRequestComplete(ctx, http.MethodGet, callURL.Path, started, 0, 0, requestErrorClass(err, metrics.ErrorClassConnection))
 
 		return nil, errors.Join(errors.New("failed to call GET endpoint"), err)
 	}
-	defer resp.Body.Close()
+	if err := decodeContentEncoding(resp); err != nil {
+		resp.Body.Close()
+		span.SetStatus(codes.Error, err.Error())
+		s.monitorRequestComplete(ctx, http.MethodGet, callURL.Path, started, resp.StatusCode, 0, requestErrorClass(err, metrics.ErrorClassRead))
+
+		return nil, errors.Join(errors.New("failed to decode GET response"), err)
+	}
+	defer func() {
+		if !streaming {
+			resp.Body.Close()
+		}
+	}()
 	log = log.With().Int("status_code", resp.StatusCode).Logger()
+	span.SetAttributes(attribute.Int("status_code", resp.StatusCode))
 
 	res := &httpResponse{
 		statusCode: resp.StatusCode,
 	}
 	populateHeaders(res, resp)
 
+	if stream && (resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent) {
+		if contentType, err := ParseFromMediaType(resp.Header.Get("Content-Type")); err == nil && contentType == ContentTypeSSZ {
+			res.contentType = contentType
+			if err := populateConsensusVersion(res, resp); err != nil {
+				return nil, errors.Join(errors.New("failed to parse consensus version"), err)
+			}
+			span.SetAttributes(attribute.String("content_type", res.contentType.String()))
+			span.AddEvent("Streaming response")
+
+			streaming = true
+			res.reader = &responseReader{
+				body: resp.Body,
+				onClose: func(size int, err error) {
+					cancel()
+					release()
+					errorClass := metrics.ErrorClassNone
+					if err != nil {
+						errorClass = requestErrorClass(err, metrics.ErrorClassRead)
+					}
+					s.monitorRequestComplete(ctx, http.MethodGet, callURL.Path, started, resp.StatusCode, size, errorClass)
+				},
+			}
+
+			return res, nil
+		}
+	}
+
 	// Although it would be more efficient to keep the body as a Reader, that would
 	// require the calling function to be aware that it needs to close the body
 	// once it is done with it.  To avoid that complexity, we read here and store the
@@ -310,7 +509,7 @@ func (s *Service) get(ctx context.Context,
 		}
 
 		span.SetStatus(codes.Error, err.Error())
-		s.monitorGetComplete(ctx, callURL.Path, "failed")
+		s.monitorRequestComplete(ctx, http.MethodGet, callURL.Path, started, resp.StatusCode, 0, requestErrorClass(err, metrics.ErrorClassRead))
 
 		return nil, errors.Join(errors.New("failed to read GET response"), err)
 	}
@@ -319,7 +518,7 @@ func (s *Service) get(ctx context.Context,
 		// Nothing returned.  This is not considered an error.
 		span.AddEvent("Received empty response")
 		log.Trace().Msg("Endpoint returned no content")
-		s.monitorGetComplete(ctx, callURL.Path, "succeeded")
+		s.monitorRequestComplete(ctx, http.MethodGet, callURL.Path, started, resp.StatusCode, 0, metrics.ErrorClassNone)
 
 		return res, nil
 	}
@@ -329,24 +528,35 @@ func (s *Service) get(ctx context.Context,
 		log.Debug().Err(err).Msg("Failed to obtain content type; assuming JSON")
 		res.contentType = ContentTypeJSON
 	}
+	span.SetAttributes(attribute.String("content_type", res.contentType.String()))
 	span.AddEvent("Received response", trace.WithAttributes(
 		attribute.Int("size", len(res.body)),
 		attribute.String("content-type", res.contentType.String()),
 	))
 
+	if resp.StatusCode == http.StatusNotAcceptable && supportsSSZ && !s.enforceJSON {
+		// The server would not provide either of our content types; fall back to JSON only.
+		log.Debug().Msg("Server did not accept content types; retrying with JSON")
+		span.AddEvent("Falling back to JSON")
+		s.monitorRequestComplete(ctx, http.MethodGet, callURL.Path, started, resp.StatusCode, len(res.body), statusErrorClass(resp.StatusCode))
+
+		// Release this request's resources before making the next, otherwise
+		// the fallback could wait forever for the slot that we hold.
+		resp.Body.Close()
+		release()
+		cancel()
+
+		return s.getOnce(ctx, endpoint, query, opts, false, stream)
+	}
+
 	statusFamily := statusCodeFamily(resp.StatusCode)
 	if statusFamily != 2 {
 		s.logBadStatus(ctx, "GET", res, log)
 
 		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
-		s.monitorGetComplete(ctx, callURL.Path, "failed")
+		s.monitorRequestComplete(ctx, http.MethodGet, callURL.Path, started, resp.StatusCode, len(res.body), statusErrorClass(resp.StatusCode))
 
-		return nil, &api.Error{
-			Method:     http.MethodGet,
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
-			Data:       res.body,
-		}
+		return nil, apiError(http.MethodGet, endpoint, res, time.Since(started))
 	}
 
 	if res.contentType == ContentTypeJSON {
@@ -360,7 +570,7 @@ func (s *Service) get(ctx context.Context,
 		return nil, errors.Join(errors.New("failed to parse consensus version"), err)
 	}
 
-	s.monitorGetComplete(ctx, callURL.Path, "succeeded")
+	s.monitorRequestComplete(ctx, http.MethodGet, callURL.Path, started, resp.StatusCode, len(res.body), metrics.ErrorClassNone)
 
 	return res, nil
 }
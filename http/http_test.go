@@ -2,9 +2,14 @@ package http_test
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"net"
 	nethttp "net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
@@ -84,3 +89,220 @@ func TestClientShouldSendExtraHeadersWhenProvided(t *testing.T) {
 	require.True(t, errors.As(err, &apiError))
 	require.Equal(t, nethttp.StatusTeapot, apiError.StatusCode)
 }
+
+func TestClientShouldSendAuthorizationWhenProvided(t *testing.T) {
+	tests := []struct {
+		name          string
+		parameters    []http.Parameter
+		authorization string
+	}{
+		{
+			name: "AuthToken",
+			parameters: []http.Parameter{
+				http.WithAuthToken("token"),
+			},
+			authorization: "Bearer token",
+		},
+		{
+			name: "BasicAuth",
+			parameters: []http.Parameter{
+				http.WithBasicAuth("user", "pass"),
+			},
+			authorization: "Basic dXNlcjpwYXNz",
+		},
+		{
+			name: "OverridesExtraHeaders",
+			parameters: []http.Parameter{
+				http.WithExtraHeaders(map[string]string{"authorization": "Bearer old", "X-Extra": "extra"}),
+				http.WithAuthToken("token"),
+			},
+			authorization: "Bearer token",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if values := r.Header.Values("Authorization"); len(values) != 1 || values[0] != test.authorization {
+					w.WriteHeader(nethttp.StatusUnauthorized)
+					return
+				}
+				switch r.URL.Path {
+				case "/eth/v1/node/version":
+					w.WriteHeader(nethttp.StatusOK)
+					_, _ = w.Write([]byte(`{"data":{"version":"test"}}`))
+				case "/eth/v1/node/syncing":
+					w.WriteHeader(nethttp.StatusOK)
+					_, _ = w.Write([]byte(`{"data":{"is_syncing":false,"is_optimistic":false,"el_offline":false,"head_slot":"8504736","sync_distance":"0"}}`))
+				default:
+					w.WriteHeader(nethttp.StatusTeapot)
+				}
+			}))
+			defer srv.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			svc, err := http.New(ctx, append([]http.Parameter{http.WithAddress(srv.URL)}, test.parameters...)...)
+			require.NoError(t, err)
+
+			_, err = svc.(consensusclient.GenesisProvider).Genesis(ctx, &api.GenesisOpts{})
+			var apiError *api.Error
+			require.True(t, errors.As(err, &apiError))
+			require.Equal(t, nethttp.StatusTeapot, apiError.StatusCode)
+		})
+	}
+}
+
+// nodeHandler is a handler that responds to the requests made by the client on startup.
+func nodeHandler(w nethttp.ResponseWriter, r *nethttp.Request) {
+	switch r.URL.Path {
+	case "/eth/v1/node/version":
+		w.WriteHeader(nethttp.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"version":"test"}}`))
+	case "/eth/v1/node/syncing":
+		w.WriteHeader(nethttp.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"is_syncing":false,"is_optimistic":false,"el_offline":false,"head_slot":"8504736","sync_distance":"0"}}`))
+	default:
+		w.WriteHeader(nethttp.StatusTeapot)
+	}
+}
+
+func TestUnixSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "beacon.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	srv := httptest.NewUnstartedServer(nethttp.HandlerFunc(nodeHandler))
+	srv.Listener = listener
+	srv.Start()
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc, err := http.New(ctx, http.WithAddress("unix://"+socketPath))
+	require.NoError(t, err)
+	require.Equal(t, "unix://"+socketPath, svc.Address())
+
+	_, err = svc.(consensusclient.GenesisProvider).Genesis(ctx, &api.GenesisOpts{})
+	var apiError *api.Error
+	require.True(t, errors.As(err, &apiError))
+	require.Equal(t, nethttp.StatusTeapot, apiError.StatusCode)
+}
+
+func TestDialContext(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(nodeHandler))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var dialed atomic.Bool
+	dialContext := func(ctx context.Context, network string, _ string) (net.Conn, error) {
+		dialed.Store(true)
+
+		// Ignore the requested address, connecting to the test server instead.
+		return (&net.Dialer{}).DialContext(ctx, network, srv.Listener.Addr().String())
+	}
+
+	svc, err := http.New(ctx,
+		http.WithAddress("http://beacon.invalid"),
+		http.WithDialContext(dialContext),
+	)
+	require.NoError(t, err)
+	require.True(t, dialed.Load())
+
+	_, err = svc.(consensusclient.GenesisProvider).Genesis(ctx, &api.GenesisOpts{})
+	var apiError *api.Error
+	require.True(t, errors.As(err, &apiError))
+	require.Equal(t, nethttp.StatusTeapot, apiError.StatusCode)
+}
+
+func TestTLSConfig(t *testing.T) {
+	srv := httptest.NewTLSServer(nethttp.HandlerFunc(nodeHandler))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The test server's certificate is not trusted by default.
+	_, err := http.New(ctx, http.WithAddress(srv.URL))
+	require.ErrorIs(t, err, consensusclient.ErrNotActive)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(srv.Certificate())
+	svc, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTLSConfig(&tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = svc.(consensusclient.GenesisProvider).Genesis(ctx, &api.GenesisOpts{})
+	var apiError *api.Error
+	require.True(t, errors.As(err, &apiError))
+	require.Equal(t, nethttp.StatusTeapot, apiError.StatusCode)
+}
+
+func TestMethodRestrictions(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(nodeHandler))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name       string
+		parameters []http.Parameter
+		allowed    bool
+	}{
+		{
+			name:    "Unrestricted",
+			allowed: true,
+		},
+		{
+			name: "Allowed",
+			parameters: []http.Parameter{
+				http.WithAllowedMethods([]string{"Genesis"}),
+			},
+			allowed: true,
+		},
+		{
+			name: "NotAllowed",
+			parameters: []http.Parameter{
+				http.WithAllowedMethods([]string{"Spec"}),
+			},
+		},
+		{
+			name: "Denied",
+			parameters: []http.Parameter{
+				http.WithDeniedMethods([]string{"Genesis"}),
+			},
+		},
+		{
+			name: "AllowedAndDenied",
+			parameters: []http.Parameter{
+				http.WithAllowedMethods([]string{"Genesis"}),
+				http.WithDeniedMethods([]string{"Genesis"}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Startup calls made by the service itself are not subject to restriction.
+			svc, err := http.New(ctx, append([]http.Parameter{http.WithAddress(srv.URL)}, test.parameters...)...)
+			require.NoError(t, err)
+
+			_, err = svc.(consensusclient.GenesisProvider).Genesis(ctx, &api.GenesisOpts{})
+			if test.allowed {
+				var apiError *api.Error
+				require.True(t, errors.As(err, &apiError))
+			} else {
+				require.ErrorIs(t, err, api.ErrMethodNotAllowed)
+			}
+		})
+	}
+}
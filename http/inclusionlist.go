@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/focil"
+)
+
+// InclusionList fetches an inclusion list for the given options.
+func (s *Service) InclusionList(ctx context.Context,
+	opts *api.InclusionListOpts,
+) (
+	*api.Response[*focil.InclusionList],
+	error,
+) {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/validator/inclusion_list"
+	query := fmt.Sprintf("slot=%d", opts.Slot)
+	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, false)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), focil.InclusionList{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirm the inclusion list is for the requested slot.
+	if data.Slot != opts.Slot {
+		return nil, errors.Join(
+			fmt.Errorf("inclusion list for slot %d; expected %d", data.Slot, opts.Slot),
+			client.ErrInconsistentResult,
+		)
+	}
+
+	return &api.Response[*focil.InclusionList]{
+		Metadata: metadata,
+		Data:     &data,
+	}, nil
+}
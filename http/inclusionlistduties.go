@@ -0,0 +1,78 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// InclusionListDuties obtains inclusion list duties for the given options.
+func (s *Service) InclusionListDuties(ctx context.Context,
+	opts *api.InclusionListDutiesOpts,
+) (
+	*api.Response[[]*apiv1.InclusionListDuty],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if len(opts.Indices) == 0 {
+		return nil, errors.Join(errors.New("no validator indices specified"), client.ErrInvalidOptions)
+	}
+
+	indices := make([]string, len(opts.Indices))
+	for i := range opts.Indices {
+		indices[i] = fmt.Sprintf("%d", opts.Indices[i])
+	}
+	reqBody, err := json.Marshal(indices)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal validator indices"), err)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/duties/inclusion_list/%d", opts.Epoch)
+	query := ""
+
+	httpResponse, err := s.post(ctx,
+		endpoint,
+		query,
+		&opts.Common,
+		bytes.NewReader(reqBody),
+		ContentTypeJSON,
+		map[string]string{},
+	)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to request inclusion list duties"), err)
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.InclusionListDuty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.InclusionListDuty]{
+		Metadata: metadata,
+		Data:     data,
+	}, nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// submissionFailuresJSON is the body returned by a beacon node when some
+// items of a submission fail.
+type submissionFailuresJSON struct {
+	Failures []*submissionFailureJSON `json:"failures"`
+}
+
+type submissionFailureJSON struct {
+	Index   json.RawMessage `json:"index"`
+	Message string          `json:"message"`
+}
+
+// indexedSubmissionError returns an IndexedSubmissionError if the response to
+// a submission reports per-item failures, otherwise the original error.
+// If indices is supplied it maps the position of each submitted item to its
+// index in the caller's original list.
+func indexedSubmissionError(res *httpResponse, err error, indices []int) error {
+	var body []byte
+	var apiErr *api.Error
+	switch {
+	case err != nil && errors.As(err, &apiErr):
+		body = apiErr.Data
+	case err != nil:
+		return err
+	case res != nil && (res.statusCode == http.StatusAccepted || res.statusCode == http.StatusPartialContent):
+		body = res.body
+	default:
+		return nil
+	}
+
+	var data submissionFailuresJSON
+	if len(bytes.TrimSpace(body)) == 0 || json.Unmarshal(body, &data) != nil || len(data.Failures) == 0 {
+		return err
+	}
+
+	submissionErr := &api.IndexedSubmissionError{
+		FailedIndices: make([]int, 0, len(data.Failures)),
+		Messages:      make([]string, 0, len(data.Failures)),
+		Err:           err,
+	}
+	for _, failure := range data.Failures {
+		if failure == nil {
+			continue
+		}
+		// Some beacon nodes return the index as a string rather than a number.
+		index, parseErr := strconv.Atoi(string(bytes.Trim(failure.Index, "\"")))
+		if parseErr != nil {
+			return err
+		}
+		if index >= 0 && index < len(indices) {
+			index = indices[index]
+		}
+		submissionErr.FailedIndices = append(submissionErr.FailedIndices, index)
+		submissionErr.Messages = append(submissionErr.Messages, failure.Message)
+	}
+
+	return submissionErr
+}
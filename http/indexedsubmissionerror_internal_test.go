@@ -0,0 +1,119 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexedSubmissionError(t *testing.T) {
+	failuresBody := []byte(`{"code":400,"message":"some failures","failures":[{"index":1,"message":"bad signature"},{"index":"3","message":"unknown block"}]}`)
+
+	tests := []struct {
+		name     string
+		res      *httpResponse
+		err      error
+		indices  []int
+		expected *api.IndexedSubmissionError
+		errStr   string
+	}{
+		{
+			name: "Success",
+			res:  &httpResponse{statusCode: http.StatusOK},
+		},
+		{
+			name: "NoContent",
+			res:  &httpResponse{statusCode: http.StatusNoContent},
+		},
+		{
+			name:   "OtherError",
+			err:    errors.New("connection refused"),
+			errStr: "connection refused",
+		},
+		{
+			name: "BadRequestNoFailures",
+			err: &api.Error{
+				Method:     http.MethodPost,
+				StatusCode: http.StatusBadRequest,
+				Data:       []byte(`{"code":400,"message":"bad"}`),
+			},
+			errStr: `POST failed with status 400: {"code":400,"message":"bad"}`,
+		},
+		{
+			name: "BadRequestFailures",
+			err: &api.Error{
+				Method:     http.MethodPost,
+				StatusCode: http.StatusBadRequest,
+				Data:       failuresBody,
+			},
+			expected: &api.IndexedSubmissionError{
+				FailedIndices: []int{1, 3},
+				Messages:      []string{"bad signature", "unknown block"},
+			},
+		},
+		{
+			name: "AcceptedFailures",
+			res: &httpResponse{
+				statusCode: http.StatusAccepted,
+				body:       failuresBody,
+			},
+			expected: &api.IndexedSubmissionError{
+				FailedIndices: []int{1, 3},
+				Messages:      []string{"bad signature", "unknown block"},
+			},
+		},
+		{
+			name: "PartialContentMapped",
+			res: &httpResponse{
+				statusCode: http.StatusPartialContent,
+				body:       failuresBody,
+			},
+			indices: []int{0, 2, 4, 5},
+			expected: &api.IndexedSubmissionError{
+				FailedIndices: []int{2, 5},
+				Messages:      []string{"bad signature", "unknown block"},
+			},
+		},
+		{
+			name: "AcceptedInvalidBody",
+			res: &httpResponse{
+				statusCode: http.StatusAccepted,
+				body:       []byte("not json"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := indexedSubmissionError(test.res, test.err, test.indices)
+			switch {
+			case test.expected != nil:
+				var indexedErr *api.IndexedSubmissionError
+				require.True(t, errors.As(err, &indexedErr))
+				require.Equal(t, test.expected.FailedIndices, indexedErr.FailedIndices)
+				require.Equal(t, test.expected.Messages, indexedErr.Messages)
+				require.Equal(t, test.err, indexedErr.Err)
+			case test.errStr != "":
+				require.EqualError(t, err, test.errStr)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// lightClientObject is a light client structure that can be decoded from
+// either JSON or SSZ.
+type lightClientObject interface {
+	json.Unmarshaler
+	UnmarshalSSZ(buf []byte) error
+}
+
+// unmarshalLightClientSSZ unmarshals SSZ data in to a light client structure,
+// taking custom specifications in to account if required.
+func (s *Service) unmarshalLightClientSSZ(ctx context.Context, obj lightClientObject, data []byte) error {
+	if !s.customSpecSupport {
+		return obj.UnmarshalSSZ(data)
+	}
+
+	specs, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return errors.Join(errors.New("failed to request specs"), err)
+	}
+
+	return dynssz.NewDynSsz(specs.Data).UnmarshalSSZ(obj, data)
+}
+
+// lightClientForkDigests returns a map of fork digests to the data versions
+// they represent, for use when decoding SSZ light client responses.
+func (s *Service) lightClientForkDigests(ctx context.Context) (map[phase0.ForkDigest]spec.DataVersion, error) {
+	genesisResponse, err := s.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain genesis"), err)
+	}
+	specResponse, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+
+	forks := map[string]spec.DataVersion{
+		"ALTAIR":    spec.DataVersionAltair,
+		"BELLATRIX": spec.DataVersionBellatrix,
+		"CAPELLA":   spec.DataVersionCapella,
+		"DENEB":     spec.DataVersionDeneb,
+		"ELECTRA":   spec.DataVersionElectra,
+		"FULU":      spec.DataVersionFulu,
+	}
+
+	digests := make(map[phase0.ForkDigest]spec.DataVersion, len(forks))
+	for name, version := range forks {
+		forkVersion, isVersion := specResponse.Data[name+"_FORK_VERSION"].(phase0.Version)
+		if !isVersion {
+			// Fork not known to the node.
+			continue
+		}
+
+		var digest phase0.ForkDigest
+		if version == spec.DataVersionFulu {
+			// The fulu fork digest is modified by the blob parameters in force at the fork.
+			// Later blob parameter changes are not tracked here.
+			electraEpoch, epochExists := specResponse.Data["ELECTRA_FORK_EPOCH"].(uint64)
+			maxBlobs, maxBlobsExists := specResponse.Data["MAX_BLOBS_PER_BLOCK_ELECTRA"].(uint64)
+			if !epochExists || !maxBlobsExists {
+				continue
+			}
+			digest, err = signing.ComputeBlobParametersForkDigest(forkVersion,
+				genesisResponse.Data.GenesisValidatorsRoot,
+				phase0.Epoch(electraEpoch),
+				maxBlobs,
+			)
+		} else {
+			digest, err = signing.ComputeForkDigest(forkVersion, genesisResponse.Data.GenesisValidatorsRoot)
+		}
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to calculate fork digest for %s", version), err)
+		}
+		digests[digest] = version
+	}
+
+	return digests, nil
+}
+
+// lightClientChunk is a single versioned item of a light client SSZ range response.
+type lightClientChunk struct {
+	version spec.DataVersion
+	data    []byte
+}
+
+// lightClientChunks splits a light client SSZ range response in to its individual chunks.
+// Each chunk is made up of an 8-byte length, a 4-byte fork digest and the SSZ-encoded data,
+// where the length covers both the fork digest and the data.
+func (s *Service) lightClientChunks(ctx context.Context, data []byte) ([]*lightClientChunk, error) {
+	digests, err := s.lightClientForkDigests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make([]*lightClientChunk, 0)
+	for len(data) > 0 {
+		if len(data) < 12 {
+			return nil, errors.New("light client chunk header too short")
+		}
+		chunkLen := binary.LittleEndian.Uint64(data[0:8])
+		if chunkLen < 4 || chunkLen > uint64(len(data)-8) {
+			return nil, errors.New("light client chunk length invalid")
+		}
+
+		var digest phase0.ForkDigest
+		copy(digest[:], data[8:12])
+		version, exists := digests[digest]
+		if !exists {
+			return nil, fmt.Errorf("unknown fork digest %#x", digest)
+		}
+
+		chunks = append(chunks, &lightClientChunk{
+			version: version,
+			data:    data[12 : 8+chunkLen],
+		})
+		data = data[8+chunkLen:]
+	}
+
+	return chunks, nil
+}
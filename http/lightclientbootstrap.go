@@ -0,0 +1,118 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+)
+
+// LightClientBootstrap fetches the light client bootstrap given options.
+func (s *Service) LightClientBootstrap(ctx context.Context,
+	opts *api.LightClientBootstrapOpts,
+) (
+	*api.Response[*spec.VersionedLightClientBootstrap],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%#x", opts.Block)
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	data, obj, err := newVersionedLightClientBootstrap(httpResponse.consensusVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.Response[*spec.VersionedLightClientBootstrap]{
+		Data: data,
+	}
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		if err := s.unmarshalLightClientSSZ(ctx, obj, httpResponse.body); err != nil {
+			return nil, errors.Join(errors.New("failed to decode light client bootstrap"), err)
+		}
+		response.Metadata = metadataFromHeaders(httpResponse.headers)
+	case ContentTypeJSON:
+		var raw json.RawMessage
+		raw, response.Metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), json.RawMessage{})
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, obj); err != nil {
+			return nil, errors.Join(errors.New("failed to decode light client bootstrap"), err)
+		}
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+	response.Metadata = consensusVersionMetadata(response.Metadata, httpResponse)
+
+	return response, nil
+}
+
+// newVersionedLightClientBootstrap creates an empty versioned light client bootstrap for the given version,
+// returning both the container and the object to be decoded.
+func newVersionedLightClientBootstrap(version spec.DataVersion) (*spec.VersionedLightClientBootstrap, lightClientObject, error) {
+	res := &spec.VersionedLightClientBootstrap{
+		Version: version,
+	}
+
+	switch version {
+	case spec.DataVersionAltair:
+		res.Altair = &altair.LightClientBootstrap{}
+
+		return res, res.Altair, nil
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &altair.LightClientBootstrap{}
+
+		return res, res.Bellatrix, nil
+	case spec.DataVersionCapella:
+		res.Capella = &capella.LightClientBootstrap{}
+
+		return res, res.Capella, nil
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.LightClientBootstrap{}
+
+		return res, res.Deneb, nil
+	case spec.DataVersionElectra:
+		res.Electra = &electra.LightClientBootstrap{}
+
+		return res, res.Electra, nil
+	case spec.DataVersionFulu:
+		res.Fulu = &electra.LightClientBootstrap{}
+
+		return res, res.Fulu, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported version %s", version)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// LightClientOptimisticUpdate fetches the light client optimistic update given options.
+func (s *Service) LightClientOptimisticUpdate(ctx context.Context,
+	opts *api.LightClientOptimisticUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientOptimisticUpdate],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/beacon/light_client/optimistic_update"
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	data, obj, err := newVersionedLightClientOptimisticUpdate(httpResponse.consensusVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.Response[*spec.VersionedLightClientOptimisticUpdate]{
+		Data: data,
+	}
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		if err := s.unmarshalLightClientSSZ(ctx, obj, httpResponse.body); err != nil {
+			return nil, errors.Join(errors.New("failed to decode light client optimistic update"), err)
+		}
+		response.Metadata = metadataFromHeaders(httpResponse.headers)
+	case ContentTypeJSON:
+		var raw json.RawMessage
+		raw, response.Metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), json.RawMessage{})
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, obj); err != nil {
+			return nil, errors.Join(errors.New("failed to decode light client optimistic update"), err)
+		}
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+	response.Metadata = consensusVersionMetadata(response.Metadata, httpResponse)
+
+	return response, nil
+}
+
+// newVersionedLightClientOptimisticUpdate creates an empty versioned light client optimistic update for the given version,
+// returning both the container and the object to be decoded.
+func newVersionedLightClientOptimisticUpdate(version spec.DataVersion) (*spec.VersionedLightClientOptimisticUpdate, lightClientObject, error) {
+	res := &spec.VersionedLightClientOptimisticUpdate{
+		Version: version,
+	}
+
+	switch version {
+	case spec.DataVersionAltair:
+		res.Altair = &altair.LightClientOptimisticUpdate{}
+
+		return res, res.Altair, nil
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &altair.LightClientOptimisticUpdate{}
+
+		return res, res.Bellatrix, nil
+	case spec.DataVersionCapella:
+		res.Capella = &capella.LightClientOptimisticUpdate{}
+
+		return res, res.Capella, nil
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.LightClientOptimisticUpdate{}
+
+		return res, res.Deneb, nil
+	case spec.DataVersionElectra:
+		res.Electra = &deneb.LightClientOptimisticUpdate{}
+
+		return res, res.Electra, nil
+	case spec.DataVersionFulu:
+		res.Fulu = &deneb.LightClientOptimisticUpdate{}
+
+		return res, res.Fulu, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported version %s", version)
+	}
+}
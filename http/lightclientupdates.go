@@ -0,0 +1,160 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+)
+
+// lightClientUpdateJSON is a single versioned item of a light client updates JSON response.
+type lightClientUpdateJSON struct {
+	Version spec.DataVersion `json:"version"`
+	Data    json.RawMessage  `json:"data"`
+}
+
+// LightClientUpdates fetches the light client updates given options.
+func (s *Service) LightClientUpdates(ctx context.Context,
+	opts *api.LightClientUpdatesOpts,
+) (
+	*api.Response[[]*spec.VersionedLightClientUpdate],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Count == 0 {
+		return nil, errors.Join(errors.New("no count specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := "/eth/v1/beacon/light_client/updates"
+	query := fmt.Sprintf("start_period=%d&count=%d", opts.StartPeriod, opts.Count)
+	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		return s.lightClientUpdatesFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		return s.lightClientUpdatesFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (s *Service) lightClientUpdatesFromSSZ(ctx context.Context,
+	res *httpResponse,
+) (
+	*api.Response[[]*spec.VersionedLightClientUpdate],
+	error,
+) {
+	chunks, err := s.lightClientChunks(ctx, res.body)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*spec.VersionedLightClientUpdate, len(chunks))
+	for i, chunk := range chunks {
+		update, obj, err := newVersionedLightClientUpdate(chunk.version)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.unmarshalLightClientSSZ(ctx, obj, chunk.data); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to decode light client update %d", i), err)
+		}
+		updates[i] = update
+	}
+
+	return &api.Response[[]*spec.VersionedLightClientUpdate]{
+		Data:     updates,
+		Metadata: metadataFromHeaders(res.headers),
+	}, nil
+}
+
+func (*Service) lightClientUpdatesFromJSON(res *httpResponse) (*api.Response[[]*spec.VersionedLightClientUpdate], error) {
+	var items []*lightClientUpdateJSON
+	if err := json.NewDecoder(bytes.NewReader(res.body)).Decode(&items); err != nil {
+		return nil, errors.Join(errors.New("failed to parse JSON"), err)
+	}
+
+	updates := make([]*spec.VersionedLightClientUpdate, len(items))
+	for i, item := range items {
+		update, obj, err := newVersionedLightClientUpdate(item.Version)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(item.Data, obj); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to decode light client update %d", i), err)
+		}
+		updates[i] = update
+	}
+
+	return &api.Response[[]*spec.VersionedLightClientUpdate]{
+		Data:     updates,
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+// newVersionedLightClientUpdate creates an empty versioned light client update for the given version,
+// returning both the container and the object to be decoded.
+func newVersionedLightClientUpdate(version spec.DataVersion) (*spec.VersionedLightClientUpdate, lightClientObject, error) {
+	res := &spec.VersionedLightClientUpdate{
+		Version: version,
+	}
+
+	switch version {
+	case spec.DataVersionAltair:
+		res.Altair = &altair.LightClientUpdate{}
+
+		return res, res.Altair, nil
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &altair.LightClientUpdate{}
+
+		return res, res.Bellatrix, nil
+	case spec.DataVersionCapella:
+		res.Capella = &capella.LightClientUpdate{}
+
+		return res, res.Capella, nil
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.LightClientUpdate{}
+
+		return res, res.Deneb, nil
+	case spec.DataVersionElectra:
+		res.Electra = &electra.LightClientUpdate{}
+
+		return res, res.Electra, nil
+	case spec.DataVersionFulu:
+		res.Fulu = &electra.LightClientUpdate{}
+
+		return res, res.Fulu, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported version %s", version)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"unicode"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// serviceFuncPrefix is the prefix of the names of functions on the service, as
+// reported by the runtime.
+var serviceFuncPrefix = reflect.TypeOf(Service{}).PkgPath() + ".(*Service)."
+
+// maxMethodFrames is the maximum number of stack frames examined when finding
+// the method being called.
+const maxMethodFrames = 64
+
+// isServiceMethod returns true if the name is that of an exported method of the service.
+func isServiceMethod(name string) bool {
+	_, exists := reflect.TypeOf(&Service{}).MethodByName(name)
+
+	return exists
+}
+
+// methodSet creates a set from a list of methods, or nil if there are none.
+func methodSet(methods []string) map[string]struct{} {
+	if len(methods) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		set[method] = struct{}{}
+	}
+
+	return set
+}
+
+// assertMethodAllowed returns an error if the exported method that is calling
+// it is not allowed by the method restrictions of the service.  Calls that the
+// service makes to itself are always allowed.
+func (s *Service) assertMethodAllowed() error {
+	if s.allowedMethods == nil && s.deniedMethods == nil {
+		return nil
+	}
+
+	method, internal := s.callingMethod()
+	if method == "" || internal {
+		return nil
+	}
+
+	if _, denied := s.deniedMethods[method]; denied {
+		return errors.Join(fmt.Errorf("%s is denied", method), api.ErrMethodNotAllowed)
+	}
+	if s.allowedMethods != nil {
+		if _, allowed := s.allowedMethods[method]; !allowed {
+			return errors.Join(fmt.Errorf("%s is not allowed", method), api.ErrMethodNotAllowed)
+		}
+	}
+
+	return nil
+}
+
+// callingMethod returns the innermost exported method of the service on the
+// call stack, and true if it was called from within the service.
+func (*Service) callingMethod() (string, bool) {
+	pcs := make([]uintptr, maxMethodFrames)
+	// Skip runtime.Callers, callingMethod and assertMethodAllowed.
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+
+	method := ""
+	for {
+		frame, more := frames.Next()
+		if name, isServiceFunc := strings.CutPrefix(frame.Function, serviceFuncPrefix); isServiceFunc {
+			if method != "" {
+				// The method was called by the service itself.
+				return method, true
+			}
+			if !strings.Contains(name, ".") && unicode.IsUpper(rune(name[0])) {
+				method = name
+			}
+		}
+		if !more {
+			return method, false
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/prometheus/client_golang/prometheus"
@@ -67,20 +68,58 @@ func registerPrometheusMetrics(_ context.Context) error {
 	return nil
 }
 
-func (s *Service) monitorGetComplete(_ context.Context, endpoint string, result string) {
-	if requestsMetric == nil {
-		return
+// monitorRequestComplete records the completion of a request.
+func (s *Service) monitorRequestComplete(ctx context.Context,
+	method string,
+	endpoint string,
+	started time.Time,
+	statusCode int,
+	responseSize int,
+	errorClass metrics.ErrorClass,
+) {
+	reducedEndpoint := reduceEndpoint(endpoint)
+
+	if requestsMetric != nil {
+		result := "succeeded"
+		if errorClass != metrics.ErrorClassNone {
+			result = "failed"
+		}
+		requestsMetric.WithLabelValues(s.address, method, reducedEndpoint, result).Inc()
 	}
 
-	requestsMetric.WithLabelValues(s.address, "GET", reduceEndpoint(endpoint), result).Inc()
+	if s.monitor != nil {
+		s.monitor.RequestCompleted(ctx, &metrics.Request{
+			Server:       s.address,
+			Method:       method,
+			Endpoint:     reducedEndpoint,
+			StatusCode:   statusCode,
+			Duration:     time.Since(started),
+			ResponseSize: responseSize,
+			ErrorClass:   errorClass,
+		})
+	}
 }
 
-func (s *Service) monitorPostComplete(_ context.Context, endpoint string, result string) {
-	if requestsMetric == nil {
-		return
+// requestErrorClass returns the error class for an error encountered
+// when making a request or reading its response.
+func requestErrorClass(err error, defaultClass metrics.ErrorClass) metrics.ErrorClass {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return metrics.ErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return metrics.ErrorClassTimeout
+	default:
+		return defaultClass
+	}
+}
+
+// statusErrorClass returns the error class for a non-2xx status code.
+func statusErrorClass(statusCode int) metrics.ErrorClass {
+	if statusCodeFamily(statusCode) == 4 {
+		return metrics.ErrorClassClient
 	}
 
-	requestsMetric.WithLabelValues(s.address, "POST", reduceEndpoint(endpoint), result).Inc()
+	return metrics.ErrorClassServer
 }
 
 type templateReplacement struct {
@@ -91,7 +130,7 @@ type templateReplacement struct {
 var endpointTemplates = []*templateReplacement{
 	{
 		pattern: regexp.MustCompile(
-			"/(blinded_blocks|blob_sidecars|blocks|headers|sync_committee)/(0x[0-9a-fA-F]{64}|[0-9]+|head|genesis|finalized)",
+			"/(blinded_blocks|blob_sidecars|blocks|data_column_sidecars|headers|sync_committee)/(0x[0-9a-fA-F]{64}|[0-9]+|head|genesis|finalized)",
 		),
 		replacement: []byte("/$1/{block_id}"),
 	},
@@ -100,9 +139,13 @@ var endpointTemplates = []*templateReplacement{
 		replacement: []byte("/bootstrap/{block_root}"),
 	},
 	{
-		pattern:     regexp.MustCompile("/duties/(attester|proposer|sync)/[0-9]+"),
+		pattern:     regexp.MustCompile("/duties/(attester|proposer|sync|inclusion_list)/[0-9]+"),
 		replacement: []byte("/duties/$1/{epoch}"),
 	},
+	{
+		pattern:     regexp.MustCompile("/liveness/[0-9]+"),
+		replacement: []byte("/liveness/{epoch}"),
+	},
 	{
 		pattern:     regexp.MustCompile("/peers/[0-9a-zA-Z]+"),
 		replacement: []byte("/peers/{peer_id}"),
@@ -0,0 +1,142 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type testRequestMonitor struct {
+	mu       sync.Mutex
+	requests []*metrics.Request
+}
+
+func (*testRequestMonitor) Presenter() string {
+	return "test"
+}
+
+func (m *testRequestMonitor) RequestCompleted(_ context.Context, request *metrics.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, request)
+}
+
+func TestRequestMonitor(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/eth/v1/beacon/states/head/finality_checkpoints" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{}}`))
+
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	monitor := &testRequestMonitor{}
+	s := &Service{
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      address.String(),
+		client:       http.DefaultClient,
+		timeout:      timeout,
+		monitor:      monitor,
+		extraHeaders: map[string]string{},
+	}
+
+	_, err = s.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", "", &api.CommonOpts{}, false)
+	require.NoError(t, err)
+	_, err = s.get(ctx, "/eth/v1/beacon/headers/0x0000000000000000000000000000000000000000000000000000000000000000", "", &api.CommonOpts{}, false)
+	require.Error(t, err)
+
+	require.Len(t, monitor.requests, 2)
+	require.Equal(t, address.String(), monitor.requests[0].Server)
+	require.Equal(t, http.MethodGet, monitor.requests[0].Method)
+	require.Equal(t, "/eth/v1/beacon/states/{state_id}/finality_checkpoints", monitor.requests[0].Endpoint)
+	require.Equal(t, http.StatusOK, monitor.requests[0].StatusCode)
+	require.Equal(t, len(`{"data":{}}`), monitor.requests[0].ResponseSize)
+	require.Equal(t, metrics.ErrorClassNone, monitor.requests[0].ErrorClass)
+	require.Equal(t, "/eth/v1/beacon/headers/{block_id}", monitor.requests[1].Endpoint)
+	require.Equal(t, http.StatusNotFound, monitor.requests[1].StatusCode)
+	require.Equal(t, metrics.ErrorClassClient, monitor.requests[1].ErrorClass)
+}
+
+func TestReduceEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		expected string
+	}{
+		{
+			name:     "Static",
+			endpoint: "/eth/v1/node/version",
+			expected: "/eth/v1/node/version",
+		},
+		{
+			name:     "BlockRoot",
+			endpoint: "/eth/v2/beacon/blocks/0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20",
+			expected: "/eth/v2/beacon/blocks/{block_id}",
+		},
+		{
+			name:     "BlobSidecars",
+			endpoint: "/eth/v1/beacon/blob_sidecars/head",
+			expected: "/eth/v1/beacon/blob_sidecars/{block_id}",
+		},
+		{
+			name:     "DataColumnSidecars",
+			endpoint: "/eth/v1/debug/beacon/data_column_sidecars/12345",
+			expected: "/eth/v1/debug/beacon/data_column_sidecars/{block_id}",
+		},
+		{
+			name:     "StateValidator",
+			endpoint: "/eth/v1/beacon/states/finalized/validators/123",
+			expected: "/eth/v1/beacon/states/{state_id}/validators/{validator_id}",
+		},
+		{
+			name:     "AttesterDuties",
+			endpoint: "/eth/v1/validator/duties/attester/10",
+			expected: "/eth/v1/validator/duties/attester/{epoch}",
+		},
+		{
+			name:     "ValidatorLiveness",
+			endpoint: "/eth/v1/validator/liveness/10",
+			expected: "/eth/v1/validator/liveness/{epoch}",
+		},
+		{
+			name:     "InclusionListDuties",
+			endpoint: "/eth/v1/validator/duties/inclusion_list/10",
+			expected: "/eth/v1/validator/duties/inclusion_list/{epoch}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, reduceEndpoint(test.endpoint))
+		})
+	}
+}
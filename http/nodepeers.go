@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 )
@@ -28,6 +29,9 @@ func (s *Service) NodePeers(ctx context.Context, opts *api.NodePeersOpts) (*api.
 	if err := s.assertIsActive(ctx); err != nil {
 		return nil, err
 	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
 
 	// all options are considered optional
 	endpoint := "/eth/v1/node/peers"
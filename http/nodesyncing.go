@@ -26,6 +26,9 @@ import (
 func (s *Service) NodeSyncing(ctx context.Context, opts *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error) {
 	// We do not run checkIsActive here as it calls this function, as checkIsActive can call this function
 	// and so it would cause a loop.
+	if err := s.assertMethodAllowed(); err != nil {
+		return nil, err
+	}
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
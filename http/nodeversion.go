@@ -33,6 +33,9 @@ func (s *Service) NodeVersion(ctx context.Context,
 	error,
 ) {
 	// Carry this out without a connection check, as it is called when activating a client.
+	if err := s.assertMethodAllowed(); err != nil {
+		return nil, err
+	}
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
@@ -14,28 +14,60 @@
 package http
 
 import (
+	"crypto/tls"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type parameters struct {
-	logLevel           zerolog.Level
-	monitor            metrics.Service
-	address            string
-	timeout            time.Duration
-	indexChunkSize     int
-	pubKeyChunkSize    int
-	extraHeaders       map[string]string
-	enforceJSON        bool
-	allowDelayedStart  bool
-	hooks              *Hooks
-	reducedMemoryUsage bool
-	customSpecSupport  bool
-	client             *http.Client
+	logLevel            zerolog.Level
+	monitor             metrics.Service
+	address             string
+	timeout             time.Duration
+	indexChunkSize      int
+	pubKeyChunkSize     int
+	validatorsChunkSize int
+	extraHeaders        map[string]string
+	authToken           string
+	basicAuthUser       string
+	basicAuthPassword   string
+	jwtSecret           []byte
+	enforceJSON         bool
+	preferSSZ           bool
+	preferSSZSubmission bool
+	compression         bool
+	allowDelayedStart   bool
+	hooks               *Hooks
+	tracerProvider      trace.TracerProvider
+	reducedMemoryUsage  bool
+	customSpecSupport   bool
+	client              *http.Client
+	roundTripper        http.RoundTripper
+	dialContext         DialContextFunc
+	tlsConfig           *tls.Config
+	allowedMethods      []string
+	deniedMethods       []string
+	retries             int
+	retryBackoff        time.Duration
+	maxIdleConns        int
+	maxConnsPerHost     int
+	idleConnTimeout     time.Duration
+	http2               bool
+	rateLimit           float64
+	maxConcurrent       int
+	circuitThreshold    int
+	circuitCoolDown     time.Duration
+
+	eventsReconnectInitialDelay time.Duration
+	eventsReconnectMaxDelay     time.Duration
 }
 
 // Parameter is the interface for service parameters.
@@ -56,7 +88,8 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
-// WithMonitor sets the monitor for the service.
+// WithMonitor sets the monitor for the service.  If the monitor is a
+// metrics.RequestMonitor it is also given details of each request.
 func WithMonitor(monitor metrics.Service) Parameter {
 	return parameterFunc(func(p *parameters) {
 		p.monitor = monitor
@@ -91,10 +124,53 @@ func WithPubKeyChunkSize(pubKeyChunkSize int) Parameter {
 	})
 }
 
+// WithValidatorsChunkSize sets the maximum number of validator indices and
+// public keys sent in a single validators or validator balances request.
+// Requests for more validators are split into chunks that are fetched in
+// parallel, and their results merged.  Defaults to 1000.
+func WithValidatorsChunkSize(validatorsChunkSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorsChunkSize = validatorsChunkSize
+	})
+}
+
 // WithExtraHeaders sets additional headers to be sent with each HTTP request.
+// Headers from multiple calls are merged, with later values taking precedence.
 func WithExtraHeaders(headers map[string]string) Parameter {
 	return parameterFunc(func(p *parameters) {
-		p.extraHeaders = headers
+		for k, v := range headers {
+			p.extraHeaders[k] = v
+		}
+	})
+}
+
+// WithAuthToken sets a bearer token to be sent in the Authorization header of
+// each HTTP request.  This takes precedence over any Authorization header
+// supplied with WithExtraHeaders.
+func WithAuthToken(token string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.authToken = token
+	})
+}
+
+// WithBasicAuth sets a user and password to be sent as basic authentication in
+// the Authorization header of each HTTP request.  This takes precedence over
+// any Authorization header supplied with WithExtraHeaders.
+func WithBasicAuth(user string, password string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.basicAuthUser = user
+		p.basicAuthPassword = password
+	})
+}
+
+// WithJWTSecret sets a shared secret from which an HS256 JWT is generated and
+// sent in the Authorization header of each HTTP request, in the style of the
+// engine API.  A fresh token is generated for each request, so tokens do not
+// expire.  The secret must be at least 32 bytes.  This takes precedence over any
+// Authorization header supplied with WithExtraHeaders.
+func WithJWTSecret(secret []byte) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.jwtSecret = secret
 	})
 }
 
@@ -105,6 +181,34 @@ func WithEnforceJSON(enforceJSON bool) Parameter {
 	})
 }
 
+// WithPreferSSZ sets whether SSZ is preferred over JSON for responses from endpoints that
+// can provide both.  If not, JSON is preferred but SSZ is still accepted.  Either way, if
+// the server rejects the request as not acceptable it is retried requesting JSON only.
+// This has no effect if WithEnforceJSON is set.  Defaults to true.
+func WithPreferSSZ(preferSSZ bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preferSSZ = preferSSZ
+	})
+}
+
+// WithPreferSSZSubmission sets whether blocks are submitted as SSZ rather than JSON.
+// SSZ submission is considerably faster to encode and decode for large blocks.
+// This has no effect if WithEnforceJSON is set.  Defaults to false.
+func WithPreferSSZSubmission(preferSSZSubmission bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preferSSZSubmission = preferSSZSubmission
+	})
+}
+
+// WithCompression sets whether responses are requested with gzip or deflate
+// compression, and transparently decompressed.  This can considerably reduce
+// transfer times for large responses from remote beacon nodes.  Defaults to false.
+func WithCompression(compression bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.compression = compression
+	})
+}
+
 // WithAllowDelayedStart allows the service to start even if the client is unavailable.
 func WithAllowDelayedStart(allowDelayedStart bool) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -144,7 +248,171 @@ func WithHTTPClient(client *http.Client) Parameter {
 	})
 }
 
+// WithRoundTripper provides a custom round tripper for communication with the HTTP server,
+// for example to add a proxy, TLS configuration or request signing.  It is used with the
+// standard HTTP client, so cannot be supplied alongside WithHTTPClient.
+func WithRoundTripper(roundTripper http.RoundTripper) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.roundTripper = roundTripper
+	})
+}
+
+// WithDialContext provides a custom function for dialing connections to the HTTP
+// server, for example to connect over a tunnel.  It is used with the standard HTTP
+// client, so cannot be supplied alongside WithHTTPClient or WithRoundTripper.
+// Addresses of the form "unix:///path/to/socket" connect over a Unix socket
+// without the need for a custom dialer.
+func WithDialContext(dialContext DialContextFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dialContext = dialContext
+	})
+}
+
+// WithTLSConfig provides the TLS configuration for connections to the HTTP
+// server, for example to supply client certificates, trust a custom certificate
+// authority or skip verification on development networks.  It is used with the
+// standard HTTP client, so cannot be supplied alongside WithHTTPClient or
+// WithRoundTripper.  If not supplied the system defaults are used.
+func WithTLSConfig(tlsConfig *tls.Config) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tlsConfig = tlsConfig
+	})
+}
+
+// WithAllowedMethods restricts the service to the given methods, for example
+// "Genesis" or "SubmitAttestations".  Calls to other methods fail with
+// api.ErrMethodNotAllowed without contacting the server.  Calls made internally
+// by the service, for example to check the connection state, are not restricted.
+func WithAllowedMethods(methods []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.allowedMethods = methods
+	})
+}
+
+// WithDeniedMethods refuses calls to the given methods, for example to create a
+// read-only service that cannot submit data.  Calls to these methods fail with
+// api.ErrMethodNotAllowed without contacting the server.  This takes precedence
+// over WithAllowedMethods.
+func WithDeniedMethods(methods []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.deniedMethods = methods
+	})
+}
+
+// WithTracerProvider sets the tracer provider for spans created by the service.
+// If not supplied the global tracer provider is used.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tracerProvider = tracerProvider
+	})
+}
+
+// WithEventsReconnectInitialDelay sets the delay before the first attempt to reconnect a dropped events stream.
+// The delay doubles with each failed attempt, up to the maximum set by WithEventsReconnectMaxDelay.
+func WithEventsReconnectInitialDelay(delay time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsReconnectInitialDelay = delay
+	})
+}
+
+// WithEventsReconnectMaxDelay sets the maximum delay between attempts to reconnect a dropped events stream.
+func WithEventsReconnectMaxDelay(delay time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsReconnectMaxDelay = delay
+	})
+}
+
+// WithRetries sets the number of times that a GET request is retried on a
+// transient failure, such as a timeout or a 502, 503 or 504 response.  Submissions
+// are never retried.  Individual calls can override this with api.WithRetryBudget.
+func WithRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retries = retries
+	})
+}
+
+// WithRetryBackoff sets the delay before the first retry of a GET request.  The
+// delay doubles for each subsequent retry.
+func WithRetryBackoff(backoff time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryBackoff = backoff
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+// WithMaxIdleConns sets the maximum number of idle connections kept open to
+// the beacon node.  Defaults to 64.
+// This has no effect if WithHTTPClient or WithRoundTripper is supplied.
+func WithMaxIdleConns(maxIdleConns int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxIdleConns = maxIdleConns
+	})
+}
+
+// WithMaxConnsPerHost sets the maximum number of connections, active or idle,
+// to the beacon node.  Zero means no limit.  Defaults to 64.
+// This has no effect if WithHTTPClient or WithRoundTripper is supplied.
+func WithMaxConnsPerHost(maxConnsPerHost int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxConnsPerHost = maxConnsPerHost
+	})
+}
+
+// WithIdleConnTimeout sets the time after which an idle connection to the
+// beacon node is closed.  Zero means no timeout.  Defaults to 10 minutes.
+// This has no effect if WithHTTPClient or WithRoundTripper is supplied.
+func WithIdleConnTimeout(idleConnTimeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.idleConnTimeout = idleConnTimeout
+	})
+}
+
+// WithHTTP2 sets whether the client attempts to use HTTP/2 when connecting to
+// the beacon node over TLS.  Defaults to false.
+// This has no effect if WithHTTPClient or WithRoundTripper is supplied.
+func WithHTTP2(http2 bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.http2 = http2
+	})
+}
+
+// WithRateLimit sets the maximum number of requests started each second.
+// Requests over the limit are queued until they can be made, or their context
+// is done.  Zero means no limit.  Defaults to 0.
+func WithRateLimit(rps float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.rateLimit = rps
+	})
+}
+
+// WithMaxConcurrentRequests sets the maximum number of requests in flight at
+// any one time.  Requests over the limit are queued until they can be made, or
+// their context is done.  Zero means no limit.  Defaults to 0.
+func WithMaxConcurrentRequests(maxConcurrentRequests int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxConcurrent = maxConcurrentRequests
+	})
+}
+
+// WithCircuitBreakerThreshold sets the number of consecutive failures of an
+// endpoint after which its circuit breaker opens.  Whilst the circuit breaker is
+// open calls to the endpoint fail immediately with ErrCircuitOpen, until the cool
+// down set by WithCircuitBreakerCoolDown has passed.  Zero disables the circuit
+// breaker.  Defaults to 0.
+func WithCircuitBreakerThreshold(threshold int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.circuitThreshold = threshold
+	})
+}
+
+// WithCircuitBreakerCoolDown sets the time for which an open circuit breaker
+// fails calls before allowing a trial call through.  Defaults to 30 seconds.
+func WithCircuitBreakerCoolDown(coolDown time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.circuitCoolDown = coolDown
+	})
+}
+
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
 		logLevel:          zerolog.GlobalLevel(),
@@ -153,7 +421,21 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		pubKeyChunkSize:   -1,
 		extraHeaders:      make(map[string]string),
 		allowDelayedStart: false,
+		preferSSZ:         true,
 		hooks:             &Hooks{},
+
+		validatorsChunkSize: 1000,
+
+		retryBackoff: 250 * time.Millisecond,
+
+		maxIdleConns:    64,
+		maxConnsPerHost: 64,
+		idleConnTimeout: 600 * time.Second,
+
+		circuitCoolDown: 30 * time.Second,
+
+		eventsReconnectInitialDelay: time.Second,
+		eventsReconnectMaxDelay:     time.Minute,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -164,6 +446,14 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.address == "" {
 		return nil, errors.New("no address specified")
 	}
+	if socketPath, isUnixSocket := unixSocketPath(parameters.address); isUnixSocket {
+		if socketPath == "" {
+			return nil, errors.New("no unix socket path specified")
+		}
+		if parameters.dialContext != nil {
+			return nil, errors.New("cannot specify both unix socket address and dialer")
+		}
+	}
 	if parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
@@ -173,9 +463,94 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.pubKeyChunkSize == 0 {
 		return nil, errors.New("no public key chunk size specified")
 	}
+	if parameters.validatorsChunkSize <= 0 {
+		return nil, errors.New("validators chunk size must be positive")
+	}
 	if parameters.hooks == nil {
 		return nil, errors.New("no hooks specified")
 	}
+	if parameters.eventsReconnectInitialDelay <= 0 {
+		return nil, errors.New("no events reconnect initial delay specified")
+	}
+	if parameters.eventsReconnectMaxDelay < parameters.eventsReconnectInitialDelay {
+		return nil, errors.New("events reconnect maximum delay cannot be less than initial delay")
+	}
+	if parameters.retries < 0 {
+		return nil, errors.New("retries cannot be negative")
+	}
+	if parameters.retryBackoff < 0 {
+		return nil, errors.New("retry backoff cannot be negative")
+	}
+	if parameters.maxIdleConns <= 0 {
+		return nil, errors.New("no maximum idle connections specified")
+	}
+	if parameters.maxConnsPerHost < 0 {
+		return nil, errors.New("maximum connections per host cannot be negative")
+	}
+	if parameters.idleConnTimeout < 0 {
+		return nil, errors.New("idle connection timeout cannot be negative")
+	}
+	if parameters.rateLimit < 0 {
+		return nil, errors.New("rate limit cannot be negative")
+	}
+	if parameters.maxConcurrent < 0 {
+		return nil, errors.New("maximum concurrent requests cannot be negative")
+	}
+	if parameters.circuitThreshold < 0 {
+		return nil, errors.New("circuit breaker threshold cannot be negative")
+	}
+	if parameters.circuitCoolDown <= 0 {
+		return nil, errors.New("no circuit breaker cool down specified")
+	}
+	if parameters.client != nil && parameters.roundTripper != nil {
+		return nil, errors.New("cannot specify both HTTP client and round tripper")
+	}
+	if _, isUnixSocket := unixSocketPath(parameters.address); (isUnixSocket || parameters.dialContext != nil) &&
+		(parameters.client != nil || parameters.roundTripper != nil) {
+		return nil, errors.New("cannot specify dialer with HTTP client or round tripper")
+	}
+	for _, method := range append(append([]string{}, parameters.allowedMethods...), parameters.deniedMethods...) {
+		if !isServiceMethod(method) {
+			return nil, fmt.Errorf("unknown method %s", method)
+		}
+	}
+	if parameters.tlsConfig != nil && (parameters.client != nil || parameters.roundTripper != nil) {
+		return nil, errors.New("cannot specify TLS configuration with HTTP client or round tripper")
+	}
+	authMethods := 0
+	if parameters.authToken != "" {
+		authMethods++
+	}
+	if parameters.basicAuthUser != "" {
+		authMethods++
+	}
+	if parameters.jwtSecret != nil {
+		authMethods++
+	}
+	if authMethods > 1 {
+		return nil, errors.New("cannot specify more than one of auth token, basic auth and JWT secret")
+	}
+	if parameters.jwtSecret != nil && len(parameters.jwtSecret) < minJWTSecretLength {
+		return nil, fmt.Errorf("JWT secret must be at least %d bytes", minJWTSecretLength)
+	}
+	if parameters.basicAuthUser == "" && parameters.basicAuthPassword != "" {
+		return nil, errors.New("no basic auth user specified")
+	}
+
+	if authMethods > 0 {
+		for k := range parameters.extraHeaders {
+			if strings.EqualFold(k, "Authorization") {
+				delete(parameters.extraHeaders, k)
+			}
+		}
+	}
+	switch {
+	case parameters.authToken != "":
+		parameters.extraHeaders["Authorization"] = "Bearer " + parameters.authToken
+	case parameters.basicAuthUser != "":
+		credentials := parameters.basicAuthUser + ":" + parameters.basicAuthPassword
+		parameters.extraHeaders["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+	}
 
 	return &parameters, nil
 }
@@ -16,12 +16,14 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 
 	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -34,7 +36,6 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	dynssz "github.com/pk910/dynamic-ssz"
-	"go.opentelemetry.io/otel"
 )
 
 // Proposal fetches a potential beacon block for signing.
@@ -44,7 +45,7 @@ func (s *Service) Proposal(ctx context.Context,
 	*api.Response[*api.VersionedProposal],
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "Proposal")
+	ctx, span := s.tracer().Start(ctx, "Proposal")
 	defer span.End()
 
 	if err := s.assertIsSynced(ctx); err != nil {
@@ -80,6 +81,9 @@ func (s *Service) Proposal(ctx context.Context,
 	if err != nil {
 		return nil, errors.Join(errors.New("failed to request beacon block proposal"), err)
 	}
+	if err := s.ensureConsensusVersion(ctx, httpResponse, &opts.Slot); err != nil {
+		return nil, err
+	}
 
 	var response *api.Response[*api.VersionedProposal]
 	switch httpResponse.contentType {
@@ -106,9 +110,9 @@ func (s *Service) Proposal(ctx context.Context,
 		)
 	}
 
-	// Only check the RANDAO reveal if we are not connected to DVT middleware,
-	// as the returned values will be decided by the middleware.
-	if !s.connectedToDVTMiddleware {
+	// Only check the RANDAO reveal if the node does not alter it, as is the case
+	// for DVT middleware where the returned values are decided by the middleware.
+	if !s.quirks().AltersProposalRANDAO {
 		blockRandaoReveal, err := response.Data.RandaoReveal()
 		if err != nil {
 			return nil, err
@@ -120,6 +124,7 @@ func (s *Service) Proposal(ctx context.Context,
 			)
 		}
 	}
+	response.Metadata = consensusVersionMetadata(response.Metadata, httpResponse)
 
 	return response, nil
 }
@@ -234,6 +239,22 @@ func (s *Service) beaconBlockProposalFromSSZ(ctx context.Context,
 				err = response.Data.Electra.UnmarshalSSZ(res.body)
 			}
 		}
+	case spec.DataVersionFulu:
+		if response.Data.Blinded {
+			response.Data.FuluBlinded = &apiv1fulu.BlindedBeaconBlock{}
+			if s.customSpecSupport {
+				err = dynSSZ.UnmarshalSSZ(response.Data.FuluBlinded, res.body)
+			} else {
+				err = response.Data.FuluBlinded.UnmarshalSSZ(res.body)
+			}
+		} else {
+			response.Data.Fulu = &apiv1fulu.BlockContents{}
+			if s.customSpecSupport {
+				err = dynSSZ.UnmarshalSSZ(response.Data.Fulu, res.body)
+			} else {
+				err = response.Data.Fulu.UnmarshalSSZ(res.body)
+			}
+		}
 	default:
 		return nil, fmt.Errorf("unhandled block proposal version %s", res.consensusVersion)
 	}
@@ -260,6 +281,7 @@ func (s *Service) beaconBlockProposalFromJSON(res *httpResponse) (*api.Response[
 	if err := s.populateProposalDataFromHeaders(response, res.headers); err != nil {
 		return nil, err
 	}
+	s.populateProposalDataFromBody(response, res.headers, res.body)
 
 	var err error
 	switch res.consensusVersion {
@@ -321,6 +343,18 @@ func (s *Service) beaconBlockProposalFromJSON(res *httpResponse) (*api.Response[
 				&apiv1electra.BlockContents{},
 			)
 		}
+	case spec.DataVersionFulu:
+		if response.Data.Blinded {
+			response.Data.FuluBlinded, response.Metadata, err = decodeJSONResponse(
+				bytes.NewReader(res.body),
+				&apiv1fulu.BlindedBeaconBlock{},
+			)
+		} else {
+			response.Data.Fulu, response.Metadata, err = decodeJSONResponse(
+				bytes.NewReader(res.body),
+				&apiv1fulu.BlockContents{},
+			)
+		}
 	default:
 		err = fmt.Errorf("unsupported version %s", res.consensusVersion)
 	}
@@ -358,3 +392,27 @@ func (*Service) populateProposalDataFromHeaders(response *api.Response[*api.Vers
 
 	return nil
 }
+
+// populateProposalDataFromBody populates the proposal metadata from the JSON
+// body of the response, for servers that do not supply it in the headers.
+func (*Service) populateProposalDataFromBody(response *api.Response[*api.VersionedProposal],
+	headers map[string]string,
+	body []byte,
+) {
+	for k := range headers {
+		if strings.EqualFold(k, "Eth-Execution-Payload-Blinded") {
+			// Headers take precedence.
+			return
+		}
+	}
+
+	metadata := &api.ProposalMetadata{}
+	if err := json.Unmarshal(body, metadata); err != nil {
+		// Metadata is not present in the body either.
+		return
+	}
+
+	response.Data.Blinded = metadata.Blinded
+	response.Data.ExecutionValue = metadata.ExecutionValue
+	response.Data.ConsensusValue = metadata.ConsensusValue
+}
@@ -0,0 +1,76 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulateProposalDataFromBody(t *testing.T) {
+	body := []byte(`{"version":"deneb","execution_payload_blinded":true,"execution_payload_value":"12345","consensus_block_value":"678","data":{}}`)
+
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		body           []byte
+		blinded        bool
+		executionValue *big.Int
+		consensusValue *big.Int
+	}{
+		{
+			name:           "Body",
+			headers:        map[string]string{},
+			body:           body,
+			blinded:        true,
+			executionValue: big.NewInt(12345),
+			consensusValue: big.NewInt(678),
+		},
+		{
+			name: "HeadersPresent",
+			headers: map[string]string{
+				"eth-execution-payload-blinded": "false",
+			},
+			body:           body,
+			executionValue: big.NewInt(0),
+			consensusValue: big.NewInt(0),
+		},
+		{
+			name:           "BodyMissingMetadata",
+			headers:        map[string]string{},
+			body:           []byte(`{"version":"deneb","data":{}}`),
+			executionValue: big.NewInt(0),
+			consensusValue: big.NewInt(0),
+		},
+	}
+
+	s := &Service{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := &api.Response[*api.VersionedProposal]{
+				Data: &api.VersionedProposal{
+					ExecutionValue: big.NewInt(0),
+					ConsensusValue: big.NewInt(0),
+				},
+			}
+			s.populateProposalDataFromBody(response, test.headers, test.body)
+			require.Equal(t, test.blinded, response.Data.Blinded)
+			require.Equal(t, test.executionValue, response.Data.ExecutionValue)
+			require.Equal(t, test.consensusValue, response.Data.ConsensusValue)
+		})
+	}
+}
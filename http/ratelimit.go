@@ -0,0 +1,96 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// rateLimiter spaces requests so that no more than a given number are
+// started each second.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newRateLimiter creates a rate limiter for the given number of requests per second.
+func newRateLimiter(rps float64) *rateLimiter {
+	return &rateLimiter{
+		interval: time.Duration(float64(time.Second) / rps),
+	}
+}
+
+// wait waits until a request can be started, or the context is done.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	r.mu.Lock()
+	now := time.Now()
+	if r.next.Before(now) {
+		r.next = now
+	}
+	slot := r.next
+	delay := slot.Sub(now)
+	r.next = slot.Add(r.interval)
+	r.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// Return the slot as the request will not be made, but only if no
+		// later slot has been handed out; otherwise the schedule is kept.
+		r.mu.Lock()
+		if r.next.Equal(slot.Add(r.interval)) {
+			r.next = slot
+		}
+		r.mu.Unlock()
+
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// acquireRequestSlot waits until a request can be made within the configured
+// rate and concurrency limits.  The returned function must be called once the
+// request has completed; it is safe to call it more than once.
+func (s *Service) acquireRequestSlot(ctx context.Context) (func(), error) {
+	if s.requestSem != nil {
+		if err := s.requestSem.Acquire(ctx, 1); err != nil {
+			return nil, errors.Join(errors.New("failed to obtain request slot"), err)
+		}
+	}
+	release := sync.OnceFunc(func() {
+		if s.requestSem != nil {
+			s.requestSem.Release(1)
+		}
+	})
+
+	if s.rateLimiter != nil {
+		if err := s.rateLimiter.wait(ctx); err != nil {
+			release()
+
+			return nil, errors.Join(errors.New("failed to obtain request slot"), err)
+		}
+	}
+
+	return release, nil
+}
@@ -0,0 +1,198 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	limiter := newRateLimiter(100)
+	started := time.Now()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, limiter.wait(ctx))
+	}
+	// The first request is immediate, and the remainder are spaced 10ms apart.
+	require.GreaterOrEqual(t, time.Since(started), 40*time.Millisecond)
+
+	// A cancelled context returns immediately.
+	limiter = newRateLimiter(0.1)
+	require.NoError(t, limiter.wait(ctx))
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, limiter.wait(cancelledCtx), context.Canceled)
+}
+
+func TestRateLimiterCancelQueued(t *testing.T) {
+	ctx := context.Background()
+
+	limiter := newRateLimiter(10)
+	require.NoError(t, limiter.wait(ctx))
+
+	// Queue two requests, and cancel the first whilst the second is still waiting.
+	firstCtx, cancelFirst := context.WithCancel(ctx)
+	firstErr := make(chan error, 1)
+	go func() {
+		firstErr <- limiter.wait(firstCtx)
+	}()
+	require.Eventually(t, func() bool {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+
+		return time.Until(limiter.next) > 150*time.Millisecond
+	}, time.Second, time.Millisecond)
+	secondErr := make(chan error, 1)
+	go func() {
+		secondErr <- limiter.wait(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+
+		return time.Until(limiter.next) > 250*time.Millisecond
+	}, time.Second, time.Millisecond)
+
+	limiter.mu.Lock()
+	next := limiter.next
+	limiter.mu.Unlock()
+	cancelFirst()
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+
+	// The schedule is unchanged, as the second request holds a later slot.
+	limiter.mu.Lock()
+	require.Equal(t, next, limiter.next)
+	limiter.mu.Unlock()
+	require.NoError(t, <-secondErr)
+
+	// Cancelling the last reservation returns its slot.
+	lastCtx, cancelLast := context.WithCancel(ctx)
+	lastErr := make(chan error, 1)
+	go func() {
+		lastErr <- limiter.wait(lastCtx)
+	}()
+	require.Eventually(t, func() bool {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+
+		return limiter.next.After(next)
+	}, time.Second, time.Millisecond)
+	cancelLast()
+	require.ErrorIs(t, <-lastErr, context.Canceled)
+	limiter.mu.Lock()
+	require.Equal(t, next, limiter.next)
+	limiter.mu.Unlock()
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	ctx := context.Background()
+
+	var inFlight atomic.Int32
+	var maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			highest := maxInFlight.Load()
+			if current <= highest || maxInFlight.CompareAndSwap(highest, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	s := &Service{
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      address.String(),
+		client:       http.DefaultClient,
+		timeout:      timeout,
+		extraHeaders: map[string]string{},
+		requestSem:   semaphore.NewWeighted(2),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.get(ctx, "/eth/v1/node/version", "", &api.CommonOpts{}, false)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), maxInFlight.Load())
+
+	// A queued request honours its context.
+	require.NoError(t, s.requestSem.Acquire(ctx, 2))
+	_, err = s.get(ctx, "/eth/v1/node/version", "", &api.CommonOpts{Timeout: 10 * time.Millisecond}, false)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	s.requestSem.Release(2)
+}
+
+func TestMaxConcurrentRequestsJSONFallback(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("Accept"), "application/octet-stream") {
+			w.WriteHeader(http.StatusNotAcceptable)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	s := &Service{
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      address.String(),
+		client:       http.DefaultClient,
+		timeout:      timeout,
+		extraHeaders: map[string]string{},
+		requestSem:   semaphore.NewWeighted(1),
+	}
+
+	// The fallback to JSON must not wait for the slot held by the SSZ request.
+	res, err := s.get(ctx, "/eth/v1/node/version", "", &api.CommonOpts{Timeout: time.Second}, true)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeJSON, res.contentType)
+
+	// The slot is available once the request has completed.
+	require.True(t, s.requestSem.TryAcquire(1))
+	s.requestSem.Release(1)
+}
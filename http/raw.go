@@ -0,0 +1,115 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// RawGET sends a GET request to the given endpoint, for example
+// "/eth/v1/node/health", and returns the raw response.  The request uses the
+// service's headers, timeouts, metrics and retries, allowing endpoints that are
+// not yet supported by the service to be called.  A response with a non-2xx
+// status is returned as an *api.Error, which contains the status and body.
+func (s *Service) RawGET(ctx context.Context,
+	endpoint string,
+	opts *api.RawGETOpts,
+) (
+	*api.RawResponse,
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if !strings.HasPrefix(endpoint, "/") {
+		return nil, errors.Join(errors.New("endpoint must start with /"), client.ErrInvalidOptions)
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, opts.Query, &opts.Common, opts.SSZ)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(httpResponse), nil
+}
+
+// RawPOST sends a POST request to the given endpoint and returns the raw
+// response, as per RawGET.  POST requests are not retried.
+func (s *Service) RawPOST(ctx context.Context,
+	endpoint string,
+	opts *api.RawPOSTOpts,
+) (
+	*api.RawResponse,
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if !strings.HasPrefix(endpoint, "/") {
+		return nil, errors.Join(errors.New("endpoint must start with /"), client.ErrInvalidOptions)
+	}
+
+	contentType := ContentTypeJSON
+	if opts.ContentType != "" {
+		var err error
+		contentType, err = ParseFromMediaType(opts.ContentType)
+		if err != nil {
+			return nil, errors.Join(errors.New("invalid content type"), client.ErrInvalidOptions, err)
+		}
+	}
+	headers := opts.Headers
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+
+	httpResponse, err := s.post(ctx,
+		endpoint,
+		opts.Query,
+		&opts.Common,
+		bytes.NewReader(opts.Body),
+		contentType,
+		headers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(httpResponse), nil
+}
+
+// rawResponse converts an HTTP response to a raw response.
+func rawResponse(res *httpResponse) *api.RawResponse {
+	rawResponse := &api.RawResponse{
+		StatusCode: res.statusCode,
+		Headers:    res.headers,
+		Body:       res.body,
+	}
+	if len(res.body) > 0 {
+		rawResponse.ContentType = res.contentType.MediaType()
+	}
+
+	return rawResponse
+}
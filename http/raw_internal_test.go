@@ -0,0 +1,115 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func rawTestService(t *testing.T, handler http.HandlerFunc) *Service {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          address.String(),
+		client:           http.DefaultClient,
+		timeout:          time.Second,
+		monitor:          &testRequestMonitor{},
+		extraHeaders:     map[string]string{"X-Service": "service"},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+}
+
+func TestRawGET(t *testing.T) {
+	ctx := context.Background()
+
+	s := rawTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/custom":
+			require.Equal(t, "a=1", r.URL.RawQuery)
+			require.Equal(t, "service", r.Header.Get("X-Service"))
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Custom", "value")
+			_, _ = w.Write([]byte(`{"data":"ok"}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"not found"}`))
+		}
+	})
+
+	_, err := s.RawGET(ctx, "/eth/v1/custom", nil)
+	require.ErrorIs(t, err, client.ErrNoOptions)
+
+	_, err = s.RawGET(ctx, "eth/v1/custom", &api.RawGETOpts{})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+
+	res, err := s.RawGET(ctx, "/eth/v1/custom", &api.RawGETOpts{Query: "a=1"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "application/json", res.ContentType)
+	require.Equal(t, "value", res.Headers["X-Custom"])
+	require.Equal(t, `{"data":"ok"}`, string(res.Body))
+
+	_, err = s.RawGET(ctx, "/eth/v1/missing", &api.RawGETOpts{})
+	var apiErr *api.Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "not found", apiErr.Message)
+}
+
+func TestRawPOST(t *testing.T) {
+	ctx := context.Background()
+
+	s := rawTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+		require.Equal(t, "request", r.Header.Get("X-Request"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x01, 0x02}, body)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := s.RawPOST(ctx, "/eth/v1/custom", &api.RawPOSTOpts{ContentType: "text/plain"})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+
+	res, err := s.RawPOST(ctx, "/eth/v1/custom", &api.RawPOSTOpts{
+		Body:        []byte{0x01, 0x02},
+		ContentType: "application/octet-stream",
+		Headers:     map[string]string{"X-Request": "request"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, res.StatusCode)
+	require.Empty(t, res.Body)
+}
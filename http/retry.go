@@ -0,0 +1,146 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// retryBudget returns the number of times a call can be retried.
+func (s *Service) retryBudget(ctx context.Context) int {
+	if retries, exists := api.RetryBudget(ctx); exists {
+		return retries
+	}
+
+	return s.retries
+}
+
+// maxRetryBackoff is the maximum delay between retries.
+const maxRetryBackoff = 10 * time.Second
+
+// retryDelay returns the delay before the given retry attempt, starting at 1.
+// The delay doubles with each attempt, up to maxRetryBackoff.
+func (s *Service) retryDelay(attempt int) time.Duration {
+	delay := s.retryBackoff
+	for i := 1; i < attempt && delay < maxRetryBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryBackoff {
+		delay = maxRetryBackoff
+	}
+
+	return delay
+}
+
+// isRetryable returns true if the error from a call is transient, and so the
+// call is worth retrying.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		// The caller's context is done, so there is no point retrying.
+		return false
+	}
+
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		// The call timed out, rather than the caller's context.
+		return true
+	}
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// get sends an HTTP get request and returns the response, retrying on
+// transient failures.  Only GET requests are retried, as submissions are
+// not guaranteed to be idempotent.
+func (s *Service) get(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	supportsSSZ bool,
+) (
+	*httpResponse,
+	error,
+) {
+	return s.withRetries(ctx, endpoint, func() (*httpResponse, error) {
+		return s.getOnce(ctx, endpoint, query, opts, supportsSSZ, false)
+	})
+}
+
+// getStream sends an HTTP get request and returns the response, as per get.
+// If the response is SSZ then its body is not read, and is instead available
+// from the response's reader, which the caller must close.
+func (s *Service) getStream(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+) (
+	*httpResponse,
+	error,
+) {
+	return s.withRetries(ctx, endpoint, func() (*httpResponse, error) {
+		return s.getOnce(ctx, endpoint, query, opts, true, true)
+	})
+}
+
+// withRetries carries out a request, retrying on transient failures.
+func (s *Service) withRetries(ctx context.Context,
+	endpoint string,
+	request func() (*httpResponse, error),
+) (
+	*httpResponse,
+	error,
+) {
+	retries := s.retryBudget(ctx)
+	for attempt := 0; ; attempt++ {
+		if err := s.circuitAllow(ctx, endpoint); err != nil {
+			return nil, err
+		}
+		res, err := request()
+		s.circuitRecord(ctx, endpoint, err)
+		if err == nil || attempt >= retries || !isRetryable(ctx, err) {
+			return res, err
+		}
+
+		delay := s.retryDelay(attempt + 1)
+		s.log.Debug().
+			Str("endpoint", endpoint).
+			Int("attempt", attempt+1).
+			Int("retries", retries).
+			Stringer("delay", delay).
+			Err(err).
+			Msg("Transient failure; retrying")
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetries(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		retries  int
+		failures int32
+		status   int
+		post     bool
+		calls    int32
+		err      bool
+	}{
+		{
+			name:     "Disabled",
+			ctx:      ctx,
+			failures: 1,
+			status:   http.StatusServiceUnavailable,
+			calls:    1,
+			err:      true,
+		},
+		{
+			name:     "Recovers",
+			ctx:      ctx,
+			retries:  2,
+			failures: 2,
+			status:   http.StatusBadGateway,
+			calls:    3,
+		},
+		{
+			name:     "Exhausted",
+			ctx:      ctx,
+			retries:  2,
+			failures: 5,
+			status:   http.StatusGatewayTimeout,
+			calls:    3,
+			err:      true,
+		},
+		{
+			name:     "NotTransient",
+			ctx:      ctx,
+			retries:  2,
+			failures: 1,
+			status:   http.StatusInternalServerError,
+			calls:    1,
+			err:      true,
+		},
+		{
+			name:     "Post",
+			ctx:      ctx,
+			retries:  2,
+			failures: 1,
+			status:   http.StatusServiceUnavailable,
+			post:     true,
+			calls:    1,
+			err:      true,
+		},
+		{
+			name:     "ContextBudget",
+			ctx:      api.WithRetryBudget(ctx, 1),
+			retries:  5,
+			failures: 5,
+			status:   http.StatusServiceUnavailable,
+			calls:    2,
+			err:      true,
+		},
+		{
+			name:     "ContextBudgetZero",
+			ctx:      api.WithRetryBudget(ctx, 0),
+			retries:  5,
+			failures: 5,
+			status:   http.StatusServiceUnavailable,
+			calls:    1,
+			err:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if calls.Add(1) <= test.failures {
+					w.WriteHeader(test.status)
+
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      address.String(),
+				client:       http.DefaultClient,
+				timeout:      timeout,
+				extraHeaders: map[string]string{},
+				retries:      test.retries,
+				retryBackoff: time.Millisecond,
+			}
+
+			if test.post {
+				_, err = s.post(test.ctx, "/eth/v1/beacon/pool/attestations", "", &api.CommonOpts{}, bytes.NewReader([]byte("[]")), ContentTypeJSON, map[string]string{})
+			} else {
+				_, err = s.get(test.ctx, "/eth/v1/beacon/states/head/finality_checkpoints", "", &api.CommonOpts{}, false)
+			}
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.calls, calls.Load())
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	s := &Service{
+		retryBackoff: time.Second,
+	}
+
+	require.Equal(t, time.Second, s.retryDelay(1))
+	require.Equal(t, 2*time.Second, s.retryDelay(2))
+	require.Equal(t, 4*time.Second, s.retryDelay(3))
+	require.Equal(t, maxRetryBackoff, s.retryDelay(10))
+}
@@ -0,0 +1,72 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRoundTripper counts the requests that pass through it.
+type countingRoundTripper struct {
+	requests atomic.Int32
+}
+
+func (c *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRoundTripper(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/node/version":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"version":"test"}}`))
+		case "/eth/v1/node/syncing":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false,"is_optimistic":false,"el_offline":false}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	roundTripper := &countingRoundTripper{}
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithAddress(server.URL),
+		WithTimeout(time.Second),
+		WithAllowDelayedStart(true),
+		WithRoundTripper(roundTripper),
+	)
+	require.NoError(t, err)
+	require.Equal(t, roundTripper, s.(*Service).client.Transport)
+	require.Equal(t, roundTripper, s.(*Service).eventsTransport)
+
+	_, err = s.(*Service).NodeVersion(ctx, &api.NodeVersionOpts{})
+	require.NoError(t, err)
+	require.Positive(t, roundTripper.requests.Load())
+}
@@ -15,6 +15,7 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -23,15 +24,19 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/sync/singleflight"
 )
 
 // Service is an Ethereum 2 client service.
@@ -43,6 +48,10 @@ type Service struct {
 	address string
 	client  *http.Client
 	timeout time.Duration
+	monitor metrics.RequestMonitor
+
+	// tracerProvider is the provider of tracers for spans.
+	tracerProvider trace.TracerProvider
 
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
@@ -57,23 +66,63 @@ type Service struct {
 	nodeVersion          string
 	nodeVersionMutex     sync.RWMutex
 
+	// Methods supported by the node, cached alongside the static values.
+	// A failure to probe the node is also cached, until supportedMethodsRetry.
+	supportedMethods      *api.SupportedMethods
+	supportedMethodsErr   error
+	supportedMethodsRetry time.Time
+	supportedMethodsMutex sync.RWMutex
+	supportedMethodsProbe singleflight.Group
+
 	// User-specified chunk sizes.
 	userIndexChunkSize  int
 	userPubKeyChunkSize int
+	validatorsChunkSize int
 	extraHeaders        map[string]string
+	jwtSecret           []byte
 
 	// Connection support.
 	hooks *Hooks
 
+	// Retries of GET requests.
+	retries      int
+	retryBackoff time.Duration
+
+	// Request limiting.
+	rateLimiter *rateLimiter
+	requestSem  *semaphore.Weighted
+
+	// Circuit breaker, if enabled.
+	circuitBreaker *circuitBreaker
+
+	// Events stream transport, if supplied by the user.
+	eventsTransport http.RoundTripper
+
+	// TLS configuration, if supplied by the user.
+	tlsConfig *tls.Config
+
+	// Known quirks of the node's implementation.
+	clientQuirks atomic.Pointer[apiv1.ClientQuirks]
+
+	// Method restrictions, if supplied by the user.
+	allowedMethods map[string]struct{}
+	deniedMethods  map[string]struct{}
+
+	// Events stream reconnection.
+	eventsReconnectInitialDelay time.Duration
+	eventsReconnectMaxDelay     time.Duration
+
 	// Endpoint support.
-	pingSem                  *semaphore.Weighted
-	connectionMu             sync.RWMutex
-	connectionActive         bool
-	connectionSynced         bool
-	enforceJSON              bool
-	connectedToDVTMiddleware bool
-	reducedMemoryUsage       bool
-	customSpecSupport        bool
+	pingSem             *semaphore.Weighted
+	connectionMu        sync.RWMutex
+	connectionActive    bool
+	connectionSynced    bool
+	enforceJSON         bool
+	preferSSZ           bool
+	preferSSZSubmission bool
+	compression         bool
+	reducedMemoryUsage  bool
+	customSpecSupport   bool
 }
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
@@ -95,42 +144,119 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 		}
 	}
 
+	address := parameters.address
+	dialContext := parameters.dialContext
+	socketPath, isUnixSocket := unixSocketPath(address)
+	if isUnixSocket {
+		// Requests are sent to a placeholder host, with the dialer connecting to the socket.
+		address = "http://localhost"
+		dialContext = unixSocketDialer(socketPath, parameters.timeout)
+	}
+	customDialer := dialContext != nil
+	var tlsConfig *tls.Config
+	if parameters.tlsConfig != nil {
+		tlsConfig = parameters.tlsConfig.Clone()
+	}
+	if !customDialer {
+		dialContext = (&net.Dialer{
+			Timeout:   parameters.timeout,
+			KeepAlive: 30 * time.Second,
+			DualStack: true,
+		}).DialContext
+	}
+
 	httpClient := parameters.client
-	if httpClient == nil {
+	switch {
+	case httpClient != nil:
+		// Use the supplied client as-is.
+	case parameters.roundTripper != nil:
+		httpClient = &http.Client{
+			Transport: parameters.roundTripper,
+		}
+	default:
 		httpClient = &http.Client{
 			Transport: &http.Transport{
-				DialContext: (&net.Dialer{
-					Timeout:   parameters.timeout,
-					KeepAlive: 30 * time.Second,
-					DualStack: true,
-				}).DialContext,
-				MaxIdleConns:        64,
-				MaxConnsPerHost:     64,
-				MaxIdleConnsPerHost: 64,
-				IdleConnTimeout:     600 * time.Second,
+				DialContext:         dialContext,
+				TLSClientConfig:     tlsConfig,
+				MaxIdleConns:        parameters.maxIdleConns,
+				MaxConnsPerHost:     parameters.maxConnsPerHost,
+				MaxIdleConnsPerHost: parameters.maxIdleConns,
+				IdleConnTimeout:     parameters.idleConnTimeout,
+				ForceAttemptHTTP2:   parameters.http2,
 			},
 		}
 	}
+	// Events streams use their own transport unless the user has supplied one.
+	var eventsTransport http.RoundTripper
+	if parameters.client != nil || parameters.roundTripper != nil {
+		eventsTransport = httpClient.Transport
+	}
+	if customDialer {
+		eventsTransport = &http.Transport{
+			DialContext:     dialContext,
+			TLSClientConfig: tlsConfig,
+		}
+	}
 
-	base, address, err := parseAddress(parameters.address)
+	base, maskedAddress, err := parseAddress(address)
 	if err != nil {
 		return nil, err
 	}
+	displayAddress := maskedAddress.String()
+	if isUnixSocket {
+		displayAddress = parameters.address
+	}
+
+	var limiter *rateLimiter
+	if parameters.rateLimit > 0 {
+		limiter = newRateLimiter(parameters.rateLimit)
+	}
+	var requestSem *semaphore.Weighted
+	if parameters.maxConcurrent > 0 {
+		requestSem = semaphore.NewWeighted(int64(parameters.maxConcurrent))
+	}
+
+	var breaker *circuitBreaker
+	if parameters.circuitThreshold > 0 {
+		breaker = newCircuitBreaker(parameters.circuitThreshold, parameters.circuitCoolDown)
+	}
 
 	s := &Service{
 		log:                 log,
 		base:                base,
-		address:             address.String(),
+		address:             displayAddress,
 		client:              httpClient,
 		timeout:             parameters.timeout,
 		userIndexChunkSize:  parameters.indexChunkSize,
 		userPubKeyChunkSize: parameters.pubKeyChunkSize,
+		validatorsChunkSize: parameters.validatorsChunkSize,
 		extraHeaders:        parameters.extraHeaders,
+		jwtSecret:           parameters.jwtSecret,
 		enforceJSON:         parameters.enforceJSON,
+		preferSSZ:           parameters.preferSSZ,
+		preferSSZSubmission: parameters.preferSSZSubmission,
+		compression:         parameters.compression,
 		pingSem:             semaphore.NewWeighted(1),
 		hooks:               parameters.hooks,
 		reducedMemoryUsage:  parameters.reducedMemoryUsage,
 		customSpecSupport:   parameters.customSpecSupport,
+		tracerProvider:      parameters.tracerProvider,
+		eventsTransport:     eventsTransport,
+		tlsConfig:           tlsConfig,
+		allowedMethods:      methodSet(parameters.allowedMethods),
+		deniedMethods:       methodSet(parameters.deniedMethods),
+		retries:             parameters.retries,
+		retryBackoff:        parameters.retryBackoff,
+		rateLimiter:         limiter,
+		requestSem:          requestSem,
+		circuitBreaker:      breaker,
+
+		eventsReconnectInitialDelay: parameters.eventsReconnectInitialDelay,
+		eventsReconnectMaxDelay:     parameters.eventsReconnectMaxDelay,
+	}
+
+	if monitor, isMonitor := parameters.monitor.(metrics.RequestMonitor); isMonitor {
+		s.monitor = monitor
 	}
 
 	// Ping the client to see if it is ready to serve requests.
@@ -215,23 +341,34 @@ func (s *Service) clearStaticValues() {
 	s.nodeVersionMutex.Lock()
 	s.nodeVersion = ""
 	s.nodeVersionMutex.Unlock()
+	s.supportedMethodsMutex.Lock()
+	s.supportedMethods = nil
+	s.supportedMethodsErr = nil
+	s.supportedMethodsMutex.Unlock()
 }
 
-// checkDVT checks if connected to DVT middleware and sets
-// internal flags appropriately.
-func (s *Service) checkDVT(ctx context.Context) error {
+// checkClientInfo obtains information about the implementation of the node,
+// enabling workarounds for any known quirks.
+func (s *Service) checkClientInfo(ctx context.Context) error {
 	response, err := s.NodeVersion(ctx, &api.NodeVersionOpts{})
 	if err != nil {
-		return errors.Join(errors.New("failed to obtain node version for DVT check"), err)
+		return errors.Join(errors.New("failed to obtain node version for client check"), err)
 	}
 
-	version := strings.ToLower(response.Data)
+	info := apiv1.ParseClientInfo(response.Data)
+	s.clientQuirks.Store(&info.Quirks)
 
-	if strings.Contains(version, "charon") {
-		s.connectedToDVTMiddleware = true
+	return nil
+}
+
+// quirks returns the known quirks of the node's implementation.
+func (s *Service) quirks() *apiv1.ClientQuirks {
+	quirks := s.clientQuirks.Load()
+	if quirks == nil {
+		return &apiv1.ClientQuirks{}
 	}
 
-	return nil
+	return quirks
 }
 
 // Name provides the name of the service.
@@ -283,9 +420,9 @@ func (s *Service) CheckConnectionState(ctx context.Context) {
 	if !wasActive && active {
 		// Switched from not active to active.
 
-		// Check connection to DVT middleware.
-		if err := s.checkDVT(ctx); err != nil {
-			log.Error().Err(err).Msg("Failed to check DVT connection on client activation; returning to inactive")
+		// Check the implementation of the node for quirks.
+		if err := s.checkClientInfo(ctx); err != nil {
+			log.Error().Err(err).Msg("Failed to check client on activation; returning to inactive")
 			active = false
 		}
 	}
@@ -360,6 +497,10 @@ func (s *Service) IsSynced() bool {
 }
 
 func (s *Service) assertIsActive(ctx context.Context) error {
+	if err := s.assertMethodAllowed(); err != nil {
+		return err
+	}
+
 	active := s.IsActive()
 	if active {
 		return nil
@@ -375,6 +516,10 @@ func (s *Service) assertIsActive(ctx context.Context) error {
 }
 
 func (s *Service) assertIsSynced(ctx context.Context) error {
+	if err := s.assertMethodAllowed(); err != nil {
+		return err
+	}
+
 	synced := s.IsSynced()
 	if synced {
 		return nil
@@ -394,6 +539,11 @@ func (s *Service) assertIsSynced(ctx context.Context) error {
 	return nil
 }
 
+// submitSSZ returns true if block submissions should be sent as SSZ.
+func (s *Service) submitSSZ() bool {
+	return s.preferSSZSubmission && !s.enforceJSON
+}
+
 func parseAddress(address string) (*url.URL, *url.URL, error) {
 	if !strings.HasPrefix(address, "http") {
 		address = fmt.Sprintf("http://%s", address)
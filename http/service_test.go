@@ -15,6 +15,9 @@ package http_test
 
 import (
 	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -80,6 +83,15 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters\nno public key chunk size specified",
 		},
+		{
+			name: "ValidatorsChunkSizeZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithValidatorsChunkSize(0),
+			},
+			err: "problem with parameters\nvalidators chunk size must be positive",
+		},
 		{
 			name: "HooksMissing",
 			parameters: []v1.Parameter{
@@ -89,6 +101,162 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters\nno hooks specified",
 		},
+		{
+			name: "RetriesNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithRetries(-1),
+			},
+			err: "problem with parameters\nretries cannot be negative",
+		},
+		{
+			name: "MaxIdleConnsZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxIdleConns(0),
+			},
+			err: "problem with parameters\nno maximum idle connections specified",
+		},
+		{
+			name: "MaxConnsPerHostNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxConnsPerHost(-1),
+			},
+			err: "problem with parameters\nmaximum connections per host cannot be negative",
+		},
+		{
+			name: "IdleConnTimeoutNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithIdleConnTimeout(-1),
+			},
+			err: "problem with parameters\nidle connection timeout cannot be negative",
+		},
+		{
+			name: "RateLimitNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithRateLimit(-1),
+			},
+			err: "problem with parameters\nrate limit cannot be negative",
+		},
+		{
+			name: "MaxConcurrentRequestsNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxConcurrentRequests(-1),
+			},
+			err: "problem with parameters\nmaximum concurrent requests cannot be negative",
+		},
+		{
+			name: "CircuitBreakerThresholdNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithCircuitBreakerThreshold(-1),
+			},
+			err: "problem with parameters\ncircuit breaker threshold cannot be negative",
+		},
+		{
+			name: "CircuitBreakerCoolDownZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithCircuitBreakerCoolDown(0),
+			},
+			err: "problem with parameters\nno circuit breaker cool down specified",
+		},
+		{
+			name: "ClientAndRoundTripper",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithHTTPClient(&http.Client{}),
+				v1.WithRoundTripper(http.DefaultTransport),
+			},
+			err: "problem with parameters\ncannot specify both HTTP client and round tripper",
+		},
+		{
+			name: "AuthTokenAndBasicAuth",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithAuthToken("token"),
+				v1.WithBasicAuth("user", "pass"),
+			},
+			err: "problem with parameters\ncannot specify more than one of auth token, basic auth and JWT secret",
+		},
+		{
+			name: "BasicAuthUserMissing",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithBasicAuth("", "pass"),
+			},
+			err: "problem with parameters\nno basic auth user specified",
+		},
+		{
+			name: "JWTSecretShort",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithJWTSecret([]byte{0x01}),
+			},
+			err: "problem with parameters\nJWT secret must be at least 32 bytes",
+		},
+		{
+			name: "UnixSocketPathMissing",
+			parameters: []v1.Parameter{
+				v1.WithAddress("unix://"),
+				v1.WithTimeout(5 * time.Second),
+			},
+			err: "problem with parameters\nno unix socket path specified",
+		},
+		{
+			name: "UnixSocketAndDialer",
+			parameters: []v1.Parameter{
+				v1.WithAddress("unix:///var/run/beacon.sock"),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithDialContext((&net.Dialer{}).DialContext),
+			},
+			err: "problem with parameters\ncannot specify both unix socket address and dialer",
+		},
+		{
+			name: "DialerAndRoundTripper",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithDialContext((&net.Dialer{}).DialContext),
+				v1.WithRoundTripper(http.DefaultTransport),
+			},
+			err: "problem with parameters\ncannot specify dialer with HTTP client or round tripper",
+		},
+		{
+			name: "TLSConfigAndClient",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}),
+				v1.WithHTTPClient(&http.Client{}),
+			},
+			err: "problem with parameters\ncannot specify TLS configuration with HTTP client or round tripper",
+		},
+		{
+			name: "MethodUnknown",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithDeniedMethods([]string{"Unknown"}),
+			},
+			err: "problem with parameters\nunknown method Unknown",
+		},
 		{
 			name: "Good",
 			parameters: []v1.Parameter{
@@ -97,6 +265,18 @@ func TestService(t *testing.T) {
 				v1.WithAllowDelayedStart(true),
 			},
 		},
+		{
+			name: "ConnectionPool",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithAllowDelayedStart(true),
+				v1.WithMaxIdleConns(256),
+				v1.WithMaxConnsPerHost(0),
+				v1.WithIdleConnTimeout(time.Minute),
+				v1.WithHTTP2(true),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -123,41 +303,77 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttestationDataProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationPoolProvider)(nil), s)
+	assert.Implements(t, (*client.AttestationRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingSubmitter)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingsSubmitter)(nil), s)
+	assert.Implements(t, (*client.BLSToExecutionChangePoolProvider)(nil), s)
 	assert.Implements(t, (*client.BLSToExecutionChangesSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BeaconBlockWithOptsSubmitter)(nil), s)
+	assert.Implements(t, (*client.BeaconCommitteeSelectionsProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconStateProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconStateDownloader)(nil), s)
 	assert.Implements(t, (*client.BeaconStateRandaoProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateRootProvider)(nil), s)
 	assert.Implements(t, (*client.BlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BlobSidecarsProvider)(nil), s)
+	assert.Implements(t, (*client.BlockRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.DepositContractProvider)(nil), s)
+	assert.Implements(t, (*client.DepositSnapshotProvider)(nil), s)
 	assert.Implements(t, (*client.EventsProvider)(nil), s)
+	assert.Implements(t, (*client.EventsWithHandlersProvider)(nil), s)
+	assert.Implements(t, (*client.ExpectedWithdrawalsProvider)(nil), s)
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
+	assert.Implements(t, (*client.ForkChoiceProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
+	assert.Implements(t, (*client.InclusionListProvider)(nil), s)
+	assert.Implements(t, (*client.InclusionListDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.InclusionListsSubmitter)(nil), s)
+	assert.Implements(t, (*client.NodeIdentityProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeerProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeerCountProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeersProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalProvider)(nil), s)
+	assert.Implements(t, (*client.ProposalSlashingSubmitter)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerSlashingPoolProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeMessagesSubmitter)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeSelectionsProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorIdentitiesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorLivenessProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorsIteratorProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedBlobSidecarsProvider)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitPoolProvider)(nil), s)
 
 	// Non-standard extensions.
+	assert.Implements(t, (*client.ClientInfoProvider)(nil), s)
 	assert.Implements(t, (*client.DomainProvider)(nil), s)
+	assert.Implements(t, (*client.SupportedMethodsProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
+
+	// Composite interfaces.
+	assert.Implements(t, (*client.IndexerRequirements)(nil), s)
+	assert.Implements(t, (*client.MEVRequirements)(nil), s)
+	assert.Implements(t, (*client.ValidatorClientRequirements)(nil), s)
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -54,6 +55,9 @@ func (s *Service) SignedBeaconBlock(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err := s.ensureConsensusVersion(ctx, httpResponse, slotFromID(opts.Block)); err != nil {
+		return nil, err
+	}
 
 	var response *api.Response[*spec.VersionedSignedBeaconBlock]
 	switch httpResponse.contentType {
@@ -67,6 +71,7 @@ func (s *Service) SignedBeaconBlock(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	response.Metadata = consensusVersionMetadata(response.Metadata, httpResponse)
 
 	return response, nil
 }
@@ -156,6 +161,16 @@ func (s *Service) signedBeaconBlockFromSSZ(ctx context.Context,
 		if err != nil {
 			return nil, errors.Join(errors.New("failed to decode electra signed block contents"), err)
 		}
+	case spec.DataVersionFulu:
+		response.Data.Fulu = &fulu.SignedBeaconBlock{}
+		if s.customSpecSupport {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Fulu, res.body)
+		} else {
+			err = response.Data.Fulu.UnmarshalSSZ(res.body)
+		}
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode fulu signed block contents"), err)
+		}
 	default:
 		return nil, fmt.Errorf("unhandled block version %s", res.consensusVersion)
 	}
@@ -196,6 +211,10 @@ func (*Service) signedBeaconBlockFromJSON(res *httpResponse) (*api.Response[*spe
 		response.Data.Electra, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
 			&electra.SignedBeaconBlock{},
 		)
+	case spec.DataVersionFulu:
+		response.Data.Fulu, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&fulu.SignedBeaconBlock{},
+		)
 	default:
 		return nil, fmt.Errorf("unhandled version %s", res.consensusVersion)
 	}
@@ -52,14 +52,15 @@ func (s *Service) SubmitAggregateAttestations(ctx context.Context, opts *api.Sub
 
 	headers := make(map[string]string)
 	headers["Eth-Consensus-Version"] = strings.ToLower(aggregateAndProofs[0].Version.String())
-	if _, err = s.post(ctx,
+	res, err := s.post(ctx,
 		endpoint,
 		query,
 		&opts.Common,
 		bytes.NewReader(specJSON),
 		ContentTypeJSON,
 		headers,
-	); err != nil {
+	)
+	if err := indexedSubmissionError(res, err, nil); err != nil {
 		return errors.Join(errors.New("failed to submit versioned aggregate and proofs"), err)
 	}
 
@@ -96,6 +97,8 @@ func createUnversionedAggregates(aggregateAndProofs []*spec.VersionedSignedAggre
 			unversionedAggregates = append(unversionedAggregates, aggregateAndProofs[i].Deneb)
 		case spec.DataVersionElectra:
 			unversionedAggregates = append(unversionedAggregates, aggregateAndProofs[i].Electra)
+		case spec.DataVersionFulu:
+			unversionedAggregates = append(unversionedAggregates, aggregateAndProofs[i].Fulu)
 		default:
 			return nil, errors.Join(errors.New("unknown aggregate and proof version"), client.ErrInvalidOptions)
 		}
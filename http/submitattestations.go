@@ -37,7 +37,7 @@ func (s *Service) SubmitAttestations(ctx context.Context, opts *api.SubmitAttest
 		return errors.Join(errors.New("no attestations supplied"), client.ErrInvalidOptions)
 	}
 	attestations := opts.Attestations
-	unversionedAttestations, err := s.createUnversionedAttestations(attestations)
+	unversionedAttestations, indices, version, err := s.createUnversionedAttestations(ctx, attestations)
 	if err != nil {
 		return err
 	}
@@ -51,51 +51,95 @@ func (s *Service) SubmitAttestations(ctx context.Context, opts *api.SubmitAttest
 	query := ""
 
 	headers := make(map[string]string)
-	headers["Eth-Consensus-Version"] = strings.ToLower(attestations[0].Version.String())
-	if _, err = s.post(ctx,
+	headers["Eth-Consensus-Version"] = strings.ToLower(version.String())
+	res, err := s.post(ctx,
 		endpoint,
 		query,
 		&opts.Common,
 		bytes.NewReader(specJSON),
 		ContentTypeJSON,
 		headers,
-	); err != nil {
+	)
+	if err := indexedSubmissionError(res, err, indices); err != nil {
 		return errors.Join(errors.New("failed to submit versioned beacon attestations"), err)
 	}
 
 	return nil
 }
 
-func (s *Service) createUnversionedAttestations(attestations []*spec.VersionedAttestation) ([]any, error) {
+// createUnversionedAttestations returns the unversioned attestations to submit, along
+// with the index in the supplied attestations of each of them and the version at which
+// they are submitted.  Attestations from before Electra that are for a slot at or after
+// the Electra fork are converted to single attestations, to support callers that have
+// not migrated.
+func (s *Service) createUnversionedAttestations(ctx context.Context,
+	attestations []*spec.VersionedAttestation,
+) (
+	[]any,
+	[]int,
+	spec.DataVersion,
+	error,
+) {
 	var version spec.DataVersion
+	var submissionVersion spec.DataVersion
+	var forks *forkVersions
 	var unversionedAttestations []any
+	var indices []int
 
 	for i := range attestations {
 		if attestations[i] == nil {
-			return nil, errors.Join(errors.New("nil attestation version supplied"), client.ErrInvalidOptions)
+			return nil, nil, spec.DataVersionUnknown, errors.Join(errors.New("nil attestation version supplied"), client.ErrInvalidOptions)
 		}
 
 		// Ensure consistent versioning.
 		if version == spec.DataVersionUnknown {
 			version = attestations[i].Version
 		} else if version != attestations[i].Version {
-			return nil, errors.Join(errors.New("attestations must all be of the same version"), client.ErrInvalidOptions)
+			return nil, nil, spec.DataVersionUnknown, errors.Join(errors.New("attestations must all be of the same version"), client.ErrInvalidOptions)
 		}
 
 		// Append to unversionedAttestations.
 		switch attestations[i].Version {
-		case spec.DataVersionPhase0:
-			unversionedAttestations = append(unversionedAttestations, attestations[i].Phase0)
-		case spec.DataVersionAltair:
-			unversionedAttestations = append(unversionedAttestations, attestations[i].Altair)
-		case spec.DataVersionBellatrix:
-			unversionedAttestations = append(unversionedAttestations, attestations[i].Bellatrix)
-		case spec.DataVersionCapella:
-			unversionedAttestations = append(unversionedAttestations, attestations[i].Capella)
-		case spec.DataVersionDeneb:
-			unversionedAttestations = append(unversionedAttestations, attestations[i].Deneb)
-		case spec.DataVersionElectra:
-			singleAttestation, err := attestations[i].Electra.ToSingleAttestation(attestations[i].ValidatorIndex)
+		case spec.DataVersionPhase0,
+			spec.DataVersionAltair,
+			spec.DataVersionBellatrix,
+			spec.DataVersionCapella,
+			spec.DataVersionDeneb:
+			if forks == nil {
+				var err error
+				forks, err = s.forkVersions(ctx)
+				if err != nil {
+					return nil, nil, spec.DataVersionUnknown, err
+				}
+			}
+			data, err := attestations[i].Data()
+			if err != nil || data == nil {
+				return nil, nil, spec.DataVersionUnknown, errors.Join(errors.New("attestation missing data"), client.ErrInvalidOptions)
+			}
+			slotVersion := forks.versionAtSlot(data.Slot)
+			if slotVersion < spec.DataVersionElectra {
+				slotVersion = attestations[i].Version
+			}
+			if submissionVersion == spec.DataVersionUnknown {
+				submissionVersion = slotVersion
+			} else if submissionVersion != slotVersion {
+				return nil, nil, spec.DataVersionUnknown, errors.Join(errors.New("attestations must all be for the same fork"), client.ErrInvalidOptions)
+			}
+			if slotVersion < spec.DataVersionElectra {
+				unversionedAttestations = append(unversionedAttestations, unversionedLegacyAttestation(attestations[i]))
+
+				break
+			}
+			singleAttestation, err := attestations[i].ToSingleAttestation()
+			if err != nil {
+				s.log.Warn().Err(err).Msg("Failed to convert legacy attestation to single attestation")
+
+				continue
+			}
+			unversionedAttestations = append(unversionedAttestations, singleAttestation)
+		case spec.DataVersionElectra, spec.DataVersionFulu:
+			submissionVersion = version
+			singleAttestation, err := attestations[i].ToSingleAttestation()
 			if err != nil {
 				s.log.Warn().Err(err).Msg("Failed to convert attestation to single attestation")
 
@@ -103,9 +147,26 @@ func (s *Service) createUnversionedAttestations(attestations []*spec.VersionedAt
 			}
 			unversionedAttestations = append(unversionedAttestations, singleAttestation)
 		default:
-			return nil, errors.Join(errors.New("unknown attestation version"), client.ErrInvalidOptions)
+			return nil, nil, spec.DataVersionUnknown, errors.Join(errors.New("unknown attestation version"), client.ErrInvalidOptions)
 		}
+		indices = append(indices, i)
 	}
 
-	return unversionedAttestations, nil
+	return unversionedAttestations, indices, submissionVersion, nil
+}
+
+// unversionedLegacyAttestation returns the attestation from before Electra.
+func unversionedLegacyAttestation(attestation *spec.VersionedAttestation) any {
+	switch attestation.Version {
+	case spec.DataVersionAltair:
+		return attestation.Altair
+	case spec.DataVersionBellatrix:
+		return attestation.Bellatrix
+	case spec.DataVersionCapella:
+		return attestation.Capella
+	case spec.DataVersionDeneb:
+		return attestation.Deneb
+	default:
+		return attestation.Phase0
+	}
 }
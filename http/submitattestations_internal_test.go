@@ -0,0 +1,138 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitLegacyAttestations(t *testing.T) {
+	ctx := context.Background()
+
+	validatorIndex := phase0.ValidatorIndex(12)
+	legacyAttestation := func(slot phase0.Slot) *spec.VersionedAttestation {
+		aggregationBits := bitfield.NewBitlist(8)
+		aggregationBits.SetBitAt(3, true)
+
+		return &spec.VersionedAttestation{
+			Version:        spec.DataVersionDeneb,
+			ValidatorIndex: &validatorIndex,
+			Deneb: &phase0.Attestation{
+				AggregationBits: aggregationBits,
+				Data: &phase0.AttestationData{
+					Slot:   slot,
+					Index:  5,
+					Source: &phase0.Checkpoint{},
+					Target: &phase0.Checkpoint{},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		attestations []*spec.VersionedAttestation
+		version      string
+		fields       []string
+		err          string
+	}{
+		{
+			name:         "PreElectra",
+			attestations: []*spec.VersionedAttestation{legacyAttestation(31)},
+			version:      "deneb",
+			fields:       []string{"aggregation_bits", "data", "signature"},
+		},
+		{
+			name:         "PostElectra",
+			attestations: []*spec.VersionedAttestation{legacyAttestation(32)},
+			version:      "electra",
+			fields:       []string{"attester_index", "committee_index", "data", "signature"},
+		},
+		{
+			name:         "MixedForks",
+			attestations: []*spec.VersionedAttestation{legacyAttestation(31), legacyAttestation(32)},
+			err:          "attestations must all be for the same fork",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var version string
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				version = r.Header.Get("Eth-Consensus-Version")
+				var err error
+				body, err = io.ReadAll(r.Body)
+				require.NoError(t, err)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:              zerolog.Nop(),
+				base:             base,
+				address:          address.String(),
+				client:           http.DefaultClient,
+				timeout:          timeout,
+				extraHeaders:     map[string]string{},
+				connectionActive: true,
+				connectionSynced: true,
+				spec: map[string]any{
+					"SLOTS_PER_EPOCH":    uint64(32),
+					"DENEB_FORK_EPOCH":   uint64(0),
+					"ELECTRA_FORK_EPOCH": uint64(1),
+				},
+			}
+
+			err = s.SubmitAttestations(ctx, &api.SubmitAttestationsOpts{
+				Attestations: test.attestations,
+			})
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.version, version)
+
+			var submitted []map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(body, &submitted))
+			require.Len(t, submitted, 1)
+			fields := make([]string, 0, len(submitted[0]))
+			for field := range submitted[0] {
+				fields = append(fields, field)
+			}
+			require.ElementsMatch(t, test.fields, fields)
+			if test.version == "electra" {
+				require.Equal(t, `"5"`, string(submitted[0]["committee_index"]))
+				require.Equal(t, `"12"`, string(submitted[0]["attester_index"]))
+			}
+		})
+	}
+}
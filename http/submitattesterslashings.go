@@ -0,0 +1,117 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// SubmitAttesterSlashings submits versioned attester slashings.
+func (s *Service) SubmitAttesterSlashings(ctx context.Context, opts *api.SubmitAttesterSlashingsOpts) error {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return err
+	}
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+	if len(opts.AttesterSlashings) == 0 {
+		return errors.Join(errors.New("no attester slashings supplied"), client.ErrInvalidOptions)
+	}
+
+	// The endpoint takes a single slashing, so submit them individually.
+	for i, slashing := range opts.AttesterSlashings {
+		unversionedSlashing, err := unversionedAttesterSlashing(slashing)
+		if err != nil {
+			return errors.Join(fmt.Errorf("invalid attester slashing %d", i), err)
+		}
+
+		specJSON, err := json.Marshal(unversionedSlashing)
+		if err != nil {
+			return errors.Join(errors.New("failed to marshal JSON"), err)
+		}
+
+		endpoint := "/eth/v2/beacon/pool/attester_slashings"
+		query := ""
+
+		headers := make(map[string]string)
+		headers["Eth-Consensus-Version"] = strings.ToLower(slashing.Version.String())
+		if _, err := s.post(ctx,
+			endpoint,
+			query,
+			&opts.Common,
+			bytes.NewReader(specJSON),
+			ContentTypeJSON,
+			headers,
+		); err != nil {
+			return errors.Join(errors.New("failed to submit attester slashing"), err)
+		}
+	}
+
+	return nil
+}
+
+// unversionedAttesterSlashing returns the data of a versioned attester slashing.
+func unversionedAttesterSlashing(slashing *spec.VersionedAttesterSlashing) (any, error) {
+	if slashing == nil {
+		return nil, errors.Join(errors.New("nil attester slashing supplied"), client.ErrInvalidOptions)
+	}
+
+	var res any
+	switch slashing.Version {
+	case spec.DataVersionPhase0:
+		if slashing.Phase0 != nil {
+			res = slashing.Phase0
+		}
+	case spec.DataVersionAltair:
+		if slashing.Altair != nil {
+			res = slashing.Altair
+		}
+	case spec.DataVersionBellatrix:
+		if slashing.Bellatrix != nil {
+			res = slashing.Bellatrix
+		}
+	case spec.DataVersionCapella:
+		if slashing.Capella != nil {
+			res = slashing.Capella
+		}
+	case spec.DataVersionDeneb:
+		if slashing.Deneb != nil {
+			res = slashing.Deneb
+		}
+	case spec.DataVersionElectra:
+		if slashing.Electra != nil {
+			res = slashing.Electra
+		}
+	case spec.DataVersionFulu:
+		if slashing.Fulu != nil {
+			res = slashing.Fulu
+		}
+	default:
+		return nil, errors.Join(errors.New("unknown attester slashing version"), client.ErrInvalidOptions)
+	}
+	if res == nil {
+		return nil, errors.Join(fmt.Errorf("no %s attester slashing supplied", slashing.Version), client.ErrInvalidOptions)
+	}
+
+	return res, nil
+}
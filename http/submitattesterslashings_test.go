@@ -0,0 +1,80 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitAttesterSlashings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		opts *api.SubmitAttesterSlashingsOpts
+		err  string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "Empty",
+			opts: &api.SubmitAttesterSlashingsOpts{},
+			err:  "no attester slashings supplied",
+		},
+		{
+			name: "NilSlashing",
+			opts: &api.SubmitAttesterSlashingsOpts{
+				AttesterSlashings: []*spec.VersionedAttesterSlashing{nil},
+			},
+			err: "nil attester slashing supplied",
+		},
+		{
+			name: "DataMissing",
+			opts: &api.SubmitAttesterSlashingsOpts{
+				AttesterSlashings: []*spec.VersionedAttesterSlashing{
+					{
+						Version: spec.DataVersionElectra,
+						Deneb:   &phase0.AttesterSlashing{},
+					},
+				},
+			},
+			err: "no electra attester slashing supplied",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := service.(client.AttesterSlashingsSubmitter).SubmitAttesterSlashings(ctx, test.opts)
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -32,13 +33,72 @@ func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSi
 		return err
 	}
 
-	var specJSON []byte
-	var err error
-
 	if block == nil {
 		return errors.Join(errors.New("no block supplied"), client.ErrInvalidOptions)
 	}
 
+	if err := s.submitBeaconBlock(ctx, "/eth/v1/beacon/blocks", "", &api.CommonOpts{}, block); err != nil {
+		return errors.Join(errors.New("failed to submit beacon block"), err)
+	}
+
+	return nil
+}
+
+// SubmitBeaconBlockWithOpts submits a beacon block with the given options.
+func (s *Service) SubmitBeaconBlockWithOpts(ctx context.Context, opts *api.SubmitBeaconBlockOpts) error {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return err
+	}
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+	if opts.Block == nil {
+		return errors.Join(errors.New("no block supplied"), client.ErrInvalidOptions)
+	}
+
+	query := ""
+	if opts.BroadcastValidation != nil {
+		query = "broadcast_validation=" + opts.BroadcastValidation.String()
+	}
+
+	if err := s.submitBeaconBlock(ctx, "/eth/v2/beacon/blocks", query, &opts.Common, opts.Block); err != nil {
+		return errors.Join(errors.New("failed to submit beacon block"), err)
+	}
+
+	return nil
+}
+
+func (s *Service) submitBeaconBlock(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	block *spec.VersionedSignedBeaconBlock,
+) error {
+	var body []byte
+	var contentType ContentType
+	var err error
+	if s.submitSSZ() {
+		contentType = ContentTypeSSZ
+		body, err = beaconBlockSSZ(block)
+	} else {
+		contentType = ContentTypeJSON
+		body, err = beaconBlockJSON(block)
+	}
+	if err != nil {
+		return err
+	}
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = strings.ToLower(block.Version.String())
+	_, err = s.post(ctx, endpoint, query, opts, bytes.NewReader(body), contentType, headers)
+
+	return err
+}
+
+func beaconBlockJSON(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	var specJSON []byte
+	var err error
+
 	switch block.Version {
 	case spec.DataVersionPhase0:
 		specJSON, err = json.Marshal(block.Phase0)
@@ -52,26 +112,48 @@ func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSi
 		specJSON, err = json.Marshal(block.Deneb)
 	case spec.DataVersionElectra:
 		specJSON, err = json.Marshal(block.Electra)
+	case spec.DataVersionFulu:
+		specJSON, err = json.Marshal(block.Fulu)
 	default:
 		err = errors.New("unknown block version")
 	}
 	if err != nil {
-		return errors.Join(errors.New("failed to marshal JSON"), err)
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
 	}
 
-	endpoint := "/eth/v1/beacon/blocks"
-	query := ""
+	return specJSON, nil
+}
 
-	if _, err := s.post(ctx,
-		endpoint,
-		query,
-		&api.CommonOpts{},
-		bytes.NewReader(specJSON),
-		ContentTypeJSON,
-		map[string]string{},
-	); err != nil {
-		return errors.Join(errors.New("failed to submit beacon block"), err)
+func beaconBlockSSZ(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	// Ensure that the block data is present, as SSZ marshalling does not handle nil values.
+	if _, err := block.Slot(); err != nil {
+		return nil, errors.Join(errors.New("invalid block"), err)
 	}
 
-	return nil
+	var specSSZ []byte
+	var err error
+
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		specSSZ, err = block.Phase0.MarshalSSZ()
+	case spec.DataVersionAltair:
+		specSSZ, err = block.Altair.MarshalSSZ()
+	case spec.DataVersionBellatrix:
+		specSSZ, err = block.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		specSSZ, err = block.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		specSSZ, err = block.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		specSSZ, err = block.Electra.MarshalSSZ()
+	case spec.DataVersionFulu:
+		specSSZ, err = block.Fulu.MarshalSSZ()
+	default:
+		err = errors.New("unknown block version")
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal SSZ"), err)
+	}
+
+	return specSSZ, nil
 }
@@ -0,0 +1,258 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv2 "github.com/attestantio/go-eth2-client/api/v2"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBlockContentType(t *testing.T) {
+	ctx := context.Background()
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot: 1,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+				},
+			},
+		},
+	}
+	blindedBlock := &apiv1bellatrix.SignedBlindedBeaconBlock{
+		Message: &apiv1bellatrix.BlindedBeaconBlock{
+			Slot: 2,
+			Body: &apiv1bellatrix.BlindedBeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: bitfield.NewBitvector512(),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		preferSSZSubmission bool
+		enforceJSON         bool
+		contentType         string
+	}{
+		{
+			name:        "Default",
+			contentType: "application/json",
+		},
+		{
+			name:                "PreferSSZSubmission",
+			preferSSZSubmission: true,
+			contentType:         "application/octet-stream",
+		},
+		{
+			name:                "EnforceJSON",
+			preferSSZSubmission: true,
+			enforceJSON:         true,
+			contentType:         "application/json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests []*http.Request
+			var bodies [][]byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				requests = append(requests, r)
+				bodies = append(bodies, body)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:                 zerolog.Nop(),
+				base:                base,
+				address:             address.String(),
+				client:              http.DefaultClient,
+				timeout:             timeout,
+				extraHeaders:        map[string]string{},
+				connectionActive:    true,
+				connectionSynced:    true,
+				enforceJSON:         test.enforceJSON,
+				preferSSZSubmission: test.preferSSZSubmission,
+			}
+
+			require.NoError(t, s.SubmitBeaconBlock(ctx, block))
+			require.NoError(t, s.SubmitBlindedBeaconBlock(ctx, &api.VersionedSignedBlindedBeaconBlock{
+				Version:   spec.DataVersionBellatrix,
+				Bellatrix: blindedBlock,
+			}))
+			require.NoError(t, s.SubmitBlindedProposal(ctx, &api.SubmitBlindedProposalOpts{
+				Proposal: &api.VersionedSignedBlindedProposal{
+					Version:   spec.DataVersionBellatrix,
+					Bellatrix: blindedBlock,
+				},
+			}))
+			require.Len(t, requests, 3)
+
+			require.Equal(t, "/eth/v1/beacon/blocks", requests[0].URL.Path)
+			require.Equal(t, "phase0", requests[0].Header.Get("Eth-Consensus-Version"))
+			require.Equal(t, "/eth/v1/beacon/blinded_blocks", requests[1].URL.Path)
+			require.Equal(t, "bellatrix", requests[1].Header.Get("Eth-Consensus-Version"))
+			require.Equal(t, "/eth/v2/beacon/blinded_blocks", requests[2].URL.Path)
+			require.Equal(t, "bellatrix", requests[2].Header.Get("Eth-Consensus-Version"))
+
+			for i := range requests {
+				require.Equal(t, test.contentType, requests[i].Header.Get("Content-Type"))
+			}
+
+			if test.contentType == "application/octet-stream" {
+				expected, err := block.Phase0.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, expected, bodies[0])
+				expected, err = blindedBlock.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, expected, bodies[1])
+				require.Equal(t, expected, bodies[2])
+			} else {
+				expected, err := json.Marshal(block.Phase0)
+				require.NoError(t, err)
+				require.Equal(t, expected, bodies[0])
+				expected, err = json.Marshal(blindedBlock)
+				require.NoError(t, err)
+				require.Equal(t, expected, bodies[1])
+				require.Equal(t, expected, bodies[2])
+			}
+		})
+	}
+}
+
+func TestSubmitBlockSSZMissingData(t *testing.T) {
+	s := &Service{
+		connectionActive:    true,
+		connectionSynced:    true,
+		preferSSZSubmission: true,
+	}
+
+	err := s.SubmitBeaconBlock(context.Background(), &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionDeneb,
+	})
+	require.ErrorContains(t, err, "invalid block")
+
+	err = s.SubmitBlindedProposal(context.Background(), &api.SubmitBlindedProposalOpts{
+		Proposal: &api.VersionedSignedBlindedProposal{
+			Version: spec.DataVersionDeneb,
+		},
+	})
+	require.ErrorIs(t, err, api.ErrDataMissing)
+}
+
+func TestSubmitBeaconBlockWithOpts(t *testing.T) {
+	ctx := context.Background()
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.SignedBeaconBlock{},
+	}
+	consensusAndEquivocation := apiv2.BroadcastValidationConsensusAndEquivocation
+
+	tests := []struct {
+		name  string
+		opts  *api.SubmitBeaconBlockOpts
+		query string
+		err   string
+	}{
+		{
+			name: "Nil",
+			err:  "no options specified",
+		},
+		{
+			name: "BlockMissing",
+			opts: &api.SubmitBeaconBlockOpts{},
+			err:  "no block supplied\ninvalid options",
+		},
+		{
+			name: "Good",
+			opts: &api.SubmitBeaconBlockOpts{
+				Block: block,
+			},
+		},
+		{
+			name: "BroadcastValidation",
+			opts: &api.SubmitBeaconBlockOpts{
+				Block:               block,
+				BroadcastValidation: &consensusAndEquivocation,
+			},
+			query: "broadcast_validation=consensus_and_equivocation",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var request *http.Request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				request = r
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:              zerolog.Nop(),
+				base:             base,
+				address:          address.String(),
+				client:           http.DefaultClient,
+				timeout:          timeout,
+				extraHeaders:     map[string]string{},
+				connectionActive: true,
+				connectionSynced: true,
+			}
+
+			err = s.SubmitBeaconBlockWithOpts(ctx, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "/eth/v2/beacon/blocks", request.URL.Path)
+			require.Equal(t, test.query, request.URL.RawQuery)
+			require.Equal(t, "capella", request.Header.Get("Eth-Consensus-Version"))
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -32,13 +33,47 @@ func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.Versi
 		return err
 	}
 
-	var specJSON []byte
-	var err error
-
 	if block == nil {
 		return errors.Join(errors.New("no blinded block supplied"), client.ErrInvalidOptions)
 	}
 
+	var body []byte
+	var contentType ContentType
+	var err error
+	if s.submitSSZ() {
+		contentType = ContentTypeSSZ
+		body, err = blindedBeaconBlockSSZ(block)
+	} else {
+		contentType = ContentTypeJSON
+		body, err = blindedBeaconBlockJSON(block)
+	}
+	if err != nil {
+		return err
+	}
+
+	endpoint := "/eth/v1/beacon/blinded_blocks"
+	query := ""
+
+	if _, err := s.post(ctx,
+		endpoint,
+		query,
+		&api.CommonOpts{},
+		bytes.NewReader(body),
+		contentType,
+		map[string]string{
+			"Eth-Consensus-Version": strings.ToLower(block.Version.String()),
+		},
+	); err != nil {
+		return errors.Join(errors.New("failed to submit blinded beacon block"), err)
+	}
+
+	return nil
+}
+
+func blindedBeaconBlockJSON(block *api.VersionedSignedBlindedBeaconBlock) ([]byte, error) {
+	var specJSON []byte
+	var err error
+
 	switch block.Version {
 	case spec.DataVersionPhase0:
 		err = errors.New("blinded phase0 blocks not supported")
@@ -52,26 +87,48 @@ func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.Versi
 		specJSON, err = json.Marshal(block.Deneb)
 	case spec.DataVersionElectra:
 		specJSON, err = json.Marshal(block.Electra)
+	case spec.DataVersionFulu:
+		specJSON, err = json.Marshal(block.Fulu)
 	default:
 		err = errors.New("unknown block version")
 	}
 	if err != nil {
-		return errors.Join(errors.New("failed to marshal JSON"), err)
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
 	}
 
-	endpoint := "/eth/v1/beacon/blinded_blocks"
-	query := ""
+	return specJSON, nil
+}
 
-	if _, err := s.post(ctx,
-		endpoint,
-		query,
-		&api.CommonOpts{},
-		bytes.NewReader(specJSON),
-		ContentTypeJSON,
-		map[string]string{},
-	); err != nil {
-		return errors.Join(errors.New("failed to submit blinded beacon block"), err)
+func blindedBeaconBlockSSZ(block *api.VersionedSignedBlindedBeaconBlock) ([]byte, error) {
+	// Ensure that the block data is present, as SSZ marshalling does not handle nil values.
+	if _, err := block.Slot(); err != nil {
+		return nil, errors.Join(errors.New("invalid block"), err)
 	}
 
-	return nil
+	var specSSZ []byte
+	var err error
+
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		err = errors.New("blinded phase0 blocks not supported")
+	case spec.DataVersionAltair:
+		err = errors.New("blinded altair blocks not supported")
+	case spec.DataVersionBellatrix:
+		specSSZ, err = block.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		specSSZ, err = block.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		specSSZ, err = block.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		specSSZ, err = block.Electra.MarshalSSZ()
+	case spec.DataVersionFulu:
+		specSSZ, err = block.Fulu.MarshalSSZ()
+	default:
+		err = errors.New("unknown block version")
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal SSZ"), err)
+	}
+
+	return specSSZ, nil
 }
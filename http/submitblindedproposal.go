@@ -39,41 +39,77 @@ func (s *Service) SubmitBlindedProposal(ctx context.Context,
 		return errors.Join(errors.New("no proposal supplied"), client.ErrInvalidOptions)
 	}
 
+	var body []byte
+	var contentType ContentType
+	var err error
+	if s.submitSSZ() {
+		contentType = ContentTypeSSZ
+		body, err = blindedProposalSSZ(opts.Proposal)
+	} else {
+		contentType = ContentTypeJSON
+		body, err = blindedProposalJSON(opts.Proposal)
+	}
+	if err != nil {
+		return err
+	}
+
+	endpoint := "/eth/v2/beacon/blinded_blocks"
+	query := ""
+	if opts.BroadcastValidation != nil {
+		query = "broadcast_validation=" + opts.BroadcastValidation.String()
+	}
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = strings.ToLower(opts.Proposal.Version.String())
+	_, err = s.post(ctx, endpoint, query, &opts.Common, bytes.NewBuffer(body), contentType, headers)
+	if err != nil {
+		return errors.Join(errors.New("failed to submit blinded proposal"), err)
+	}
+
+	return nil
+}
+
+func blindedProposalJSON(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
 	var specJSON []byte
 	var err error
 
-	switch opts.Proposal.Version {
+	switch proposal.Version {
 	case spec.DataVersionPhase0:
 		err = errors.New("blinded phase0 proposals not supported")
 	case spec.DataVersionAltair:
 		err = errors.New("blinded altair proposals not supported")
 	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(opts.Proposal.Bellatrix)
+		specJSON, err = json.Marshal(proposal.Bellatrix)
 	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(opts.Proposal.Capella)
+		specJSON, err = json.Marshal(proposal.Capella)
 	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(opts.Proposal.Deneb)
+		specJSON, err = json.Marshal(proposal.Deneb)
 	case spec.DataVersionElectra:
-		specJSON, err = json.Marshal(opts.Proposal.Electra)
+		specJSON, err = json.Marshal(proposal.Electra)
+	case spec.DataVersionFulu:
+		specJSON, err = json.Marshal(proposal.Fulu)
 	default:
 		err = errors.New("unknown proposal version")
 	}
 	if err != nil {
-		return errors.Join(errors.New("failed to marshal JSON"), err)
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
 	}
 
-	endpoint := "/eth/v2/beacon/blinded_blocks"
-	query := ""
-	if opts.BroadcastValidation != nil {
-		query = "broadcast_validation=" + opts.BroadcastValidation.String()
+	return specJSON, nil
+}
+
+func blindedProposalSSZ(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
+	switch proposal.Version {
+	case spec.DataVersionPhase0:
+		return nil, errors.New("blinded phase0 proposals not supported")
+	case spec.DataVersionAltair:
+		return nil, errors.New("blinded altair proposals not supported")
 	}
 
-	headers := make(map[string]string)
-	headers["Eth-Consensus-Version"] = strings.ToLower(opts.Proposal.Version.String())
-	_, err = s.post(ctx, endpoint, query, &opts.Common, bytes.NewBuffer(specJSON), ContentTypeJSON, headers)
+	specSSZ, err := proposal.MarshalSSZ()
 	if err != nil {
-		return errors.Join(errors.New("failed to submit blinded proposal"), err)
+		return nil, errors.Join(errors.New("failed to marshal SSZ"), err)
 	}
 
-	return nil
+	return specSSZ, nil
 }
@@ -0,0 +1,64 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// SubmitInclusionLists submits signed inclusion lists.
+func (s *Service) SubmitInclusionLists(ctx context.Context, opts *api.SubmitInclusionListsOpts) error {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return err
+	}
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+	if len(opts.InclusionLists) == 0 {
+		return errors.Join(errors.New("no inclusion lists supplied"), client.ErrInvalidOptions)
+	}
+	for i := range opts.InclusionLists {
+		if opts.InclusionLists[i] == nil || opts.InclusionLists[i].Message == nil {
+			return errors.Join(errors.New("nil inclusion list supplied"), client.ErrInvalidOptions)
+		}
+	}
+
+	specJSON, err := json.Marshal(opts.InclusionLists)
+	if err != nil {
+		return errors.Join(errors.New("failed to marshal JSON"), err)
+	}
+
+	endpoint := "/eth/v1/beacon/pool/inclusion_lists"
+	query := ""
+
+	res, err := s.post(ctx,
+		endpoint,
+		query,
+		&opts.Common,
+		bytes.NewReader(specJSON),
+		ContentTypeJSON,
+		map[string]string{},
+	)
+	if err := indexedSubmissionError(res, err, nil); err != nil {
+		return errors.Join(errors.New("failed to submit inclusion lists"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// supportedMethodsRetryInterval is the time after which a failed probe of the
+// node's supported methods is retried.
+const supportedMethodsRetryInterval = time.Minute
+
+// methodProbe is a request used to find out if the node serves the endpoint
+// behind one or more methods.
+type methodProbe struct {
+	methods  []string
+	post     bool
+	endpoint string
+	// dataMayBeMissing is set if the endpoint can return 404 when it is served
+	// but has no data to return.
+	dataMayBeMissing bool
+}
+
+// methodProbes returns the probes for methods whose endpoints are optional or
+// not served by all nodes.  Methods without a probe are assumed to be supported.
+func methodProbes(epoch phase0.Epoch) []*methodProbe {
+	return []*methodProbe{
+		{
+			methods:  []string{"AttestationRewards"},
+			post:     true,
+			endpoint: fmt.Sprintf("/eth/v1/beacon/rewards/attestations/%d", epoch),
+		},
+		{
+			methods:  []string{"BlockRewards"},
+			endpoint: "/eth/v1/beacon/rewards/blocks/head",
+		},
+		{
+			methods:  []string{"SyncCommitteeRewards"},
+			post:     true,
+			endpoint: "/eth/v1/beacon/rewards/sync_committee/head",
+		},
+		{
+			methods: []string{
+				"LightClientBootstrap",
+				"LightClientFinalityUpdate",
+				"LightClientOptimisticUpdate",
+				"LightClientUpdates",
+			},
+			endpoint:         "/eth/v1/beacon/light_client/finality_update",
+			dataMayBeMissing: true,
+		},
+		{
+			methods:  []string{"BeaconCommitteeSelections"},
+			post:     true,
+			endpoint: "/eth/v1/validator/beacon_committee_selections",
+		},
+		{
+			methods:  []string{"SyncCommitteeSelections"},
+			post:     true,
+			endpoint: "/eth/v1/validator/sync_committee_selections",
+		},
+		{
+			methods: []string{
+				"InclusionList",
+				"InclusionListDuties",
+				"SubmitInclusionLists",
+			},
+			post:     true,
+			endpoint: fmt.Sprintf("/eth/v1/validator/duties/inclusion_list/%d", epoch),
+		},
+	}
+}
+
+// unknownEndpoint is an endpoint that no node serves, used to find out how the
+// node responds to requests for endpoints that it does not serve.
+const unknownEndpoint = "/eth/v1/unknown"
+
+// SupportedMethods provides the methods supported by the client.  Endpoints
+// that are not served by all nodes are probed when this is first called, with
+// the results cached alongside other static values from the node.
+func (s *Service) SupportedMethods(ctx context.Context) (*api.SupportedMethods, error) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
+	s.supportedMethodsMutex.RLock()
+	supportedMethods := s.supportedMethods
+	err := s.supportedMethodsErr
+	retry := s.supportedMethodsRetry
+	s.supportedMethodsMutex.RUnlock()
+	if supportedMethods != nil {
+		return supportedMethods, nil
+	}
+	if err != nil && time.Now().Before(retry) {
+		return nil, err
+	}
+
+	// Probe without holding the lock, sharing the probe between concurrent callers.
+	resCh := s.supportedMethodsProbe.DoChan("", func() (any, error) {
+		return s.probeSupportedMethods(ctx)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-resCh:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		return res.Val.(*api.SupportedMethods), nil
+	}
+}
+
+// probeSupportedMethods probes the node for the methods that it supports, and
+// caches the result.
+func (s *Service) probeSupportedMethods(ctx context.Context) (*api.SupportedMethods, error) {
+	supportedMethods, err := s.fetchSupportedMethods(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			// Only cache failures that are not down to the caller.
+			s.supportedMethodsMutex.Lock()
+			s.supportedMethodsErr = err
+			s.supportedMethodsRetry = time.Now().Add(supportedMethodsRetryInterval)
+			s.supportedMethodsMutex.Unlock()
+		}
+
+		return nil, err
+	}
+
+	s.supportedMethodsMutex.Lock()
+	s.supportedMethods = supportedMethods
+	s.supportedMethodsErr = nil
+	s.supportedMethodsMutex.Unlock()
+
+	return supportedMethods, nil
+}
+
+// fetchSupportedMethods probes the node for the methods that it supports.
+func (s *Service) fetchSupportedMethods(ctx context.Context) (*api.SupportedMethods, error) {
+	epoch, err := s.probeEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var unknownEndpointErr *api.Error
+	unsupported := make(map[string]struct{})
+	for _, probe := range methodProbes(epoch) {
+		supported, apiErr, err := s.probeMethod(ctx, probe)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to probe %s", probe.endpoint), err)
+		}
+		if !supported && probe.dataMayBeMissing && apiErr.StatusCode == http.StatusNotFound {
+			// A 404 could state that there is no data, so only consider the endpoint
+			// unsupported if the response matches that for an unknown endpoint.
+			if unknownEndpointErr == nil {
+				unknownEndpointErr, err = s.probeUnknownEndpoint(ctx)
+				if err != nil {
+					return nil, errors.Join(errors.New("failed to probe unknown endpoint"), err)
+				}
+			}
+			supported = unknownEndpointErr.StatusCode != http.StatusNotFound ||
+				!bytes.Equal(apiErr.Data, unknownEndpointErr.Data)
+		}
+		if !supported {
+			for _, method := range probe.methods {
+				unsupported[method] = struct{}{}
+			}
+		}
+	}
+
+	serviceType := reflect.TypeOf(s)
+	methods := make([]string, 0, serviceType.NumMethod())
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		if _, exists := unsupported[serviceType.Method(i).Name]; !exists {
+			methods = append(methods, serviceType.Method(i).Name)
+		}
+	}
+
+	return api.NewSupportedMethods(methods), nil
+}
+
+// probeEpoch returns an epoch for which rewards should be available.
+func (s *Service) probeEpoch(ctx context.Context) (phase0.Epoch, error) {
+	specResponse, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return 0, err
+	}
+	slotsPerEpoch, isCorrectType := specResponse.Data["SLOTS_PER_EPOCH"].(uint64)
+	if !isCorrectType {
+		return 0, ErrIncorrectType
+	}
+
+	syncingResponse, err := s.NodeSyncing(ctx, &api.NodeSyncingOpts{})
+	if err != nil {
+		return 0, err
+	}
+
+	epoch := phase0.Epoch(uint64(syncingResponse.Data.HeadSlot) / slotsPerEpoch)
+	if epoch < 2 {
+		return 0, nil
+	}
+
+	// Rewards are only available once the epoch has been processed.
+	return epoch - 2, nil
+}
+
+// probeMethod returns true if the node serves the endpoint of the probe.  Any
+// response other than one stating that the endpoint does not exist is taken to
+// mean that it is served, as the probe does not supply meaningful data.  If the
+// endpoint is not served then the error returned by the node is also returned.
+func (s *Service) probeMethod(ctx context.Context, probe *methodProbe) (bool, *api.Error, error) {
+	var err error
+	if probe.post {
+		// Supply a single validator index, to keep the work required of the node small.
+		_, err = s.post(ctx, probe.endpoint, "", &api.CommonOpts{}, bytes.NewReader([]byte(`["0"]`)), ContentTypeJSON, map[string]string{})
+	} else {
+		_, err = s.get(ctx, probe.endpoint, "", &api.CommonOpts{}, false)
+	}
+	if err == nil {
+		return true, nil, nil
+	}
+
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return false, nil, err
+	}
+	switch apiErr.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return false, apiErr, nil
+	default:
+		return true, nil, nil
+	}
+}
+
+// probeUnknownEndpoint returns the error returned by the node for an endpoint
+// that it does not serve.
+func (s *Service) probeUnknownEndpoint(ctx context.Context) (*api.Error, error) {
+	_, err := s.get(ctx, unknownEndpoint, "", &api.CommonOpts{}, false)
+	if err == nil {
+		return nil, errors.New("node returned data for unknown endpoint")
+	}
+
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return nil, err
+	}
+
+	return apiErr, nil
+}
@@ -0,0 +1,216 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedMethods(t *testing.T) {
+	ctx := context.Background()
+
+	var probes atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32"}}`))
+
+			return
+		case "/eth/v1/node/syncing":
+			_, _ = w.Write([]byte(`{"data":{"is_syncing":false,"is_optimistic":false,"el_offline":false,"head_slot":"100","sync_distance":"0"}}`))
+
+			return
+		case "/eth/v1/beacon/rewards/attestations/1":
+			w.WriteHeader(http.StatusNotFound)
+		case "/eth/v1/beacon/rewards/blocks/head":
+			_, _ = w.Write([]byte(`{"data":{}}`))
+		case "/eth/v1/beacon/rewards/sync_committee/head":
+			w.WriteHeader(http.StatusBadRequest)
+		case "/eth/v1/beacon/light_client/finality_update":
+			w.WriteHeader(http.StatusNotImplemented)
+		case "/eth/v1/validator/beacon_committee_selections", "/eth/v1/validator/sync_committee_selections":
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case "/eth/v1/validator/duties/inclusion_list/1":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+		probes.Add(1)
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          address.String(),
+		client:           http.DefaultClient,
+		timeout:          time.Second,
+		monitor:          &testRequestMonitor{},
+		extraHeaders:     map[string]string{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	supportedMethods, err := s.SupportedMethods(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), probes.Load())
+
+	for method, supported := range map[string]bool{
+		"Genesis":                   true,
+		"BlockRewards":              true,
+		"SyncCommitteeRewards":      true,
+		"AttestationRewards":        false,
+		"LightClientBootstrap":      false,
+		"LightClientUpdates":        false,
+		"BeaconCommitteeSelections": false,
+		"SyncCommitteeSelections":   false,
+		"InclusionList":             false,
+		"InclusionListDuties":       false,
+		"SubmitInclusionLists":      false,
+		"Unknown":                   false,
+	} {
+		require.Equal(t, supported, supportedMethods.Supports(method), method)
+	}
+
+	// Results are cached.
+	_, err = s.SupportedMethods(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), probes.Load())
+
+	// Results are refetched once static values are cleared.
+	s.clearStaticValues()
+	_, err = s.SupportedMethods(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(14), probes.Load())
+}
+
+func TestSupportedMethodsDataNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	var lightClientMissing atomic.Bool
+	var probeBody atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32"}}`))
+		case "/eth/v1/node/syncing":
+			_, _ = w.Write([]byte(`{"data":{"is_syncing":false,"is_optimistic":false,"el_offline":false,"head_slot":"100","sync_distance":"0"}}`))
+		case "/eth/v1/beacon/light_client/finality_update":
+			if lightClientMissing.Load() {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"code":404,"message":"Not found"}`))
+
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"No finality update available"}`))
+		case unknownEndpoint:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"Not found"}`))
+		case "/eth/v1/beacon/rewards/attestations/1":
+			body, _ := io.ReadAll(r.Body)
+			probeBody.Store(string(body))
+			_, _ = w.Write([]byte(`{"data":{}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          address.String(),
+		client:           http.DefaultClient,
+		timeout:          time.Second,
+		monitor:          &testRequestMonitor{},
+		extraHeaders:     map[string]string{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	// A 404 stating that there is no data means that the endpoint is served.
+	supportedMethods, err := s.SupportedMethods(ctx)
+	require.NoError(t, err)
+	require.True(t, supportedMethods.Supports("LightClientFinalityUpdate"))
+	require.Equal(t, `["0"]`, probeBody.Load())
+
+	// A 404 matching that of an unknown endpoint means that the endpoint is not served.
+	lightClientMissing.Store(true)
+	s.clearStaticValues()
+	supportedMethods, err = s.SupportedMethods(ctx)
+	require.NoError(t, err)
+	require.False(t, supportedMethods.Supports("LightClientFinalityUpdate"))
+}
+
+func TestSupportedMethodsFailure(t *testing.T) {
+	ctx := context.Background()
+
+	var probes atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32"}}`))
+		case "/eth/v1/node/syncing":
+			probes.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          address.String(),
+		client:           http.DefaultClient,
+		timeout:          time.Second,
+		monitor:          &testRequestMonitor{},
+		extraHeaders:     map[string]string{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	_, err = s.SupportedMethods(ctx)
+	require.Error(t, err)
+	require.Equal(t, int64(1), probes.Load())
+
+	// The failure is cached until the retry interval has passed.
+	_, err = s.SupportedMethods(ctx)
+	require.Error(t, err)
+	require.Equal(t, int64(1), probes.Load())
+
+	s.supportedMethodsMutex.Lock()
+	s.supportedMethodsRetry = time.Now()
+	s.supportedMethodsMutex.Unlock()
+	_, err = s.SupportedMethods(ctx)
+	require.Error(t, err)
+	require.Equal(t, int64(2), probes.Load())
+}
@@ -23,7 +23,6 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -34,7 +33,7 @@ func (s *Service) SyncCommitteeRewards(ctx context.Context,
 	*api.Response[[]*apiv1.SyncCommitteeReward],
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "SyncCommitteeRewards")
+	ctx, span := s.tracer().Start(ctx, "SyncCommitteeRewards")
 	defer span.End()
 
 	if err := s.assertIsActive(ctx); err != nil {
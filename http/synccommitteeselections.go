@@ -0,0 +1,75 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SyncCommitteeSelections combines partial sync committee selection proofs into aggregated selection proofs.
+func (s *Service) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	ctx, span := s.tracer().Start(ctx, "SyncCommitteeSelections")
+	defer span.End()
+
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if len(opts.Selections) == 0 {
+		return nil, errors.Join(errors.New("no selections specified"), client.ErrInvalidOptions)
+	}
+	span.SetAttributes(attribute.Int("selections", len(opts.Selections)))
+
+	reqData, err := json.Marshal(opts.Selections)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal request data"), err)
+	}
+
+	httpResponse, err := s.post(ctx,
+		"/eth/v1/validator/sync_committee_selections",
+		"",
+		&opts.Common,
+		bytes.NewReader(reqData),
+		ContentTypeJSON,
+		map[string]string{},
+	)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to request sync committee selections"), err)
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.SyncCommitteeSelection{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.SyncCommitteeSelection]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
@@ -0,0 +1,63 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the tracer used for spans.
+const tracerName = "attestantio.go-eth2-client.http"
+
+// tracer returns the tracer for the service.
+func (s *Service) tracer() trace.Tracer {
+	if s.tracerProvider == nil {
+		return otel.Tracer(tracerName)
+	}
+
+	return s.tracerProvider.Tracer(tracerName)
+}
+
+// injectTraceContext adds the trace context of the context to the request headers,
+// allowing the beacon node to continue the trace.
+func injectTraceContext(ctx context.Context, req *http.Request) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+}
+
+var (
+	blockIDPattern = regexp.MustCompile("/(?:blinded_blocks|blob_sidecars|blocks|headers)/(0x[0-9a-fA-F]{64}|[0-9]+|head|genesis|finalized)")
+	stateIDPattern = regexp.MustCompile("/states/(0x[0-9a-fA-F]{64}|[0-9]+|head|genesis|finalized|justified)")
+)
+
+// endpointAttributes returns span attributes for an endpoint.
+func endpointAttributes(endpoint string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		attribute.String("endpoint", reduceEndpoint(endpoint)),
+	}
+	if match := blockIDPattern.FindStringSubmatch(endpoint); match != nil {
+		attributes = append(attributes, attribute.String("block_id", match[1]))
+	}
+	if match := stateIDPattern.FindStringSubmatch(endpoint); match != nil {
+		attributes = append(attributes, attribute.String("state_id", match[1]))
+	}
+
+	return attributes
+}
@@ -0,0 +1,130 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordingTracerProvider records the spans started by its tracers.
+type recordingTracerProvider struct {
+	names  []string
+	spans  []string
+	starts [][]attribute.KeyValue
+}
+
+func (p *recordingTracerProvider) Tracer(name string, _ ...trace.TracerOption) trace.Tracer {
+	p.names = append(p.names, name)
+
+	return &recordingTracer{provider: p}
+}
+
+type recordingTracer struct {
+	provider *recordingTracerProvider
+}
+
+func (t *recordingTracer) Start(ctx context.Context,
+	spanName string,
+	opts ...trace.SpanStartOption,
+) (
+	context.Context,
+	trace.Span,
+) {
+	t.provider.spans = append(t.provider.spans, spanName)
+	config := trace.NewSpanStartConfig(opts...)
+	t.provider.starts = append(t.provider.starts, config.Attributes())
+
+	return trace.NewNoopTracerProvider().Tracer("").Start(ctx, spanName, opts...)
+}
+
+func TestEndpointAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		expected []attribute.KeyValue
+	}{
+		{
+			name:     "Plain",
+			endpoint: "/eth/v1/node/version",
+			expected: []attribute.KeyValue{
+				attribute.String("endpoint", "/eth/v1/node/version"),
+			},
+		},
+		{
+			name:     "Block",
+			endpoint: "/eth/v2/beacon/blocks/head",
+			expected: []attribute.KeyValue{
+				attribute.String("endpoint", "/eth/v2/beacon/blocks/{block_id}"),
+				attribute.String("block_id", "head"),
+			},
+		},
+		{
+			name:     "State",
+			endpoint: "/eth/v1/beacon/states/12345/finality_checkpoints",
+			expected: []attribute.KeyValue{
+				attribute.String("endpoint", "/eth/v1/beacon/states/{state_id}/finality_checkpoints"),
+				attribute.String("state_id", "12345"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, endpointAttributes(test.endpoint))
+		})
+	}
+}
+
+func TestTracerProvider(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	base, address, err := parseAddress(server.URL)
+	require.NoError(t, err)
+
+	provider := &recordingTracerProvider{}
+	s := &Service{
+		log:            zerolog.Nop(),
+		base:           base,
+		address:        address.String(),
+		client:         http.DefaultClient,
+		timeout:        timeout,
+		extraHeaders:   map[string]string{},
+		tracerProvider: provider,
+	}
+
+	_, err = s.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", "", &api.CommonOpts{}, false)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{tracerName}, provider.names)
+	require.Equal(t, []string{"get"}, provider.spans)
+	require.Equal(t, []attribute.KeyValue{
+		attribute.String("endpoint", "/eth/v1/beacon/states/{state_id}/finality_checkpoints"),
+		attribute.String("state_id", "head"),
+	}, provider.starts[0])
+}
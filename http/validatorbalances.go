@@ -27,6 +27,9 @@ import (
 )
 
 // ValidatorBalances provides the validator balances for the given options.
+// Requests for more validators than the validators chunk size are split into
+// chunks that are fetched in parallel; if the state is not fixed, for example
+// "head", chunks can be served from different states.
 func (s *Service) ValidatorBalances(ctx context.Context,
 	opts *api.ValidatorBalancesOpts,
 ) (
@@ -43,18 +46,33 @@ func (s *Service) ValidatorBalances(ctx context.Context,
 		return nil, errors.Join(errors.New("no state specified"), client.ErrInvalidOptions)
 	}
 
-	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_balances", opts.State)
-	query := ""
-
-	body := make([]string, 0, len(opts.Indices)+len(opts.PubKeys))
+	ids := make([]string, 0, len(opts.Indices)+len(opts.PubKeys))
 	for i := range opts.Indices {
-		body = append(body, fmt.Sprintf("%d", opts.Indices[i]))
+		ids = append(ids, fmt.Sprintf("%d", opts.Indices[i]))
 	}
 	for i := range opts.PubKeys {
-		body = append(body, opts.PubKeys[i].String())
+		ids = append(ids, opts.PubKeys[i].String())
 	}
 
-	data, err := json.Marshal(body)
+	return fetchInChunks(ctx, ids, s.validatorsChunkSize,
+		func(ctx context.Context, chunk []string) (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error) {
+			return s.validatorBalancesChunk(ctx, opts, chunk)
+		},
+	)
+}
+
+// validatorBalancesChunk fetches the balances of the validators with the given IDs in a single request.
+func (s *Service) validatorBalancesChunk(ctx context.Context,
+	opts *api.ValidatorBalancesOpts,
+	ids []string,
+) (
+	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
+	error,
+) {
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_balances", opts.State)
+	query := ""
+
+	data, err := json.Marshal(ids)
 	if err != nil {
 		return nil, errors.Join(errors.New("failed to marshal request data"), err)
 	}
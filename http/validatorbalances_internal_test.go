@@ -0,0 +1,156 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorBalancesChunking(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		chunkSize int
+		indices   int
+		pubKeys   int
+		failAbove int
+		requests  int
+		err       string
+	}{
+		{
+			name:      "Single",
+			chunkSize: 10,
+			indices:   8,
+			pubKeys:   2,
+			requests:  1,
+		},
+		{
+			name:      "Chunked",
+			chunkSize: 10,
+			indices:   90,
+			pubKeys:   5,
+			requests:  10,
+		},
+		{
+			name:      "ChunkFails",
+			chunkSize: 10,
+			indices:   95,
+			failAbove: 50,
+			err:       "500",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := 0
+			maxIDs := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var ids []string
+				if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+
+					return
+				}
+				mu.Lock()
+				requests++
+				maxIDs = max(maxIDs, len(ids))
+				mu.Unlock()
+
+				balances := make([]string, 0, len(ids))
+				for _, id := range ids {
+					var index uint64
+					if strings.HasPrefix(id, "0x") {
+						// Public keys are for validators from 1000, by their first byte.
+						index = 1000 + uint64(id[3]-'0')
+					} else {
+						var err error
+						index, err = strconv.ParseUint(id, 10, 64)
+						if err != nil {
+							w.WriteHeader(http.StatusBadRequest)
+
+							return
+						}
+					}
+					if test.failAbove > 0 && index > uint64(test.failAbove) {
+						w.WriteHeader(http.StatusInternalServerError)
+
+						return
+					}
+					balances = append(balances, fmt.Sprintf(`{"index":"%d","balance":"%d"}`, index, 32000000000+index))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"execution_optimistic":false,"finalized":true,"data":[` + strings.Join(balances, ",") + `]}`))
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:                 zerolog.Nop(),
+				base:                base,
+				address:             address.String(),
+				client:              http.DefaultClient,
+				timeout:             timeout,
+				extraHeaders:        map[string]string{},
+				connectionActive:    true,
+				connectionSynced:    true,
+				validatorsChunkSize: test.chunkSize,
+			}
+
+			expected := make(map[phase0.ValidatorIndex]phase0.Gwei)
+			indices := make([]phase0.ValidatorIndex, 0, test.indices)
+			for i := 0; i < test.indices; i++ {
+				indices = append(indices, phase0.ValidatorIndex(i))
+				expected[phase0.ValidatorIndex(i)] = phase0.Gwei(32000000000 + i)
+			}
+			pubKeys := make([]phase0.BLSPubKey, 0, test.pubKeys)
+			for i := 0; i < test.pubKeys; i++ {
+				pubKeys = append(pubKeys, phase0.BLSPubKey{byte(i)})
+				expected[phase0.ValidatorIndex(1000+i)] = phase0.Gwei(32000000000 + 1000 + i)
+			}
+
+			response, err := s.ValidatorBalances(ctx, &api.ValidatorBalancesOpts{
+				State:   "head",
+				Indices: indices,
+				PubKeys: pubKeys,
+			})
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expected, response.Data)
+			require.Equal(t, true, response.Metadata["finalized"])
+			require.Equal(t, test.requests, requests)
+			require.LessOrEqual(t, maxIDs, test.chunkSize)
+		})
+	}
+}
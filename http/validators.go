@@ -24,7 +24,6 @@ import (
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -34,13 +33,16 @@ type validatorsBody struct {
 }
 
 // Validators provides the validators, with their balance and status, for the given options.
+// Requests for more validators than the validators chunk size are split into
+// chunks that are fetched in parallel; if the state is not fixed, for example
+// "head", chunks can be served from different states.
 func (s *Service) Validators(ctx context.Context,
 	opts *api.ValidatorsOpts,
 ) (
 	*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator],
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "Validators")
+	ctx, span := s.tracer().Start(ctx, "Validators")
 	defer span.End()
 
 	if err := s.assertIsActive(ctx); err != nil {
@@ -59,24 +61,41 @@ func (s *Service) Validators(ctx context.Context,
 		return s.validatorsFromState(ctx, opts)
 	}
 
-	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", opts.State)
-	query := ""
-
-	body := &validatorsBody{
-		IDs:      make([]string, 0),
-		Statuses: make([]string, 0),
-	}
+	ids := make([]string, 0, len(opts.Indices)+len(opts.PubKeys))
 	for i := range opts.Indices {
-		body.IDs = append(body.IDs, fmt.Sprintf("%d", opts.Indices[i]))
+		ids = append(ids, fmt.Sprintf("%d", opts.Indices[i]))
 	}
 	for i := range opts.PubKeys {
-		body.IDs = append(body.IDs, opts.PubKeys[i].String())
+		ids = append(ids, opts.PubKeys[i].String())
 	}
+	statuses := make([]string, 0, len(opts.ValidatorStates))
 	for i := range opts.ValidatorStates {
-		body.Statuses = append(body.Statuses, opts.ValidatorStates[i].String())
+		statuses = append(statuses, opts.ValidatorStates[i].String())
 	}
 
-	reqData, err := json.Marshal(body)
+	return fetchInChunks(ctx, ids, s.validatorsChunkSize,
+		func(ctx context.Context, chunk []string) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+			return s.validatorsChunk(ctx, opts, chunk, statuses)
+		},
+	)
+}
+
+// validatorsChunk fetches the validators with the given IDs in a single request.
+func (s *Service) validatorsChunk(ctx context.Context,
+	opts *api.ValidatorsOpts,
+	ids []string,
+	statuses []string,
+) (
+	*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator],
+	error,
+) {
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", opts.State)
+	query := ""
+
+	reqData, err := json.Marshal(&validatorsBody{
+		IDs:      ids,
+		Statuses: statuses,
+	})
 	if err != nil {
 		return nil, errors.Join(errors.New("failed to marshal request data"), err)
 	}
@@ -112,7 +131,7 @@ func (s *Service) validatorsFromState(ctx context.Context,
 	*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator],
 	error,
 ) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "validatorsFromState")
+	ctx, span := s.tracer().Start(ctx, "validatorsFromState")
 	defer span.End()
 
 	stateResponse, err := s.BeaconState(ctx, &api.BeaconStateOpts{State: opts.State, Common: opts.Common})
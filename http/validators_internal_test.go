@@ -0,0 +1,155 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorsChunking(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		chunkSize int
+		indices   int
+		failAbove int
+		requests  int
+		err       string
+	}{
+		{
+			name:      "Single",
+			chunkSize: 10,
+			indices:   10,
+			requests:  1,
+		},
+		{
+			name:      "Chunked",
+			chunkSize: 10,
+			indices:   95,
+			requests:  10,
+		},
+		{
+			name:      "ChunkFails",
+			chunkSize: 10,
+			indices:   95,
+			failAbove: 50,
+			err:       "failed to request validators",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := 0
+			maxIDs := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body validatorsBody
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+
+					return
+				}
+				mu.Lock()
+				requests++
+				maxIDs = max(maxIDs, len(body.IDs))
+				mu.Unlock()
+
+				validators := make([]*apiv1.Validator, 0, len(body.IDs))
+				for _, id := range body.IDs {
+					index, err := strconv.ParseUint(id, 10, 64)
+					if err != nil {
+						w.WriteHeader(http.StatusBadRequest)
+
+						return
+					}
+					if test.failAbove > 0 && index > uint64(test.failAbove) {
+						w.WriteHeader(http.StatusInternalServerError)
+
+						return
+					}
+					validators = append(validators, &apiv1.Validator{
+						Index:   phase0.ValidatorIndex(index),
+						Balance: 32000000000,
+						Status:  apiv1.ValidatorStateActiveOngoing,
+						Validator: &phase0.Validator{
+							PublicKey:             phase0.BLSPubKey{byte(index)},
+							WithdrawalCredentials: make([]byte, 32),
+							EffectiveBalance:      32000000000,
+						},
+					})
+				}
+				data, err := json.Marshal(validators)
+				if err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"execution_optimistic":false,"finalized":true,"data":` + string(data) + `}`))
+			}))
+			defer server.Close()
+
+			base, address, err := parseAddress(server.URL)
+			require.NoError(t, err)
+
+			s := &Service{
+				log:                 zerolog.Nop(),
+				base:                base,
+				address:             address.String(),
+				client:              http.DefaultClient,
+				timeout:             timeout,
+				extraHeaders:        map[string]string{},
+				connectionActive:    true,
+				connectionSynced:    true,
+				validatorsChunkSize: test.chunkSize,
+			}
+
+			indices := make([]phase0.ValidatorIndex, 0, test.indices)
+			for i := 0; i < test.indices; i++ {
+				indices = append(indices, phase0.ValidatorIndex(i))
+			}
+
+			response, err := s.Validators(ctx, &api.ValidatorsOpts{
+				State:   "head",
+				Indices: indices,
+			})
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, response.Data, test.indices)
+			for _, index := range indices {
+				require.Equal(t, index, response.Data[index].Index)
+			}
+			require.Equal(t, true, response.Metadata["finalized"])
+			require.Equal(t, test.requests, requests)
+			require.LessOrEqual(t, maxIDs, test.chunkSize)
+		})
+	}
+}
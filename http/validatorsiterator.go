@@ -0,0 +1,59 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// ValidatorsIterator provides an iterator over the validators, with their balance and status, for the given options.
+func (s *Service) ValidatorsIterator(ctx context.Context,
+	opts *api.ValidatorsIteratorOpts,
+) (
+	*api.ValidatorsIterator,
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.State == "" {
+		return nil, errors.Join(errors.New("no state specified"), client.ErrInvalidOptions)
+	}
+	if opts.PageSize < 0 {
+		return nil, errors.Join(errors.New("page size cannot be negative"), client.ErrInvalidOptions)
+	}
+
+	// Fix the state so that all pages come from the same state.
+	iteratorOpts := *opts
+	switch opts.State {
+	case "head", "justified", "finalized":
+		stateRootResponse, err := s.BeaconStateRoot(ctx, &api.BeaconStateRootOpts{
+			Common: opts.Common,
+			State:  opts.State,
+		})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain state root"), err)
+		}
+		iteratorOpts.State = stateRootResponse.Data.String()
+	}
+
+	return api.NewValidatorsIterator(s.Validators, &iteratorOpts), nil
+}
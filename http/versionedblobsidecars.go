@@ -0,0 +1,102 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// VersionedBlobSidecars fetches the versioned blob sidecars given options.
+// For Fulu and later blocks the data column sidecars held by the node are
+// returned, in which case the indices in the options are not used.
+func (s *Service) VersionedBlobSidecars(ctx context.Context,
+	opts *api.BlobSidecarsOpts,
+) (
+	*api.Response[*api.VersionedBlobSidecars],
+	error,
+) {
+	ctx, span := s.tracer().Start(ctx, "VersionedBlobSidecars")
+	defer span.End()
+
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", opts.Block)
+	httpResponse, err := s.get(ctx, endpoint, blobSidecarsQuery(opts), &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureConsensusVersion(ctx, httpResponse, slotFromID(opts.Block)); err != nil {
+		return nil, err
+	}
+
+	var blobsResponse *api.Response[[]*deneb.BlobSidecar]
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		blobsResponse, err = s.blobSidecarsFromSSZ(httpResponse)
+	case ContentTypeJSON:
+		blobsResponse, err = s.blobSidecarsFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	version := httpResponse.consensusVersion
+	if version == spec.DataVersionUnknown {
+		// Older beacon nodes do not supply the version; blob sidecars were introduced in Deneb.
+		version = spec.DataVersionDeneb
+	}
+
+	response := &api.Response[*api.VersionedBlobSidecars]{
+		Data: &api.VersionedBlobSidecars{
+			Version: version,
+		},
+		Metadata: consensusVersionMetadata(blobsResponse.Metadata, httpResponse),
+	}
+	switch version {
+	case spec.DataVersionDeneb:
+		response.Data.Deneb = blobsResponse.Data
+	case spec.DataVersionElectra:
+		response.Data.Electra = blobsResponse.Data
+	case spec.DataVersionFulu:
+		columnsResponse, err := s.DataColumnSidecars(ctx, &api.DataColumnSidecarsOpts{
+			Common: opts.Common,
+			Block:  opts.Block,
+		})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain data column sidecars"), err)
+		}
+		response.Data.Fulu = columnsResponse.Data
+	default:
+		return nil, fmt.Errorf("unsupported blob sidecars version %s", version)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// GasLimit is the gas limit for a validator.
+type GasLimit struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// GasLimit is the gas limit targeted by the validator.
+	GasLimit uint64
+}
+
+// gasLimitJSON is the API representation of the struct.
+type gasLimitJSON struct {
+	Pubkey   string `json:"pubkey,omitempty"`
+	GasLimit string `json:"gas_limit"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g *GasLimit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&gasLimitJSON{
+		Pubkey:   fmt.Sprintf("%#x", g.Pubkey),
+		GasLimit: strconv.FormatUint(g.GasLimit, 10),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *GasLimit) UnmarshalJSON(input []byte) error {
+	var data gasLimitJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Pubkey != "" {
+		if err := g.Pubkey.UnmarshalJSON([]byte(fmt.Sprintf("%q", data.Pubkey))); err != nil {
+			return errors.Wrap(err, "invalid value for public key")
+		}
+	}
+	if data.GasLimit == "" {
+		return errors.New("gas limit missing")
+	}
+	gasLimit, err := strconv.ParseUint(data.GasLimit, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for gas limit")
+	}
+	g.GasLimit = gasLimit
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (g *GasLimit) String() string {
+	data, err := json.Marshal(g)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,151 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+type importKeystoresJSON struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+type deleteKeysJSON struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+// ListKeystores lists the local keystores known to the keymanager.
+func (s *Service) ListKeystores(ctx context.Context,
+	opts *ListKeystoresOpts,
+) (
+	*api.Response[[]*Keystore],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/keystores"
+	httpResponse, err := s.call(ctx, http.MethodGet, endpoint, "", &opts.Common, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to list keystores"), err)
+	}
+
+	data := make([]*Keystore, 0)
+	metadata, err := decodeJSONResponse(httpResponse.body, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*Keystore]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// ImportKeystores imports local keystores in to the keymanager.
+func (s *Service) ImportKeystores(ctx context.Context,
+	opts *ImportKeystoresOpts,
+) (
+	*api.Response[[]*ImportResult],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if len(opts.Keystores) == 0 {
+		return nil, errors.Join(errors.New("no keystores specified"), client.ErrInvalidOptions)
+	}
+	if len(opts.Passwords) != len(opts.Keystores) {
+		return nil, errors.Join(fmt.Errorf("%d keystores but %d passwords", len(opts.Keystores), len(opts.Passwords)), client.ErrInvalidOptions)
+	}
+
+	endpoint := "/eth/v1/keystores"
+	httpResponse, err := s.call(ctx, http.MethodPost, endpoint, "", &opts.Common, &importKeystoresJSON{
+		Keystores:          opts.Keystores,
+		Passwords:          opts.Passwords,
+		SlashingProtection: opts.SlashingProtection,
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to import keystores"), err)
+	}
+
+	data := make([]*ImportResult, 0)
+	metadata, err := decodeJSONResponse(httpResponse.body, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*ImportResult]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// DeleteKeystores deletes local keystores from the keymanager, returning their slashing protection data.
+func (s *Service) DeleteKeystores(ctx context.Context,
+	opts *DeleteKeystoresOpts,
+) (
+	*api.Response[*DeletedKeystores],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if len(opts.Pubkeys) == 0 {
+		return nil, errors.Join(errors.New("no public keys specified"), client.ErrInvalidOptions)
+	}
+
+	reqData := &deleteKeysJSON{
+		Pubkeys: make([]string, len(opts.Pubkeys)),
+	}
+	for i := range opts.Pubkeys {
+		reqData.Pubkeys[i] = opts.Pubkeys[i].String()
+	}
+
+	endpoint := "/eth/v1/keystores"
+	httpResponse, err := s.call(ctx, http.MethodDelete, endpoint, "", &opts.Common, reqData)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to delete keystores"), err)
+	}
+
+	data := &DeletedKeystores{
+		Results: make([]*DeleteResult, 0),
+	}
+	metadata, err := decodeJSONResponse(httpResponse.body, &data.Results)
+	if err != nil {
+		return nil, err
+	}
+	if slashingProtection, exists := metadata["slashing_protection"]; exists {
+		var isString bool
+		data.SlashingProtection, isString = slashingProtection.(string)
+		if !isString {
+			return nil, errors.New("slashing protection is not a string")
+		}
+		delete(metadata, "slashing_protection")
+	}
+
+	return &api.Response[*DeletedKeystores]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
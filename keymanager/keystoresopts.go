@@ -0,0 +1,44 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ListKeystoresOpts are the options for listing keystores.
+type ListKeystoresOpts struct {
+	Common api.CommonOpts
+}
+
+// ImportKeystoresOpts are the options for importing keystores.
+type ImportKeystoresOpts struct {
+	Common api.CommonOpts
+
+	// Keystores are the EIP-2335 keystores to import, each as a JSON string.
+	Keystores []string
+	// Passwords are the passwords for the keystores, in the same order.
+	Passwords []string
+	// SlashingProtection is optional EIP-3076 slashing protection data, as a JSON string.
+	SlashingProtection string
+}
+
+// DeleteKeystoresOpts are the options for deleting keystores.
+type DeleteKeystoresOpts struct {
+	Common api.CommonOpts
+
+	// Pubkeys are the public keys of the keystores to delete.
+	Pubkeys []phase0.BLSPubKey
+}
@@ -0,0 +1,79 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// RemoteKey is a key held by a remote signer that is known to the keymanager.
+type RemoteKey struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// URL is the URL of the remote signer.
+	// If empty when importing then the keymanager's default remote signer is used.
+	URL string
+	// Readonly is true if the key cannot be deleted through the API.
+	// This is ignored when importing keys.
+	Readonly bool
+}
+
+// remoteKeyJSON is the API representation of the struct.
+type remoteKeyJSON struct {
+	Pubkey   string `json:"pubkey"`
+	URL      string `json:"url,omitempty"`
+	Readonly bool   `json:"readonly"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *RemoteKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&remoteKeyJSON{
+		Pubkey:   fmt.Sprintf("%#x", r.Pubkey),
+		URL:      r.URL,
+		Readonly: r.Readonly,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RemoteKey) UnmarshalJSON(input []byte) error {
+	var data remoteKeyJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Pubkey == "" {
+		return errors.New("public key missing")
+	}
+	if err := r.Pubkey.UnmarshalJSON([]byte(fmt.Sprintf("%q", data.Pubkey))); err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+	r.URL = data.URL
+	r.Readonly = data.Readonly
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (r *RemoteKey) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
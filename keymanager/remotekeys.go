@@ -0,0 +1,129 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+type importRemoteKeysJSON struct {
+	RemoteKeys []*RemoteKey `json:"remote_keys"`
+}
+
+// ListRemoteKeys lists the remote signer keys known to the keymanager.
+func (s *Service) ListRemoteKeys(ctx context.Context,
+	opts *ListRemoteKeysOpts,
+) (
+	*api.Response[[]*RemoteKey],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/remotekeys"
+	httpResponse, err := s.call(ctx, http.MethodGet, endpoint, "", &opts.Common, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to list remote keys"), err)
+	}
+
+	data := make([]*RemoteKey, 0)
+	metadata, err := decodeJSONResponse(httpResponse.body, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*RemoteKey]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// ImportRemoteKeys imports remote signer keys in to the keymanager.
+func (s *Service) ImportRemoteKeys(ctx context.Context,
+	opts *ImportRemoteKeysOpts,
+) (
+	*api.Response[[]*ImportResult],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if len(opts.RemoteKeys) == 0 {
+		return nil, errors.Join(errors.New("no remote keys specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := "/eth/v1/remotekeys"
+	httpResponse, err := s.call(ctx, http.MethodPost, endpoint, "", &opts.Common, &importRemoteKeysJSON{
+		RemoteKeys: opts.RemoteKeys,
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to import remote keys"), err)
+	}
+
+	data := make([]*ImportResult, 0)
+	metadata, err := decodeJSONResponse(httpResponse.body, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*ImportResult]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// DeleteRemoteKeys deletes remote signer keys from the keymanager.
+func (s *Service) DeleteRemoteKeys(ctx context.Context,
+	opts *DeleteRemoteKeysOpts,
+) (
+	*api.Response[[]*DeleteResult],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if len(opts.Pubkeys) == 0 {
+		return nil, errors.Join(errors.New("no public keys specified"), client.ErrInvalidOptions)
+	}
+
+	reqData := &deleteKeysJSON{
+		Pubkeys: make([]string, len(opts.Pubkeys)),
+	}
+	for i := range opts.Pubkeys {
+		reqData.Pubkeys[i] = opts.Pubkeys[i].String()
+	}
+
+	endpoint := "/eth/v1/remotekeys"
+	httpResponse, err := s.call(ctx, http.MethodDelete, endpoint, "", &opts.Common, reqData)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to delete remote keys"), err)
+	}
+
+	data := make([]*DeleteResult, 0)
+	metadata, err := decodeJSONResponse(httpResponse.body, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*DeleteResult]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
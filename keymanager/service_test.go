@@ -0,0 +1,214 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+const testPubkey = "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"
+
+func testServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{}
+	handlers["GET /eth/v1/keystores"] = func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"validating_pubkey":"` + testPubkey + `","derivation_path":"m/12381/3600/0/0/0","readonly":true}]}`))
+	}
+	handlers["POST /eth/v1/keystores"] = func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req map[string]any
+		require.NoError(t, json.Unmarshal(body, &req))
+		require.Len(t, req["keystores"], 1)
+		require.Len(t, req["passwords"], 1)
+		_, _ = w.Write([]byte(`{"data":[{"status":"imported","message":""}]}`))
+	}
+	handlers["DELETE /eth/v1/keystores"] = func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"status":"deleted"}],"slashing_protection":"{\"metadata\":{}}"}`))
+	}
+	handlers["GET /eth/v1/validator/"+testPubkey+"/feerecipient"] = func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"pubkey":"` + testPubkey + `","ethaddress":"0x000102030405060708090a0b0c0d0e0f10111213"}}`))
+	}
+	handlers["POST /eth/v1/validator/"+testPubkey+"/gas_limit"] = func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"gas_limit":"36000000"}`, string(body))
+		w.WriteHeader(http.StatusAccepted)
+	}
+	handlers["POST /eth/v1/validator/"+testPubkey+"/voluntary_exit"] = func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "epoch=5", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`{"data":{"message":{"epoch":"5","validator_index":"1"},"signature":"0xabababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababab"}}`))
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"unauthorized"}`))
+
+			return
+		}
+		handler, exists := handlers[r.Method+" "+r.URL.Path]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []keymanager.Parameter
+		err    string
+	}{
+		{
+			name: "AddressMissing",
+			params: []keymanager.Parameter{
+				keymanager.WithBearerToken("secret"),
+			},
+			err: "problem with parameters\nno address specified",
+		},
+		{
+			name: "BearerTokenMissing",
+			params: []keymanager.Parameter{
+				keymanager.WithAddress("localhost:7500"),
+			},
+			err: "problem with parameters\nno bearer token specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []keymanager.Parameter{
+				keymanager.WithAddress("localhost:7500"),
+				keymanager.WithBearerToken("secret"),
+				keymanager.WithTimeout(0),
+			},
+			err: "problem with parameters\nno timeout specified",
+		},
+		{
+			name: "Good",
+			params: []keymanager.Parameter{
+				keymanager.WithAddress("localhost:7500"),
+				keymanager.WithBearerToken("secret"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := keymanager.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCalls(t *testing.T) {
+	ctx := context.Background()
+	server := testServer(t)
+
+	s, err := keymanager.New(ctx,
+		keymanager.WithAddress(server.URL),
+		keymanager.WithBearerToken("secret"),
+	)
+	require.NoError(t, err)
+
+	var pubkey phase0.BLSPubKey
+	require.NoError(t, pubkey.UnmarshalJSON([]byte(`"`+testPubkey+`"`)))
+
+	keystores, err := s.ListKeystores(ctx, &keymanager.ListKeystoresOpts{})
+	require.NoError(t, err)
+	require.Len(t, keystores.Data, 1)
+	require.Equal(t, pubkey, keystores.Data[0].ValidatingPubkey)
+	require.True(t, keystores.Data[0].Readonly)
+
+	_, err = s.ImportKeystores(ctx, &keymanager.ImportKeystoresOpts{
+		Keystores: []string{"{}"},
+	})
+	require.ErrorContains(t, err, "1 keystores but 0 passwords")
+
+	imported, err := s.ImportKeystores(ctx, &keymanager.ImportKeystoresOpts{
+		Keystores: []string{"{}"},
+		Passwords: []string{"password"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, keymanager.ImportStatusImported, imported.Data[0].Status)
+
+	deleted, err := s.DeleteKeystores(ctx, &keymanager.DeleteKeystoresOpts{
+		Pubkeys: []phase0.BLSPubKey{pubkey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, keymanager.DeleteStatusDeleted, deleted.Data.Results[0].Status)
+	require.Equal(t, `{"metadata":{}}`, deleted.Data.SlashingProtection)
+	require.NotContains(t, deleted.Metadata, "slashing_protection")
+
+	feeRecipient, err := s.FeeRecipient(ctx, &keymanager.ValidatorOpts{Pubkey: pubkey})
+	require.NoError(t, err)
+	require.Equal(t, pubkey, feeRecipient.Data.Pubkey)
+	require.Equal(t, "0x000102030405060708090a0b0c0d0e0f10111213", feeRecipient.Data.ETHAddress.String())
+
+	require.NoError(t, s.SetGasLimit(ctx, &keymanager.SetGasLimitOpts{
+		Pubkey:   pubkey,
+		GasLimit: 36000000,
+	}))
+
+	epoch := phase0.Epoch(5)
+	exit, err := s.SignVoluntaryExit(ctx, &keymanager.SignVoluntaryExitOpts{
+		Pubkey: pubkey,
+		Epoch:  &epoch,
+	})
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(1), exit.Data.Message.ValidatorIndex)
+
+	// Remote keys are not served by the test server.
+	_, err = s.ListRemoteKeys(ctx, &keymanager.ListRemoteKeysOpts{})
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestUnauthorized(t *testing.T) {
+	ctx := context.Background()
+	server := testServer(t)
+
+	s, err := keymanager.New(ctx,
+		keymanager.WithAddress(server.URL),
+		keymanager.WithBearerToken("wrong"),
+	)
+	require.NoError(t, err)
+
+	_, err = s.ListKeystores(ctx, &keymanager.ListKeystoresOpts{})
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
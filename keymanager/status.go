@@ -0,0 +1,85 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ImportStatus is the status of an individual key import.
+type ImportStatus string
+
+const (
+	// ImportStatusImported means the key was imported.
+	ImportStatusImported ImportStatus = "imported"
+	// ImportStatusDuplicate means the key was already known to the keymanager.
+	ImportStatusDuplicate ImportStatus = "duplicate"
+	// ImportStatusError means the key could not be imported.
+	ImportStatusError ImportStatus = "error"
+)
+
+// DeleteStatus is the status of an individual key deletion.
+type DeleteStatus string
+
+const (
+	// DeleteStatusDeleted means the key was deleted.
+	DeleteStatusDeleted DeleteStatus = "deleted"
+	// DeleteStatusNotActive means the key was not active, but slashing protection data was returned.
+	DeleteStatusNotActive DeleteStatus = "not_active"
+	// DeleteStatusNotFound means the key was not known to the keymanager.
+	DeleteStatusNotFound DeleteStatus = "not_found"
+	// DeleteStatusError means the key could not be deleted.
+	DeleteStatusError DeleteStatus = "error"
+)
+
+// ImportResult is the result of importing an individual key.
+type ImportResult struct {
+	Status  ImportStatus `json:"status"`
+	Message string       `json:"message,omitempty"`
+}
+
+// String returns a string version of the structure.
+func (r *ImportResult) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// DeleteResult is the result of deleting an individual key.
+type DeleteResult struct {
+	Status  DeleteStatus `json:"status"`
+	Message string       `json:"message,omitempty"`
+}
+
+// String returns a string version of the structure.
+func (r *DeleteResult) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// DeletedKeystores is the result of deleting a number of keystores.
+type DeletedKeystores struct {
+	// Results are the results of the deletions, in the same order as the requested public keys.
+	Results []*DeleteResult
+	// SlashingProtection is the EIP-3076 slashing protection data for the deleted keys, as a JSON string.
+	SlashingProtection string
+}
@@ -0,0 +1,87 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// FeeRecipient fetches the fee recipient configured for a validator.
+func (s *Service) FeeRecipient(ctx context.Context,
+	opts *ValidatorOpts,
+) (
+	*api.Response[*FeeRecipient],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", opts.Pubkey)
+	httpResponse, err := s.call(ctx, http.MethodGet, endpoint, "", &opts.Common, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain fee recipient"), err)
+	}
+
+	data := &FeeRecipient{}
+	metadata, err := decodeJSONResponse(httpResponse.body, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*FeeRecipient]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// SetFeeRecipient sets the fee recipient for a validator.
+func (s *Service) SetFeeRecipient(ctx context.Context,
+	opts *SetFeeRecipientOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", opts.Pubkey)
+	if _, err := s.call(ctx, http.MethodPost, endpoint, "", &opts.Common, &feeRecipientJSON{
+		ETHAddress: opts.FeeRecipient.String(),
+	}); err != nil {
+		return errors.Join(errors.New("failed to set fee recipient"), err)
+	}
+
+	return nil
+}
+
+// DeleteFeeRecipient removes the fee recipient configured for a validator, reverting it to the keymanager's default.
+func (s *Service) DeleteFeeRecipient(ctx context.Context,
+	opts *ValidatorOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", opts.Pubkey)
+	if _, err := s.call(ctx, http.MethodDelete, endpoint, "", &opts.Common, nil); err != nil {
+		return errors.Join(errors.New("failed to delete fee recipient"), err)
+	}
+
+	return nil
+}
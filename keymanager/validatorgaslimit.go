@@ -0,0 +1,88 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// GasLimit fetches the gas limit configured for a validator.
+func (s *Service) GasLimit(ctx context.Context,
+	opts *ValidatorOpts,
+) (
+	*api.Response[*GasLimit],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", opts.Pubkey)
+	httpResponse, err := s.call(ctx, http.MethodGet, endpoint, "", &opts.Common, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain gas limit"), err)
+	}
+
+	data := &GasLimit{}
+	metadata, err := decodeJSONResponse(httpResponse.body, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*GasLimit]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// SetGasLimit sets the gas limit for a validator.
+func (s *Service) SetGasLimit(ctx context.Context,
+	opts *SetGasLimitOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", opts.Pubkey)
+	if _, err := s.call(ctx, http.MethodPost, endpoint, "", &opts.Common, &gasLimitJSON{
+		GasLimit: strconv.FormatUint(opts.GasLimit, 10),
+	}); err != nil {
+		return errors.Join(errors.New("failed to set gas limit"), err)
+	}
+
+	return nil
+}
+
+// DeleteGasLimit removes the gas limit configured for a validator, reverting it to the keymanager's default.
+func (s *Service) DeleteGasLimit(ctx context.Context,
+	opts *ValidatorOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", opts.Pubkey)
+	if _, err := s.call(ctx, http.MethodDelete, endpoint, "", &opts.Common, nil); err != nil {
+		return errors.Join(errors.New("failed to delete gas limit"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// Graffiti fetches the graffiti configured for a validator.
+func (s *Service) Graffiti(ctx context.Context,
+	opts *ValidatorOpts,
+) (
+	*api.Response[*Graffiti],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/graffiti", opts.Pubkey)
+	httpResponse, err := s.call(ctx, http.MethodGet, endpoint, "", &opts.Common, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain graffiti"), err)
+	}
+
+	data := &Graffiti{}
+	metadata, err := decodeJSONResponse(httpResponse.body, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*Graffiti]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// SetGraffiti sets the graffiti for a validator.
+func (s *Service) SetGraffiti(ctx context.Context,
+	opts *SetGraffitiOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/graffiti", opts.Pubkey)
+	if _, err := s.call(ctx, http.MethodPost, endpoint, "", &opts.Common, &graffitiJSON{
+		Graffiti: opts.Graffiti,
+	}); err != nil {
+		return errors.Join(errors.New("failed to set graffiti"), err)
+	}
+
+	return nil
+}
+
+// DeleteGraffiti removes the graffiti configured for a validator, reverting it to the keymanager's default.
+func (s *Service) DeleteGraffiti(ctx context.Context,
+	opts *ValidatorOpts,
+) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/graffiti", opts.Pubkey)
+	if _, err := s.call(ctx, http.MethodDelete, endpoint, "", &opts.Common, nil); err != nil {
+		return errors.Join(errors.New("failed to delete graffiti"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ValidatorOpts are the options for calls that operate on a single validator
+// without additional data, such as fetching or deleting a configured value.
+type ValidatorOpts struct {
+	Common api.CommonOpts
+
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+}
+
+// SetFeeRecipientOpts are the options for setting the fee recipient of a validator.
+type SetFeeRecipientOpts struct {
+	Common api.CommonOpts
+
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// FeeRecipient is the execution address that will receive fees.
+	FeeRecipient bellatrix.ExecutionAddress
+}
+
+// SetGasLimitOpts are the options for setting the gas limit of a validator.
+type SetGasLimitOpts struct {
+	Common api.CommonOpts
+
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// GasLimit is the gas limit to target.
+	GasLimit uint64
+}
+
+// SetGraffitiOpts are the options for setting the graffiti of a validator.
+type SetGraffitiOpts struct {
+	Common api.CommonOpts
+
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// Graffiti is the graffiti to use when proposing blocks.
+	Graffiti string
+}
+
+// SignVoluntaryExitOpts are the options for signing a voluntary exit.
+type SignVoluntaryExitOpts struct {
+	Common api.CommonOpts
+
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// Epoch is the epoch at which the exit is valid.
+	// If nil then the keymanager uses the current epoch.
+	Epoch *phase0.Epoch
+}
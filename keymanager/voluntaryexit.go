@@ -0,0 +1,60 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SignVoluntaryExit has the keymanager sign a voluntary exit for a validator.
+// The signed exit is returned rather than broadcast; it can be submitted to a
+// beacon node with SubmitVoluntaryExit.
+func (s *Service) SignVoluntaryExit(ctx context.Context,
+	opts *SignVoluntaryExitOpts,
+) (
+	*api.Response[*phase0.SignedVoluntaryExit],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/voluntary_exit", opts.Pubkey)
+	query := ""
+	if opts.Epoch != nil {
+		query = fmt.Sprintf("epoch=%d", *opts.Epoch)
+	}
+	httpResponse, err := s.call(ctx, http.MethodPost, endpoint, query, &opts.Common, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to sign voluntary exit"), err)
+	}
+
+	data := &phase0.SignedVoluntaryExit{}
+	metadata, err := decodeJSONResponse(httpResponse.body, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*phase0.SignedVoluntaryExit]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
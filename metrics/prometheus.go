@@ -0,0 +1,93 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusMonitor is a request monitor that exposes metrics through prometheus.
+type PrometheusMonitor struct {
+	requestDuration *prometheus.HistogramVec
+	responseSize    *prometheus.HistogramVec
+	requestErrors   *prometheus.CounterVec
+}
+
+// NewPrometheusMonitor creates a request monitor that registers its metrics
+// with the supplied registerer.  If registerer is nil the default registerer is used.
+func NewPrometheusMonitor(registerer prometheus.Registerer) (*PrometheusMonitor, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	m := &PrometheusMonitor{
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "consensusclient",
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "The time taken for requests",
+			Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+		}, []string{"server", "method", "endpoint", "status_code"}),
+		responseSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "consensusclient",
+			Subsystem: "http",
+			Name:      "response_size_bytes",
+			Help:      "The size of responses",
+			Buckets:   prometheus.ExponentialBuckets(64, 4, 12),
+		}, []string{"server", "method", "endpoint"}),
+		requestErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "consensusclient",
+			Subsystem: "http",
+			Name:      "request_errors_total",
+			Help:      "Number of failed requests",
+		}, []string{"server", "method", "endpoint", "class"}),
+	}
+
+	if err := registerer.Register(m.requestDuration); err != nil {
+		return nil, errors.Join(errors.New("failed to register request_duration_seconds"), err)
+	}
+	if err := registerer.Register(m.responseSize); err != nil {
+		return nil, errors.Join(errors.New("failed to register response_size_bytes"), err)
+	}
+	if err := registerer.Register(m.requestErrors); err != nil {
+		return nil, errors.Join(errors.New("failed to register request_errors_total"), err)
+	}
+
+	return m, nil
+}
+
+// Presenter provides the presenter for this service.
+func (*PrometheusMonitor) Presenter() string {
+	return "prometheus"
+}
+
+// RequestCompleted is called when a request completes.
+func (m *PrometheusMonitor) RequestCompleted(_ context.Context, request *Request) {
+	m.requestDuration.WithLabelValues(request.Server,
+		request.Method,
+		request.Endpoint,
+		strconv.Itoa(request.StatusCode),
+	).Observe(request.Duration.Seconds())
+
+	if request.ErrorClass != ErrorClassNone {
+		m.requestErrors.WithLabelValues(request.Server, request.Method, request.Endpoint, string(request.ErrorClass)).Inc()
+
+		return
+	}
+	m.responseSize.WithLabelValues(request.Server, request.Method, request.Endpoint).Observe(float64(request.ResponseSize))
+}
@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusMonitor(t *testing.T) {
+	ctx := context.Background()
+
+	registry := prometheus.NewRegistry()
+	monitor, err := metrics.NewPrometheusMonitor(registry)
+	require.NoError(t, err)
+	require.Equal(t, "prometheus", monitor.Presenter())
+
+	// Registering a second time should fail.
+	_, err = metrics.NewPrometheusMonitor(registry)
+	require.Error(t, err)
+
+	monitor.RequestCompleted(ctx, &metrics.Request{
+		Server:       "localhost:5052",
+		Method:       "GET",
+		Endpoint:     "/eth/v1/node/version",
+		StatusCode:   200,
+		Duration:     10 * time.Millisecond,
+		ResponseSize: 100,
+	})
+	monitor.RequestCompleted(ctx, &metrics.Request{
+		Server:     "localhost:5052",
+		Method:     "GET",
+		Endpoint:   "/eth/v1/node/version",
+		Duration:   time.Second,
+		ErrorClass: metrics.ErrorClassTimeout,
+	})
+
+	// Two duration series (one per status code), one response size series and one error series.
+	require.Equal(t, 4, testutil.CollectAndCount(registry))
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() == "consensusclient_http_request_errors_total" {
+			require.Len(t, family.GetMetric(), 1)
+			require.InDelta(t, 1.0, family.GetMetric()[0].GetCounter().GetValue(), 0)
+		}
+	}
+}
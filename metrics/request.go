@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"time"
+)
+
+// ErrorClass is the class of error encountered by a request.
+type ErrorClass string
+
+const (
+	// ErrorClassNone is used for requests that succeeded.
+	ErrorClassNone ErrorClass = ""
+	// ErrorClassCanceled is used for requests whose context was canceled.
+	ErrorClassCanceled ErrorClass = "canceled"
+	// ErrorClassTimeout is used for requests that timed out.
+	ErrorClassTimeout ErrorClass = "timeout"
+	// ErrorClassConnection is used for requests that failed to connect or send.
+	ErrorClassConnection ErrorClass = "connection"
+	// ErrorClassRead is used for requests whose response could not be read.
+	ErrorClassRead ErrorClass = "read"
+	// ErrorClassClient is used for requests that returned a 4xx status code.
+	ErrorClassClient ErrorClass = "client"
+	// ErrorClassServer is used for requests that returned any other non-2xx status code.
+	ErrorClassServer ErrorClass = "server"
+)
+
+// Request contains details of a completed request.
+type Request struct {
+	// Server is the address of the server.
+	Server string
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the endpoint of the request, with identifiers replaced by templates.
+	Endpoint string
+	// StatusCode is the HTTP status code of the response, or 0 if there was no response.
+	StatusCode int
+	// Duration is the time taken for the request.
+	Duration time.Duration
+	// ResponseSize is the size of the response body in bytes.
+	ResponseSize int
+	// ErrorClass is the class of error encountered, or ErrorClassNone if the request succeeded.
+	ErrorClass ErrorClass
+}
+
+// RequestMonitor is a metrics service that receives details of each request.
+type RequestMonitor interface {
+	Service
+
+	// RequestCompleted is called when a request completes.
+	RequestCompleted(ctx context.Context, request *Request)
+}
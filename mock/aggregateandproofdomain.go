@@ -20,6 +20,10 @@ import (
 )
 
 // AggregateAndProofDomain provides the aggregate and proof domain.
-func (*Service) AggregateAndProofDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) AggregateAndProofDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx, "AggregateAndProofDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+
 	return spec.DomainType{0x06, 0x00, 0x00, 0x00}, nil
 }
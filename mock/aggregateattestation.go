@@ -28,6 +28,10 @@ func (s *Service) AggregateAttestation(ctx context.Context,
 	*api.Response[*spec.VersionedAttestation],
 	error,
 ) {
+	if err := s.inject(ctx, "AggregateAttestation"); err != nil {
+		return nil, err
+	}
+
 	if s.AggregateAttestationFunc != nil {
 		return s.AggregateAttestationFunc(ctx, opts)
 	}
@@ -27,6 +27,10 @@ func (s *Service) AttestationData(ctx context.Context,
 	*api.Response[*phase0.AttestationData],
 	error,
 ) {
+	if err := s.inject(ctx, "AttestationData"); err != nil {
+		return nil, err
+	}
+
 	if s.AttestationDataFunc != nil {
 		return s.AttestationDataFunc(ctx, opts)
 	}
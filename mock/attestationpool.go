@@ -17,27 +17,39 @@ import (
 	"context"
 
 	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // AttestationPool fetches the attestation pool for the given slot.
-func (*Service) AttestationPool(_ context.Context,
-	_ *api.AttestationPoolOpts,
+func (s *Service) AttestationPool(ctx context.Context,
+	opts *api.AttestationPoolOpts,
 ) (
-	*api.Response[[]*phase0.Attestation],
+	*api.Response[[]*spec.VersionedAttestation],
 	error,
 ) {
-	data := make([]*phase0.Attestation, 5)
+	if err := s.inject(ctx, "AttestationPool"); err != nil {
+		return nil, err
+	}
+
+	if s.AttestationPoolFunc != nil {
+		return s.AttestationPoolFunc(ctx, opts)
+	}
+
+	data := make([]*spec.VersionedAttestation, 5)
 	for i := 0; i < 5; i++ {
-		data[i] = &phase0.Attestation{
-			Data: &phase0.AttestationData{
-				Source: &phase0.Checkpoint{},
-				Target: &phase0.Checkpoint{},
+		data[i] = &spec.VersionedAttestation{
+			Version: spec.DataVersionPhase0,
+			Phase0: &phase0.Attestation{
+				Data: &phase0.AttestationData{
+					Source: &phase0.Checkpoint{},
+					Target: &phase0.Checkpoint{},
+				},
 			},
 		}
 	}
 
-	return &api.Response[[]*phase0.Attestation]{
+	return &api.Response[[]*spec.VersionedAttestation]{
 		Data:     data,
 		Metadata: make(map[string]any),
 	}, nil
@@ -27,6 +27,10 @@ func (s *Service) AttestationRewards(ctx context.Context,
 	*api.Response[*apiv1.AttestationRewards],
 	error,
 ) {
+	if err := s.inject(ctx, "AttestationRewards"); err != nil {
+		return nil, err
+	}
+
 	if s.AttestationRewardsFunc != nil {
 		return s.AttestationRewardsFunc(ctx, opts)
 	}
@@ -28,6 +28,10 @@ func (s *Service) AttesterDuties(ctx context.Context,
 	*api.Response[[]*apiv1.AttesterDuty],
 	error,
 ) {
+	if err := s.inject(ctx, "AttesterDuties"); err != nil {
+		return nil, err
+	}
+
 	if s.AttesterDutiesFunc != nil {
 		return s.AttesterDutiesFunc(ctx, opts)
 	}
@@ -0,0 +1,42 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Service) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttesterSlashing],
+	error,
+) {
+	if err := s.inject(ctx, "AttesterSlashingPool"); err != nil {
+		return nil, err
+	}
+
+	if s.AttesterSlashingPoolFunc != nil {
+		return s.AttesterSlashingPoolFunc(ctx, opts)
+	}
+
+	return &api.Response[[]*spec.VersionedAttesterSlashing]{
+		Data:     make([]*spec.VersionedAttesterSlashing, 0),
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -20,6 +20,10 @@ import (
 )
 
 // BeaconAttesterDomain provides the beacon attester domain.
-func (*Service) BeaconAttesterDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx, "BeaconAttesterDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+
 	return spec.DomainType{0x01, 0x00, 0x00, 0x00}, nil
 }
@@ -28,6 +28,10 @@ func (s *Service) BeaconBlockHeader(ctx context.Context,
 	*api.Response[*apiv1.BeaconBlockHeader],
 	error,
 ) {
+	if err := s.inject(ctx, "BeaconBlockHeader"); err != nil {
+		return nil, err
+	}
+
 	if s.BeaconBlockHeaderFunc != nil {
 		return s.BeaconBlockHeaderFunc(ctx, opts)
 	}
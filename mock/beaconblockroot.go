@@ -27,6 +27,10 @@ func (s *Service) BeaconBlockRoot(ctx context.Context,
 	*api.Response[*phase0.Root],
 	error,
 ) {
+	if err := s.inject(ctx, "BeaconBlockRoot"); err != nil {
+		return nil, err
+	}
+
 	if s.BeaconBlockRootFunc != nil {
 		return s.BeaconBlockRootFunc(ctx, opts)
 	}
@@ -21,12 +21,20 @@ import (
 )
 
 // BeaconCommittees fetches all beacon committees for the epoch at the given state.
-func (*Service) BeaconCommittees(_ context.Context,
-	_ *api.BeaconCommitteesOpts,
+func (s *Service) BeaconCommittees(ctx context.Context,
+	opts *api.BeaconCommitteesOpts,
 ) (
 	*api.Response[[]*apiv1.BeaconCommittee],
 	error,
 ) {
+	if err := s.inject(ctx, "BeaconCommittees"); err != nil {
+		return nil, err
+	}
+
+	if s.BeaconCommitteesFunc != nil {
+		return s.BeaconCommitteesFunc(ctx, opts)
+	}
+
 	data := make([]*apiv1.BeaconCommittee, 5)
 	for i := 0; i < 5; i++ {
 		data[i] = &apiv1.BeaconCommittee{}
@@ -0,0 +1,48 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// BeaconCommitteeSelections combines partial beacon committee selection proofs into aggregated selection proofs.
+func (s *Service) BeaconCommitteeSelections(ctx context.Context,
+	opts *api.BeaconCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommitteeSelection],
+	error,
+) {
+	if err := s.inject(ctx, "BeaconCommitteeSelections"); err != nil {
+		return nil, err
+	}
+
+	if s.BeaconCommitteeSelectionsFunc != nil {
+		return s.BeaconCommitteeSelectionsFunc(ctx, opts)
+	}
+
+	// Return the supplied selections unchanged.
+	selections := []*apiv1.BeaconCommitteeSelection{}
+	if opts != nil {
+		selections = opts.Selections
+	}
+
+	return &api.Response[[]*apiv1.BeaconCommitteeSelection]{
+		Data:     selections,
+		Metadata: make(map[string]any),
+	}, nil
+}
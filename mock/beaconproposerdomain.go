@@ -20,6 +20,10 @@ import (
 )
 
 // BeaconProposerDomain provides the beacon proposer domain.
-func (*Service) BeaconProposerDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx, "BeaconProposerDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+
 	return spec.DomainType{0x00, 0x00, 0x00, 0x00}, nil
 }
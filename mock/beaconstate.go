@@ -28,6 +28,10 @@ func (s *Service) BeaconState(ctx context.Context,
 	*api.Response[*spec.VersionedBeaconState],
 	error,
 ) {
+	if err := s.inject(ctx, "BeaconState"); err != nil {
+		return nil, err
+	}
+
 	if s.BeaconStateFunc != nil {
 		return s.BeaconStateFunc(ctx, opts)
 	}
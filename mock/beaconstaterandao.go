@@ -0,0 +1,44 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Service) BeaconStateRandao(ctx context.Context,
+	opts *api.BeaconStateRandaoOpts,
+) (
+	*api.Response[*phase0.Root],
+	error,
+) {
+	if err := s.inject(ctx, "BeaconStateRandao"); err != nil {
+		return nil, err
+	}
+
+	if s.BeaconStateRandaoFunc != nil {
+		return s.BeaconStateRandaoFunc(ctx, opts)
+	}
+
+	data := phase0.Root{}
+
+	return &api.Response[*phase0.Root]{
+		Data:     &data,
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -27,6 +27,10 @@ func (s *Service) BeaconStateRoot(ctx context.Context,
 	*api.Response[*phase0.Root],
 	error,
 ) {
+	if err := s.inject(ctx, "BeaconStateRoot"); err != nil {
+		return nil, err
+	}
+
 	if s.BeaconStateRootFunc != nil {
 		return s.BeaconStateRootFunc(ctx, opts)
 	}
@@ -24,12 +24,20 @@ import (
 )
 
 // BlindedProposal fetches a blinded proposal for signing.
-func (*Service) BlindedProposal(_ context.Context,
+func (s *Service) BlindedProposal(ctx context.Context,
 	opts *api.BlindedProposalOpts,
 ) (
 	*api.Response[*api.VersionedBlindedProposal],
 	error,
 ) {
+	if err := s.inject(ctx, "BlindedProposal"); err != nil {
+		return nil, err
+	}
+
+	if s.BlindedProposalFunc != nil {
+		return s.BlindedProposalFunc(ctx, opts)
+	}
+
 	// Build a beacon block.
 
 	// Create a few attestations.
@@ -0,0 +1,42 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// BlobSidecars fetches the blobs given a block ID.
+func (s *Service) BlobSidecars(ctx context.Context,
+	opts *api.BlobSidecarsOpts,
+) (
+	*api.Response[[]*deneb.BlobSidecar],
+	error,
+) {
+	if err := s.inject(ctx, "BlobSidecars"); err != nil {
+		return nil, err
+	}
+
+	if s.BlobSidecarsFunc != nil {
+		return s.BlobSidecarsFunc(ctx, opts)
+	}
+
+	return &api.Response[[]*deneb.BlobSidecar]{
+		Data:     make([]*deneb.BlobSidecar, 0),
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -27,6 +27,10 @@ func (s *Service) BlockRewards(ctx context.Context,
 	*api.Response[*apiv1.BlockRewards],
 	error,
 ) {
+	if err := s.inject(ctx, "BlockRewards"); err != nil {
+		return nil, err
+	}
+
 	if s.BlockRewardsFunc != nil {
 		return s.BlockRewardsFunc(ctx, opts)
 	}
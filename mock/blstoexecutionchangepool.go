@@ -0,0 +1,42 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+// BLSToExecutionChangePool fetches the BLS to execution change pool.
+func (s *Service) BLSToExecutionChangePool(ctx context.Context,
+	opts *api.BLSToExecutionChangePoolOpts,
+) (
+	*api.Response[[]*capella.SignedBLSToExecutionChange],
+	error,
+) {
+	if err := s.inject(ctx, "BLSToExecutionChangePool"); err != nil {
+		return nil, err
+	}
+
+	if s.BLSToExecutionChangePoolFunc != nil {
+		return s.BLSToExecutionChangePoolFunc(ctx, opts)
+	}
+
+	return &api.Response[[]*capella.SignedBLSToExecutionChange]{
+		Data:     make([]*capella.SignedBLSToExecutionChange, 0),
+		Metadata: make(map[string]any),
+	}, nil
+}
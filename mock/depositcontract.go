@@ -27,6 +27,10 @@ func (s *Service) DepositContract(ctx context.Context,
 	*api.Response[*apiv1.DepositContract],
 	error,
 ) {
+	if err := s.inject(ctx, "DepositContract"); err != nil {
+		return nil, err
+	}
+
 	if s.DepositContractFunc != nil {
 		return s.DepositContractFunc(ctx, opts)
 	}
@@ -20,6 +20,10 @@ import (
 )
 
 // DepositDomain provides the deposit domain.
-func (*Service) DepositDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) DepositDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx, "DepositDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+
 	return spec.DomainType{0x03, 0x00, 0x00, 0x00}, nil
 }
@@ -24,6 +24,10 @@ import (
 
 // Domain provides a domain for a given domain type at a given epoch.
 func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	if err := s.inject(ctx, "Domain"); err != nil {
+		return phase0.Domain{}, err
+	}
+
 	// Obtain the fork for the epoch.
 	fork, err := s.forkAtEpoch(ctx, epoch)
 	if err != nil {
@@ -38,6 +42,10 @@ func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoc
 // for a chain's fork schedule to have multiple forks at genesis.  In this situation,
 // GenesisDomain() will return the first, and Domain() will return the last.
 func (s *Service) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	if err := s.inject(ctx, "GenesisDomain"); err != nil {
+		return phase0.Domain{}, err
+	}
+
 	// Obtain the fork for genesis .
 	fork, err := s.forkAtGenesis(ctx)
 	if err != nil {
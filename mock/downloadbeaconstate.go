@@ -0,0 +1,49 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// DownloadBeaconState downloads a beacon state given a state ID.
+func (s *Service) DownloadBeaconState(ctx context.Context,
+	opts *api.DownloadBeaconStateOpts,
+) (
+	*api.Response[*api.BeaconStateDownload],
+	error,
+) {
+	if err := s.inject(ctx, "DownloadBeaconState"); err != nil {
+		return nil, err
+	}
+
+	if s.DownloadBeaconStateFunc != nil {
+		return s.DownloadBeaconStateFunc(ctx, opts)
+	}
+
+	data := &api.BeaconStateDownload{
+		Version: spec.DataVersionPhase0,
+	}
+	if opts != nil {
+		data.Size = opts.Offset
+	}
+
+	return &api.Response[*api.BeaconStateDownload]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
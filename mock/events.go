@@ -21,9 +21,26 @@ import (
 
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Service) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
+	if err := s.inject(ctx, "Events"); err != nil {
+		return err
+	}
+
 	if s.EventsFunc != nil {
 		return s.EventsFunc(ctx, topics, handler)
 	}
 
 	return nil
 }
+
+// EventsWithHandlers feeds events to the supplied typed handlers.
+func (s *Service) EventsWithHandlers(ctx context.Context, handlers *client.EventHandlers) error {
+	if err := s.inject(ctx, "EventsWithHandlers"); err != nil {
+		return err
+	}
+
+	if s.EventsWithHandlersFunc != nil {
+		return s.EventsWithHandlersFunc(ctx, handlers)
+	}
+
+	return nil
+}
@@ -20,6 +20,10 @@ import (
 )
 
 // FarFutureEpoch provides the values for FAR_FUTURE_EPOCH of the chain.
-func (*Service) FarFutureEpoch(_ context.Context) (spec.Epoch, error) {
+func (s *Service) FarFutureEpoch(ctx context.Context) (spec.Epoch, error) {
+	if err := s.inject(ctx, "FarFutureEpoch"); err != nil {
+		return 0, err
+	}
+
 	return spec.Epoch(0xffffffffffffffff), nil
 }
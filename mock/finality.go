@@ -23,6 +23,10 @@ import (
 
 // Finality provides the finality given a state ID.
 func (s *Service) Finality(ctx context.Context, opts *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+	if err := s.inject(ctx, "Finality"); err != nil {
+		return nil, err
+	}
+
 	if s.FinalityFunc != nil {
 		return s.FinalityFunc(ctx, opts)
 	}
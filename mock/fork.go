@@ -27,6 +27,10 @@ func (s *Service) Fork(ctx context.Context,
 	*api.Response[*phase0.Fork],
 	error,
 ) {
+	if err := s.inject(ctx, "Fork"); err != nil {
+		return nil, err
+	}
+
 	if s.ForkFunc != nil {
 		return s.ForkFunc(ctx, opts)
 	}
@@ -27,6 +27,10 @@ func (s *Service) ForkChoice(ctx context.Context,
 	*api.Response[*apiv1.ForkChoice],
 	error,
 ) {
+	if err := s.inject(ctx, "ForkChoice"); err != nil {
+		return nil, err
+	}
+
 	if s.ForkChoiceFunc != nil {
 		return s.ForkChoiceFunc(ctx, opts)
 	}
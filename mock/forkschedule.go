@@ -27,6 +27,10 @@ func (s *Service) ForkSchedule(ctx context.Context,
 	*api.Response[[]*phase0.Fork],
 	error,
 ) {
+	if err := s.inject(ctx, "ForkSchedule"); err != nil {
+		return nil, err
+	}
+
 	if s.ForkScheduleFunc != nil {
 		return s.ForkScheduleFunc(ctx, opts)
 	}
@@ -0,0 +1,749 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/focil"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// WithAggregateAttestationFunc sets the function used to respond to calls to AggregateAttestation.
+func WithAggregateAttestationFunc(f func(context.Context, *api.AggregateAttestationOpts) (*api.Response[*spec.VersionedAttestation], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.AggregateAttestationFunc = f
+		})
+	})
+}
+
+// WithAttestationDataFunc sets the function used to respond to calls to AttestationData.
+func WithAttestationDataFunc(f func(context.Context, *api.AttestationDataOpts) (*api.Response[*phase0.AttestationData], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.AttestationDataFunc = f
+		})
+	})
+}
+
+// WithAttestationPoolFunc sets the function used to respond to calls to AttestationPool.
+func WithAttestationPoolFunc(f func(context.Context, *api.AttestationPoolOpts) (*api.Response[[]*spec.VersionedAttestation], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.AttestationPoolFunc = f
+		})
+	})
+}
+
+// WithAttestationRewardsFunc sets the function used to respond to calls to AttestationRewards.
+func WithAttestationRewardsFunc(f func(context.Context, *api.AttestationRewardsOpts) (*api.Response[*apiv1.AttestationRewards], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.AttestationRewardsFunc = f
+		})
+	})
+}
+
+// WithAttesterDutiesFunc sets the function used to respond to calls to AttesterDuties.
+func WithAttesterDutiesFunc(f func(context.Context, *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.AttesterDutiesFunc = f
+		})
+	})
+}
+
+// WithAttesterSlashingPoolFunc sets the function used to respond to calls to AttesterSlashingPool.
+func WithAttesterSlashingPoolFunc(f func(context.Context, *api.AttesterSlashingPoolOpts) (*api.Response[[]*spec.VersionedAttesterSlashing], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.AttesterSlashingPoolFunc = f
+		})
+	})
+}
+
+// WithBLSToExecutionChangePoolFunc sets the function used to respond to calls to BLSToExecutionChangePool.
+func WithBLSToExecutionChangePoolFunc(f func(context.Context, *api.BLSToExecutionChangePoolOpts) (*api.Response[[]*capella.SignedBLSToExecutionChange], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BLSToExecutionChangePoolFunc = f
+		})
+	})
+}
+
+// WithBeaconBlockHeaderFunc sets the function used to respond to calls to BeaconBlockHeader.
+func WithBeaconBlockHeaderFunc(f func(context.Context, *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BeaconBlockHeaderFunc = f
+		})
+	})
+}
+
+// WithBeaconBlockRootFunc sets the function used to respond to calls to BeaconBlockRoot.
+func WithBeaconBlockRootFunc(f func(context.Context, *api.BeaconBlockRootOpts) (*api.Response[*phase0.Root], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BeaconBlockRootFunc = f
+		})
+	})
+}
+
+// WithBeaconCommitteeSelectionsFunc sets the function used to respond to calls to BeaconCommitteeSelections.
+func WithBeaconCommitteeSelectionsFunc(f func(context.Context, *api.BeaconCommitteeSelectionsOpts) (*api.Response[[]*apiv1.BeaconCommitteeSelection], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BeaconCommitteeSelectionsFunc = f
+		})
+	})
+}
+
+// WithBeaconCommitteesFunc sets the function used to respond to calls to BeaconCommittees.
+func WithBeaconCommitteesFunc(f func(context.Context, *api.BeaconCommitteesOpts) (*api.Response[[]*apiv1.BeaconCommittee], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BeaconCommitteesFunc = f
+		})
+	})
+}
+
+// WithBeaconStateFunc sets the function used to respond to calls to BeaconState.
+func WithBeaconStateFunc(f func(context.Context, *api.BeaconStateOpts) (*api.Response[*spec.VersionedBeaconState], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BeaconStateFunc = f
+		})
+	})
+}
+
+// WithBeaconStateRandaoFunc sets the function used to respond to calls to BeaconStateRandao.
+func WithBeaconStateRandaoFunc(f func(context.Context, *api.BeaconStateRandaoOpts) (*api.Response[*phase0.Root], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BeaconStateRandaoFunc = f
+		})
+	})
+}
+
+// WithBeaconStateRootFunc sets the function used to respond to calls to BeaconStateRoot.
+func WithBeaconStateRootFunc(f func(context.Context, *api.BeaconStateRootOpts) (*api.Response[*phase0.Root], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BeaconStateRootFunc = f
+		})
+	})
+}
+
+// WithBlindedProposalFunc sets the function used to respond to calls to BlindedProposal.
+func WithBlindedProposalFunc(f func(context.Context, *api.BlindedProposalOpts) (*api.Response[*api.VersionedBlindedProposal], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BlindedProposalFunc = f
+		})
+	})
+}
+
+// WithBlobSidecarsFunc sets the function used to respond to calls to BlobSidecars.
+func WithBlobSidecarsFunc(f func(context.Context, *api.BlobSidecarsOpts) (*api.Response[[]*deneb.BlobSidecar], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BlobSidecarsFunc = f
+		})
+	})
+}
+
+// WithBlockRewardsFunc sets the function used to respond to calls to BlockRewards.
+func WithBlockRewardsFunc(f func(context.Context, *api.BlockRewardsOpts) (*api.Response[*apiv1.BlockRewards], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.BlockRewardsFunc = f
+		})
+	})
+}
+
+// WithClientInfoFunc sets the function used to respond to calls to ClientInfo.
+func WithClientInfoFunc(f func(context.Context) (*api.Response[*apiv1.ClientInfo], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ClientInfoFunc = f
+		})
+	})
+}
+
+// WithDataColumnSidecarsFunc sets the function used to respond to calls to DataColumnSidecars.
+func WithDataColumnSidecarsFunc(f func(context.Context, *api.DataColumnSidecarsOpts) (*api.Response[[]*fulu.DataColumnSidecar], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.DataColumnSidecarsFunc = f
+		})
+	})
+}
+
+// WithDepositContractFunc sets the function used to respond to calls to DepositContract.
+func WithDepositContractFunc(f func(context.Context, *api.DepositContractOpts) (*api.Response[*apiv1.DepositContract], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.DepositContractFunc = f
+		})
+	})
+}
+
+// WithDepositSnapshotFunc sets the function used to respond to calls to DepositSnapshot.
+func WithDepositSnapshotFunc(f func(context.Context, *api.DepositSnapshotOpts) (*api.Response[*apiv1.DepositSnapshot], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.DepositSnapshotFunc = f
+		})
+	})
+}
+
+// WithDownloadBeaconStateFunc sets the function used to respond to calls to DownloadBeaconState.
+func WithDownloadBeaconStateFunc(f func(context.Context, *api.DownloadBeaconStateOpts) (*api.Response[*api.BeaconStateDownload], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.DownloadBeaconStateFunc = f
+		})
+	})
+}
+
+// WithEventsFunc sets the function used to respond to calls to Events.
+func WithEventsFunc(f func(context.Context, []string, client.EventHandlerFunc) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.EventsFunc = f
+		})
+	})
+}
+
+// WithEventsWithHandlersFunc sets the function used to respond to calls to EventsWithHandlers.
+func WithEventsWithHandlersFunc(f func(context.Context, *client.EventHandlers) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.EventsWithHandlersFunc = f
+		})
+	})
+}
+
+// WithExpectedWithdrawalsFunc sets the function used to respond to calls to ExpectedWithdrawals.
+func WithExpectedWithdrawalsFunc(f func(context.Context, *api.ExpectedWithdrawalsOpts) (*api.Response[[]*capella.Withdrawal], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ExpectedWithdrawalsFunc = f
+		})
+	})
+}
+
+// WithFinalityFunc sets the function used to respond to calls to Finality.
+func WithFinalityFunc(f func(context.Context, *api.FinalityOpts) (*api.Response[*apiv1.Finality], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.FinalityFunc = f
+		})
+	})
+}
+
+// WithForkChoiceFunc sets the function used to respond to calls to ForkChoice.
+func WithForkChoiceFunc(f func(context.Context, *api.ForkChoiceOpts) (*api.Response[*apiv1.ForkChoice], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ForkChoiceFunc = f
+		})
+	})
+}
+
+// WithForkFunc sets the function used to respond to calls to Fork.
+func WithForkFunc(f func(context.Context, *api.ForkOpts) (*api.Response[*phase0.Fork], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ForkFunc = f
+		})
+	})
+}
+
+// WithForkScheduleFunc sets the function used to respond to calls to ForkSchedule.
+func WithForkScheduleFunc(f func(context.Context, *api.ForkScheduleOpts) (*api.Response[[]*phase0.Fork], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ForkScheduleFunc = f
+		})
+	})
+}
+
+// WithGenesisFunc sets the function used to respond to calls to Genesis.
+func WithGenesisFunc(f func(context.Context, *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.GenesisFunc = f
+		})
+	})
+}
+
+// WithInclusionListFunc sets the function used to respond to calls to InclusionList.
+func WithInclusionListFunc(f func(context.Context, *api.InclusionListOpts) (*api.Response[*focil.InclusionList], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.InclusionListFunc = f
+		})
+	})
+}
+
+// WithInclusionListDutiesFunc sets the function used to respond to calls to InclusionListDuties.
+func WithInclusionListDutiesFunc(f func(context.Context, *api.InclusionListDutiesOpts) (*api.Response[[]*apiv1.InclusionListDuty], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.InclusionListDutiesFunc = f
+		})
+	})
+}
+
+// WithLightClientBootstrapFunc sets the function used to respond to calls to LightClientBootstrap.
+func WithLightClientBootstrapFunc(f func(context.Context, *api.LightClientBootstrapOpts) (*api.Response[*spec.VersionedLightClientBootstrap], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.LightClientBootstrapFunc = f
+		})
+	})
+}
+
+// WithLightClientFinalityUpdateFunc sets the function used to respond to calls to LightClientFinalityUpdate.
+func WithLightClientFinalityUpdateFunc(f func(context.Context, *api.LightClientFinalityUpdateOpts) (*api.Response[*spec.VersionedLightClientFinalityUpdate], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.LightClientFinalityUpdateFunc = f
+		})
+	})
+}
+
+// WithLightClientOptimisticUpdateFunc sets the function used to respond to calls to LightClientOptimisticUpdate.
+func WithLightClientOptimisticUpdateFunc(f func(context.Context, *api.LightClientOptimisticUpdateOpts) (*api.Response[*spec.VersionedLightClientOptimisticUpdate], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.LightClientOptimisticUpdateFunc = f
+		})
+	})
+}
+
+// WithLightClientUpdatesFunc sets the function used to respond to calls to LightClientUpdates.
+func WithLightClientUpdatesFunc(f func(context.Context, *api.LightClientUpdatesOpts) (*api.Response[[]*spec.VersionedLightClientUpdate], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.LightClientUpdatesFunc = f
+		})
+	})
+}
+
+// WithNodeClientFunc sets the function used to respond to calls to NodeClient.
+func WithNodeClientFunc(f func(context.Context) (*api.Response[string], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.NodeClientFunc = f
+		})
+	})
+}
+
+// WithNodeIdentityFunc sets the function used to respond to calls to NodeIdentity.
+func WithNodeIdentityFunc(f func(context.Context, *api.NodeIdentityOpts) (*api.Response[*apiv1.NodeIdentity], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.NodeIdentityFunc = f
+		})
+	})
+}
+
+// WithNodePeerCountFunc sets the function used to respond to calls to NodePeerCount.
+func WithNodePeerCountFunc(f func(context.Context, *api.NodePeerCountOpts) (*api.Response[*apiv1.PeerCount], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.NodePeerCountFunc = f
+		})
+	})
+}
+
+// WithNodePeerFunc sets the function used to respond to calls to NodePeer.
+func WithNodePeerFunc(f func(context.Context, *api.NodePeerOpts) (*api.Response[*apiv1.Peer], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.NodePeerFunc = f
+		})
+	})
+}
+
+// WithNodePeersFunc sets the function used to respond to calls to NodePeers.
+func WithNodePeersFunc(f func(context.Context, *api.NodePeersOpts) (*api.Response[[]*apiv1.Peer], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.NodePeersFunc = f
+		})
+	})
+}
+
+// WithNodeSyncingFunc sets the function used to respond to calls to NodeSyncing.
+func WithNodeSyncingFunc(f func(context.Context, *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.NodeSyncingFunc = f
+		})
+	})
+}
+
+// WithNodeVersionFunc sets the function used to respond to calls to NodeVersion.
+func WithNodeVersionFunc(f func(context.Context, *api.NodeVersionOpts) (*api.Response[string], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.NodeVersionFunc = f
+		})
+	})
+}
+
+// WithProposalFunc sets the function used to respond to calls to Proposal.
+func WithProposalFunc(f func(context.Context, *api.ProposalOpts) (*api.Response[*api.VersionedProposal], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ProposalFunc = f
+		})
+	})
+}
+
+// WithProposerDutiesFunc sets the function used to respond to calls to ProposerDuties.
+func WithProposerDutiesFunc(f func(context.Context, *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ProposerDutiesFunc = f
+		})
+	})
+}
+
+// WithProposerSlashingPoolFunc sets the function used to respond to calls to ProposerSlashingPool.
+func WithProposerSlashingPoolFunc(f func(context.Context, *api.ProposerSlashingPoolOpts) (*api.Response[[]*phase0.ProposerSlashing], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ProposerSlashingPoolFunc = f
+		})
+	})
+}
+
+// WithSignedBeaconBlockFunc sets the function used to respond to calls to SignedBeaconBlock.
+func WithSignedBeaconBlockFunc(f func(context.Context, *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SignedBeaconBlockFunc = f
+		})
+	})
+}
+
+// WithSpecFunc sets the function used to respond to calls to Spec.
+func WithSpecFunc(f func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SpecFunc = f
+		})
+	})
+}
+
+// WithSubmitAggregateAttestationsFunc sets the function used to respond to calls to SubmitAggregateAttestations.
+func WithSubmitAggregateAttestationsFunc(f func(context.Context, *api.SubmitAggregateAttestationsOpts) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitAggregateAttestationsFunc = f
+		})
+	})
+}
+
+// WithSubmitAttestationsFunc sets the function used to respond to calls to SubmitAttestations.
+func WithSubmitAttestationsFunc(f func(context.Context, *api.SubmitAttestationsOpts) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitAttestationsFunc = f
+		})
+	})
+}
+
+// WithSubmitAttesterSlashingFunc sets the function used to respond to calls to SubmitAttesterSlashing.
+func WithSubmitAttesterSlashingFunc(f func(context.Context, *phase0.AttesterSlashing) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitAttesterSlashingFunc = f
+		})
+	})
+}
+
+// WithSubmitAttesterSlashingsFunc sets the function used to respond to calls to SubmitAttesterSlashings.
+func WithSubmitAttesterSlashingsFunc(f func(context.Context, *api.SubmitAttesterSlashingsOpts) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitAttesterSlashingsFunc = f
+		})
+	})
+}
+
+// WithSubmitBLSToExecutionChangesFunc sets the function used to respond to calls to SubmitBLSToExecutionChanges.
+func WithSubmitBLSToExecutionChangesFunc(f func(context.Context, []*capella.SignedBLSToExecutionChange) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitBLSToExecutionChangesFunc = f
+		})
+	})
+}
+
+// WithSubmitBeaconBlockFunc sets the function used to respond to calls to SubmitBeaconBlock.
+func WithSubmitBeaconBlockFunc(f func(context.Context, *spec.VersionedSignedBeaconBlock) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitBeaconBlockFunc = f
+		})
+	})
+}
+
+// WithSubmitBeaconBlockWithOptsFunc sets the function used to respond to calls to SubmitBeaconBlockWithOpts.
+func WithSubmitBeaconBlockWithOptsFunc(f func(context.Context, *api.SubmitBeaconBlockOpts) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitBeaconBlockWithOptsFunc = f
+		})
+	})
+}
+
+// WithSubmitBeaconCommitteeSubscriptionsFunc sets the function used to respond to calls to SubmitBeaconCommitteeSubscriptions.
+func WithSubmitBeaconCommitteeSubscriptionsFunc(f func(context.Context, []*apiv1.BeaconCommitteeSubscription) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitBeaconCommitteeSubscriptionsFunc = f
+		})
+	})
+}
+
+// WithSubmitBlindedBeaconBlockFunc sets the function used to respond to calls to SubmitBlindedBeaconBlock.
+func WithSubmitBlindedBeaconBlockFunc(f func(context.Context, *api.VersionedSignedBlindedBeaconBlock) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitBlindedBeaconBlockFunc = f
+		})
+	})
+}
+
+// WithSubmitBlindedProposalFunc sets the function used to respond to calls to SubmitBlindedProposal.
+func WithSubmitBlindedProposalFunc(f func(context.Context, *api.SubmitBlindedProposalOpts) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitBlindedProposalFunc = f
+		})
+	})
+}
+
+// WithSubmitInclusionListsFunc sets the function used to respond to calls to SubmitInclusionLists.
+func WithSubmitInclusionListsFunc(f func(context.Context, *api.SubmitInclusionListsOpts) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitInclusionListsFunc = f
+		})
+	})
+}
+
+// WithSubmitProposalFunc sets the function used to respond to calls to SubmitProposal.
+func WithSubmitProposalFunc(f func(context.Context, *api.SubmitProposalOpts) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitProposalFunc = f
+		})
+	})
+}
+
+// WithSubmitProposalPreparationsFunc sets the function used to respond to calls to SubmitProposalPreparations.
+func WithSubmitProposalPreparationsFunc(f func(context.Context, []*apiv1.ProposalPreparation) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitProposalPreparationsFunc = f
+		})
+	})
+}
+
+// WithSubmitProposalSlashingFunc sets the function used to respond to calls to SubmitProposalSlashing.
+func WithSubmitProposalSlashingFunc(f func(context.Context, *phase0.ProposerSlashing) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitProposalSlashingFunc = f
+		})
+	})
+}
+
+// WithSubmitSyncCommitteeContributionsFunc sets the function used to respond to calls to SubmitSyncCommitteeContributions.
+func WithSubmitSyncCommitteeContributionsFunc(f func(context.Context, []*altair.SignedContributionAndProof) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitSyncCommitteeContributionsFunc = f
+		})
+	})
+}
+
+// WithSubmitSyncCommitteeMessagesFunc sets the function used to respond to calls to SubmitSyncCommitteeMessages.
+func WithSubmitSyncCommitteeMessagesFunc(f func(context.Context, []*altair.SyncCommitteeMessage) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitSyncCommitteeMessagesFunc = f
+		})
+	})
+}
+
+// WithSubmitSyncCommitteeSubscriptionsFunc sets the function used to respond to calls to SubmitSyncCommitteeSubscriptions.
+func WithSubmitSyncCommitteeSubscriptionsFunc(f func(context.Context, []*apiv1.SyncCommitteeSubscription) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitSyncCommitteeSubscriptionsFunc = f
+		})
+	})
+}
+
+// WithSubmitValidatorRegistrationsFunc sets the function used to respond to calls to SubmitValidatorRegistrations.
+func WithSubmitValidatorRegistrationsFunc(f func(context.Context, []*api.VersionedSignedValidatorRegistration) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitValidatorRegistrationsFunc = f
+		})
+	})
+}
+
+// WithSubmitVoluntaryExitFunc sets the function used to respond to calls to SubmitVoluntaryExit.
+func WithSubmitVoluntaryExitFunc(f func(context.Context, *phase0.SignedVoluntaryExit) error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SubmitVoluntaryExitFunc = f
+		})
+	})
+}
+
+// WithSupportedMethodsFunc sets the function used to respond to calls to SupportedMethods.
+func WithSupportedMethodsFunc(f func(context.Context) (*api.SupportedMethods, error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SupportedMethodsFunc = f
+		})
+	})
+}
+
+// WithSyncCommitteeContributionFunc sets the function used to respond to calls to SyncCommitteeContribution.
+func WithSyncCommitteeContributionFunc(f func(context.Context, *api.SyncCommitteeContributionOpts) (*api.Response[*altair.SyncCommitteeContribution], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SyncCommitteeContributionFunc = f
+		})
+	})
+}
+
+// WithSyncCommitteeDutiesFunc sets the function used to respond to calls to SyncCommitteeDuties.
+func WithSyncCommitteeDutiesFunc(f func(context.Context, *api.SyncCommitteeDutiesOpts) (*api.Response[[]*apiv1.SyncCommitteeDuty], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SyncCommitteeDutiesFunc = f
+		})
+	})
+}
+
+// WithSyncCommitteeFunc sets the function used to respond to calls to SyncCommittee.
+func WithSyncCommitteeFunc(f func(context.Context, *api.SyncCommitteeOpts) (*api.Response[*apiv1.SyncCommittee], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SyncCommitteeFunc = f
+		})
+	})
+}
+
+// WithSyncCommitteeRewardsFunc sets the function used to respond to calls to SyncCommitteeRewards.
+func WithSyncCommitteeRewardsFunc(f func(context.Context, *api.SyncCommitteeRewardsOpts) (*api.Response[[]*apiv1.SyncCommitteeReward], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SyncCommitteeRewardsFunc = f
+		})
+	})
+}
+
+// WithSyncCommitteeSelectionsFunc sets the function used to respond to calls to SyncCommitteeSelections.
+func WithSyncCommitteeSelectionsFunc(f func(context.Context, *api.SyncCommitteeSelectionsOpts) (*api.Response[[]*apiv1.SyncCommitteeSelection], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.SyncCommitteeSelectionsFunc = f
+		})
+	})
+}
+
+// WithValidatorBalancesFunc sets the function used to respond to calls to ValidatorBalances.
+func WithValidatorBalancesFunc(f func(context.Context, *api.ValidatorBalancesOpts) (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ValidatorBalancesFunc = f
+		})
+	})
+}
+
+// WithValidatorIdentitiesFunc sets the function used to respond to calls to ValidatorIdentities.
+func WithValidatorIdentitiesFunc(f func(context.Context, *api.ValidatorIdentitiesOpts) (*api.Response[[]*apiv1.ValidatorIdentity], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ValidatorIdentitiesFunc = f
+		})
+	})
+}
+
+// WithValidatorLivenessFunc sets the function used to respond to calls to ValidatorLiveness.
+func WithValidatorLivenessFunc(f func(context.Context, *api.ValidatorLivenessOpts) (*api.Response[[]*apiv1.ValidatorLiveness], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ValidatorLivenessFunc = f
+		})
+	})
+}
+
+// WithValidatorsFunc sets the function used to respond to calls to Validators.
+func WithValidatorsFunc(f func(context.Context, *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ValidatorsFunc = f
+		})
+	})
+}
+
+// WithValidatorsIteratorFunc sets the function used to respond to calls to ValidatorsIterator.
+func WithValidatorsIteratorFunc(f func(context.Context, *api.ValidatorsIteratorOpts) (*api.ValidatorsIterator, error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.ValidatorsIteratorFunc = f
+		})
+	})
+}
+
+// WithVersionedBlobSidecarsFunc sets the function used to respond to calls to VersionedBlobSidecars.
+func WithVersionedBlobSidecarsFunc(f func(context.Context, *api.BlobSidecarsOpts) (*api.Response[*api.VersionedBlobSidecars], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.VersionedBlobSidecarsFunc = f
+		})
+	})
+}
+
+// WithVoluntaryExitPoolFunc sets the function used to respond to calls to VoluntaryExitPool.
+func WithVoluntaryExitPoolFunc(f func(context.Context, *api.VoluntaryExitPoolOpts) (*api.Response[[]*phase0.SignedVoluntaryExit], error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.funcs = append(p.funcs, func(s *Service) {
+			s.VoluntaryExitPoolFunc = f
+		})
+	})
+}
@@ -23,6 +23,10 @@ import (
 
 // Genesis provides the genesis information of the chain.
 func (s *Service) Genesis(ctx context.Context, opts *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+	if err := s.inject(ctx, "Genesis"); err != nil {
+		return nil, err
+	}
+
 	if s.GenesisFunc != nil {
 		return s.GenesisFunc(ctx, opts)
 	}
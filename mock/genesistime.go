@@ -22,6 +22,10 @@ import (
 
 // GenesisTime provides the genesis time of the chain.
 func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
+	if err := s.inject(ctx, "GenesisTime"); err != nil {
+		return time.Time{}, err
+	}
+
 	genesisResponse, err := s.Genesis(ctx, &api.GenesisOpts{})
 	if err != nil {
 		return time.Time{}, err
@@ -0,0 +1,46 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/focil"
+)
+
+// InclusionList fetches an inclusion list for the given options.
+func (s *Service) InclusionList(ctx context.Context,
+	opts *api.InclusionListOpts,
+) (
+	*api.Response[*focil.InclusionList],
+	error,
+) {
+	if err := s.inject(ctx, "InclusionList"); err != nil {
+		return nil, err
+	}
+
+	if s.InclusionListFunc != nil {
+		return s.InclusionListFunc(ctx, opts)
+	}
+
+	return &api.Response[*focil.InclusionList]{
+		Data: &focil.InclusionList{
+			Slot:         opts.Slot,
+			Transactions: make([]bellatrix.Transaction, 0),
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
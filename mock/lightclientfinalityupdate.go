@@ -0,0 +1,46 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+)
+
+// LightClientFinalityUpdate fetches the latest light client finality update given options.
+func (s *Service) LightClientFinalityUpdate(ctx context.Context,
+	opts *api.LightClientFinalityUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientFinalityUpdate],
+	error,
+) {
+	if err := s.inject(ctx, "LightClientFinalityUpdate"); err != nil {
+		return nil, err
+	}
+
+	if s.LightClientFinalityUpdateFunc != nil {
+		return s.LightClientFinalityUpdateFunc(ctx, opts)
+	}
+
+	return &api.Response[*spec.VersionedLightClientFinalityUpdate]{
+		Data: &spec.VersionedLightClientFinalityUpdate{
+			Version: spec.DataVersionAltair,
+			Altair:  &altair.LightClientFinalityUpdate{},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
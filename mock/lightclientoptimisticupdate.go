@@ -0,0 +1,46 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+)
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update given options.
+func (s *Service) LightClientOptimisticUpdate(ctx context.Context,
+	opts *api.LightClientOptimisticUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientOptimisticUpdate],
+	error,
+) {
+	if err := s.inject(ctx, "LightClientOptimisticUpdate"); err != nil {
+		return nil, err
+	}
+
+	if s.LightClientOptimisticUpdateFunc != nil {
+		return s.LightClientOptimisticUpdateFunc(ctx, opts)
+	}
+
+	return &api.Response[*spec.VersionedLightClientOptimisticUpdate]{
+		Data: &spec.VersionedLightClientOptimisticUpdate{
+			Version: spec.DataVersionAltair,
+			Altair:  &altair.LightClientOptimisticUpdate{},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,52 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// NodeIdentity provides the network identity of the node.
+func (s *Service) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	if err := s.inject(ctx, "NodeIdentity"); err != nil {
+		return nil, err
+	}
+
+	if s.NodeIdentityFunc != nil {
+		return s.NodeIdentityFunc(ctx, opts)
+	}
+
+	return &api.Response[*apiv1.NodeIdentity]{
+		Data: &apiv1.NodeIdentity{
+			PeerID:             "MOCK16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96",
+			ENR:                "enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8",
+			P2PAddresses:       []string{"/ip4/127.0.0.1/tcp/9000"},
+			DiscoveryAddresses: []string{"/ip4/127.0.0.1/udp/9000"},
+			Metadata: &apiv1.NodeMetadata{
+				Attnets:  bitfield.NewBitvector64(),
+				Syncnets: bitfield.NewBitvector4(),
+			},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -27,6 +27,10 @@ func (s *Service) NodePeers(ctx context.Context,
 	*api.Response[[]*apiv1.Peer],
 	error,
 ) {
+	if err := s.inject(ctx, "NodePeers"); err != nil {
+		return nil, err
+	}
+
 	if s.NodePeersFunc != nil {
 		return s.NodePeersFunc(ctx, opts)
 	}
@@ -27,6 +27,10 @@ func (s *Service) NodeSyncing(ctx context.Context,
 	*api.Response[*apiv1.SyncState],
 	error,
 ) {
+	if err := s.inject(ctx, "NodeSyncing"); err != nil {
+		return nil, err
+	}
+
 	if s.NodeSyncingFunc != nil {
 		return s.NodeSyncingFunc(ctx, opts)
 	}
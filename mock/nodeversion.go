@@ -26,6 +26,10 @@ func (s *Service) NodeVersion(ctx context.Context,
 	*api.Response[string],
 	error,
 ) {
+	if err := s.inject(ctx, "NodeVersion"); err != nil {
+		return nil, err
+	}
+
 	if s.NodeVersionFunc != nil {
 		return s.NodeVersionFunc(ctx, opts)
 	}
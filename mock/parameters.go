@@ -17,14 +17,19 @@ import (
 	"errors"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
-	logLevel    zerolog.Level
-	name        string
-	timeout     time.Duration
-	genesisTime time.Time
+	logLevel      zerolog.Level
+	name          string
+	timeout       time.Duration
+	genesisTime   time.Time
+	syncCommittee []phase0.ValidatorIndex
+	latency       time.Duration
+	errors        map[string]error
+	funcs         []func(*Service)
 }
 
 // Parameter is the interface for service parameters.
@@ -66,6 +71,29 @@ func WithGenesisTime(genesisTime time.Time) Parameter {
 	})
 }
 
+// WithSyncCommittee sets the validators in the sync committee for the mock.
+// If set, sync committee and sync committee duty responses are based on it.
+func WithSyncCommittee(validators []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncCommittee = validators
+	})
+}
+
+// WithLatency sets a delay applied to every call made to the mock.
+func WithLatency(latency time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.latency = latency
+	})
+}
+
+// WithError sets an error to be returned by every call to the named method,
+// for example "BeaconBlockHeader" or "SubmitAttestations".
+func WithError(method string, err error) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.errors[method] = err
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -73,6 +101,7 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		name:        "mock",
 		timeout:     2 * time.Second,
 		genesisTime: time.Now(),
+		errors:      make(map[string]error),
 	}
 	for _, p := range params {
 		if params != nil {
@@ -83,6 +112,9 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.name == "" {
 		return nil, errors.New("name not specified")
 	}
+	if parameters.latency < 0 {
+		return nil, errors.New("latency cannot be negative")
+	}
 
 	return &parameters, nil
 }
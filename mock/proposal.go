@@ -28,6 +28,10 @@ func (s *Service) Proposal(ctx context.Context,
 ) (
 	*api.Response[*api.VersionedProposal], error,
 ) {
+	if err := s.inject(ctx, "Proposal"); err != nil {
+		return nil, err
+	}
+
 	if s.ProposalFunc != nil {
 		return s.ProposalFunc(ctx, opts)
 	}
@@ -23,6 +23,10 @@ import (
 // ProposerDuties obtains proposer duties for the given epoch.
 // If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
 func (s *Service) ProposerDuties(ctx context.Context, opts *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error) {
+	if err := s.inject(ctx, "ProposerDuties"); err != nil {
+		return nil, err
+	}
+
 	if s.ProposerDutiesFunc != nil {
 		return s.ProposerDutiesFunc(ctx, opts)
 	}
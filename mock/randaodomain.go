@@ -20,6 +20,10 @@ import (
 )
 
 // RANDAODomain provides the RANDAO domain.
-func (*Service) RANDAODomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) RANDAODomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx, "RANDAODomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+
 	return spec.DomainType{0x02, 0x00, 0x00, 0x00}, nil
 }
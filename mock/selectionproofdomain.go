@@ -20,6 +20,10 @@ import (
 )
 
 // SelectionProofDomain provides the selection proof domain.
-func (*Service) SelectionProofDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) SelectionProofDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx, "SelectionProofDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+
 	return spec.DomainType{0x05, 0x00, 0x00, 0x00}, nil
 }
@@ -22,6 +22,10 @@ import (
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/focil"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -35,7 +39,8 @@ type Service struct {
 	name    string
 	timeout time.Duration
 
-	genesisTime time.Time
+	genesisTime   time.Time
+	syncCommittee []phase0.ValidatorIndex
 
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
@@ -45,41 +50,97 @@ type Service struct {
 	// forkSchedule    []*phase0.Fork
 	nodeVersion string
 
+	// Latency and errors injected in to calls.
+	latency        time.Duration
+	injectedErrors map[string]error
+
 	// Values that can be altered if required.
 	HeadSlot     phase0.Slot
 	SyncDistance phase0.Slot
 
 	// Functions that can be provided to mock specific responses from this client.
-	AggregateAttestationFunc      func(context.Context, *api.AggregateAttestationOpts) (*api.Response[*spec.VersionedAttestation], error)
-	AttesterDutiesFunc            func(context.Context, *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error)
-	AttestationDataFunc           func(context.Context, *api.AttestationDataOpts) (*api.Response[*phase0.AttestationData], error)
-	AttestationRewardsFunc        func(context.Context, *api.AttestationRewardsOpts) (*api.Response[*apiv1.AttestationRewards], error)
-	BeaconBlockHeaderFunc         func(context.Context, *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error)
-	BeaconBlockRootFunc           func(context.Context, *api.BeaconBlockRootOpts) (*api.Response[*phase0.Root], error)
-	BeaconStateFunc               func(context.Context, *api.BeaconStateOpts) (*api.Response[*spec.VersionedBeaconState], error)
-	BeaconStateRandaoFunc         func(context.Context, *api.BeaconStateRandaoOpts) (*api.Response[*phase0.Root], error)
-	BeaconStateRootFunc           func(context.Context, *api.BeaconStateRootOpts) (*api.Response[*phase0.Root], error)
-	BlockRewardsFunc              func(context.Context, *api.BlockRewardsOpts) (*api.Response[*apiv1.BlockRewards], error)
-	DepositContractFunc           func(context.Context, *api.DepositContractOpts) (*api.Response[*apiv1.DepositContract], error)
-	EventsFunc                    func(context.Context, []string, client.EventHandlerFunc) error
-	FinalityFunc                  func(context.Context, *api.FinalityOpts) (*api.Response[*apiv1.Finality], error)
-	ForkChoiceFunc                func(context.Context, *api.ForkChoiceOpts) (*api.Response[*apiv1.ForkChoice], error)
-	ForkFunc                      func(context.Context, *api.ForkOpts) (*api.Response[*phase0.Fork], error)
-	ForkScheduleFunc              func(context.Context, *api.ForkScheduleOpts) (*api.Response[[]*phase0.Fork], error)
-	GenesisFunc                   func(context.Context, *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error)
-	NodePeersFunc                 func(context.Context, *api.NodePeersOpts) (*api.Response[[]*apiv1.Peer], error)
-	NodeSyncingFunc               func(context.Context, *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error)
-	NodeVersionFunc               func(context.Context, *api.NodeVersionOpts) (*api.Response[string], error)
-	ProposalFunc                  func(context.Context, *api.ProposalOpts) (*api.Response[*api.VersionedProposal], error)
-	ProposerDutiesFunc            func(context.Context, *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error)
-	SignedBeaconBlockFunc         func(context.Context, *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error)
-	SpecFunc                      func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error)
-	SyncCommitteeContributionFunc func(context.Context, *api.SyncCommitteeContributionOpts) (*api.Response[*altair.SyncCommitteeContribution], error)
-	SyncCommitteeDutiesFunc       func(context.Context, *api.SyncCommitteeDutiesOpts) (*api.Response[[]*apiv1.SyncCommitteeDuty], error)
-	SyncCommitteeRewardsFunc      func(context.Context, *api.SyncCommitteeRewardsOpts) (*api.Response[[]*apiv1.SyncCommitteeReward], error)
-	ValidatorBalancesFunc         func(context.Context, *api.ValidatorBalancesOpts) (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error)
-	ValidatorsFunc                func(context.Context, *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error)
-	VoluntaryExitPoolFunc         func(context.Context, *api.VoluntaryExitPoolOpts) (*api.Response[[]*phase0.SignedVoluntaryExit], error)
+	AggregateAttestationFunc        func(context.Context, *api.AggregateAttestationOpts) (*api.Response[*spec.VersionedAttestation], error)
+	AttestationDataFunc             func(context.Context, *api.AttestationDataOpts) (*api.Response[*phase0.AttestationData], error)
+	AttestationPoolFunc             func(context.Context, *api.AttestationPoolOpts) (*api.Response[[]*spec.VersionedAttestation], error)
+	AttestationRewardsFunc          func(context.Context, *api.AttestationRewardsOpts) (*api.Response[*apiv1.AttestationRewards], error)
+	AttesterDutiesFunc              func(context.Context, *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error)
+	AttesterSlashingPoolFunc        func(context.Context, *api.AttesterSlashingPoolOpts) (*api.Response[[]*spec.VersionedAttesterSlashing], error)
+	BLSToExecutionChangePoolFunc    func(context.Context, *api.BLSToExecutionChangePoolOpts) (*api.Response[[]*capella.SignedBLSToExecutionChange], error)
+	BeaconBlockHeaderFunc           func(context.Context, *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error)
+	BeaconBlockRootFunc             func(context.Context, *api.BeaconBlockRootOpts) (*api.Response[*phase0.Root], error)
+	BeaconCommitteeSelectionsFunc   func(context.Context, *api.BeaconCommitteeSelectionsOpts) (*api.Response[[]*apiv1.BeaconCommitteeSelection], error)
+	BeaconCommitteesFunc            func(context.Context, *api.BeaconCommitteesOpts) (*api.Response[[]*apiv1.BeaconCommittee], error)
+	BeaconStateFunc                 func(context.Context, *api.BeaconStateOpts) (*api.Response[*spec.VersionedBeaconState], error)
+	BeaconStateRandaoFunc           func(context.Context, *api.BeaconStateRandaoOpts) (*api.Response[*phase0.Root], error)
+	BeaconStateRootFunc             func(context.Context, *api.BeaconStateRootOpts) (*api.Response[*phase0.Root], error)
+	BlindedProposalFunc             func(context.Context, *api.BlindedProposalOpts) (*api.Response[*api.VersionedBlindedProposal], error)
+	BlobSidecarsFunc                func(context.Context, *api.BlobSidecarsOpts) (*api.Response[[]*deneb.BlobSidecar], error)
+	BlockRewardsFunc                func(context.Context, *api.BlockRewardsOpts) (*api.Response[*apiv1.BlockRewards], error)
+	ClientInfoFunc                  func(context.Context) (*api.Response[*apiv1.ClientInfo], error)
+	DataColumnSidecarsFunc          func(context.Context, *api.DataColumnSidecarsOpts) (*api.Response[[]*fulu.DataColumnSidecar], error)
+	DepositContractFunc             func(context.Context, *api.DepositContractOpts) (*api.Response[*apiv1.DepositContract], error)
+	DepositSnapshotFunc             func(context.Context, *api.DepositSnapshotOpts) (*api.Response[*apiv1.DepositSnapshot], error)
+	DownloadBeaconStateFunc         func(context.Context, *api.DownloadBeaconStateOpts) (*api.Response[*api.BeaconStateDownload], error)
+	EventsFunc                      func(context.Context, []string, client.EventHandlerFunc) error
+	EventsWithHandlersFunc          func(context.Context, *client.EventHandlers) error
+	ExpectedWithdrawalsFunc         func(context.Context, *api.ExpectedWithdrawalsOpts) (*api.Response[[]*capella.Withdrawal], error)
+	FinalityFunc                    func(context.Context, *api.FinalityOpts) (*api.Response[*apiv1.Finality], error)
+	ForkChoiceFunc                  func(context.Context, *api.ForkChoiceOpts) (*api.Response[*apiv1.ForkChoice], error)
+	ForkFunc                        func(context.Context, *api.ForkOpts) (*api.Response[*phase0.Fork], error)
+	ForkScheduleFunc                func(context.Context, *api.ForkScheduleOpts) (*api.Response[[]*phase0.Fork], error)
+	GenesisFunc                     func(context.Context, *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error)
+	InclusionListDutiesFunc         func(context.Context, *api.InclusionListDutiesOpts) (*api.Response[[]*apiv1.InclusionListDuty], error)
+	InclusionListFunc               func(context.Context, *api.InclusionListOpts) (*api.Response[*focil.InclusionList], error)
+	LightClientBootstrapFunc        func(context.Context, *api.LightClientBootstrapOpts) (*api.Response[*spec.VersionedLightClientBootstrap], error)
+	LightClientFinalityUpdateFunc   func(context.Context, *api.LightClientFinalityUpdateOpts) (*api.Response[*spec.VersionedLightClientFinalityUpdate], error)
+	LightClientOptimisticUpdateFunc func(context.Context, *api.LightClientOptimisticUpdateOpts) (*api.Response[*spec.VersionedLightClientOptimisticUpdate], error)
+	LightClientUpdatesFunc          func(context.Context, *api.LightClientUpdatesOpts) (*api.Response[[]*spec.VersionedLightClientUpdate], error)
+	NodeClientFunc                  func(context.Context) (*api.Response[string], error)
+	NodeIdentityFunc                func(context.Context, *api.NodeIdentityOpts) (*api.Response[*apiv1.NodeIdentity], error)
+	NodePeerCountFunc               func(context.Context, *api.NodePeerCountOpts) (*api.Response[*apiv1.PeerCount], error)
+	NodePeerFunc                    func(context.Context, *api.NodePeerOpts) (*api.Response[*apiv1.Peer], error)
+	NodePeersFunc                   func(context.Context, *api.NodePeersOpts) (*api.Response[[]*apiv1.Peer], error)
+	NodeSyncingFunc                 func(context.Context, *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error)
+	NodeVersionFunc                 func(context.Context, *api.NodeVersionOpts) (*api.Response[string], error)
+	ProposalFunc                    func(context.Context, *api.ProposalOpts) (*api.Response[*api.VersionedProposal], error)
+	ProposerDutiesFunc              func(context.Context, *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error)
+	ProposerSlashingPoolFunc        func(context.Context, *api.ProposerSlashingPoolOpts) (*api.Response[[]*phase0.ProposerSlashing], error)
+	SignedBeaconBlockFunc           func(context.Context, *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error)
+	SpecFunc                        func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error)
+	SupportedMethodsFunc            func(context.Context) (*api.SupportedMethods, error)
+	SyncCommitteeContributionFunc   func(context.Context, *api.SyncCommitteeContributionOpts) (*api.Response[*altair.SyncCommitteeContribution], error)
+	SyncCommitteeDutiesFunc         func(context.Context, *api.SyncCommitteeDutiesOpts) (*api.Response[[]*apiv1.SyncCommitteeDuty], error)
+	SyncCommitteeFunc               func(context.Context, *api.SyncCommitteeOpts) (*api.Response[*apiv1.SyncCommittee], error)
+	SyncCommitteeRewardsFunc        func(context.Context, *api.SyncCommitteeRewardsOpts) (*api.Response[[]*apiv1.SyncCommitteeReward], error)
+	SyncCommitteeSelectionsFunc     func(context.Context, *api.SyncCommitteeSelectionsOpts) (*api.Response[[]*apiv1.SyncCommitteeSelection], error)
+	ValidatorBalancesFunc           func(context.Context, *api.ValidatorBalancesOpts) (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error)
+	ValidatorIdentitiesFunc         func(context.Context, *api.ValidatorIdentitiesOpts) (*api.Response[[]*apiv1.ValidatorIdentity], error)
+	ValidatorLivenessFunc           func(context.Context, *api.ValidatorLivenessOpts) (*api.Response[[]*apiv1.ValidatorLiveness], error)
+	ValidatorsFunc                  func(context.Context, *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error)
+	ValidatorsIteratorFunc          func(context.Context, *api.ValidatorsIteratorOpts) (*api.ValidatorsIterator, error)
+	VersionedBlobSidecarsFunc       func(context.Context, *api.BlobSidecarsOpts) (*api.Response[*api.VersionedBlobSidecars], error)
+	VoluntaryExitPoolFunc           func(context.Context, *api.VoluntaryExitPoolOpts) (*api.Response[[]*phase0.SignedVoluntaryExit], error)
+
+	// Functions that can be provided to mock submissions to this client.
+	SubmitAggregateAttestationsFunc        func(context.Context, *api.SubmitAggregateAttestationsOpts) error
+	SubmitAttestationsFunc                 func(context.Context, *api.SubmitAttestationsOpts) error
+	SubmitAttesterSlashingFunc             func(context.Context, *phase0.AttesterSlashing) error
+	SubmitAttesterSlashingsFunc            func(context.Context, *api.SubmitAttesterSlashingsOpts) error
+	SubmitBLSToExecutionChangesFunc        func(context.Context, []*capella.SignedBLSToExecutionChange) error
+	SubmitBeaconBlockFunc                  func(context.Context, *spec.VersionedSignedBeaconBlock) error
+	SubmitBeaconBlockWithOptsFunc          func(context.Context, *api.SubmitBeaconBlockOpts) error
+	SubmitBeaconCommitteeSubscriptionsFunc func(context.Context, []*apiv1.BeaconCommitteeSubscription) error
+	SubmitBlindedBeaconBlockFunc           func(context.Context, *api.VersionedSignedBlindedBeaconBlock) error
+	SubmitBlindedProposalFunc              func(context.Context, *api.SubmitBlindedProposalOpts) error
+	SubmitInclusionListsFunc               func(context.Context, *api.SubmitInclusionListsOpts) error
+	SubmitProposalFunc                     func(context.Context, *api.SubmitProposalOpts) error
+	SubmitProposalPreparationsFunc         func(context.Context, []*apiv1.ProposalPreparation) error
+	SubmitProposalSlashingFunc             func(context.Context, *phase0.ProposerSlashing) error
+	SubmitSyncCommitteeContributionsFunc   func(context.Context, []*altair.SignedContributionAndProof) error
+	SubmitSyncCommitteeMessagesFunc        func(context.Context, []*altair.SyncCommitteeMessage) error
+	SubmitSyncCommitteeSubscriptionsFunc   func(context.Context, []*apiv1.SyncCommitteeSubscription) error
+	SubmitValidatorRegistrationsFunc       func(context.Context, []*api.VersionedSignedValidatorRegistration) error
+	SubmitVoluntaryExitFunc                func(context.Context, *phase0.SignedVoluntaryExit) error
 }
 
 // log is a service-wide logger.
@@ -99,10 +160,11 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	}
 
 	s := &Service{
-		name:        parameters.name,
-		genesisTime: parameters.genesisTime,
-		timeout:     parameters.timeout,
-		nodeVersion: "mock",
+		name:          parameters.name,
+		genesisTime:   parameters.genesisTime,
+		syncCommittee: parameters.syncCommittee,
+		timeout:       parameters.timeout,
+		nodeVersion:   "mock",
 
 		HeadSlot:     12345,
 		SyncDistance: 0,
@@ -113,6 +175,14 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
 
+	// Apply scripted behaviour once static values are in place, so that it
+	// only affects calls made by the caller.
+	s.latency = parameters.latency
+	s.injectedErrors = parameters.errors
+	for _, f := range parameters.funcs {
+		f(s)
+	}
+
 	// Close the service on context done.
 	go func(*Service) {
 		<-ctx.Done()
@@ -15,13 +15,232 @@ package mock_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/stretchr/testify/require"
 )
 
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []mock.Parameter
+		err    string
+	}{
+		{
+			name:   "NameMissing",
+			params: []mock.Parameter{mock.WithName("")},
+			err:    "problem with parameters: name not specified",
+		},
+		{
+			name:   "LatencyNegative",
+			params: []mock.Parameter{mock.WithLatency(-1)},
+			err:    "problem with parameters: latency cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []mock.Parameter{
+				mock.WithLatency(time.Millisecond),
+				mock.WithError("Genesis", errors.New("injected")),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := mock.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	s, err := mock.New(context.Background())
+	require.NoError(t, err)
+
+	require.Implements(t, (*client.EpochFromStateIDProvider)(nil), s)
+	require.Implements(t, (*client.SlotFromStateIDProvider)(nil), s)
+	require.Implements(t, (*client.SlotDurationProvider)(nil), s)
+	require.Implements(t, (*client.SlotsPerEpochProvider)(nil), s)
+	require.Implements(t, (*client.FarFutureEpochProvider)(nil), s)
+	require.Implements(t, (*client.TargetAggregatorsPerCommitteeProvider)(nil), s)
+	require.Implements(t, (*client.SignedBeaconBlockProvider)(nil), s)
+	require.Implements(t, (*client.BlobSidecarsProvider)(nil), s)
+	require.Implements(t, (*client.VersionedBlobSidecarsProvider)(nil), s)
+	require.Implements(t, (*client.DataColumnSidecarsProvider)(nil), s)
+	require.Implements(t, (*client.LightClientBootstrapProvider)(nil), s)
+	require.Implements(t, (*client.LightClientUpdatesProvider)(nil), s)
+	require.Implements(t, (*client.LightClientFinalityUpdateProvider)(nil), s)
+	require.Implements(t, (*client.LightClientOptimisticUpdateProvider)(nil), s)
+	require.Implements(t, (*client.BeaconCommitteesProvider)(nil), s)
+	require.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
+	require.Implements(t, (*client.AggregateAttestationProvider)(nil), s)
+	require.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), s)
+	require.Implements(t, (*client.AttestationDataProvider)(nil), s)
+	require.Implements(t, (*client.AttestationPoolProvider)(nil), s)
+	require.Implements(t, (*client.AttestationRewardsProvider)(nil), s)
+	require.Implements(t, (*client.AttestationsSubmitter)(nil), s)
+	require.Implements(t, (*client.AttesterSlashingSubmitter)(nil), s)
+	require.Implements(t, (*client.AttesterSlashingsSubmitter)(nil), s)
+	require.Implements(t, (*client.BLSToExecutionChangePoolProvider)(nil), s)
+	require.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
+	require.Implements(t, (*client.AttesterSlashingPoolProvider)(nil), s)
+	require.Implements(t, (*client.BlockRewardsProvider)(nil), s)
+	require.Implements(t, (*client.DepositContractProvider)(nil), s)
+	require.Implements(t, (*client.DepositSnapshotProvider)(nil), s)
+	require.Implements(t, (*client.SyncCommitteeDutiesProvider)(nil), s)
+	require.Implements(t, (*client.SyncCommitteeMessagesSubmitter)(nil), s)
+	require.Implements(t, (*client.SyncCommitteeSelectionsProvider)(nil), s)
+	require.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
+	require.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
+	require.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
+	require.Implements(t, (*client.SyncCommitteeRewardsProvider)(nil), s)
+	require.Implements(t, (*client.BLSToExecutionChangesSubmitter)(nil), s)
+	require.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
+	require.Implements(t, (*client.ProposalProvider)(nil), s)
+	require.Implements(t, (*client.ProposalSlashingSubmitter)(nil), s)
+	require.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
+	require.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
+	require.Implements(t, (*client.BeaconBlockWithOptsSubmitter)(nil), s)
+	require.Implements(t, (*client.ProposalSubmitter)(nil), s)
+	require.Implements(t, (*client.BeaconCommitteeSelectionsProvider)(nil), s)
+	require.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
+	require.Implements(t, (*client.BeaconStateProvider)(nil), s)
+	require.Implements(t, (*client.BeaconStateDownloader)(nil), s)
+	require.Implements(t, (*client.BeaconStateRandaoProvider)(nil), s)
+	require.Implements(t, (*client.BeaconStateRootProvider)(nil), s)
+	require.Implements(t, (*client.BlindedBeaconBlockSubmitter)(nil), s)
+	require.Implements(t, (*client.BlindedProposalSubmitter)(nil), s)
+	require.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
+	require.Implements(t, (*client.EventsProvider)(nil), s)
+	require.Implements(t, (*client.EventsWithHandlersProvider)(nil), s)
+	require.Implements(t, (*client.ExpectedWithdrawalsProvider)(nil), s)
+	require.Implements(t, (*client.FinalityProvider)(nil), s)
+	require.Implements(t, (*client.ForkChoiceProvider)(nil), s)
+	require.Implements(t, (*client.ForkProvider)(nil), s)
+	require.Implements(t, (*client.ForkScheduleProvider)(nil), s)
+	require.Implements(t, (*client.GenesisProvider)(nil), s)
+	require.Implements(t, (*client.InclusionListProvider)(nil), s)
+	require.Implements(t, (*client.InclusionListDutiesProvider)(nil), s)
+	require.Implements(t, (*client.InclusionListsSubmitter)(nil), s)
+	require.Implements(t, (*client.NodeIdentityProvider)(nil), s)
+	require.Implements(t, (*client.NodePeerProvider)(nil), s)
+	require.Implements(t, (*client.NodePeerCountProvider)(nil), s)
+	require.Implements(t, (*client.NodePeersProvider)(nil), s)
+	require.Implements(t, (*client.NodeSyncingProvider)(nil), s)
+	require.Implements(t, (*client.NodeVersionProvider)(nil), s)
+	require.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
+	require.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
+	require.Implements(t, (*client.ProposerSlashingPoolProvider)(nil), s)
+	require.Implements(t, (*client.SpecProvider)(nil), s)
+	require.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	require.Implements(t, (*client.ValidatorsProvider)(nil), s)
+	require.Implements(t, (*client.ValidatorIdentitiesProvider)(nil), s)
+	require.Implements(t, (*client.ValidatorLivenessProvider)(nil), s)
+	require.Implements(t, (*client.ValidatorsIteratorProvider)(nil), s)
+	require.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
+	require.Implements(t, (*client.VoluntaryExitPoolProvider)(nil), s)
+	require.Implements(t, (*client.ClientInfoProvider)(nil), s)
+	require.Implements(t, (*client.DomainProvider)(nil), s)
+	require.Implements(t, (*client.SupportedMethodsProvider)(nil), s)
+	require.Implements(t, (*client.GenesisTimeProvider)(nil), s)
+	require.Implements(t, (*client.NodeClientProvider)(nil), s)
+
+	// Composite interfaces.
+	require.Implements(t, (*client.IndexerRequirements)(nil), s)
+	require.Implements(t, (*client.MEVRequirements)(nil), s)
+	require.Implements(t, (*client.ValidatorClientRequirements)(nil), s)
+}
+
+func TestWithFunc(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := mock.New(ctx,
+		mock.WithBeaconBlockHeaderFunc(func(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+			return &api.Response[*apiv1.BeaconBlockHeader]{
+				Data: &apiv1.BeaconBlockHeader{
+					Root: phase0.Root{0x01},
+				},
+				Metadata: map[string]any{"block": opts.Block},
+			}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	response, err := s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{0x01}, response.Data.Root)
+	require.Equal(t, "head", response.Metadata["block"])
+}
+
+func TestWithSubmitFunc(t *testing.T) {
+	ctx := context.Background()
+
+	var submitted []*apiv1.ProposalPreparation
+	s, err := mock.New(ctx,
+		mock.WithSubmitProposalPreparationsFunc(func(_ context.Context, preparations []*apiv1.ProposalPreparation) error {
+			submitted = append(submitted, preparations...)
+
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SubmitProposalPreparations(ctx, []*apiv1.ProposalPreparation{{ValidatorIndex: 1}}))
+	require.Len(t, submitted, 1)
+}
+
+func TestWithError(t *testing.T) {
+	ctx := context.Background()
+
+	injected := errors.New("injected")
+	s, err := mock.New(ctx,
+		mock.WithError("Genesis", injected),
+		mock.WithError("SubmitAttestations", injected),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Genesis(ctx, &api.GenesisOpts{})
+	require.ErrorIs(t, err, injected)
+	_, err = s.GenesisTime(ctx)
+	require.ErrorIs(t, err, injected)
+	require.ErrorIs(t, s.SubmitAttestations(ctx, &api.SubmitAttestationsOpts{}), injected)
+
+	// Other methods are unaffected.
+	_, err = s.Spec(ctx, &api.SpecOpts{})
+	require.NoError(t, err)
+}
+
+func TestWithLatency(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := mock.New(ctx, mock.WithLatency(50*time.Millisecond))
+	require.NoError(t, err)
+
+	started := time.Now()
+	_, err = s.NodeVersion(ctx, &api.NodeVersionOpts{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(started), 50*time.Millisecond)
+
+	// Latency is cut short by the context.
+	cancelledCtx, cancel := context.WithTimeout(ctx, time.Millisecond)
+	defer cancel()
+	_, err = s.NodeVersion(cancelledCtx, &api.NodeVersionOpts{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 func TestMockFunc(t *testing.T) {
 	ctx := context.Background()
 
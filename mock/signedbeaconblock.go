@@ -28,6 +28,10 @@ func (s *Service) SignedBeaconBlock(ctx context.Context,
 	*api.Response[*spec.VersionedSignedBeaconBlock],
 	error,
 ) {
+	if err := s.inject(ctx, "SignedBeaconBlock"); err != nil {
+		return nil, err
+	}
+
 	if s.SignedBeaconBlockFunc != nil {
 		return s.SignedBeaconBlockFunc(ctx, opts)
 	}
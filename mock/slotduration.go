@@ -19,6 +19,10 @@ import (
 )
 
 // SlotDuration provides the duration of a slot of the chain.
-func (*Service) SlotDuration(_ context.Context) (time.Duration, error) {
+func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
+	if err := s.inject(ctx, "SlotDuration"); err != nil {
+		return 0, err
+	}
+
 	return 12 * time.Second, nil
 }
@@ -18,6 +18,10 @@ import (
 )
 
 // SlotsPerEpoch provides the slots per epoch of the chain.
-func (*Service) SlotsPerEpoch(_ context.Context) (uint64, error) {
+func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	if err := s.inject(ctx, "SlotsPerEpoch"); err != nil {
+		return 0, err
+	}
+
 	return 32, nil
 }
@@ -23,6 +23,10 @@ import (
 // Spec provides the spec information of the chain.
 // This returns various useful values.
 func (s *Service) Spec(ctx context.Context, opts *api.SpecOpts) (*api.Response[map[string]any], error) {
+	if err := s.inject(ctx, "Spec"); err != nil {
+		return nil, err
+	}
+
 	if s.SpecFunc != nil {
 		return s.SpecFunc(ctx, opts)
 	}
@@ -0,0 +1,67 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SlotFromStateID parses the state ID and returns the relevant slot.
+func (s *Service) SlotFromStateID(ctx context.Context, stateID string) (phase0.Slot, error) {
+	if err := s.inject(ctx, "SlotFromStateID"); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case stateID == "genesis":
+		return 0, nil
+	case stateID == "head", stateID == "justified", stateID == "finalized":
+		return s.HeadSlot, nil
+	case strings.HasPrefix(stateID, "0x"):
+		return 0, errors.New("state from state root not implemented")
+	default:
+		// State ID should be a slot.
+		slot, err := strconv.ParseUint(stateID, 10, 64)
+		if err != nil {
+			return 0, errors.Wrap(err, fmt.Sprintf("failed to parse state %s as a slot", stateID))
+		}
+
+		return phase0.Slot(slot), nil
+	}
+}
+
+// EpochFromStateID parses the state ID and returns the relevant epoch.
+func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (phase0.Epoch, error) {
+	if err := s.inject(ctx, "EpochFromStateID"); err != nil {
+		return 0, err
+	}
+
+	slot, err := s.SlotFromStateID(ctx, stateID)
+	if err != nil {
+		return 0, err
+	}
+
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	return phase0.Epoch(uint64(slot) / slotsPerEpoch), nil
+}
@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitAggregateAttestations submits aggregate attestations.
-func (*Service) SubmitAggregateAttestations(_ context.Context, _ *api.SubmitAggregateAttestationsOpts) error {
+func (s *Service) SubmitAggregateAttestations(ctx context.Context, opts *api.SubmitAggregateAttestationsOpts) error {
+	if err := s.inject(ctx, "SubmitAggregateAttestations"); err != nil {
+		return err
+	}
+
+	if s.SubmitAggregateAttestationsFunc != nil {
+		return s.SubmitAggregateAttestationsFunc(ctx, opts)
+	}
+
 	return nil
 }
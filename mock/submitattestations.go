@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitAttestations submits attestations.
-func (*Service) SubmitAttestations(_ context.Context, _ *api.SubmitAttestationsOpts) error {
+func (s *Service) SubmitAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error {
+	if err := s.inject(ctx, "SubmitAttestations"); err != nil {
+		return err
+	}
+
+	if s.SubmitAttestationsFunc != nil {
+		return s.SubmitAttestationsFunc(ctx, opts)
+	}
+
 	return nil
 }
@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitAttesterSlashing submits a proposal slashing.
-func (*Service) SubmitAttesterSlashing(_ context.Context, _ *phase0.AttesterSlashing) error {
+func (s *Service) SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	if err := s.inject(ctx, "SubmitAttesterSlashing"); err != nil {
+		return err
+	}
+
+	if s.SubmitAttesterSlashingFunc != nil {
+		return s.SubmitAttesterSlashingFunc(ctx, slashing)
+	}
+
 	return nil
 }
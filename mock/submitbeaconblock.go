@@ -16,10 +16,32 @@ package mock
 import (
 	"context"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
 )
 
 // SubmitBeaconBlock submits a beacon block.
-func (*Service) SubmitBeaconBlock(_ context.Context, _ *spec.VersionedSignedBeaconBlock) error {
+func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
+	if err := s.inject(ctx, "SubmitBeaconBlock"); err != nil {
+		return err
+	}
+
+	if s.SubmitBeaconBlockFunc != nil {
+		return s.SubmitBeaconBlockFunc(ctx, block)
+	}
+
+	return nil
+}
+
+// SubmitBeaconBlockWithOpts submits a beacon block with the given options.
+func (s *Service) SubmitBeaconBlockWithOpts(ctx context.Context, opts *api.SubmitBeaconBlockOpts) error {
+	if err := s.inject(ctx, "SubmitBeaconBlockWithOpts"); err != nil {
+		return err
+	}
+
+	if s.SubmitBeaconBlockWithOptsFunc != nil {
+		return s.SubmitBeaconBlockWithOptsFunc(ctx, opts)
+	}
+
 	return nil
 }
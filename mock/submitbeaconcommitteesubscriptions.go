@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
-func (*Service) SubmitBeaconCommitteeSubscriptions(_ context.Context, _ []*api.BeaconCommitteeSubscription) error {
+func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*api.BeaconCommitteeSubscription) error {
+	if err := s.inject(ctx, "SubmitBeaconCommitteeSubscriptions"); err != nil {
+		return err
+	}
+
+	if s.SubmitBeaconCommitteeSubscriptionsFunc != nil {
+		return s.SubmitBeaconCommitteeSubscriptionsFunc(ctx, subscriptions)
+	}
+
 	return nil
 }
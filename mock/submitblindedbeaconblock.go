@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitBlindedBeaconBlock submits a blinded beacon block.
-func (*Service) SubmitBlindedBeaconBlock(_ context.Context, _ *api.VersionedSignedBlindedBeaconBlock) error {
+func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
+	if err := s.inject(ctx, "SubmitBlindedBeaconBlock"); err != nil {
+		return err
+	}
+
+	if s.SubmitBlindedBeaconBlockFunc != nil {
+		return s.SubmitBlindedBeaconBlockFunc(ctx, block)
+	}
+
 	return nil
 }
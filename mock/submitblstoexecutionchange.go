@@ -20,6 +20,10 @@ import (
 )
 
 // SubmitBLSToExecutionChange submits a BLS to execution address change operation.
-func (*Service) SubmitBLSToExecutionChange(_ context.Context, _ *capella.SignedBLSToExecutionChange) error {
+func (s *Service) SubmitBLSToExecutionChange(ctx context.Context, _ *capella.SignedBLSToExecutionChange) error {
+	if err := s.inject(ctx, "SubmitBLSToExecutionChange"); err != nil {
+		return err
+	}
+
 	return nil
 }
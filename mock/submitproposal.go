@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitProposal submits a proposal.
-func (*Service) SubmitProposal(_ context.Context, _ *api.VersionedSignedProposal) error {
+func (s *Service) SubmitProposal(ctx context.Context, opts *api.SubmitProposalOpts) error {
+	if err := s.inject(ctx, "SubmitProposal"); err != nil {
+		return err
+	}
+
+	if s.SubmitProposalFunc != nil {
+		return s.SubmitProposalFunc(ctx, opts)
+	}
+
 	return nil
 }
@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitProposalSlashing submits a proposal slashing.
-func (*Service) SubmitProposalSlashing(_ context.Context, _ *phase0.ProposerSlashing) error {
+func (s *Service) SubmitProposalSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	if err := s.inject(ctx, "SubmitProposalSlashing"); err != nil {
+		return err
+	}
+
+	if s.SubmitProposalSlashingFunc != nil {
+		return s.SubmitProposalSlashingFunc(ctx, slashing)
+	}
+
 	return nil
 }
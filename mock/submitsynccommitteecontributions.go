@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitSyncCommitteeContributions submits sync committee contributions.
-func (*Service) SubmitSyncCommitteeContributions(_ context.Context, _ []*altair.SignedContributionAndProof) error {
+func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
+	if err := s.inject(ctx, "SubmitSyncCommitteeContributions"); err != nil {
+		return err
+	}
+
+	if s.SubmitSyncCommitteeContributionsFunc != nil {
+		return s.SubmitSyncCommitteeContributionsFunc(ctx, contributionAndProofs)
+	}
+
 	return nil
 }
@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitSyncCommitteeMessages submits sync committee messages.
-func (*Service) SubmitSyncCommitteeMessages(_ context.Context, _ []*altair.SyncCommitteeMessage) error {
+func (s *Service) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error {
+	if err := s.inject(ctx, "SubmitSyncCommitteeMessages"); err != nil {
+		return err
+	}
+
+	if s.SubmitSyncCommitteeMessagesFunc != nil {
+		return s.SubmitSyncCommitteeMessagesFunc(ctx, messages)
+	}
+
 	return nil
 }
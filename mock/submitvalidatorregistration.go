@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitValidatorRegistrations submits a validator registration.
-func (*Service) SubmitValidatorRegistrations(_ context.Context, _ []*api.VersionedSignedValidatorRegistration) error {
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
+	if err := s.inject(ctx, "SubmitValidatorRegistrations"); err != nil {
+		return err
+	}
+
+	if s.SubmitValidatorRegistrationsFunc != nil {
+		return s.SubmitValidatorRegistrationsFunc(ctx, registrations)
+	}
+
 	return nil
 }
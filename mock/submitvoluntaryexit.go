@@ -20,6 +20,14 @@ import (
 )
 
 // SubmitVoluntaryExit submits a voluntary exit.
-func (*Service) SubmitVoluntaryExit(_ context.Context, _ *spec.SignedVoluntaryExit) error {
+func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *spec.SignedVoluntaryExit) error {
+	if err := s.inject(ctx, "SubmitVoluntaryExit"); err != nil {
+		return err
+	}
+
+	if s.SubmitVoluntaryExitFunc != nil {
+		return s.SubmitVoluntaryExitFunc(ctx, voluntaryExit)
+	}
+
 	return nil
 }
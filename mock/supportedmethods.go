@@ -0,0 +1,40 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// SupportedMethods provides the methods supported by the client.
+func (s *Service) SupportedMethods(ctx context.Context) (*api.SupportedMethods, error) {
+	if err := s.inject(ctx, "SupportedMethods"); err != nil {
+		return nil, err
+	}
+
+	if s.SupportedMethodsFunc != nil {
+		return s.SupportedMethodsFunc(ctx)
+	}
+
+	serviceType := reflect.TypeOf(s)
+	methods := make([]string, 0, serviceType.NumMethod())
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		methods = append(methods, serviceType.Method(i).Name)
+	}
+
+	return api.NewSupportedMethods(methods), nil
+}
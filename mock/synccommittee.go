@@ -18,12 +18,36 @@ import (
 
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
+// syncCommitteeSubnetCount is the number of sync committee subnets.
+const syncCommitteeSubnetCount = 4
+
 // SyncCommittee fetches the sync committee for the given state.
-func (*Service) SyncCommittee(_ context.Context, _ *api.SyncCommitteeOpts) (*api.Response[*apiv1.SyncCommittee], error) {
+func (s *Service) SyncCommittee(ctx context.Context, opts *api.SyncCommitteeOpts) (*api.Response[*apiv1.SyncCommittee], error) {
+	if err := s.inject(ctx, "SyncCommittee"); err != nil {
+		return nil, err
+	}
+
+	if s.SyncCommitteeFunc != nil {
+		return s.SyncCommitteeFunc(ctx, opts)
+	}
+
+	data := &apiv1.SyncCommittee{}
+	if len(s.syncCommittee) > 0 {
+		data.Validators = s.syncCommittee
+		data.ValidatorAggregates = make([][]phase0.ValidatorIndex, syncCommitteeSubnetCount)
+		subcommitteeSize := (len(s.syncCommittee) + syncCommitteeSubnetCount - 1) / syncCommitteeSubnetCount
+		for i := range data.ValidatorAggregates {
+			start := min(i*subcommitteeSize, len(s.syncCommittee))
+			end := min(start+subcommitteeSize, len(s.syncCommittee))
+			data.ValidatorAggregates[i] = s.syncCommittee[start:end]
+		}
+	}
+
 	return &api.Response[*apiv1.SyncCommittee]{
-		Data:     &apiv1.SyncCommittee{},
+		Data:     data,
 		Metadata: make(map[string]any),
 	}, nil
 }
@@ -0,0 +1,80 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommittee(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx, mock.WithSyncCommittee([]phase0.ValidatorIndex{10, 11, 12, 10, 13, 14, 15, 16}))
+	require.NoError(t, err)
+
+	committee, err := service.SyncCommittee(ctx, &api.SyncCommitteeOpts{State: "head"})
+	require.NoError(t, err)
+	require.Len(t, committee.Data.Validators, 8)
+	require.Equal(t, [][]phase0.ValidatorIndex{{10, 11}, {12, 10}, {13, 14}, {15, 16}}, committee.Data.ValidatorAggregates)
+
+	duties, err := service.SyncCommitteeDuties(ctx, &api.SyncCommitteeDutiesOpts{
+		Indices: []phase0.ValidatorIndex{10, 13, 20},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*apiv1.SyncCommitteeDuty{
+		{
+			ValidatorIndex:                10,
+			ValidatorSyncCommitteeIndices: []phase0.CommitteeIndex{0, 3},
+		},
+		{
+			ValidatorIndex:                13,
+			ValidatorSyncCommitteeIndices: []phase0.CommitteeIndex{4},
+		},
+	}, duties.Data)
+
+	contribution, err := service.SyncCommitteeContribution(ctx, &api.SyncCommitteeContributionOpts{
+		Slot:              100,
+		SubcommitteeIndex: 2,
+		BeaconBlockRoot:   phase0.Root{0x01},
+	})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(100), contribution.Data.Slot)
+	require.Equal(t, uint64(2), contribution.Data.SubcommitteeIndex)
+	require.Equal(t, phase0.Root{0x01}, contribution.Data.BeaconBlockRoot)
+}
+
+func TestSyncCommitteeUnset(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	committee, err := service.SyncCommittee(ctx, &api.SyncCommitteeOpts{State: "head"})
+	require.NoError(t, err)
+	require.Empty(t, committee.Data.Validators)
+
+	// Without a committee every requested validator has a duty.
+	duties, err := service.SyncCommitteeDuties(ctx, &api.SyncCommitteeDutiesOpts{
+		Indices: []phase0.ValidatorIndex{1, 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, duties.Data, 2)
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // SyncCommitteeDuties obtains sync committee duties.
@@ -27,10 +28,18 @@ func (s *Service) SyncCommitteeDuties(ctx context.Context,
 	*api.Response[[]*apiv1.SyncCommitteeDuty],
 	error,
 ) {
+	if err := s.inject(ctx, "SyncCommitteeDuties"); err != nil {
+		return nil, err
+	}
+
 	if s.SyncCommitteeDutiesFunc != nil {
 		return s.SyncCommitteeDutiesFunc(ctx, opts)
 	}
 
+	if len(s.syncCommittee) > 0 {
+		return s.syncCommitteeDuties(opts), nil
+	}
+
 	data := make([]*apiv1.SyncCommitteeDuty, len(opts.Indices))
 	for i := range opts.Indices {
 		data[i] = &apiv1.SyncCommitteeDuty{
@@ -43,3 +52,30 @@ func (s *Service) SyncCommitteeDuties(ctx context.Context,
 		Metadata: make(map[string]any),
 	}, nil
 }
+
+// syncCommitteeDuties provides the duties for the requested validators in the sync committee.
+func (s *Service) syncCommitteeDuties(opts *api.SyncCommitteeDutiesOpts) *api.Response[[]*apiv1.SyncCommitteeDuty] {
+	data := make([]*apiv1.SyncCommitteeDuty, 0)
+	for _, index := range opts.Indices {
+		var duty *apiv1.SyncCommitteeDuty
+		for position, member := range s.syncCommittee {
+			if member != index {
+				continue
+			}
+			if duty == nil {
+				duty = &apiv1.SyncCommitteeDuty{
+					ValidatorIndex: index,
+				}
+			}
+			duty.ValidatorSyncCommitteeIndices = append(duty.ValidatorSyncCommitteeIndices, phase0.CommitteeIndex(position))
+		}
+		if duty != nil {
+			data = append(data, duty)
+		}
+	}
+
+	return &api.Response[[]*apiv1.SyncCommitteeDuty]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}
+}
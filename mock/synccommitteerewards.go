@@ -27,6 +27,10 @@ func (s *Service) SyncCommitteeRewards(ctx context.Context,
 	*api.Response[[]*apiv1.SyncCommitteeReward],
 	error,
 ) {
+	if err := s.inject(ctx, "SyncCommitteeRewards"); err != nil {
+		return nil, err
+	}
+
 	if s.SyncCommitteeRewardsFunc != nil {
 		return s.SyncCommitteeRewardsFunc(ctx, opts)
 	}
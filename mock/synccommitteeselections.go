@@ -0,0 +1,48 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// SyncCommitteeSelections combines partial sync committee selection proofs into aggregated selection proofs.
+func (s *Service) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	if err := s.inject(ctx, "SyncCommitteeSelections"); err != nil {
+		return nil, err
+	}
+
+	if s.SyncCommitteeSelectionsFunc != nil {
+		return s.SyncCommitteeSelectionsFunc(ctx, opts)
+	}
+
+	// Return the supplied selections unchanged.
+	selections := []*apiv1.SyncCommitteeSelection{}
+	if opts != nil {
+		selections = opts.Selections
+	}
+
+	return &api.Response[[]*apiv1.SyncCommitteeSelection]{
+		Data:     selections,
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -18,6 +18,10 @@ import (
 )
 
 // TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
-func (*Service) TargetAggregatorsPerCommittee(_ context.Context) (uint64, error) {
+func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	if err := s.inject(ctx, "TargetAggregatorsPerCommittee"); err != nil {
+		return 0, err
+	}
+
 	return 4, nil
 }
@@ -30,6 +30,10 @@ func (s *Service) ValidatorBalances(ctx context.Context,
 	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
 	error,
 ) {
+	if err := s.inject(ctx, "ValidatorBalances"); err != nil {
+		return nil, err
+	}
+
 	if s.ValidatorBalancesFunc != nil {
 		return s.ValidatorBalancesFunc(ctx, opts)
 	}
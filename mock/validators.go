@@ -28,6 +28,10 @@ func (s *Service) Validators(ctx context.Context,
 	*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator],
 	error,
 ) {
+	if err := s.inject(ctx, "Validators"); err != nil {
+		return nil, err
+	}
+
 	if s.ValidatorsFunc != nil {
 		return s.ValidatorsFunc(ctx, opts)
 	}
@@ -0,0 +1,38 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// ValidatorsIterator provides an iterator over the validators, with their balance and status, for the given options.
+func (s *Service) ValidatorsIterator(ctx context.Context,
+	opts *api.ValidatorsIteratorOpts,
+) (
+	*api.ValidatorsIterator,
+	error,
+) {
+	if err := s.inject(ctx, "ValidatorsIterator"); err != nil {
+		return nil, err
+	}
+
+	if s.ValidatorsIteratorFunc != nil {
+		return s.ValidatorsIteratorFunc(ctx, opts)
+	}
+
+	return api.NewValidatorsIterator(s.Validators, opts), nil
+}
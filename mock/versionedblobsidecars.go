@@ -0,0 +1,46 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// VersionedBlobSidecars fetches the versioned blob sidecars given options.
+func (s *Service) VersionedBlobSidecars(ctx context.Context,
+	opts *api.BlobSidecarsOpts,
+) (
+	*api.Response[*api.VersionedBlobSidecars],
+	error,
+) {
+	if err := s.inject(ctx, "VersionedBlobSidecars"); err != nil {
+		return nil, err
+	}
+
+	if s.VersionedBlobSidecarsFunc != nil {
+		return s.VersionedBlobSidecarsFunc(ctx, opts)
+	}
+
+	return &api.Response[*api.VersionedBlobSidecars]{
+		Data: &api.VersionedBlobSidecars{
+			Version: spec.DataVersionDeneb,
+			Deneb:   []*deneb.BlobSidecar{},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
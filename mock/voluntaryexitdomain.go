@@ -20,6 +20,10 @@ import (
 )
 
 // VoluntaryExitDomain provides the voluntary exit domain.
-func (*Service) VoluntaryExitDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) VoluntaryExitDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx, "VoluntaryExitDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+
 	return spec.DomainType{0x04, 0x00, 0x00, 0x00}, nil
 }
@@ -27,6 +27,10 @@ func (s *Service) VoluntaryExitPool(ctx context.Context,
 	*api.Response[[]*phase0.SignedVoluntaryExit],
 	error,
 ) {
+	if err := s.inject(ctx, "VoluntaryExitPool"); err != nil {
+		return nil, err
+	}
+
 	if s.VoluntaryExitPoolFunc != nil {
 		return s.VoluntaryExitPoolFunc(ctx, opts)
 	}
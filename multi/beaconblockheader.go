@@ -28,7 +28,7 @@ func (s *Service) BeaconBlockHeader(ctx context.Context,
 	*api.Response[*apiv1.BeaconBlockHeader],
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	res, err := s.doConsensusCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		beaconBlockHeader, err := client.(consensusclient.BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, opts)
 		if err != nil {
 			return nil, err
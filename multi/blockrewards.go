@@ -28,14 +28,14 @@ func (s *Service) BlockRewards(ctx context.Context,
 	*api.Response[*apiv1.BlockRewards],
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	res, err := s.doCall(ctx, ifSupported("BlockRewards", func(ctx context.Context, client consensusclient.Service) (any, error) {
 		attestationData, err := client.(consensusclient.BlockRewardsProvider).BlockRewards(ctx, opts)
 		if err != nil {
 			return nil, err
 		}
 
 		return attestationData, nil
-	}, nil)
+	}), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,182 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// ClientError is the error returned by an individual client.
+type ClientError struct {
+	// Address is the address of the client.
+	Address string
+	// Err is the error returned by the client.
+	Err error
+}
+
+// BroadcastError is returned when a broadcast submission is not accepted by any client.
+type BroadcastError struct {
+	// Errors are the errors returned by each client.
+	Errors []*ClientError
+}
+
+// Error implements the error interface.
+func (e *BroadcastError) Error() string {
+	clientErrs := make([]string, 0, len(e.Errors))
+	for _, clientErr := range e.Errors {
+		clientErrs = append(clientErrs, fmt.Sprintf("%s: %v", clientErr.Address, clientErr.Err))
+	}
+
+	return fmt.Sprintf("submission not accepted by any client (%s)", strings.Join(clientErrs, "; "))
+}
+
+// Unwrap returns the errors returned by each client.
+func (e *BroadcastError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, clientErr := range e.Errors {
+		errs = append(errs, clientErr.Err)
+	}
+
+	return errs
+}
+
+// doSubmit carries out a submission.  If broadcast submissions are enabled the
+// submission is sent to all active clients concurrently, and succeeds if any of
+// them accepts it; otherwise it is sent to the active clients in turn until one
+// succeeds.
+func (s *Service) doSubmit(ctx context.Context, call callFunc, errHandler errHandlerFunc) (any, error) {
+	if !s.broadcastSubmissions {
+		return s.doCall(ctx, call, errHandler)
+	}
+
+	log := s.log.With().Logger()
+	ctx = log.WithContext(ctx)
+
+	// Grab local copy of active clients in case it is updated whilst we are using it.
+	s.clientsMu.RLock()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+
+	if len(activeClients) == 0 {
+		// There are no active clients; attempt to re-enable the inactive clients.
+		s.recheck(ctx)
+		s.clientsMu.RLock()
+		activeClients = s.activeClients
+		s.clientsMu.RUnlock()
+	}
+
+	if len(activeClients) == 0 {
+		return nil, errors.New("no clients to which to make call")
+	}
+
+	results := make([]any, len(activeClients))
+	errs := make([]error, len(activeClients))
+	var wg sync.WaitGroup
+	for i, client := range activeClients {
+		wg.Add(1)
+		go func(i int, client consensusclient.Service) {
+			defer wg.Done()
+			log := log.With().Str("client", client.Name()).Str("address", client.Address()).Logger()
+			started := time.Now()
+			res, err := call(ctx, client)
+			latency := time.Since(started)
+			if err == nil && res == nil {
+				err = errors.New("empty response")
+			}
+			if err != nil {
+				var failover bool
+				failover, err = s.shouldFailover(ctx, client, err, errHandler)
+				if failover {
+					log.Debug().Err(err).Msg("Deactivating client on error")
+					s.recordCall(client, latency, err)
+					s.deactivateClient(ctx, client)
+				}
+				errs[i] = err
+
+				return
+			}
+			s.recordCall(client, latency, nil)
+			results[i] = res
+		}(i, client)
+	}
+	wg.Wait()
+
+	var res any
+	broadcastErr := &BroadcastError{
+		Errors: make([]*ClientError, 0),
+	}
+	for i, client := range activeClients {
+		if errs[i] != nil {
+			broadcastErr.Errors = append(broadcastErr.Errors, &ClientError{
+				Address: client.Address(),
+				Err:     errs[i],
+			})
+
+			continue
+		}
+		if res == nil {
+			res = results[i]
+		}
+	}
+
+	if res == nil {
+		return nil, broadcastErr
+	}
+	if len(broadcastErr.Errors) > 0 {
+		log.Debug().Err(broadcastErr).Msg("Submission accepted by some but not all clients")
+	}
+
+	return res, nil
+}
+
+// shouldFailover returns true if the error returned by a client requires failover,
+// along with the error as rewritten by the error handler.
+func (*Service) shouldFailover(ctx context.Context,
+	client consensusclient.Service,
+	err error,
+	errHandler errHandlerFunc,
+) (
+	bool,
+	error,
+) {
+	var apiErr *api.Error
+	var indexedErr *api.IndexedSubmissionError
+	switch {
+	case errors.As(err, &apiErr) && statusCodeFamily(apiErr.StatusCode) == 4:
+		// User error.
+		return false, err
+	case errors.As(err, &indexedErr):
+		// Submission processed, but some items failed.
+		return false, err
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false, err
+	case errors.Is(err, ErrNotSupported):
+		// Client does not support the call.
+		return false, err
+	}
+
+	if errHandler == nil {
+		return true, err
+	}
+
+	return errHandler(ctx, client, err)
+}
@@ -0,0 +1,57 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBroadcastRewrittenError ensures that errors rewritten by the error handler
+// are returned from broadcast submissions.
+func TestBroadcastRewrittenError(t *testing.T) {
+	ctx := context.Background()
+
+	consensusClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			consensusClient,
+		}),
+		WithBroadcastSubmissions(true),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	_, err = multi.doSubmit(ctx, func(_ context.Context, _ consensusclient.Service) (any, error) {
+		return nil, errors.New("original error")
+	}, func(_ context.Context, _ consensusclient.Service, _ error) (bool, error) {
+		return false, errors.New("rewritten error")
+	})
+	var broadcastErr *BroadcastError
+	require.ErrorAs(t, err, &broadcastErr)
+	require.Len(t, broadcastErr.Errors, 1)
+	require.EqualError(t, broadcastErr.Errors[0].Err, "rewritten error")
+
+	// The client remains active as the error handler did not request failover.
+	require.Len(t, multi.activeClients, 1)
+}
@@ -0,0 +1,125 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient counts the attestation submissions it receives.
+type countingClient struct {
+	*mock.Service
+	submissions atomic.Int32
+}
+
+func (c *countingClient) SubmitAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error {
+	c.submissions.Add(1)
+
+	return c.Service.SubmitAttestations(ctx, opts)
+}
+
+func TestBroadcastSubmissions(t *testing.T) {
+	ctx := context.Background()
+
+	opts := &api.SubmitAttestationsOpts{
+		Attestations: []*spec.VersionedAttestation{
+			{Version: spec.DataVersionPhase0, Phase0: &phase0.Attestation{}},
+		},
+	}
+
+	t.Run("AllAccept", func(t *testing.T) {
+		mock1, err := mock.New(ctx, mock.WithName("mock 1"))
+		require.NoError(t, err)
+		client1 := &countingClient{Service: mock1}
+		mock2, err := mock.New(ctx, mock.WithName("mock 2"))
+		require.NoError(t, err)
+		client2 := &countingClient{Service: mock2}
+
+		multiClient, err := multi.New(ctx,
+			multi.WithLogLevel(zerolog.Disabled),
+			multi.WithClients([]consensusclient.Service{
+				client1,
+				client2,
+			}),
+			multi.WithBroadcastSubmissions(true),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, multiClient.(consensusclient.AttestationsSubmitter).SubmitAttestations(ctx, opts))
+		require.Equal(t, int32(1), client1.submissions.Load())
+		require.Equal(t, int32(1), client2.submissions.Load())
+	})
+
+	t.Run("OneAccepts", func(t *testing.T) {
+		mock1, err := mock.New(ctx, mock.WithName("mock 1"))
+		require.NoError(t, err)
+		erroringClient1, err := testclients.NewErroring(ctx, 1, mock1)
+		require.NoError(t, err)
+		mock2, err := mock.New(ctx, mock.WithName("mock 2"))
+		require.NoError(t, err)
+
+		multiClient, err := multi.New(ctx,
+			multi.WithLogLevel(zerolog.Disabled),
+			multi.WithClients([]consensusclient.Service{
+				erroringClient1,
+				mock2,
+			}),
+			multi.WithBroadcastSubmissions(true),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, multiClient.(consensusclient.AttestationsSubmitter).SubmitAttestations(ctx, opts))
+	})
+
+	t.Run("NoneAccept", func(t *testing.T) {
+		mock1, err := mock.New(ctx, mock.WithName("mock 1"))
+		require.NoError(t, err)
+		erroringClient1, err := testclients.NewErroring(ctx, 1, mock1)
+		require.NoError(t, err)
+		mock2, err := mock.New(ctx, mock.WithName("mock 2"))
+		require.NoError(t, err)
+		erroringClient2, err := testclients.NewErroring(ctx, 1, mock2)
+		require.NoError(t, err)
+
+		multiClient, err := multi.New(ctx,
+			multi.WithLogLevel(zerolog.Disabled),
+			multi.WithClients([]consensusclient.Service{
+				erroringClient1,
+				erroringClient2,
+			}),
+			multi.WithBroadcastSubmissions(true),
+		)
+		require.NoError(t, err)
+
+		err = multiClient.(consensusclient.AttestationsSubmitter).SubmitAttestations(ctx, opts)
+		var broadcastErr *multi.BroadcastError
+		require.True(t, errors.As(err, &broadcastErr))
+		require.Len(t, broadcastErr.Errors, 2)
+		require.Equal(t, erroringClient1.Address(), broadcastErr.Errors[0].Address)
+		require.Equal(t, erroringClient2.Address(), broadcastErr.Errors[1].Address)
+	})
+}
@@ -63,6 +63,9 @@ func (s *Service) recheck(ctx context.Context) {
 				httpClient.CheckConnectionState(ctx)
 			}
 		}
+		if s.selectionStrategy == SelectionStrategyHighestHeadSlot {
+			s.recordSyncState(ctx, client)
+		}
 		switch {
 		case client.IsSynced():
 			s.activateClient(ctx, client)
@@ -160,12 +163,15 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 	if len(activeClients) == 0 {
 		return nil, errors.New("no clients to which to make call")
 	}
+	activeClients = s.orderClients(activeClients, true)
 
 	var err error
 	var res any
 	for _, client := range activeClients {
 		log := log.With().Str("client", client.Name()).Str("address", client.Address()).Logger()
+		started := time.Now()
 		res, err = call(ctx, client)
+		latency := time.Since(started)
 		if err != nil {
 			log.Trace().Err(err).Msg("Potentially deactivating client due to error")
 			var apiErr *api.Error
@@ -182,6 +188,10 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 				log.Trace().Msg("Not deactivating client on context deadline exceeded")
 
 				return res, err
+			case errors.Is(err, ErrNotSupported):
+				log.Trace().Msg("Not deactivating client that does not support the call")
+
+				continue
 			}
 
 			failover := true
@@ -190,6 +200,7 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 			}
 			if failover {
 				log.Debug().Err(err).Msg("Deactivating client on error")
+				s.recordCall(client, latency, err)
 				s.deactivateClient(ctx, client)
 
 				continue
@@ -201,9 +212,11 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 		if res == nil {
 			// No response from this client; try the next.
 			err = errors.New("empty response")
+			s.recordCall(client, latency, err)
 
 			continue
 		}
+		s.recordCall(client, latency, nil)
 
 		return res, nil
 	}
@@ -0,0 +1,154 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// doConsensusCall carries out a read call.  If a consensus threshold is set the
+// call is made to that number of active clients concurrently and their results
+// compared.  If they all agree the result is returned; if they diverge then the
+// majority result is returned if there is one, otherwise an error.
+func (s *Service) doConsensusCall(ctx context.Context, call callFunc, errHandler errHandlerFunc) (any, error) {
+	if s.consensusThreshold < 2 {
+		return s.doCall(ctx, call, errHandler)
+	}
+
+	log := s.log.With().Logger()
+	ctx = log.WithContext(ctx)
+
+	// Grab local copy of active clients in case it is updated whilst we are using it.
+	s.clientsMu.RLock()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+
+	if len(activeClients) < s.consensusThreshold {
+		// There are insufficient active clients; attempt to re-enable the inactive clients.
+		s.recheck(ctx)
+		s.clientsMu.RLock()
+		activeClients = s.activeClients
+		s.clientsMu.RUnlock()
+	}
+
+	if len(activeClients) < s.consensusThreshold {
+		return nil, fmt.Errorf("%d active clients, require %d for consensus", len(activeClients), s.consensusThreshold)
+	}
+	clients := s.orderClients(activeClients, true)[:s.consensusThreshold]
+
+	results := make([]any, len(clients))
+	errs := make([]error, len(clients))
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		wg.Add(1)
+		go func(i int, client consensusclient.Service) {
+			defer wg.Done()
+			log := log.With().Str("client", client.Name()).Str("address", client.Address()).Logger()
+			started := time.Now()
+			res, err := call(ctx, client)
+			latency := time.Since(started)
+			if err == nil && res == nil {
+				err = errors.New("empty response")
+			}
+			if err != nil {
+				var failover bool
+				failover, err = s.shouldFailover(ctx, client, err, errHandler)
+				if failover {
+					log.Debug().Err(err).Msg("Deactivating client on error")
+					s.recordCall(client, latency, err)
+					s.deactivateClient(ctx, client)
+				}
+				errs[i] = err
+
+				return
+			}
+			s.recordCall(client, latency, nil)
+			results[i] = res
+		}(i, client)
+	}
+	wg.Wait()
+
+	// Group the results by their data.
+	type group struct {
+		res   any
+		count int
+	}
+	groups := make([]*group, 0, len(results))
+	var firstErr error
+	for i := range results {
+		if errs[i] != nil {
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+
+			continue
+		}
+		data := responseData(results[i])
+		found := false
+		for _, group := range groups {
+			if reflect.DeepEqual(responseData(group.res), data) {
+				group.count++
+				found = true
+
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, &group{res: results[i], count: 1})
+		}
+	}
+
+	var majority *group
+	for _, group := range groups {
+		if majority == nil || group.count > majority.count {
+			majority = group
+		}
+	}
+
+	switch {
+	case majority != nil && majority.count == len(clients):
+		return majority.res, nil
+	case majority != nil && majority.count*2 > len(clients):
+		log.Warn().
+			Int("agreed", majority.count).
+			Int("queried", len(clients)).
+			Msg("Clients diverged; returning majority result")
+
+		return majority.res, nil
+	case len(groups) == 0:
+		return nil, firstErr
+	default:
+		return nil, errors.Wrap(ErrNoConsensus, fmt.Sprintf("%d distinct results from %d clients", len(groups), len(clients)))
+	}
+}
+
+// responseData returns the data of an API response, or the value itself if
+// it is not a response.
+func responseData(res any) any {
+	value := reflect.ValueOf(res)
+	if value.Kind() == reflect.Pointer && !value.IsNil() && value.Elem().Kind() == reflect.Struct {
+		if data := value.Elem().FieldByName("Data"); data.IsValid() {
+			return data.Interface()
+		}
+	}
+
+	return res
+}
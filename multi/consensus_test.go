@@ -0,0 +1,128 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// finalityClient creates a mock client that returns the given finalized epoch.
+func finalityClient(ctx context.Context, t *testing.T, name string, epoch phase0.Epoch) consensusclient.Service {
+	t.Helper()
+
+	client, err := mock.New(ctx, mock.WithName(name))
+	require.NoError(t, err)
+	client.FinalityFunc = func(_ context.Context, _ *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+		return &api.Response[*apiv1.Finality]{
+			Data: &apiv1.Finality{
+				Finalized: &phase0.Checkpoint{Epoch: epoch},
+			},
+			Metadata: map[string]any{"client": name},
+		}, nil
+	}
+
+	return client
+}
+
+func TestConsensusThreshold(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		epochs    []phase0.Epoch
+		threshold int
+		expected  phase0.Epoch
+		err       string
+	}{
+		{
+			name:      "Disabled",
+			epochs:    []phase0.Epoch{1, 2, 3},
+			threshold: 0,
+			expected:  1,
+		},
+		{
+			name:      "Agree",
+			epochs:    []phase0.Epoch{5, 5, 5},
+			threshold: 3,
+			expected:  5,
+		},
+		{
+			name:      "Majority",
+			epochs:    []phase0.Epoch{5, 4, 5},
+			threshold: 3,
+			expected:  5,
+		},
+		{
+			name:      "NoMajority",
+			epochs:    []phase0.Epoch{5, 4, 5},
+			threshold: 2,
+			err:       "2 distinct results from 2 clients: no consensus between clients",
+		},
+		{
+			name:      "Subset",
+			epochs:    []phase0.Epoch{5, 5, 4},
+			threshold: 2,
+			expected:  5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clients := make([]consensusclient.Service, 0, len(test.epochs))
+			for i, epoch := range test.epochs {
+				clients = append(clients, finalityClient(ctx, t, string(rune('a'+i)), epoch))
+			}
+
+			multiClient, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+				multi.WithConsensusThreshold(test.threshold),
+			)
+			require.NoError(t, err)
+
+			response, err := multiClient.(consensusclient.FinalityProvider).Finality(ctx, &api.FinalityOpts{State: "head"})
+			if test.err != "" {
+				require.ErrorIs(t, err, multi.ErrNoConsensus)
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, response.Data.Finalized.Epoch)
+			}
+		})
+	}
+}
+
+func TestConsensusThresholdTooHigh(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	_, err = multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{client}),
+		multi.WithConsensusThreshold(2),
+	)
+	require.EqualError(t, err, "problem with parameters: consensus threshold cannot be greater than the number of clients")
+}
@@ -0,0 +1,48 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+)
+
+// DataColumnSidecars fetches the data column sidecars given options.
+func (s *Service) DataColumnSidecars(ctx context.Context,
+	opts *api.DataColumnSidecarsOpts,
+) (*api.Response[[]*fulu.DataColumnSidecar],
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+		dataColumnSidecars, err := client.(consensusclient.DataColumnSidecarsProvider).DataColumnSidecars(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return dataColumnSidecars, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, isResponse := res.(*api.Response[[]*fulu.DataColumnSidecar])
+	if !isResponse {
+		return nil, ErrIncorrectType
+	}
+
+	return response, nil
+}
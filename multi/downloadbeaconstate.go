@@ -0,0 +1,75 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"io"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	writer  io.Writer
+	written int64
+}
+
+// Write writes to the underlying writer.
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+
+	return n, err
+}
+
+// DownloadBeaconState downloads a beacon state.  If a client fails part way
+// through the download then the next client resumes it from the last byte
+// written.
+func (s *Service) DownloadBeaconState(ctx context.Context,
+	opts *api.DownloadBeaconStateOpts,
+) (
+	*api.Response[*api.BeaconStateDownload],
+	error,
+) {
+	if opts == nil {
+		return nil, consensusclient.ErrNoOptions
+	}
+
+	writer := &countingWriter{
+		writer: opts.Writer,
+	}
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+		clientOpts := *opts
+		clientOpts.Writer = writer
+		clientOpts.Offset = opts.Offset + writer.written
+		download, err := client.(consensusclient.BeaconStateDownloader).DownloadBeaconState(ctx, &clientOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		return download, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, isResponse := res.(*api.Response[*api.BeaconStateDownload])
+	if !isResponse {
+		return nil, ErrIncorrectType
+	}
+
+	return response, nil
+}
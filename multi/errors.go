@@ -17,3 +17,9 @@ import "errors"
 
 // ErrIncorrectType is returned when the multi client obtain a response type it is not expecting.
 var ErrIncorrectType = errors.New("incorrect response type")
+
+// ErrNoConsensus is returned when the clients queried for a consensus call do not agree on a result.
+var ErrNoConsensus = errors.New("no consensus between clients")
+
+// ErrNotSupported is returned when a client does not support the method called.
+var ErrNotSupported = errors.New("method not supported by client")
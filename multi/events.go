@@ -1,4 +1,4 @@
-// Copyright © 2021 Attestant Limited.
+// Copyright © 2021, 2025 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,6 +15,7 @@ package multi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"time"
@@ -29,6 +30,53 @@ import (
 func (s *Service) Events(ctx context.Context,
 	topics []string,
 	handler consensusclient.EventHandlerFunc,
+) error {
+	return s.subscribeEvents(ctx, topics, func(client consensusclient.Service, ah *activeHandler) error {
+		return client.(consensusclient.EventsProvider).Events(ctx, topics, func(event *apiv1.Event) {
+			if ah.isActive(event.Topic) {
+				handler(event)
+			}
+		})
+	})
+}
+
+// EventsWithHandlers feeds events to the supplied typed handlers.
+func (s *Service) EventsWithHandlers(ctx context.Context,
+	handlers *consensusclient.EventHandlers,
+) error {
+	if handlers == nil {
+		return consensusclient.ErrNoOptions
+	}
+	topics := handlers.Topics()
+
+	return s.subscribeEvents(ctx, topics, func(client consensusclient.Service, ah *activeHandler) error {
+		provider, isProvider := client.(consensusclient.EventsWithHandlersProvider)
+		if !isProvider {
+			return errors.New("client does not provide events with handlers")
+		}
+
+		return provider.EventsWithHandlers(ctx, &consensusclient.EventHandlers{
+			AttestationHandler:          gateHandler(ah, "attestation", handlers.AttestationHandler),
+			AttesterSlashingHandler:     gateHandler(ah, "attester_slashing", handlers.AttesterSlashingHandler),
+			BlobSidecarHandler:          gateHandler(ah, "blob_sidecar", handlers.BlobSidecarHandler),
+			BlockHandler:                gateHandler(ah, "block", handlers.BlockHandler),
+			BlockGossipHandler:          gateHandler(ah, "block_gossip", handlers.BlockGossipHandler),
+			BLSToExecutionChangeHandler: gateHandler(ah, "bls_to_execution_change", handlers.BLSToExecutionChangeHandler),
+			ChainReorgHandler:           gateHandler(ah, "chain_reorg", handlers.ChainReorgHandler),
+			ContributionAndProofHandler: gateHandler(ah, "contribution_and_proof", handlers.ContributionAndProofHandler),
+			FinalizedCheckpointHandler:  gateHandler(ah, "finalized_checkpoint", handlers.FinalizedCheckpointHandler),
+			HeadHandler:                 gateHandler(ah, "head", handlers.HeadHandler),
+			PayloadAttributesHandler:    gateHandler(ah, "payload_attributes", handlers.PayloadAttributesHandler),
+			ProposerSlashingHandler:     gateHandler(ah, "proposer_slashing", handlers.ProposerSlashingHandler),
+			VoluntaryExitHandler:        gateHandler(ah, "voluntary_exit", handlers.VoluntaryExitHandler),
+		})
+	})
+}
+
+// subscribeEvents subscribes to events on all clients using the supplied function.
+func (s *Service) subscribeEvents(ctx context.Context,
+	topics []string,
+	subscribe func(client consensusclient.Service, ah *activeHandler) error,
 ) error {
 	// #nosec G404
 	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Logger()
@@ -48,9 +96,8 @@ func (s *Service) Events(ctx context.Context,
 			s:       s,
 			log:     log.With().Logger(),
 			address: client.Address(),
-			handler: handler,
 		}
-		if err := client.(consensusclient.EventsProvider).Events(ctx, topics, ah.handleEvent); err != nil {
+		if err := subscribe(client, ah); err != nil {
 			inactiveClients = append(inactiveClients, client)
 
 			continue
@@ -64,7 +111,6 @@ func (s *Service) Events(ctx context.Context,
 			s:       s,
 			log:     log.With().Logger(),
 			address: inactiveClient.Address(),
-			handler: handler,
 		}
 		go func(c consensusclient.Service, ah *activeHandler) {
 			for {
@@ -89,7 +135,7 @@ func (s *Service) Events(ctx context.Context,
 				}
 				if !syncResponse.Data.IsSyncing {
 					// Client is now synced, set up the events call.
-					if err := c.(consensusclient.EventsProvider).Events(ctx, topics, ah.handleEvent); err != nil {
+					if err := subscribe(c, ah); err != nil {
 						ah.log.Error().
 							Str("address", ah.address).
 							Strs("topics", topics).
@@ -112,19 +158,35 @@ type activeHandler struct {
 	s       *Service
 	log     zerolog.Logger
 	address string
-	handler consensusclient.EventHandlerFunc
 }
 
-func (h *activeHandler) handleEvent(event *apiv1.Event) {
-	h.log.Trace().Str("address", h.address).Str("topic", event.Topic).Msg("Event received")
+func (h *activeHandler) isActive(topic string) bool {
+	h.log.Trace().Str("address", h.address).Str("topic", topic).Msg("Event received")
 	// We only forward events from the currently active provider.  If we did not do this then we could end up with
 	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
 	// block end up with an earlier block.
 	if h.s.Address() == h.address {
 		h.log.Trace().
 			Str("address", h.address).
-			Str("topic", event.Topic).
+			Str("topic", topic).
 			Msg("Forwarding due to primary active address")
-		h.handler(event)
+
+		return true
+	}
+
+	return false
+}
+
+// gateHandler wraps a typed handler so that it only receives events from the
+// currently active provider.
+func gateHandler[T any](h *activeHandler, topic string, handler func(T)) func(T) {
+	if handler == nil {
+		return nil
+	}
+
+	return func(data T) {
+		if h.isActive(topic) {
+			handler(data)
+		}
 	}
 }
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/mock"
 	"github.com/attestantio/go-eth2-client/multi"
 	"github.com/attestantio/go-eth2-client/testclients"
@@ -51,3 +52,33 @@ func TestEvents(t *testing.T) {
 
 	require.NoError(t, multiClient.(consensusclient.EventsProvider).Events(ctx, []string{}, nil))
 }
+
+func TestEventsWithHandlers(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	erroringClient1, err := testclients.NewErroring(ctx, 0.1, client1)
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	erroringClient2, err := testclients.NewErroring(ctx, 0.1, client2)
+	require.NoError(t, err)
+	client3, err := mock.New(ctx, mock.WithName("mock 3"))
+	require.NoError(t, err)
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			erroringClient1,
+			erroringClient2,
+			client3,
+		}),
+	)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, multiClient.(consensusclient.EventsWithHandlersProvider).EventsWithHandlers(ctx, nil), consensusclient.ErrNoOptions)
+	require.NoError(t, multiClient.(consensusclient.EventsWithHandlersProvider).EventsWithHandlers(ctx, &consensusclient.EventHandlers{
+		HeadHandler: func(_ *apiv1.HeadEvent) {},
+	}))
+}
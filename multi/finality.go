@@ -23,7 +23,7 @@ import (
 
 // Finality provides the finality given a state ID.
 func (s *Service) Finality(ctx context.Context, opts *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	res, err := s.doConsensusCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		finality, err := client.(consensusclient.FinalityProvider).Finality(ctx, opts)
 		if err != nil {
 			return nil, err
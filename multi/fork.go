@@ -28,7 +28,7 @@ func (s *Service) Fork(ctx context.Context,
 	*api.Response[*phase0.Fork],
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	res, err := s.doConsensusCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		fork, err := client.(consensusclient.ForkProvider).Fork(ctx, opts)
 		if err != nil {
 			return nil, err
@@ -23,15 +23,18 @@ import (
 )
 
 type parameters struct {
-	logLevel          zerolog.Level
-	monitor           metrics.Service
-	clients           []consensusclient.Service
-	addresses         []string
-	timeout           time.Duration
-	extraHeaders      map[string]string
-	enforceJSON       bool
-	allowDelayedStart bool
-	name              string
+	logLevel             zerolog.Level
+	monitor              metrics.Service
+	clients              []consensusclient.Service
+	addresses            []string
+	timeout              time.Duration
+	extraHeaders         map[string]string
+	enforceJSON          bool
+	allowDelayedStart    bool
+	name                 string
+	selectionStrategy    SelectionStrategy
+	broadcastSubmissions bool
+	consensusThreshold   int
 }
 
 // Parameter is the interface for service parameters.
@@ -108,6 +111,31 @@ func WithName(name string) Parameter {
 	})
 }
 
+// WithSelectionStrategy sets the strategy used to select the order in which active clients are called.
+func WithSelectionStrategy(strategy SelectionStrategy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.selectionStrategy = strategy
+	})
+}
+
+// WithBroadcastSubmissions sends submissions to all active clients concurrently,
+// rather than to each in turn until one accepts.
+func WithBroadcastSubmissions(broadcastSubmissions bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.broadcastSubmissions = broadcastSubmissions
+	})
+}
+
+// WithConsensusThreshold sets the number of clients that are queried for beacon
+// block headers and roots, state roots, forks and finality.  The results must
+// agree, or have a majority, to be returned.  A threshold of 0 or 1 queries a
+// single client.
+func WithConsensusThreshold(threshold int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.consensusThreshold = threshold
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -127,6 +155,15 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if len(parameters.clients)+len(parameters.addresses) == 0 {
 		return nil, errors.New("no Ethereum 2 clients specified")
 	}
+	if parameters.selectionStrategy.String() == "unknown" {
+		return nil, errors.New("unknown selection strategy")
+	}
+	if parameters.consensusThreshold < 0 {
+		return nil, errors.New("consensus threshold cannot be negative")
+	}
+	if parameters.consensusThreshold > len(parameters.clients)+len(parameters.addresses) {
+		return nil, errors.New("consensus threshold cannot be greater than the number of clients")
+	}
 
 	return &parameters, nil
 }
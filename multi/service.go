@@ -16,6 +16,7 @@ package multi
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
@@ -33,6 +34,15 @@ type Service struct {
 	clientsMu       sync.RWMutex
 	activeClients   []consensusclient.Service
 	inactiveClients []consensusclient.Service
+
+	selectionStrategy SelectionStrategy
+	roundRobinCounter atomic.Uint64
+	healthMu          sync.RWMutex
+	health            map[consensusclient.Service]*clientHealth
+	stickyClient      consensusclient.Service
+
+	broadcastSubmissions bool
+	consensusThreshold   int
 }
 
 // New creates a new Ethereum 2 client with multiple endpoints.
@@ -96,10 +106,20 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	log.Trace().Int("active", len(activeClients)).Int("inactive", len(inactiveClients)).Msg("Initial providers")
 
 	s := &Service{
-		log:             log,
-		name:            parameters.name,
-		activeClients:   activeClients,
-		inactiveClients: inactiveClients,
+		log:                  log,
+		name:                 parameters.name,
+		activeClients:        activeClients,
+		inactiveClients:      inactiveClients,
+		selectionStrategy:    parameters.selectionStrategy,
+		health:               make(map[consensusclient.Service]*clientHealth),
+		broadcastSubmissions: parameters.broadcastSubmissions,
+		consensusThreshold:   parameters.consensusThreshold,
+	}
+
+	if s.selectionStrategy == SelectionStrategyHighestHeadSlot {
+		for _, client := range s.activeClients {
+			s.recordSyncState(ctx, client)
+		}
 	}
 
 	// Set initial metrics.
@@ -127,7 +147,7 @@ func (s *Service) Address() string {
 	s.clientsMu.RLock()
 	defer s.clientsMu.RUnlock()
 	if len(s.activeClients) > 0 {
-		return s.activeClients[0].Address()
+		return s.orderClients(s.activeClients, false)[0].Address()
 	}
 
 	return "none"
@@ -108,26 +108,50 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AttestationRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingSubmitter)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingsSubmitter)(nil), s)
+	assert.Implements(t, (*client.BLSToExecutionChangePoolProvider)(nil), s)
+	assert.Implements(t, (*client.BLSToExecutionChangesSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BeaconBlockWithOptsSubmitter)(nil), s)
+	assert.Implements(t, (*client.BeaconCommitteeSelectionsProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconStateProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconStateRandaoProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconStateDownloader)(nil), s)
 	assert.Implements(t, (*client.BlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BlindedProposalSubmitter)(nil), s)
 	assert.Implements(t, (*client.BlockRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.BlobSidecarsProvider)(nil), s)
+	assert.Implements(t, (*client.DataColumnSidecarsProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedBlobSidecarsProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.DepositContractProvider)(nil), s)
+	assert.Implements(t, (*client.DepositSnapshotProvider)(nil), s)
 	assert.Implements(t, (*client.EventsProvider)(nil), s)
+	assert.Implements(t, (*client.EventsWithHandlersProvider)(nil), s)
+	assert.Implements(t, (*client.ExpectedWithdrawalsProvider)(nil), s)
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
 	assert.Implements(t, (*client.ForkChoiceProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
+	assert.Implements(t, (*client.InclusionListProvider)(nil), s)
+	assert.Implements(t, (*client.InclusionListDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.InclusionListsSubmitter)(nil), s)
+	assert.Implements(t, (*client.NodeIdentityProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeerProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeerCountProvider)(nil), s)
+	assert.Implements(t, (*client.NodePeersProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ProposalProvider)(nil), s)
+	assert.Implements(t, (*client.ProposalSlashingSubmitter)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerSlashingPoolProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
@@ -135,13 +159,28 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.SyncCommitteeMessagesSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeSelectionsProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorIdentitiesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorLivenessProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorsIteratorProvider)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitPoolProvider)(nil), s)
 
 	// Non-standard extensions.
+	assert.Implements(t, (*client.ClientInfoProvider)(nil), s)
 	assert.Implements(t, (*client.DomainProvider)(nil), s)
+	assert.Implements(t, (*client.SupportedMethodsProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientBootstrapProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientUpdatesProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientFinalityUpdateProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientOptimisticUpdateProvider)(nil), s)
+
+	// Composite interfaces.
+	assert.Implements(t, (*client.IndexerRequirements)(nil), s)
+	assert.Implements(t, (*client.MEVRequirements)(nil), s)
+	assert.Implements(t, (*client.ValidatorClientRequirements)(nil), s)
 }
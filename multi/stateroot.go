@@ -28,7 +28,7 @@ func (s *Service) BeaconStateRoot(ctx context.Context,
 	*api.Response[*phase0.Root],
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	res, err := s.doConsensusCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		stateRoot, err := client.(consensusclient.BeaconStateRootProvider).BeaconStateRoot(ctx, opts)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,199 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+// SelectionStrategy is the strategy used to select the order in which
+// active clients are called.
+type SelectionStrategy int
+
+const (
+	// SelectionStrategyFirst calls active clients in the order in which they
+	// are held, moving on to the next client only on failure.
+	SelectionStrategyFirst SelectionStrategy = iota
+	// SelectionStrategyRoundRobin rotates the first client called for each request.
+	SelectionStrategyRoundRobin
+	// SelectionStrategyLowestLatency prefers the client with the fewest recent
+	// failures and the lowest average request latency.
+	SelectionStrategyLowestLatency
+	// SelectionStrategyHighestHeadSlot prefers the client with the highest head slot.
+	SelectionStrategyHighestHeadSlot
+	// SelectionStrategySticky prefers the client that last succeeded, until it fails.
+	SelectionStrategySticky
+)
+
+var selectionStrategyStrings = [...]string{
+	"first",
+	"round-robin",
+	"lowest-latency",
+	"highest-head-slot",
+	"sticky",
+}
+
+// String returns a string representation of the strategy.
+func (s SelectionStrategy) String() string {
+	if s < 0 || int(s) >= len(selectionStrategyStrings) {
+		return "unknown"
+	}
+
+	return selectionStrategyStrings[s]
+}
+
+// latencyWeight is the weight given to the latest request when updating the
+// moving average latency of a client.
+const latencyWeight = 0.2
+
+// clientHealth is the health of a client, as seen by its responses.
+type clientHealth struct {
+	// latency is the exponentially weighted moving average of request latency.
+	latency time.Duration
+	// failures is the number of consecutive failed requests.
+	failures uint64
+	// headSlot is the head slot last reported by the client.
+	headSlot phase0.Slot
+}
+
+// healthFor returns the health for the given client, creating it if required.
+// The caller must hold healthMu.
+func (s *Service) healthFor(client consensusclient.Service) *clientHealth {
+	health, exists := s.health[client]
+	if !exists {
+		health = &clientHealth{}
+		s.health[client] = health
+	}
+
+	return health
+}
+
+// recordCall updates the health of a client with the result of a call.
+func (s *Service) recordCall(client consensusclient.Service, latency time.Duration, err error) {
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+
+	health := s.healthFor(client)
+	if err != nil {
+		health.failures++
+
+		return
+	}
+	health.failures = 0
+	if health.latency == 0 {
+		health.latency = latency
+	} else {
+		health.latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(health.latency))
+	}
+	s.stickyClient = client
+}
+
+// recordSyncState updates the health of a client with its sync state.
+func (s *Service) recordSyncState(ctx context.Context, client consensusclient.Service) {
+	provider, isProvider := client.(consensusclient.NodeSyncingProvider)
+	if !isProvider {
+		return
+	}
+	response, err := provider.NodeSyncing(ctx, &api.NodeSyncingOpts{})
+	if err != nil {
+		zerolog.Ctx(ctx).Trace().Str("client", client.Address()).Err(err).Msg("Failed to obtain sync state")
+
+		return
+	}
+
+	s.healthMu.Lock()
+	s.healthFor(client).headSlot = response.Data.HeadSlot
+	s.healthMu.Unlock()
+}
+
+// orderClients returns the clients in the order in which they should be called
+// according to the selection strategy.  If advance is true then stateful
+// strategies move on, so that the next call obtains a different order.
+func (s *Service) orderClients(clients []consensusclient.Service, advance bool) []consensusclient.Service {
+	if len(clients) < 2 {
+		return clients
+	}
+
+	ordered := make([]consensusclient.Service, len(clients))
+	copy(ordered, clients)
+
+	switch s.selectionStrategy {
+	case SelectionStrategyRoundRobin:
+		var offset uint64
+		if advance {
+			offset = s.roundRobinCounter.Add(1) - 1
+		} else {
+			offset = s.roundRobinCounter.Load()
+		}
+		start := int(offset % uint64(len(clients)))
+		ordered = append(ordered[start:], ordered[:start]...)
+	case SelectionStrategyLowestLatency:
+		s.healthMu.RLock()
+		sort.SliceStable(ordered, func(i, j int) bool {
+			// Clients without health are treated as healthy, so that they are tried.
+			healthI := s.health[ordered[i]]
+			if healthI == nil {
+				healthI = &clientHealth{}
+			}
+			healthJ := s.health[ordered[j]]
+			if healthJ == nil {
+				healthJ = &clientHealth{}
+			}
+			switch {
+			case healthI.failures != healthJ.failures:
+				return healthI.failures < healthJ.failures
+			default:
+				return healthI.latency < healthJ.latency
+			}
+		})
+		s.healthMu.RUnlock()
+	case SelectionStrategyHighestHeadSlot:
+		s.healthMu.RLock()
+		sort.SliceStable(ordered, func(i, j int) bool {
+			var slotI, slotJ phase0.Slot
+			if health, exists := s.health[ordered[i]]; exists {
+				slotI = health.headSlot
+			}
+			if health, exists := s.health[ordered[j]]; exists {
+				slotJ = health.headSlot
+			}
+
+			return slotI > slotJ
+		})
+		s.healthMu.RUnlock()
+	case SelectionStrategySticky:
+		s.healthMu.RLock()
+		sticky := s.stickyClient
+		s.healthMu.RUnlock()
+		for i := range ordered {
+			if ordered[i] == sticky {
+				copy(ordered[1:i+1], ordered[:i])
+				ordered[0] = sticky
+
+				break
+			}
+		}
+	default:
+		// Leave in the existing order.
+	}
+
+	return ordered
+}
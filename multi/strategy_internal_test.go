@@ -0,0 +1,143 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectionStrategy(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1.HeadSlot = 10
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client2.HeadSlot = 30
+	client3, err := mock.New(ctx, mock.WithName("mock 3"))
+	require.NoError(t, err)
+	client3.HeadSlot = 20
+	clients := []consensusclient.Service{client1, client2, client3}
+
+	tests := []struct {
+		name     string
+		strategy SelectionStrategy
+		prepare  func(s *Service)
+		orders   [][]consensusclient.Service
+	}{
+		{
+			name:     "First",
+			strategy: SelectionStrategyFirst,
+			orders: [][]consensusclient.Service{
+				{client1, client2, client3},
+				{client1, client2, client3},
+			},
+		},
+		{
+			name:     "RoundRobin",
+			strategy: SelectionStrategyRoundRobin,
+			orders: [][]consensusclient.Service{
+				{client1, client2, client3},
+				{client2, client3, client1},
+				{client3, client1, client2},
+				{client1, client2, client3},
+			},
+		},
+		{
+			name:     "LowestLatency",
+			strategy: SelectionStrategyLowestLatency,
+			prepare: func(s *Service) {
+				s.recordCall(client1, 30*time.Millisecond, nil)
+				s.recordCall(client2, 10*time.Millisecond, nil)
+				s.recordCall(client3, 20*time.Millisecond, nil)
+			},
+			orders: [][]consensusclient.Service{
+				{client2, client3, client1},
+			},
+		},
+		{
+			name:     "LowestLatencyFailures",
+			strategy: SelectionStrategyLowestLatency,
+			prepare: func(s *Service) {
+				s.recordCall(client1, 30*time.Millisecond, nil)
+				s.recordCall(client2, 10*time.Millisecond, nil)
+				s.recordCall(client2, 10*time.Millisecond, errors.New("failed"))
+				s.recordCall(client3, 20*time.Millisecond, nil)
+			},
+			orders: [][]consensusclient.Service{
+				{client3, client1, client2},
+			},
+		},
+		{
+			name:     "HighestHeadSlot",
+			strategy: SelectionStrategyHighestHeadSlot,
+			orders: [][]consensusclient.Service{
+				{client2, client3, client1},
+			},
+		},
+		{
+			name:     "Sticky",
+			strategy: SelectionStrategySticky,
+			prepare: func(s *Service) {
+				s.recordCall(client3, 10*time.Millisecond, nil)
+			},
+			orders: [][]consensusclient.Service{
+				{client3, client1, client2},
+				{client3, client1, client2},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := New(ctx,
+				WithLogLevel(zerolog.Disabled),
+				WithClients(clients),
+				WithSelectionStrategy(test.strategy),
+			)
+			require.NoError(t, err)
+			multi := s.(*Service)
+			if test.prepare != nil {
+				test.prepare(multi)
+			}
+			require.Equal(t, test.orders[0][0].Address(), multi.Address())
+			for _, order := range test.orders {
+				require.Equal(t, order, multi.orderClients(clients, true))
+			}
+		})
+	}
+}
+
+func TestSelectionStrategyUnknown(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	_, err = New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client}),
+		WithSelectionStrategy(SelectionStrategy(99)),
+	)
+	require.EqualError(t, err, "problem with parameters: unknown selection strategy")
+}
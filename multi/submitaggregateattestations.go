@@ -24,7 +24,7 @@ import (
 func (s *Service) SubmitAggregateAttestations(ctx context.Context,
 	opts *api.SubmitAggregateAttestationsOpts,
 ) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	_, err := s.doSubmit(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		err := client.(consensusclient.AggregateAttestationsSubmitter).SubmitAggregateAttestations(ctx, opts)
 		if err != nil {
 			return nil, err
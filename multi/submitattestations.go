@@ -25,7 +25,7 @@ import (
 func (s *Service) SubmitAttestations(ctx context.Context,
 	opts *api.SubmitAttestationsOpts,
 ) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	_, err := s.doSubmit(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		err := client.(consensusclient.AttestationsSubmitter).SubmitAttestations(ctx, opts)
 		if err != nil {
 			return nil, err
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
 )
 
@@ -24,7 +25,7 @@ import (
 //
 // Deprecated: this will not work from the deneb hard-fork onwards.  Use SubmitProposal() instead.
 func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	_, err := s.doSubmit(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		err := client.(consensusclient.BeaconBlockSubmitter).SubmitBeaconBlock(ctx, block)
 		if err != nil {
 			return nil, err
@@ -35,3 +36,17 @@ func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSi
 
 	return err
 }
+
+// SubmitBeaconBlockWithOpts submits a beacon block with the given options.
+func (s *Service) SubmitBeaconBlockWithOpts(ctx context.Context, opts *api.SubmitBeaconBlockOpts) error {
+	_, err := s.doSubmit(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+		err := client.(consensusclient.BeaconBlockWithOptsSubmitter).SubmitBeaconBlockWithOpts(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}, nil)
+
+	return err
+}
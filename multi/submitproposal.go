@@ -24,7 +24,7 @@ import (
 func (s *Service) SubmitProposal(ctx context.Context,
 	opts *api.SubmitProposalOpts,
 ) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	_, err := s.doSubmit(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		err := client.(consensusclient.ProposalSubmitter).SubmitProposal(ctx, opts)
 		if err != nil {
 			return nil, err
@@ -24,7 +24,7 @@ import (
 func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context,
 	contributionAndProofs []*altair.SignedContributionAndProof,
 ) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	_, err := s.doSubmit(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		err := client.(consensusclient.SyncCommitteeContributionsSubmitter).SubmitSyncCommitteeContributions(ctx,
 			contributionAndProofs,
 		)
@@ -24,7 +24,7 @@ import (
 func (s *Service) SubmitValidatorRegistrations(ctx context.Context,
 	registrations []*api.VersionedSignedValidatorRegistration,
 ) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+	_, err := s.doSubmit(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
 		err := client.(consensusclient.ValidatorRegistrationsSubmitter).SubmitValidatorRegistrations(ctx, registrations)
 		if err != nil {
 			return nil, err
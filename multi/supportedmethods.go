@@ -0,0 +1,95 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// SupportedMethods provides the methods supported by the client, being those
+// supported by at least one of its active clients.
+func (s *Service) SupportedMethods(ctx context.Context) (*api.SupportedMethods, error) {
+	s.clientsMu.RLock()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+
+	supportedMethods := make([]*api.SupportedMethods, 0, len(activeClients))
+	for _, client := range activeClients {
+		provider, isProvider := client.(consensusclient.SupportedMethodsProvider)
+		if !isProvider {
+			// Unable to tell, so assume that the client supports all methods.
+			supportedMethods = nil
+
+			break
+		}
+		clientSupportedMethods, err := provider.SupportedMethods(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to obtain supported methods from %s", client.Address()))
+		}
+		supportedMethods = append(supportedMethods, clientSupportedMethods)
+	}
+
+	serviceType := reflect.TypeOf(s)
+	methods := make([]string, 0, serviceType.NumMethod())
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		method := serviceType.Method(i).Name
+		supported := supportedMethods == nil
+		for _, clientSupportedMethods := range supportedMethods {
+			if clientSupportedMethods.Supports(method) {
+				supported = true
+
+				break
+			}
+		}
+		if supported {
+			methods = append(methods, method)
+		}
+	}
+
+	return api.NewSupportedMethods(methods), nil
+}
+
+// ifSupported wraps a call so that it is only made to clients that support the
+// method, returning ErrNotSupported for those that do not.
+func ifSupported(method string, call callFunc) callFunc {
+	return func(ctx context.Context, client consensusclient.Service) (any, error) {
+		if !supportsMethod(ctx, client, method) {
+			return nil, errors.Wrap(ErrNotSupported, fmt.Sprintf("%s@%s does not support %s", client.Name(), client.Address(), method))
+		}
+
+		return call(ctx, client)
+	}
+}
+
+// supportsMethod returns true if the client supports the method.  Clients that
+// cannot state which methods they support are assumed to support all of them.
+func supportsMethod(ctx context.Context, client consensusclient.Service, method string) bool {
+	provider, isProvider := client.(consensusclient.SupportedMethodsProvider)
+	if !isProvider {
+		return true
+	}
+	supportedMethods, err := provider.SupportedMethods(ctx)
+	if err != nil {
+		// Unable to tell, so allow the call to be attempted.
+		return true
+	}
+
+	return supportedMethods.Supports(method)
+}
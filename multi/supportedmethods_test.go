@@ -0,0 +1,96 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// unsupportedRewardsClient creates a mock client that does not support attestation rewards.
+func unsupportedRewardsClient(ctx context.Context, t *testing.T, name string) consensusclient.Service {
+	t.Helper()
+
+	client, err := mock.New(ctx,
+		mock.WithName(name),
+		mock.WithSupportedMethodsFunc(func(context.Context) (*api.SupportedMethods, error) {
+			return api.NewSupportedMethods([]string{"Name", "Address", "IsActive", "IsSynced"}), nil
+		}),
+		mock.WithAttestationRewardsFunc(func(context.Context, *api.AttestationRewardsOpts) (*api.Response[*apiv1.AttestationRewards], error) {
+			return nil, errors.New("called client that does not support attestation rewards")
+		}),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestSupportedMethods(t *testing.T) {
+	ctx := context.Background()
+
+	unsupportedClient := unsupportedRewardsClient(ctx, t, "mock 1")
+	supportedClient, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			unsupportedClient,
+			supportedClient,
+		}),
+	)
+	require.NoError(t, err)
+
+	supportedMethods, err := multiClient.(consensusclient.SupportedMethodsProvider).SupportedMethods(ctx)
+	require.NoError(t, err)
+	require.True(t, supportedMethods.Supports("AttestationRewards"))
+
+	// Calls are routed to the client that supports the method, without deactivating the other.
+	for i := 0; i < 16; i++ {
+		_, err := multiClient.(consensusclient.AttestationRewardsProvider).AttestationRewards(ctx, &api.AttestationRewardsOpts{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, "mock 1", multiClient.Address())
+}
+
+func TestSupportedMethodsNoneSupported(t *testing.T) {
+	ctx := context.Background()
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			unsupportedRewardsClient(ctx, t, "mock 1"),
+			unsupportedRewardsClient(ctx, t, "mock 2"),
+		}),
+	)
+	require.NoError(t, err)
+
+	supportedMethods, err := multiClient.(consensusclient.SupportedMethodsProvider).SupportedMethods(ctx)
+	require.NoError(t, err)
+	require.False(t, supportedMethods.Supports("AttestationRewards"))
+	require.True(t, supportedMethods.Supports("Name"))
+
+	_, err = multiClient.(consensusclient.AttestationRewardsProvider).AttestationRewards(ctx, &api.AttestationRewardsOpts{})
+	require.ErrorIs(t, err, multi.ErrNotSupported)
+	require.Equal(t, "mock 1", multiClient.Address())
+}
@@ -0,0 +1,51 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// ValidatorsIterator provides an iterator over the validators, with their balance and status, for the given options.
+// Each page is obtained separately, so fails over between clients as required.
+func (s *Service) ValidatorsIterator(ctx context.Context,
+	opts *api.ValidatorsIteratorOpts,
+) (
+	*api.ValidatorsIterator,
+	error,
+) {
+	if opts == nil {
+		return nil, consensusclient.ErrNoOptions
+	}
+
+	// Fix the state so that all pages come from the same state, regardless of the client that serves them.
+	iteratorOpts := *opts
+	switch opts.State {
+	case "head", "justified", "finalized":
+		stateRootResponse, err := s.BeaconStateRoot(ctx, &api.BeaconStateRootOpts{
+			Common: opts.Common,
+			State:  opts.State,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain state root")
+		}
+		iteratorOpts.State = stateRootResponse.Data.String()
+	}
+
+	return api.NewValidatorsIterator(s.Validators, &iteratorOpts), nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorsIterator(t *testing.T) {
+	ctx := context.Background()
+
+	stateRoot := phase0.Root{0x01}
+	validators := func(_ context.Context, opts *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+		// Pages must be for the state root rather than the named state.
+		require.Equal(t, stateRoot.String(), opts.State)
+		res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+		for _, index := range opts.Indices {
+			if index < 15 {
+				res[index] = &apiv1.Validator{Index: index}
+			}
+		}
+
+		return &api.Response[map[phase0.ValidatorIndex]*apiv1.Validator]{Data: res}, nil
+	}
+	stateRootFunc := func(_ context.Context, _ *api.BeaconStateRootOpts) (*api.Response[*phase0.Root], error) {
+		return &api.Response[*phase0.Root]{Data: &stateRoot}, nil
+	}
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client1.ValidatorsFunc = validators
+	client1.BeaconStateRootFunc = stateRootFunc
+	erroringClient1, err := testclients.NewErroring(ctx, 0.5, client1)
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client2.ValidatorsFunc = validators
+	client2.BeaconStateRootFunc = stateRootFunc
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			erroringClient1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+
+	iterator, err := multiClient.(consensusclient.ValidatorsIteratorProvider).ValidatorsIterator(ctx, &api.ValidatorsIteratorOpts{
+		State:    "head",
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+
+	found := 0
+	for iterator.Next(ctx) {
+		found += len(iterator.Validators().Data)
+	}
+	require.NoError(t, iterator.Err())
+	require.Equal(t, 15, found)
+}
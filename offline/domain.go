@@ -0,0 +1,51 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+)
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (s *Service) Domain(_ context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	return s.calculateDomain(domainType, s.forkAtEpoch(epoch).CurrentVersion)
+}
+
+// GenesisDomain returns the domain for the given domain type at genesis.
+// N.B. this is not always the same as the domain at epoch 0.  It is possible
+// for a chain's fork schedule to have multiple forks at genesis.  In this situation,
+// GenesisDomain() will return the first, and Domain() will return the last.
+func (s *Service) GenesisDomain(_ context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	return s.calculateDomain(domainType, s.forkSchedule[0].CurrentVersion)
+}
+
+func (s *Service) calculateDomain(domainType phase0.DomainType, forkVersion phase0.Version) (phase0.Domain, error) {
+	var genesisValidatorsRoot phase0.Root
+	if !bytes.Equal(domainType[:], []byte{0x00, 0x00, 0x00, 0x01}) {
+		// Use the chain's genesis validators root for non-application domain types.
+		genesisValidatorsRoot = s.genesis.GenesisValidatorsRoot
+	}
+
+	domain, err := signing.ComputeDomain(domainType, forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Domain{}, errors.Join(errors.New("failed to calculate signature domain"), err)
+	}
+
+	return domain, nil
+}
@@ -0,0 +1,56 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Fork fetches fork information for the given state.
+// Only states that identify a slot, being "genesis" or a slot number, can be
+// resolved offline; other states return ErrOffline.
+func (s *Service) Fork(_ context.Context,
+	opts *api.ForkOpts,
+) (
+	*api.Response[*phase0.Fork],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.State == "" {
+		return nil, errors.Join(errors.New("no state specified"), client.ErrInvalidOptions)
+	}
+
+	var slot phase0.Slot
+	if opts.State != "genesis" {
+		val, err := strconv.ParseUint(opts.State, 10, 64)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("cannot resolve state %s", opts.State), ErrOffline)
+		}
+		slot = phase0.Slot(val)
+	}
+
+	return &api.Response[*phase0.Fork]{
+		Data:     s.forkAtEpoch(phase0.Epoch(uint64(slot) / s.slotsPerEpoch)),
+		Metadata: make(map[string]any),
+	}, nil
+}
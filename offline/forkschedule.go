@@ -0,0 +1,53 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ForkSchedule provides details of past and future changes in the chain's fork version.
+// Forks that are not scheduled are not included.
+func (s *Service) ForkSchedule(_ context.Context,
+	opts *api.ForkScheduleOpts,
+) (
+	*api.Response[[]*phase0.Fork],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	return &api.Response[[]*phase0.Fork]{
+		Data:     s.forkSchedule,
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+// forkAtEpoch works through the fork schedule to obtain the fork at the given epoch.
+func (s *Service) forkAtEpoch(epoch phase0.Epoch) *phase0.Fork {
+	currentFork := s.forkSchedule[0]
+	for i := range s.forkSchedule {
+		if s.forkSchedule[i].Epoch > epoch {
+			break
+		}
+		currentFork = s.forkSchedule[i]
+	}
+
+	return currentFork
+}
@@ -0,0 +1,93 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline
+
+import (
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+type parameters struct {
+	name                  string
+	spec                  map[string]any
+	genesisTime           time.Time
+	genesisValidatorsRoot phase0.Root
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithName sets the name for the module.
+func WithName(name string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.name = name
+	})
+}
+
+// WithSpec sets the specification of the chain, in the form returned by
+// Spec().  util/chainconfig can be used to load this from a chain
+// configuration file.
+func WithSpec(spec map[string]any) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.spec = spec
+	})
+}
+
+// WithGenesisTime sets the genesis time of the chain.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithGenesisValidatorsRoot sets the genesis validators root of the chain.
+func WithGenesisValidatorsRoot(root phase0.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisValidatorsRoot = root
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		name: "offline",
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.name == "" {
+		return nil, errors.New("name not specified")
+	}
+	if parameters.spec == nil {
+		return nil, errors.New("no spec specified")
+	}
+	if parameters.genesisTime.IsZero() {
+		return nil, errors.New("no genesis time specified")
+	}
+
+	return &parameters, nil
+}
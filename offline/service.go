@@ -0,0 +1,142 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package offline provides an Ethereum 2 client service that serves the
+// static information of a chain, such as its specification, genesis and fork
+// schedule, from configuration rather than a beacon node.  It allows code that
+// only needs types and domain computation to be used without a connection to a
+// beacon node.
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// farFutureEpoch is the epoch used for forks that are not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// Service is an Ethereum 2 client service that provides static chain
+// information without a beacon node.
+type Service struct {
+	name          string
+	spec          map[string]any
+	slotsPerEpoch uint64
+	genesis       *apiv1.Genesis
+	forkSchedule  []*phase0.Fork
+}
+
+// New creates a new offline Ethereum 2 client service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	config := apiv1.NewSpecConfig(parameters.spec)
+	slotsPerEpoch, err := config.SlotsPerEpoch()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain slots per epoch"), err)
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be zero")
+	}
+	genesisForkVersion, err := config.GenesisForkVersion()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain genesis fork version"), err)
+	}
+	forkSchedule, err := buildForkSchedule(config, genesisForkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		name:          parameters.name,
+		spec:          parameters.spec,
+		slotsPerEpoch: slotsPerEpoch,
+		genesis: &apiv1.Genesis{
+			GenesisTime:           parameters.genesisTime,
+			GenesisValidatorsRoot: parameters.genesisValidatorsRoot,
+			GenesisForkVersion:    genesisForkVersion,
+		},
+		forkSchedule: forkSchedule,
+	}, nil
+}
+
+// buildForkSchedule builds the fork schedule from the specification, ignoring
+// forks that are unknown to the specification or not scheduled.
+func buildForkSchedule(config *apiv1.SpecConfig, genesisForkVersion phase0.Version) ([]*phase0.Fork, error) {
+	forkSchedule := []*phase0.Fork{
+		{
+			PreviousVersion: genesisForkVersion,
+			CurrentVersion:  genesisForkVersion,
+			Epoch:           0,
+		},
+	}
+	for version := spec.DataVersionAltair; version <= spec.DataVersionFulu; version++ {
+		name := strings.ToUpper(version.String())
+		if !config.Has(name + "_FORK_EPOCH") {
+			continue
+		}
+		epoch, err := config.ForkEpoch(name)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to obtain %s fork epoch", version), err)
+		}
+		if epoch == farFutureEpoch {
+			continue
+		}
+		forkVersion, err := config.ForkVersion(name)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to obtain %s fork version", version), err)
+		}
+		previous := forkSchedule[len(forkSchedule)-1]
+		if epoch < previous.Epoch {
+			return nil, fmt.Errorf("%s fork epoch is before that of the previous fork", version)
+		}
+		forkSchedule = append(forkSchedule, &phase0.Fork{
+			PreviousVersion: previous.CurrentVersion,
+			CurrentVersion:  forkVersion,
+			Epoch:           epoch,
+		})
+	}
+
+	return forkSchedule, nil
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return s.name
+}
+
+// Address provides the address for the connection.
+func (*Service) Address() string {
+	return "offline"
+}
+
+// IsActive returns true if the client is active.
+// The offline service is always active, as it does not depend on a beacon node.
+func (*Service) IsActive() bool {
+	return true
+}
+
+// IsSynced returns true if the client is synced.
+// The offline service is always synced, as its information is static.
+func (*Service) IsSynced() bool {
+	return true
+}
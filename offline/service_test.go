@@ -0,0 +1,314 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/offline"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/chainconfig"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testGenesisTime           = time.Unix(1606824023, 0)
+	testGenesisValidatorsRoot = phase0.Root{
+		0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+		0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+	}
+)
+
+func testSpec() map[string]any {
+	return map[string]any{
+		"SLOTS_PER_EPOCH":        uint64(32),
+		"GENESIS_FORK_VERSION":   phase0.Version{0x00, 0x00, 0x00, 0x00},
+		"ALTAIR_FORK_VERSION":    phase0.Version{0x01, 0x00, 0x00, 0x00},
+		"ALTAIR_FORK_EPOCH":      uint64(10),
+		"BELLATRIX_FORK_VERSION": phase0.Version{0x02, 0x00, 0x00, 0x00},
+		"BELLATRIX_FORK_EPOCH":   uint64(20),
+		"CAPELLA_FORK_VERSION":   phase0.Version{0x03, 0x00, 0x00, 0x00},
+		"CAPELLA_FORK_EPOCH":     uint64(0xffffffffffffffff),
+	}
+}
+
+func testService(ctx context.Context, t *testing.T) *offline.Service {
+	t.Helper()
+
+	service, err := offline.New(ctx,
+		offline.WithSpec(testSpec()),
+		offline.WithGenesisTime(testGenesisTime),
+		offline.WithGenesisValidatorsRoot(testGenesisValidatorsRoot),
+	)
+	require.NoError(t, err)
+
+	return service
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	badForkVersion := testSpec()
+	delete(badForkVersion, "ALTAIR_FORK_VERSION")
+	badForkEpoch := testSpec()
+	badForkEpoch["BELLATRIX_FORK_EPOCH"] = uint64(5)
+	noGenesisForkVersion := testSpec()
+	delete(noGenesisForkVersion, "GENESIS_FORK_VERSION")
+
+	tests := []struct {
+		name   string
+		params []offline.Parameter
+		err    string
+	}{
+		{
+			name: "NameMissing",
+			params: []offline.Parameter{
+				offline.WithName(""),
+				offline.WithSpec(testSpec()),
+				offline.WithGenesisTime(testGenesisTime),
+			},
+			err: "problem with parameters\nname not specified",
+		},
+		{
+			name: "SpecMissing",
+			params: []offline.Parameter{
+				offline.WithGenesisTime(testGenesisTime),
+			},
+			err: "problem with parameters\nno spec specified",
+		},
+		{
+			name: "GenesisTimeMissing",
+			params: []offline.Parameter{
+				offline.WithSpec(testSpec()),
+			},
+			err: "problem with parameters\nno genesis time specified",
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			params: []offline.Parameter{
+				offline.WithSpec(map[string]any{}),
+				offline.WithGenesisTime(testGenesisTime),
+			},
+			err: "failed to obtain slots per epoch\nSLOTS_PER_EPOCH missing",
+		},
+		{
+			name: "GenesisForkVersionMissing",
+			params: []offline.Parameter{
+				offline.WithSpec(noGenesisForkVersion),
+				offline.WithGenesisTime(testGenesisTime),
+			},
+			err: "failed to obtain genesis fork version\nGENESIS_FORK_VERSION missing",
+		},
+		{
+			name: "ForkVersionMissing",
+			params: []offline.Parameter{
+				offline.WithSpec(badForkVersion),
+				offline.WithGenesisTime(testGenesisTime),
+			},
+			err: "failed to obtain altair fork version\nALTAIR_FORK_VERSION missing",
+		},
+		{
+			name: "ForkEpochOutOfOrder",
+			params: []offline.Parameter{
+				offline.WithSpec(badForkEpoch),
+				offline.WithGenesisTime(testGenesisTime),
+			},
+			err: "bellatrix fork epoch is before that of the previous fork",
+		},
+		{
+			name: "Good",
+			params: []offline.Parameter{
+				offline.WithName("test"),
+				offline.WithSpec(testSpec()),
+				offline.WithGenesisTime(testGenesisTime),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := offline.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	s := testService(context.Background(), t)
+
+	require.Implements(t, (*client.Service)(nil), s)
+	require.Implements(t, (*client.SpecProvider)(nil), s)
+	require.Implements(t, (*client.GenesisProvider)(nil), s)
+	require.Implements(t, (*client.ForkScheduleProvider)(nil), s)
+	require.Implements(t, (*client.ForkProvider)(nil), s)
+	require.Implements(t, (*client.DomainProvider)(nil), s)
+	require.Implements(t, (*client.FarFutureEpochProvider)(nil), s)
+	require.Implements(t, (*client.SupportedMethodsProvider)(nil), s)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx := context.Background()
+	s := testService(ctx, t)
+
+	_, err := s.Genesis(ctx, nil)
+	require.ErrorIs(t, err, client.ErrNoOptions)
+
+	response, err := s.Genesis(ctx, &api.GenesisOpts{})
+	require.NoError(t, err)
+	require.Equal(t, testGenesisTime, response.Data.GenesisTime)
+	require.Equal(t, testGenesisValidatorsRoot, response.Data.GenesisValidatorsRoot)
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x00, 0x00}, response.Data.GenesisForkVersion)
+}
+
+func TestForkSchedule(t *testing.T) {
+	ctx := context.Background()
+	s := testService(ctx, t)
+
+	response, err := s.ForkSchedule(ctx, &api.ForkScheduleOpts{})
+	require.NoError(t, err)
+	require.Equal(t, []*phase0.Fork{
+		{
+			PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+			CurrentVersion:  phase0.Version{0x00, 0x00, 0x00, 0x00},
+			Epoch:           0,
+		},
+		{
+			PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+			CurrentVersion:  phase0.Version{0x01, 0x00, 0x00, 0x00},
+			Epoch:           10,
+		},
+		{
+			PreviousVersion: phase0.Version{0x01, 0x00, 0x00, 0x00},
+			CurrentVersion:  phase0.Version{0x02, 0x00, 0x00, 0x00},
+			Epoch:           20,
+		},
+	}, response.Data)
+}
+
+func TestFork(t *testing.T) {
+	ctx := context.Background()
+	s := testService(ctx, t)
+
+	tests := []struct {
+		name     string
+		opts     *api.ForkOpts
+		expected phase0.Version
+		err      string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "StateMissing",
+			opts: &api.ForkOpts{},
+			err:  "no state specified\ninvalid options",
+		},
+		{
+			name:     "Genesis",
+			opts:     &api.ForkOpts{State: "genesis"},
+			expected: phase0.Version{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:     "Slot",
+			opts:     &api.ForkOpts{State: "320"},
+			expected: phase0.Version{0x01, 0x00, 0x00, 0x00},
+		},
+		{
+			name:     "SlotBeforeFork",
+			opts:     &api.ForkOpts{State: "319"},
+			expected: phase0.Version{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:     "SlotAfterLastFork",
+			opts:     &api.ForkOpts{State: "100000"},
+			expected: phase0.Version{0x02, 0x00, 0x00, 0x00},
+		},
+		{
+			name: "Head",
+			opts: &api.ForkOpts{State: "head"},
+			err:  "cannot resolve state head\nnot available offline",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := s.Fork(ctx, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, response.Data.CurrentVersion)
+			}
+		})
+	}
+}
+
+func TestDomain(t *testing.T) {
+	ctx := context.Background()
+	s := testService(ctx, t)
+
+	domainType := phase0.DomainType{0x01, 0x00, 0x00, 0x00}
+
+	expected, err := signing.ComputeDomain(domainType, phase0.Version{0x01, 0x00, 0x00, 0x00}, testGenesisValidatorsRoot)
+	require.NoError(t, err)
+	domain, err := s.Domain(ctx, domainType, 15)
+	require.NoError(t, err)
+	require.Equal(t, expected, domain)
+
+	expected, err = signing.ComputeDomain(domainType, phase0.Version{0x00, 0x00, 0x00, 0x00}, testGenesisValidatorsRoot)
+	require.NoError(t, err)
+	domain, err = s.GenesisDomain(ctx, domainType)
+	require.NoError(t, err)
+	require.Equal(t, expected, domain)
+
+	// Application domains do not use the genesis validators root.
+	applicationDomainType := phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+	expected, err = signing.ComputeDomain(applicationDomainType, phase0.Version{0x02, 0x00, 0x00, 0x00}, phase0.Root{})
+	require.NoError(t, err)
+	domain, err = s.Domain(ctx, applicationDomainType, 25)
+	require.NoError(t, err)
+	require.Equal(t, expected, domain)
+}
+
+func TestChainConfig(t *testing.T) {
+	ctx := context.Background()
+
+	config, err := chainconfig.Load([]byte("PRESET_BASE: 'minimal'\nGENESIS_FORK_VERSION: 0x00000001\nALTAIR_FORK_VERSION: 0x01000001\nALTAIR_FORK_EPOCH: 0\n"))
+	require.NoError(t, err)
+
+	s, err := offline.New(ctx,
+		offline.WithSpec(config),
+		offline.WithGenesisTime(testGenesisTime),
+	)
+	require.NoError(t, err)
+
+	response, err := s.Fork(ctx, &api.ForkOpts{State: "genesis"})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Version{0x01, 0x00, 0x00, 0x01}, response.Data.CurrentVersion)
+
+	supported, err := s.SupportedMethods(ctx)
+	require.NoError(t, err)
+	require.True(t, supported.Supports("Domain"))
+	require.False(t, supported.Supports("BeaconState"))
+}
@@ -0,0 +1,85 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+// ValidatorClientRequirements are the providers and submitters required by a
+// validator client to carry out its duties: attesting, aggregating, proposing
+// and participating in sync committees.
+type ValidatorClientRequirements interface {
+	Service
+	SpecProvider
+	GenesisProvider
+	ForkScheduleProvider
+	NodeSyncingProvider
+	EventsProvider
+	ValidatorsProvider
+	AttesterDutiesProvider
+	ProposerDutiesProvider
+	SyncCommitteeDutiesProvider
+	AttestationDataProvider
+	AttestationsSubmitter
+	AggregateAttestationProvider
+	AggregateAttestationsSubmitter
+	BeaconCommitteeSubscriptionsSubmitter
+	BeaconBlockRootProvider
+	ProposalProvider
+	ProposalSubmitter
+	ProposalPreparationsSubmitter
+	SyncCommitteeMessagesSubmitter
+	SyncCommitteeSubscriptionsSubmitter
+	SyncCommitteeContributionProvider
+	SyncCommitteeContributionsSubmitter
+}
+
+// IndexerRequirements are the providers required by a chain indexer to follow
+// the chain and store its blocks, committees, duties and validator state.
+type IndexerRequirements interface {
+	Service
+	SpecProvider
+	GenesisProvider
+	ForkScheduleProvider
+	NodeSyncingProvider
+	EventsProvider
+	FinalityProvider
+	BeaconBlockHeadersProvider
+	SignedBeaconBlockProvider
+	BlobSidecarsProvider
+	BeaconCommitteesProvider
+	SyncCommitteesProvider
+	ProposerDutiesProvider
+	ValidatorsProvider
+	ValidatorBalancesProvider
+	AttestationRewardsProvider
+	BlockRewardsProvider
+	SyncCommitteeRewardsProvider
+}
+
+// MEVRequirements are the providers and submitters required by MEV software,
+// such as relays and builders, to register validators and build and deliver
+// blinded blocks.
+type MEVRequirements interface {
+	Service
+	SpecProvider
+	GenesisProvider
+	NodeSyncingProvider
+	EventsProvider
+	ValidatorsProvider
+	ProposerDutiesProvider
+	BeaconBlockHeadersProvider
+	BeaconStateRandaoProvider
+	ExpectedWithdrawalsProvider
+	ValidatorRegistrationsSubmitter
+	BlindedProposalSubmitter
+	ProposalSubmitter
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/focil"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -127,6 +129,98 @@ type BlobSidecarsProvider interface {
 		error)
 }
 
+// VersionedBlobSidecarsProvider is the interface for providing versioned blob sidecars for a given beacon block.
+type VersionedBlobSidecarsProvider interface {
+	// VersionedBlobSidecars fetches the versioned blob sidecars given a block ID.
+	VersionedBlobSidecars(ctx context.Context,
+		opts *api.BlobSidecarsOpts,
+	) (
+		*api.Response[*api.VersionedBlobSidecars],
+		error)
+}
+
+// DataColumnSidecarsProvider is the interface for providing data column sidecars for a given beacon block.
+type DataColumnSidecarsProvider interface {
+	// DataColumnSidecars fetches the data column sidecars given a block ID.
+	DataColumnSidecars(ctx context.Context,
+		opts *api.DataColumnSidecarsOpts,
+	) (
+		*api.Response[[]*fulu.DataColumnSidecar],
+		error)
+}
+
+// LightClientBootstrapProvider is the interface for providing light client bootstrap data.
+type LightClientBootstrapProvider interface {
+	// LightClientBootstrap fetches the light client bootstrap given options.
+	LightClientBootstrap(ctx context.Context,
+		opts *api.LightClientBootstrapOpts,
+	) (
+		*api.Response[*spec.VersionedLightClientBootstrap],
+		error,
+	)
+}
+
+// LightClientUpdatesProvider is the interface for providing light client updates.
+type LightClientUpdatesProvider interface {
+	// LightClientUpdates fetches the light client updates given options.
+	LightClientUpdates(ctx context.Context,
+		opts *api.LightClientUpdatesOpts,
+	) (
+		*api.Response[[]*spec.VersionedLightClientUpdate],
+		error,
+	)
+}
+
+// LightClientFinalityUpdateProvider is the interface for providing light client finality updates.
+type LightClientFinalityUpdateProvider interface {
+	// LightClientFinalityUpdate fetches the latest light client finality update given options.
+	LightClientFinalityUpdate(ctx context.Context,
+		opts *api.LightClientFinalityUpdateOpts,
+	) (
+		*api.Response[*spec.VersionedLightClientFinalityUpdate],
+		error,
+	)
+}
+
+// LightClientOptimisticUpdateProvider is the interface for providing light client optimistic updates.
+type LightClientOptimisticUpdateProvider interface {
+	// LightClientOptimisticUpdate fetches the latest light client optimistic update given options.
+	LightClientOptimisticUpdate(ctx context.Context,
+		opts *api.LightClientOptimisticUpdateOpts,
+	) (
+		*api.Response[*spec.VersionedLightClientOptimisticUpdate],
+		error,
+	)
+}
+
+// InclusionListProvider is the interface for providing inclusion lists.
+type InclusionListProvider interface {
+	// InclusionList fetches an inclusion list for the given options.
+	InclusionList(ctx context.Context,
+		opts *api.InclusionListOpts,
+	) (
+		*api.Response[*focil.InclusionList],
+		error,
+	)
+}
+
+// InclusionListDutiesProvider is the interface for providing inclusion list duties.
+type InclusionListDutiesProvider interface {
+	// InclusionListDuties obtains inclusion list duties for the given options.
+	InclusionListDuties(ctx context.Context,
+		opts *api.InclusionListDutiesOpts,
+	) (
+		*api.Response[[]*apiv1.InclusionListDuty],
+		error,
+	)
+}
+
+// InclusionListsSubmitter is the interface for submitting inclusion lists.
+type InclusionListsSubmitter interface {
+	// SubmitInclusionLists submits signed inclusion lists.
+	SubmitInclusionLists(ctx context.Context, opts *api.SubmitInclusionListsOpts) error
+}
+
 // BeaconCommitteesProvider is the interface for providing beacon committees.
 type BeaconCommitteesProvider interface {
 	// BeaconCommittees fetches all beacon committees for the given options.
@@ -151,6 +245,70 @@ type SyncCommitteesProvider interface {
 // EventHandlerFunc is the handler for events.
 type EventHandlerFunc func(*apiv1.Event)
 
+// EventHandlers are typed handlers for events.  Events are requested for each
+// topic that has a handler.
+type EventHandlers struct {
+	AttestationHandler          func(*spec.VersionedAttestation)
+	AttesterSlashingHandler     func(*spec.VersionedAttesterSlashing)
+	BlobSidecarHandler          func(*apiv1.BlobSidecarEvent)
+	BlockHandler                func(*apiv1.BlockEvent)
+	BlockGossipHandler          func(*apiv1.BlockGossipEvent)
+	BLSToExecutionChangeHandler func(*capella.SignedBLSToExecutionChange)
+	ChainReorgHandler           func(*apiv1.ChainReorgEvent)
+	ContributionAndProofHandler func(*altair.SignedContributionAndProof)
+	FinalizedCheckpointHandler  func(*apiv1.FinalizedCheckpointEvent)
+	HeadHandler                 func(*apiv1.HeadEvent)
+	PayloadAttributesHandler    func(*apiv1.PayloadAttributesEvent)
+	ProposerSlashingHandler     func(*phase0.ProposerSlashing)
+	VoluntaryExitHandler        func(*phase0.SignedVoluntaryExit)
+}
+
+// Topics returns the event topics for which handlers are present.
+func (h *EventHandlers) Topics() []string {
+	topics := make([]string, 0)
+	if h.AttestationHandler != nil {
+		topics = append(topics, "attestation")
+	}
+	if h.AttesterSlashingHandler != nil {
+		topics = append(topics, "attester_slashing")
+	}
+	if h.BlobSidecarHandler != nil {
+		topics = append(topics, "blob_sidecar")
+	}
+	if h.BlockHandler != nil {
+		topics = append(topics, "block")
+	}
+	if h.BlockGossipHandler != nil {
+		topics = append(topics, "block_gossip")
+	}
+	if h.BLSToExecutionChangeHandler != nil {
+		topics = append(topics, "bls_to_execution_change")
+	}
+	if h.ChainReorgHandler != nil {
+		topics = append(topics, "chain_reorg")
+	}
+	if h.ContributionAndProofHandler != nil {
+		topics = append(topics, "contribution_and_proof")
+	}
+	if h.FinalizedCheckpointHandler != nil {
+		topics = append(topics, "finalized_checkpoint")
+	}
+	if h.HeadHandler != nil {
+		topics = append(topics, "head")
+	}
+	if h.PayloadAttributesHandler != nil {
+		topics = append(topics, "payload_attributes")
+	}
+	if h.ProposerSlashingHandler != nil {
+		topics = append(topics, "proposer_slashing")
+	}
+	if h.VoluntaryExitHandler != nil {
+		topics = append(topics, "voluntary_exit")
+	}
+
+	return topics
+}
+
 //
 // Standard API
 //
@@ -189,7 +347,7 @@ type AttestationPoolProvider interface {
 	AttestationPool(ctx context.Context,
 		opts *api.AttestationPoolOpts,
 	) (
-		*api.Response[[]*phase0.Attestation],
+		*api.Response[[]*spec.VersionedAttestation],
 		error,
 	)
 }
@@ -250,6 +408,17 @@ type DepositContractProvider interface {
 	)
 }
 
+// DepositSnapshotProvider is the interface for providing the deposit snapshot.
+type DepositSnapshotProvider interface {
+	// DepositSnapshot provides a snapshot of the deposit tree.
+	DepositSnapshot(ctx context.Context,
+		opts *api.DepositSnapshotOpts,
+	) (
+		*api.Response[*apiv1.DepositSnapshot],
+		error,
+	)
+}
+
 // SyncCommitteeDutiesProvider is the interface for providing sync committee duties.
 type SyncCommitteeDutiesProvider interface {
 	// SyncCommitteeDuties obtains sync committee duties.
@@ -268,6 +437,17 @@ type SyncCommitteeMessagesSubmitter interface {
 	SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error
 }
 
+// SyncCommitteeSelectionsProvider is the interface for combining sync committee selections.
+type SyncCommitteeSelectionsProvider interface {
+	// SyncCommitteeSelections combines partial sync committee selection proofs into aggregated selection proofs.
+	SyncCommitteeSelections(ctx context.Context,
+		opts *api.SyncCommitteeSelectionsOpts,
+	) (
+		*api.Response[[]*apiv1.SyncCommitteeSelection],
+		error,
+	)
+}
+
 // SyncCommitteeSubscriptionsSubmitter is the interface for submitting sync committee subnet subscription requests.
 type SyncCommitteeSubscriptionsSubmitter interface {
 	// SubmitSyncCommitteeSubscriptions subscribes to sync committees.
@@ -308,6 +488,12 @@ type BLSToExecutionChangesSubmitter interface {
 	SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error
 }
 
+// AttesterSlashingsSubmitter is the interface for submitting versioned attester slashings.
+type AttesterSlashingsSubmitter interface {
+	// SubmitAttesterSlashings submits versioned attester slashings.
+	SubmitAttesterSlashings(ctx context.Context, opts *api.SubmitAttesterSlashingsOpts) error
+}
+
 // BeaconBlockHeadersProvider is the interface for providing beacon block headers.
 type BeaconBlockHeadersProvider interface {
 	// BeaconBlockHeader provides the block header of a given block ID.
@@ -354,6 +540,14 @@ type BeaconBlockSubmitter interface {
 	SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error
 }
 
+// BeaconBlockWithOptsSubmitter is the interface for submitting beacon blocks with options.
+type BeaconBlockWithOptsSubmitter interface {
+	// SubmitBeaconBlockWithOpts submits a beacon block with the given options.
+	// Blocks from the deneb hard-fork onwards also require blobs, so should be submitted
+	// with ProposalSubmitter.SubmitProposal() instead.
+	SubmitBeaconBlockWithOpts(ctx context.Context, opts *api.SubmitBeaconBlockOpts) error
+}
+
 // ProposalSubmitter is the interface for submitting proposals.
 type ProposalSubmitter interface {
 	// SubmitProposal submits a proposal.
@@ -362,6 +556,17 @@ type ProposalSubmitter interface {
 	) error
 }
 
+// BeaconCommitteeSelectionsProvider is the interface for combining beacon committee selections.
+type BeaconCommitteeSelectionsProvider interface {
+	// BeaconCommitteeSelections combines partial beacon committee selection proofs into aggregated selection proofs.
+	BeaconCommitteeSelections(ctx context.Context,
+		opts *api.BeaconCommitteeSelectionsOpts,
+	) (
+		*api.Response[[]*apiv1.BeaconCommitteeSelection],
+		error,
+	)
+}
+
 // BeaconCommitteeSubscriptionsSubmitter is the interface for submitting beacon committee subnet subscription requests.
 type BeaconCommitteeSubscriptionsSubmitter interface {
 	// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
@@ -378,6 +583,18 @@ type BeaconStateProvider interface {
 	)
 }
 
+// BeaconStateDownloader is the interface for downloading SSZ-encoded beacon states.
+type BeaconStateDownloader interface {
+	// DownloadBeaconState downloads a beacon state given a state ID, writing it
+	// to the writer in the options.
+	DownloadBeaconState(ctx context.Context,
+		opts *api.DownloadBeaconStateOpts,
+	) (
+		*api.Response[*api.BeaconStateDownload],
+		error,
+	)
+}
+
 // BeaconStateRandaoProvider is the interface for providing beacon state RANDAOs.
 type BeaconStateRandaoProvider interface {
 	// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
@@ -428,6 +645,23 @@ type EventsProvider interface {
 	Events(ctx context.Context, topics []string, handler EventHandlerFunc) error
 }
 
+// EventsWithHandlersProvider is the interface for providing events to typed handlers.
+type EventsWithHandlersProvider interface {
+	// EventsWithHandlers feeds events to the supplied typed handlers.
+	EventsWithHandlers(ctx context.Context, handlers *EventHandlers) error
+}
+
+// ExpectedWithdrawalsProvider is the interface for providing expected withdrawals.
+type ExpectedWithdrawalsProvider interface {
+	// ExpectedWithdrawals provides the withdrawals expected to be included in a proposal built on the given state.
+	ExpectedWithdrawals(ctx context.Context,
+		opts *api.ExpectedWithdrawalsOpts,
+	) (
+		*api.Response[[]*capella.Withdrawal],
+		error,
+	)
+}
+
 // FinalityProvider is the interface for providing finality information.
 type FinalityProvider interface {
 	// Finality provides the finality given a state ID.
@@ -483,6 +717,39 @@ type GenesisProvider interface {
 	)
 }
 
+// NodeIdentityProvider is the interface for providing the node identity.
+type NodeIdentityProvider interface {
+	// NodeIdentity provides the network identity of the node.
+	NodeIdentity(ctx context.Context,
+		opts *api.NodeIdentityOpts,
+	) (
+		*api.Response[*apiv1.NodeIdentity],
+		error,
+	)
+}
+
+// NodePeerProvider is the interface for providing a single peer.
+type NodePeerProvider interface {
+	// NodePeer provides a single peer of the node.
+	NodePeer(ctx context.Context,
+		opts *api.NodePeerOpts,
+	) (
+		*api.Response[*apiv1.Peer],
+		error,
+	)
+}
+
+// NodePeerCountProvider is the interface for providing peer counts.
+type NodePeerCountProvider interface {
+	// NodePeerCount provides the number of peers of the node in each connection state.
+	NodePeerCount(ctx context.Context,
+		opts *api.NodePeerCountOpts,
+	) (
+		*api.Response[*apiv1.PeerCount],
+		error,
+	)
+}
+
 // NodePeersProvider is the interface for providing peer information.
 type NodePeersProvider interface {
 	// NodePeers provides the peers of the node.
@@ -575,12 +842,78 @@ type ValidatorsProvider interface {
 	)
 }
 
+// ValidatorIdentitiesProvider is the interface for providing validator identities.
+type ValidatorIdentitiesProvider interface {
+	// ValidatorIdentities provides the identities of validators for the given options.
+	ValidatorIdentities(ctx context.Context,
+		opts *api.ValidatorIdentitiesOpts,
+	) (
+		*api.Response[[]*apiv1.ValidatorIdentity],
+		error,
+	)
+}
+
+// ValidatorLivenessProvider is the interface for providing validator liveness.
+type ValidatorLivenessProvider interface {
+	// ValidatorLiveness provides the liveness of validators for the given options.
+	ValidatorLiveness(ctx context.Context,
+		opts *api.ValidatorLivenessOpts,
+	) (
+		*api.Response[[]*apiv1.ValidatorLiveness],
+		error,
+	)
+}
+
+// ValidatorsIteratorProvider is the interface for iterating over validators a page at a time.
+type ValidatorsIteratorProvider interface {
+	// ValidatorsIterator provides an iterator over the validators, with their balance and status, for the given options.
+	ValidatorsIterator(ctx context.Context,
+		opts *api.ValidatorsIteratorOpts,
+	) (
+		*api.ValidatorsIterator,
+		error,
+	)
+}
+
 // VoluntaryExitSubmitter is the interface for submitting voluntary exits.
 type VoluntaryExitSubmitter interface {
 	// SubmitVoluntaryExit submits a voluntary exit.
 	SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error
 }
 
+// AttesterSlashingPoolProvider is the interface for providing attester slashing pools.
+type AttesterSlashingPoolProvider interface {
+	// AttesterSlashingPool fetches the attester slashing pool.
+	AttesterSlashingPool(ctx context.Context,
+		opts *api.AttesterSlashingPoolOpts,
+	) (
+		*api.Response[[]*spec.VersionedAttesterSlashing],
+		error,
+	)
+}
+
+// BLSToExecutionChangePoolProvider is the interface for providing BLS to execution change pools.
+type BLSToExecutionChangePoolProvider interface {
+	// BLSToExecutionChangePool fetches the BLS to execution change pool.
+	BLSToExecutionChangePool(ctx context.Context,
+		opts *api.BLSToExecutionChangePoolOpts,
+	) (
+		*api.Response[[]*capella.SignedBLSToExecutionChange],
+		error,
+	)
+}
+
+// ProposerSlashingPoolProvider is the interface for providing proposer slashing pools.
+type ProposerSlashingPoolProvider interface {
+	// ProposerSlashingPool fetches the proposer slashing pool.
+	ProposerSlashingPool(ctx context.Context,
+		opts *api.ProposerSlashingPoolOpts,
+	) (
+		*api.Response[[]*phase0.ProposerSlashing],
+		error,
+	)
+}
+
 // VoluntaryExitPoolProvider is the interface for providing voluntary exit pools.
 type VoluntaryExitPoolProvider interface {
 	// VoluntaryExitPool fetches the voluntary exit pool.
@@ -621,3 +954,16 @@ type NodeClientProvider interface {
 	// NodeClient provides the client for the node.
 	NodeClient(ctx context.Context) (*api.Response[string], error)
 }
+
+// ClientInfoProvider provides structured information about the implementation of the node.
+type ClientInfoProvider interface {
+	// ClientInfo provides structured information about the implementation of the node.
+	ClientInfo(ctx context.Context) (*api.Response[*apiv1.ClientInfo], error)
+}
+
+// SupportedMethodsProvider provides the methods supported by the client.
+type SupportedMethodsProvider interface {
+	// SupportedMethods provides the methods supported by the client.  Methods
+	// that the client implements but its node does not serve are excluded.
+	SupportedMethods(ctx context.Context) (*api.SupportedMethods, error)
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -82,7 +83,7 @@ func (b *BeaconBlockBody) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (b *BeaconBlockBody) UnmarshalJSON(input []byte) error {
 	var beaconBlockBodyJSON beaconBlockBodyJSON
-	if err := json.Unmarshal(input, &beaconBlockBodyJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &beaconBlockBodyJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -108,13 +109,14 @@ func (b *BeaconBlockBody) unpack(beaconBlockBodyJSON *beaconBlockBodyJSON) error
 	if beaconBlockBodyJSON.Graffiti == "" {
 		return errors.New("graffiti missing")
 	}
-	graffiti, err := hex.DecodeString(strings.TrimPrefix(beaconBlockBodyJSON.Graffiti, "0x"))
+	graffiti, err := codecs.DecodeGraffiti(beaconBlockBodyJSON.Graffiti)
 	if err != nil {
 		return errors.Wrap(err, "invalid value for graffiti")
 	}
-	if len(graffiti) != phase0.GraffitiLength {
+	if len(graffiti) > phase0.GraffitiLength {
 		return errors.New("incorrect length for graffiti")
 	}
+	b.Graffiti = [32]byte{}
 	copy(b.Graffiti[:], graffiti)
 	if beaconBlockBodyJSON.ProposerSlashings == nil {
 		return errors.New("proposer slashings missing")
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -181,7 +182,7 @@ func (s *BeaconState) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *BeaconState) UnmarshalJSON(input []byte) error {
 	var data beaconStateJSON
-	if err := json.Unmarshal(input, &data); err != nil {
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
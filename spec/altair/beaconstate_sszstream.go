@@ -0,0 +1,54 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"io"
+
+	"github.com/attestantio/go-eth2-client/spec/internal/sszstream"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// UnmarshalSSZFrom ssz unmarshals the BeaconState object from a reader.  Unlike
+// UnmarshalSSZ the full encoding is not held in memory: the validator, balance,
+// participation and inactivity score lists, which make up the bulk of the state,
+// are decoded as they are read.
+func (b *BeaconState) UnmarshalSSZFrom(r io.Reader) error {
+	validators := make([]*phase0.Validator, 0)
+	balances := make([]phase0.Gwei, 0)
+	previousEpochParticipation := make([]ParticipationFlags, 0)
+	currentEpochParticipation := make([]ParticipationFlags, 0)
+	inactivityScores := make([]uint64, 0)
+
+	fields := []*sszstream.Field{
+		{Offset: 524464}, // HistoricalRoots
+		{Offset: 524540}, // ETH1DataVotes
+		{Offset: 524552, ElementSize: 121, MaxElements: 1099511627776, Element: sszstream.Objects(&validators)},               // Validators
+		{Offset: 524556, ElementSize: 8, MaxElements: 1099511627776, Element: sszstream.Uint64s(&balances)},                   // Balances
+		{Offset: 2687248, ElementSize: 1, MaxElements: 1099511627776, Element: sszstream.Uint8s(&previousEpochParticipation)}, // PreviousEpochParticipation
+		{Offset: 2687252, ElementSize: 1, MaxElements: 1099511627776, Element: sszstream.Uint8s(&currentEpochParticipation)},  // CurrentEpochParticipation
+		{Offset: 2687377, ElementSize: 8, MaxElements: 1099511627776, Element: sszstream.Uint64s(&inactivityScores)},          // InactivityScores
+	}
+	if err := sszstream.Unmarshal(r, 2736629, fields, b.UnmarshalSSZ); err != nil {
+		return err
+	}
+
+	b.Validators = validators
+	b.Balances = balances
+	b.PreviousEpochParticipation = previousEpochParticipation
+	b.CurrentEpochParticipation = currentEpochParticipation
+	b.InactivityScores = inactivityScores
+
+	return nil
+}
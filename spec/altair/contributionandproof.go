@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -59,7 +60,7 @@ func (a *ContributionAndProof) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *ContributionAndProof) UnmarshalJSON(input []byte) error {
 	var contributionAndProofJSON contributionAndProofJSON
-	if err := json.Unmarshal(input, &contributionAndProofJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &contributionAndProofJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
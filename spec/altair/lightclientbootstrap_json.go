@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// lightClientBootstrapJSON is the spec representation of the struct.
+type lightClientBootstrapJSON struct {
+	Header                     *LightClientHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []phase0.Root      `json:"current_sync_committee_branch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *LightClientBootstrap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientBootstrapJSON{
+		Header:                     b.Header,
+		CurrentSyncCommittee:       b.CurrentSyncCommittee,
+		CurrentSyncCommitteeBranch: b.CurrentSyncCommitteeBranch,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *LightClientBootstrap) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&lightClientBootstrapJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	b.Header = &LightClientHeader{}
+	if err := b.Header.UnmarshalJSON(raw["header"]); err != nil {
+		return errors.Wrap(err, "header")
+	}
+
+	b.CurrentSyncCommittee = &SyncCommittee{}
+	if err := b.CurrentSyncCommittee.UnmarshalJSON(raw["current_sync_committee"]); err != nil {
+		return errors.Wrap(err, "current_sync_committee")
+	}
+
+	if err := json.Unmarshal(raw["current_sync_committee_branch"], &b.CurrentSyncCommitteeBranch); err != nil {
+		return errors.Wrap(err, "current_sync_committee_branch")
+	}
+	if len(b.CurrentSyncCommitteeBranch) != currentSyncCommitteeBranchLength {
+		return errors.New("current_sync_committee_branch: incorrect length")
+	}
+
+	return nil
+}
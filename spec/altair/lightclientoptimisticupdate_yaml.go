@@ -0,0 +1,59 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// lightClientOptimisticUpdateYAML is the spec representation of the struct.
+type lightClientOptimisticUpdateYAML struct {
+	AttestedHeader *LightClientHeader `yaml:"attested_header"`
+	SyncAggregate  *SyncAggregate     `yaml:"sync_aggregate"`
+	SignatureSlot  uint64             `yaml:"signature_slot"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (o *LightClientOptimisticUpdate) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientOptimisticUpdateYAML{
+		AttestedHeader: o.AttestedHeader,
+		SyncAggregate:  o.SyncAggregate,
+		SignatureSlot:  uint64(o.SignatureSlot),
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (o *LightClientOptimisticUpdate) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var unmarshaled lightClientOptimisticUpdateJSON
+	if err := yaml.Unmarshal(input, &unmarshaled); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	marshaled, err := json.Marshal(&unmarshaled)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return o.UnmarshalJSON(marshaled)
+}
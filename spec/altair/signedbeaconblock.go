@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -54,7 +55,7 @@ func (s *SignedBeaconBlock) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SignedBeaconBlock) UnmarshalJSON(input []byte) error {
 	var signedBeaconBlockJSON signedBeaconBlockJSON
-	if err := json.Unmarshal(input, &signedBeaconBlockJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &signedBeaconBlockJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -54,7 +55,7 @@ func (s *SignedContributionAndProof) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SignedContributionAndProof) UnmarshalJSON(input []byte) error {
 	var signedContributionAndProofJSON signedContributionAndProofJSON
-	if err := json.Unmarshal(input, &signedContributionAndProofJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &signedContributionAndProofJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
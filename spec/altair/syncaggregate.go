@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -55,7 +56,7 @@ func (s *SyncAggregate) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SyncAggregate) UnmarshalJSON(input []byte) error {
 	var syncAggregateJSON syncAggregateJSON
-	if err := json.Unmarshal(input, &syncAggregateJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &syncAggregateJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -59,7 +60,7 @@ func (s *SyncCommittee) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SyncCommittee) UnmarshalJSON(input []byte) error {
 	var syncCommitteeJSON syncCommitteeJSON
-	if err := json.Unmarshal(input, &syncCommitteeJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &syncCommitteeJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -135,3 +136,51 @@ func (s *SyncCommittee) String() string {
 
 	return string(data)
 }
+
+// Positions returns the positions of the validator with the given public key
+// in the sync committee.  A validator can have multiple positions in the same
+// committee.
+func (s *SyncCommittee) Positions(pubKey phase0.BLSPubKey) []uint64 {
+	positions := make([]uint64, 0)
+	for i := range s.Pubkeys {
+		if s.Pubkeys[i] == pubKey {
+			positions = append(positions, uint64(i))
+		}
+	}
+
+	return positions
+}
+
+// Subcommittees returns the indices of the subcommittees, in ascending order,
+// of the validator with the given public key, given the number of sync
+// committee subnets.
+func (s *SyncCommittee) Subcommittees(pubKey phase0.BLSPubKey, subnetCount uint64) []uint64 {
+	subcommittees := make([]uint64, 0)
+	if subnetCount == 0 || uint64(len(s.Pubkeys)) < subnetCount {
+		return subcommittees
+	}
+	subcommitteeSize := uint64(len(s.Pubkeys)) / subnetCount
+	for _, position := range s.Positions(pubKey) {
+		subcommittee := position / subcommitteeSize
+		// Positions are in ascending order, so duplicates are adjacent.
+		if len(subcommittees) == 0 || subcommittees[len(subcommittees)-1] != subcommittee {
+			subcommittees = append(subcommittees, subcommittee)
+		}
+	}
+
+	return subcommittees
+}
+
+// SyncCommitteePeriod returns the sync committee period of the given epoch.
+func SyncCommitteePeriod(epoch phase0.Epoch, epochsPerSyncCommitteePeriod uint64) uint64 {
+	if epochsPerSyncCommitteePeriod == 0 {
+		return 0
+	}
+
+	return uint64(epoch) / epochsPerSyncCommitteePeriod
+}
+
+// SyncCommitteePeriodStartEpoch returns the first epoch of the given sync committee period.
+func SyncCommitteePeriodStartEpoch(period uint64, epochsPerSyncCommitteePeriod uint64) phase0.Epoch {
+	return phase0.Epoch(period * epochsPerSyncCommitteePeriod)
+}
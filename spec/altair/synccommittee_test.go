@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/stretchr/testify/assert"
 	require "github.com/stretchr/testify/require"
@@ -131,3 +132,22 @@ func TestSyncCommitteeYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncCommitteeSubcommittees(t *testing.T) {
+	committee := &altair.SyncCommittee{
+		Pubkeys: []phase0.BLSPubKey{{0x01}, {0x02}, {0x01}, {0x03}},
+	}
+	require.Equal(t, []uint64{0, 2}, committee.Positions(phase0.BLSPubKey{0x01}))
+	require.Equal(t, []uint64{0, 1}, committee.Subcommittees(phase0.BLSPubKey{0x01}, 2))
+	require.Equal(t, []uint64{0}, committee.Subcommittees(phase0.BLSPubKey{0x01}, 1))
+	require.Empty(t, committee.Subcommittees(phase0.BLSPubKey{0x04}, 2))
+	require.Empty(t, committee.Subcommittees(phase0.BLSPubKey{0x01}, 0))
+	require.Empty(t, committee.Subcommittees(phase0.BLSPubKey{0x01}, 8))
+}
+
+func TestSyncCommitteePeriod(t *testing.T) {
+	require.Equal(t, uint64(0), altair.SyncCommitteePeriod(255, 256))
+	require.Equal(t, uint64(1), altair.SyncCommitteePeriod(256, 256))
+	require.Equal(t, uint64(0), altair.SyncCommitteePeriod(256, 0))
+	require.Equal(t, phase0.Epoch(512), altair.SyncCommitteePeriodStartEpoch(2, 256))
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -69,7 +70,7 @@ func (s *SyncCommitteeContribution) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SyncCommitteeContribution) UnmarshalJSON(input []byte) error {
 	var syncCommitteeContributionJSON syncCommitteeContributionJSON
-	if err := json.Unmarshal(input, &syncCommitteeContributionJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &syncCommitteeContributionJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
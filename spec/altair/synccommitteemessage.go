@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -63,7 +64,7 @@ func (s *SyncCommitteeMessage) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SyncCommitteeMessage) UnmarshalJSON(input []byte) error {
 	var syncCommitteeMessageJSON syncCommitteeMessageJSON
-	if err := json.Unmarshal(input, &syncCommitteeMessageJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &syncCommitteeMessageJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
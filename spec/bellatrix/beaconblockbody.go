@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
@@ -87,7 +88,7 @@ func (b *BeaconBlockBody) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (b *BeaconBlockBody) UnmarshalJSON(input []byte) error {
 	var data beaconBlockBodyJSON
-	if err := json.Unmarshal(input, &data); err != nil {
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -113,13 +114,14 @@ func (b *BeaconBlockBody) unpack(data *beaconBlockBodyJSON) error {
 	if data.Graffiti == "" {
 		return errors.New("graffiti missing")
 	}
-	graffiti, err := hex.DecodeString(strings.TrimPrefix(data.Graffiti, "0x"))
+	graffiti, err := codecs.DecodeGraffiti(data.Graffiti)
 	if err != nil {
 		return errors.Wrap(err, "invalid value for graffiti")
 	}
-	if len(graffiti) != phase0.GraffitiLength {
+	if len(graffiti) > phase0.GraffitiLength {
 		return errors.New("incorrect length for graffiti")
 	}
+	b.Graffiti = [32]byte{}
 	copy(b.Graffiti[:], graffiti)
 	if data.ProposerSlashings == nil {
 		return errors.New("proposer slashings missing")
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
@@ -186,7 +187,7 @@ func (s *BeaconState) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *BeaconState) UnmarshalJSON(input []byte) error {
 	var data beaconStateJSON
-	if err := json.Unmarshal(input, &data); err != nil {
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -122,7 +123,7 @@ func (e *ExecutionPayload) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (e *ExecutionPayload) UnmarshalJSON(input []byte) error {
 	var data executionPayloadJSON
-	if err := json.Unmarshal(input, &data); err != nil {
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
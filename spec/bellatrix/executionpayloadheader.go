@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -117,7 +118,7 @@ func (e *ExecutionPayloadHeader) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (e *ExecutionPayloadHeader) UnmarshalJSON(input []byte) error {
 	var data executionPayloadHeaderJSON
-	if err := json.Unmarshal(input, &data); err != nil {
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
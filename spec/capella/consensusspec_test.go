@@ -14,235 +14,179 @@
 package capella_test
 
 import (
-	"bytes"
-	"fmt"
 	"os"
-	"path/filepath"
 	"testing"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	ssz "github.com/ferranbt/fastssz"
-	"github.com/goccy/go-yaml"
-	"github.com/golang/snappy"
-	clone "github.com/huandu/go-clone/generic"
-	require "github.com/stretchr/testify/require"
+	"github.com/attestantio/go-eth2-client/spectests"
 )
 
 // TestConsensusSpec tests the types against the Ethereum consensus spec tests.
 func TestConsensusSpec(t *testing.T) {
-	if os.Getenv("CONSENSUS_SPEC_TESTS_DIR") == "" {
+	if os.Getenv(spectests.DirEnv) == "" {
 		t.Skip("CONSENSUS_SPEC_TESTS_DIR not supplied, not running spec tests")
 	}
 
-	tests := []struct {
-		name string
-		s    any
-	}{
+	tests := []*spectests.Test{
 		{
-			name: "AggregateAndProof",
-			s:    &phase0.AggregateAndProof{},
+			Name:      "AggregateAndProof",
+			Container: &phase0.AggregateAndProof{},
 		},
 		{
-			name: "Attestation",
-			s:    &phase0.Attestation{},
+			Name:      "Attestation",
+			Container: &phase0.Attestation{},
 		},
 		{
-			name: "AttestationData",
-			s:    &phase0.AttestationData{},
+			Name:      "AttestationData",
+			Container: &phase0.AttestationData{},
 		},
 		{
-			name: "AttesterSlashing",
-			s:    &phase0.AttesterSlashing{},
+			Name:      "AttesterSlashing",
+			Container: &phase0.AttesterSlashing{},
 		},
 		{
-			name: "BeaconBlock",
-			s:    &capella.BeaconBlock{},
+			Name:      "BeaconBlock",
+			Container: &capella.BeaconBlock{},
 		},
 		{
-			name: "BeaconBlockBody",
-			s:    &capella.BeaconBlockBody{},
+			Name:      "BeaconBlockBody",
+			Container: &capella.BeaconBlockBody{},
 		},
 		{
-			name: "BeaconBlockHeader",
-			s:    &phase0.BeaconBlockHeader{},
+			Name:      "BeaconBlockHeader",
+			Container: &phase0.BeaconBlockHeader{},
 		},
 		{
-			name: "BeaconState",
-			s:    &capella.BeaconState{},
+			Name:      "BeaconState",
+			Container: &capella.BeaconState{},
 		},
 		{
-			name: "Checkpoint",
-			s:    &phase0.Checkpoint{},
+			Name:      "Checkpoint",
+			Container: &phase0.Checkpoint{},
 		},
 		{
-			name: "ContributionAndProof",
-			s:    &altair.ContributionAndProof{},
+			Name:      "ContributionAndProof",
+			Container: &altair.ContributionAndProof{},
 		},
 		{
-			name: "Deposit",
-			s:    &phase0.Deposit{},
+			Name:      "Deposit",
+			Container: &phase0.Deposit{},
 		},
 		{
-			name: "DepositData",
-			s:    &phase0.DepositData{},
+			Name:      "DepositData",
+			Container: &phase0.DepositData{},
 		},
 		{
-			name: "DepositMessage",
-			s:    &phase0.DepositMessage{},
+			Name:      "DepositMessage",
+			Container: &phase0.DepositMessage{},
 		},
 		{
-			name: "Eth1Data",
-			s:    &phase0.ETH1Data{},
+			Name:      "Eth1Data",
+			Container: &phase0.ETH1Data{},
 		},
 		{
-			name: "ExecutionPayload",
-			s:    &capella.ExecutionPayload{},
+			Name:      "ExecutionPayload",
+			Container: &capella.ExecutionPayload{},
 		},
 		{
-			name: "ExecutionPayloadHeader",
-			s:    &capella.ExecutionPayloadHeader{},
+			Name:      "ExecutionPayloadHeader",
+			Container: &capella.ExecutionPayloadHeader{},
 		},
 		{
-			name: "Fork",
-			s:    &phase0.Fork{},
+			Name:      "Fork",
+			Container: &phase0.Fork{},
 		},
 		{
-			name: "ForkData",
-			s:    &phase0.ForkData{},
+			Name:      "ForkData",
+			Container: &phase0.ForkData{},
 		},
 		{
-			name: "HistoricalSummary",
-			s:    &capella.HistoricalSummary{},
+			Name:      "HistoricalSummary",
+			Container: &capella.HistoricalSummary{},
 		},
 		{
-			name: "IndexedAttestation",
-			s:    &phase0.IndexedAttestation{},
+			Name:      "IndexedAttestation",
+			Container: &phase0.IndexedAttestation{},
 		},
 		{
-			name: "PendingAttestation",
-			s:    &phase0.PendingAttestation{},
+			Name:      "LightClientBootstrap",
+			Container: &capella.LightClientBootstrap{},
 		},
 		{
-			name: "ProposerSlashing",
-			s:    &phase0.ProposerSlashing{},
+			Name:      "LightClientFinalityUpdate",
+			Container: &capella.LightClientFinalityUpdate{},
 		},
 		{
-			name: "SignedAggregateAndProof",
-			s:    &phase0.SignedAggregateAndProof{},
+			Name:      "LightClientHeader",
+			Container: &capella.LightClientHeader{},
 		},
 		{
-			name: "SignedBeaconBlock",
-			s:    &capella.SignedBeaconBlock{},
+			Name:      "LightClientOptimisticUpdate",
+			Container: &capella.LightClientOptimisticUpdate{},
 		},
 		{
-			name: "SignedBeaconBlockHeader",
-			s:    &phase0.SignedBeaconBlockHeader{},
+			Name:      "LightClientUpdate",
+			Container: &capella.LightClientUpdate{},
 		},
 		{
-			name: "SignedContributionAndProof",
-			s:    &altair.SignedContributionAndProof{},
+			Name:      "PendingAttestation",
+			Container: &phase0.PendingAttestation{},
 		},
 		{
-			name: "SignedVoluntaryExit",
-			s:    &phase0.SignedVoluntaryExit{},
+			Name:      "ProposerSlashing",
+			Container: &phase0.ProposerSlashing{},
 		},
 		{
-			name: "SyncAggregate",
-			s:    &altair.SyncAggregate{},
+			Name:      "SignedAggregateAndProof",
+			Container: &phase0.SignedAggregateAndProof{},
 		},
 		{
-			name: "SyncCommitteeContribution",
-			s:    &altair.SyncCommitteeContribution{},
+			Name:      "SignedBeaconBlock",
+			Container: &capella.SignedBeaconBlock{},
 		},
 		{
-			name: "SyncCommitteeMessage",
-			s:    &altair.SyncCommitteeMessage{},
+			Name:      "SignedBeaconBlockHeader",
+			Container: &phase0.SignedBeaconBlockHeader{},
 		},
 		{
-			name: "Validator",
-			s:    &phase0.Validator{},
+			Name:      "SignedContributionAndProof",
+			Container: &altair.SignedContributionAndProof{},
 		},
 		{
-			name: "VoluntaryExit",
-			s:    &phase0.VoluntaryExit{},
+			Name:      "SignedVoluntaryExit",
+			Container: &phase0.SignedVoluntaryExit{},
 		},
 		{
-			name: "Withdrawal",
-			s:    &capella.Withdrawal{},
+			Name:      "SyncAggregate",
+			Container: &altair.SyncAggregate{},
+		},
+		{
+			Name:      "SyncAggregatorSelectionData",
+			Container: &altair.SyncAggregatorSelectionData{},
+		},
+		{
+			Name:      "SyncCommitteeContribution",
+			Container: &altair.SyncCommitteeContribution{},
+		},
+		{
+			Name:      "SyncCommitteeMessage",
+			Container: &altair.SyncCommitteeMessage{},
+		},
+		{
+			Name:      "Validator",
+			Container: &phase0.Validator{},
+		},
+		{
+			Name:      "VoluntaryExit",
+			Container: &phase0.VoluntaryExit{},
+		},
+		{
+			Name:      "Withdrawal",
+			Container: &capella.Withdrawal{},
 		},
 	}
 
-	baseDir := filepath.Join(os.Getenv("CONSENSUS_SPEC_TESTS_DIR"), "tests", "mainnet", "capella", "ssz_static")
-	for _, test := range tests {
-		dir := filepath.Join(baseDir, test.name, "ssz_random")
-		require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-			if path == dir {
-				// Only interested in subdirectories.
-				return nil
-			}
-			require.NoError(t, err)
-			if info.IsDir() {
-				t.Run(fmt.Sprintf("%s/%s", test.name, info.Name()), func(t *testing.T) {
-					s1 := clone.Clone(test.s)
-					// Obtain the struct from the YAML.
-					specYAML, err := os.ReadFile(filepath.Join(path, "value.yaml"))
-					require.NoError(t, err)
-					require.NoError(t, yaml.Unmarshal(specYAML, s1))
-					// Confirm we can return to the YAML.
-					remarshalledSpecYAML, err := yaml.Marshal(s1)
-					require.NoError(t, err)
-					require.Equal(t, testYAMLFormat(specYAML), testYAMLFormat(remarshalledSpecYAML))
-
-					// Obtain the struct from the SSZ.
-					s2 := clone.Clone(test.s)
-					compressedSpecSSZ, err := os.ReadFile(filepath.Join(path, "serialized.ssz_snappy"))
-					require.NoError(t, err)
-					var specSSZ []byte
-					specSSZ, err = snappy.Decode(specSSZ, compressedSpecSSZ)
-					require.NoError(t, err)
-					require.NoError(t, s2.(ssz.Unmarshaler).UnmarshalSSZ(specSSZ))
-					// Confirm we can return to the SSZ.
-					remarshalledSpecSSZ, err := s2.(ssz.Marshaler).MarshalSSZ()
-					require.NoError(t, err)
-					require.Equal(t, specSSZ, remarshalledSpecSSZ)
-
-					// Obtain the hash tree root from the YAML.
-					specYAMLRoot, err := os.ReadFile(filepath.Join(path, "roots.yaml"))
-					require.NoError(t, err)
-					// Confirm we calculate the same root.
-					generatedRootBytes, err := s2.(ssz.HashRoot).HashTreeRoot()
-					require.NoError(t, err)
-					generatedRoot := fmt.Sprintf("{root: '%#x'}\n", string(generatedRootBytes[:]))
-					require.Equal(t, string(specYAMLRoot), generatedRoot)
-				})
-			}
-
-			return nil
-		}))
-	}
-}
-
-func testYAMLFormat(input []byte) string {
-	val := make(map[string]any)
-	if err := yaml.UnmarshalWithOptions(input, &val, yaml.UseOrderedMap()); err != nil {
-		panic(err)
-	}
-
-	res, err := yaml.MarshalWithOptions(val, yaml.Flow(true))
-	if err != nil {
-		panic(err)
-	}
-
-	replacements := [][][]byte{
-		{[]byte(`"`), []byte(`'`)},
-		// Field 'extra_data' in BeaconBlockBody/case_3 has a non-standard format, fix here.
-		{[]byte(`extra_data: 0,`), []byte(`extra_data: '0x',`)},
-	}
-	for _, replacement := range replacements {
-		res = bytes.ReplaceAll(res, replacement[0], replacement[1])
-	}
-
-	return string(bytes.ToLower(res))
+	spectests.Run(t, spectests.SSZStaticDir(os.Getenv(spectests.DirEnv), "mainnet", "capella"), "ssz_random", tests)
 }
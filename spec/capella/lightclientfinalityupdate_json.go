@@ -0,0 +1,80 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// lightClientFinalityUpdateJSON is the spec representation of the struct.
+type lightClientFinalityUpdateJSON struct {
+	AttestedHeader  *LightClientHeader    `json:"attested_header"`
+	FinalizedHeader *LightClientHeader    `json:"finalized_header"`
+	FinalityBranch  []phase0.Root         `json:"finality_branch"`
+	SyncAggregate   *altair.SyncAggregate `json:"sync_aggregate"`
+	SignatureSlot   string                `json:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *LightClientFinalityUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientFinalityUpdateJSON{
+		AttestedHeader:  f.AttestedHeader,
+		FinalizedHeader: f.FinalizedHeader,
+		FinalityBranch:  f.FinalityBranch,
+		SyncAggregate:   f.SyncAggregate,
+		SignatureSlot:   fmt.Sprintf("%d", f.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *LightClientFinalityUpdate) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&lightClientFinalityUpdateJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	f.AttestedHeader = &LightClientHeader{}
+	if err := f.AttestedHeader.UnmarshalJSON(raw["attested_header"]); err != nil {
+		return errors.Wrap(err, "attested_header")
+	}
+
+	f.FinalizedHeader = &LightClientHeader{}
+	if err := f.FinalizedHeader.UnmarshalJSON(raw["finalized_header"]); err != nil {
+		return errors.Wrap(err, "finalized_header")
+	}
+
+	if err := json.Unmarshal(raw["finality_branch"], &f.FinalityBranch); err != nil {
+		return errors.Wrap(err, "finality_branch")
+	}
+	if len(f.FinalityBranch) != finalityBranchLength {
+		return errors.New("finality_branch: incorrect length")
+	}
+
+	f.SyncAggregate = &altair.SyncAggregate{}
+	if err := f.SyncAggregate.UnmarshalJSON(raw["sync_aggregate"]); err != nil {
+		return errors.Wrap(err, "sync_aggregate")
+	}
+
+	if err := f.SignatureSlot.UnmarshalJSON(raw["signature_slot"]); err != nil {
+		return errors.Wrap(err, "signature_slot")
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// lightClientUpdateJSON is the spec representation of the struct.
+type lightClientUpdateJSON struct {
+	AttestedHeader          *LightClientHeader    `json:"attested_header"`
+	NextSyncCommittee       *altair.SyncCommittee `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []phase0.Root         `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader    `json:"finalized_header"`
+	FinalityBranch          []phase0.Root         `json:"finality_branch"`
+	SyncAggregate           *altair.SyncAggregate `json:"sync_aggregate"`
+	SignatureSlot           string                `json:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u *LightClientUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientUpdateJSON{
+		AttestedHeader:          u.AttestedHeader,
+		NextSyncCommittee:       u.NextSyncCommittee,
+		NextSyncCommitteeBranch: u.NextSyncCommitteeBranch,
+		FinalizedHeader:         u.FinalizedHeader,
+		FinalityBranch:          u.FinalityBranch,
+		SyncAggregate:           u.SyncAggregate,
+		SignatureSlot:           fmt.Sprintf("%d", u.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *LightClientUpdate) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&lightClientUpdateJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	u.AttestedHeader = &LightClientHeader{}
+	if err := u.AttestedHeader.UnmarshalJSON(raw["attested_header"]); err != nil {
+		return errors.Wrap(err, "attested_header")
+	}
+
+	u.NextSyncCommittee = &altair.SyncCommittee{}
+	if err := u.NextSyncCommittee.UnmarshalJSON(raw["next_sync_committee"]); err != nil {
+		return errors.Wrap(err, "next_sync_committee")
+	}
+
+	if err := json.Unmarshal(raw["next_sync_committee_branch"], &u.NextSyncCommitteeBranch); err != nil {
+		return errors.Wrap(err, "next_sync_committee_branch")
+	}
+	if len(u.NextSyncCommitteeBranch) != nextSyncCommitteeBranchLength {
+		return errors.New("next_sync_committee_branch: incorrect length")
+	}
+
+	u.FinalizedHeader = &LightClientHeader{}
+	if err := u.FinalizedHeader.UnmarshalJSON(raw["finalized_header"]); err != nil {
+		return errors.Wrap(err, "finalized_header")
+	}
+
+	if err := json.Unmarshal(raw["finality_branch"], &u.FinalityBranch); err != nil {
+		return errors.Wrap(err, "finality_branch")
+	}
+	if len(u.FinalityBranch) != finalityBranchLength {
+		return errors.New("finality_branch: incorrect length")
+	}
+
+	u.SyncAggregate = &altair.SyncAggregate{}
+	if err := u.SyncAggregate.UnmarshalJSON(raw["sync_aggregate"]); err != nil {
+		return errors.Wrap(err, "sync_aggregate")
+	}
+
+	if err := u.SignatureSlot.UnmarshalJSON(raw["signature_slot"]); err != nil {
+		return errors.Wrap(err, "signature_slot")
+	}
+
+	return nil
+}
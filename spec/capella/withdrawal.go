@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
@@ -64,7 +65,7 @@ func (w *Withdrawal) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (w *Withdrawal) UnmarshalJSON(input []byte) error {
 	var data withdrawalJSON
-	err := json.Unmarshal(input, &data)
+	err := codecs.UnmarshalJSON(input, &data)
 	if err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
@@ -36,6 +36,8 @@ const (
 	DataVersionDeneb
 	// DataVersionElectra is data applicable for the Electra release of the beacon chain.
 	DataVersionElectra
+	// DataVersionFulu is data applicable for the Fulu release of the beacon chain.
+	DataVersionFulu
 )
 
 var dataVersionStrings = [...]string{
@@ -46,6 +48,7 @@ var dataVersionStrings = [...]string{
 	"capella",
 	"deneb",
 	"electra",
+	"fulu",
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -69,6 +72,8 @@ func (d *DataVersion) UnmarshalJSON(input []byte) error {
 		*d = DataVersionDeneb
 	case `"electra"`:
 		*d = DataVersionElectra
+	case `"fulu"`:
+		*d = DataVersionFulu
 	default:
 		err = fmt.Errorf("unrecognised data version %s", string(input))
 	}
@@ -15,7 +15,6 @@ package deneb
 
 import (
 	"bytes"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
@@ -80,23 +79,22 @@ func (b *BeaconBlockBody) UnmarshalJSON(input []byte) error {
 		return errors.Wrap(err, "eth1_data")
 	}
 
-	graffiti := raw["graffiti"]
-	if !bytes.HasPrefix(graffiti, []byte{'"', '0', 'x'}) {
+	if !bytes.HasPrefix(raw["graffiti"], []byte{'"', '0', 'x'}) && codecs.CurrentJSONMode() != codecs.JSONModeLenient {
 		return errors.New("graffiti: invalid prefix")
 	}
-	if !bytes.HasSuffix(graffiti, []byte{'"'}) {
-		return errors.New("graffiti: invalid suffix")
-	}
-	if len(graffiti) != 1+2+32*2+1 {
-		return errors.New("graffiti: incorrect length")
+	var graffitiStr string
+	if err := json.Unmarshal(raw["graffiti"], &graffitiStr); err != nil {
+		return errors.Wrap(err, "graffiti")
 	}
-	length, err := hex.Decode(b.Graffiti[:], graffiti[3:3+32*2])
+	graffiti, err := codecs.DecodeGraffiti(graffitiStr)
 	if err != nil {
 		return errors.Wrap(err, "graffiti")
 	}
-	if length != 32 {
+	if len(graffiti) > phase0.GraffitiLength {
 		return errors.New("graffiti: incorrect length")
 	}
+	b.Graffiti = [32]byte{}
+	copy(b.Graffiti[:], graffiti)
 
 	if err := json.Unmarshal(raw["proposer_slashings"], &b.ProposerSlashings); err != nil {
 		return errors.Wrap(err, "proposer_slashings")
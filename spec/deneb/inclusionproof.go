@@ -0,0 +1,106 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+const (
+	// blobKZGCommitmentsGeneralizedIndex is the generalized index of the blob KZG
+	// commitments field in a beacon block body.  This is unchanged in later forks.
+	blobKZGCommitmentsGeneralizedIndex = 27
+	// maxBlobCommitmentsPerBlock is the maximum number of KZG commitments in a block.
+	maxBlobCommitmentsPerBlock = 4096
+)
+
+// ProvableBody is a beacon block body from which proofs can be generated.
+type ProvableBody interface {
+	GetTree() (*ssz.Node, error)
+}
+
+// KZGCommitmentInclusionProof generates the inclusion proof of the KZG commitment at
+// the given index in the block body.
+func (b *BeaconBlockBody) KZGCommitmentInclusionProof(index BlobIndex) (KZGCommitmentInclusionProof, error) {
+	if int(index) >= len(b.BlobKZGCommitments) {
+		return KZGCommitmentInclusionProof{}, fmt.Errorf("index %d out of range for %d commitments", index, len(b.BlobKZGCommitments))
+	}
+
+	return GenerateKZGCommitmentInclusionProof(b, index)
+}
+
+// GenerateKZGCommitmentInclusionProof generates the inclusion proof of the KZG commitment
+// at the given index in any block body from deneb onwards, including blinded bodies.
+func GenerateKZGCommitmentInclusionProof(body ProvableBody, index BlobIndex) (KZGCommitmentInclusionProof, error) {
+	tree, err := body.GetTree()
+	if err != nil {
+		return KZGCommitmentInclusionProof{}, errors.Wrap(err, "failed to generate body tree")
+	}
+
+	proof, err := tree.Prove(kzgCommitmentGeneralizedIndex(index))
+	if err != nil {
+		return KZGCommitmentInclusionProof{}, errors.Wrapf(err, "failed to generate proof for commitment %d", index)
+	}
+
+	res := KZGCommitmentInclusionProof{}
+	if len(proof.Hashes) != len(res) {
+		return KZGCommitmentInclusionProof{}, fmt.Errorf("incorrect length %d for proof", len(proof.Hashes))
+	}
+	for i := range proof.Hashes {
+		copy(res[i][:], proof.Hashes[i])
+	}
+
+	return res, nil
+}
+
+// VerifyKZGCommitmentInclusionProof verifies that the KZG commitment of the blob sidecar
+// is included in the body of its block header.
+func VerifyKZGCommitmentInclusionProof(sidecar *BlobSidecar) (bool, error) {
+	if sidecar == nil {
+		return false, errors.New("sidecar missing")
+	}
+	if sidecar.SignedBlockHeader == nil || sidecar.SignedBlockHeader.Message == nil {
+		return false, errors.New("signed block header missing")
+	}
+	if sidecar.Index >= maxBlobCommitmentsPerBlock {
+		return false, nil
+	}
+
+	// The leaf is the hash tree root of the commitment.
+	var chunks [64]byte
+	copy(chunks[:], sidecar.KZGCommitment[:])
+	leaf := sha256.Sum256(chunks[:])
+
+	proof := &ssz.Proof{
+		Index:  kzgCommitmentGeneralizedIndex(sidecar.Index),
+		Leaf:   leaf[:],
+		Hashes: make([][]byte, len(sidecar.KZGCommitmentInclusionProof)),
+	}
+	for i := range sidecar.KZGCommitmentInclusionProof {
+		proof.Hashes[i] = sidecar.KZGCommitmentInclusionProof[i][:]
+	}
+
+	return ssz.VerifyProof(sidecar.SignedBlockHeader.Message.BodyRoot[:], proof)
+}
+
+// kzgCommitmentGeneralizedIndex returns the generalized index of the KZG commitment
+// at the given index in a block body.  The commitments are under the data root of
+// the list, which is the left child of the list's root.
+func kzgCommitmentGeneralizedIndex(index BlobIndex) int {
+	return blobKZGCommitmentsGeneralizedIndex*2*maxBlobCommitmentsPerBlock + int(index)
+}
@@ -0,0 +1,176 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testBody() *deneb.BeaconBlockBody {
+	return &deneb.BeaconBlockBody{
+		ETH1Data: &phase0.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		SyncAggregate: &altair.SyncAggregate{
+			SyncCommitteeBits: bitfield.NewBitvector512(),
+		},
+		ExecutionPayload: &deneb.ExecutionPayload{
+			BaseFeePerGas: uint256.NewInt(7),
+		},
+		BlobKZGCommitments: []deneb.KZGCommitment{{0x01}, {0x02}, {0x03}},
+	}
+}
+
+func TestKZGCommitmentInclusionProof(t *testing.T) {
+	body := testBody()
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+
+	for i := range body.BlobKZGCommitments {
+		proof, err := body.KZGCommitmentInclusionProof(deneb.BlobIndex(i))
+		require.NoError(t, err)
+
+		// Verify as per is_valid_merkle_branch.
+		var chunks [64]byte
+		copy(chunks[:], body.BlobKZGCommitments[i][:])
+		node := sha256.Sum256(chunks[:])
+		index := 54*4096 + i
+		for j := range proof {
+			if (index>>j)&1 == 1 {
+				node = sha256.Sum256(append(proof[j][:], node[:]...))
+			} else {
+				node = sha256.Sum256(append(node[:], proof[j][:]...))
+			}
+		}
+		require.Equal(t, bodyRoot, node)
+	}
+
+	_, err = body.KZGCommitmentInclusionProof(3)
+	require.EqualError(t, err, "index 3 out of range for 3 commitments")
+}
+
+func TestVerifyKZGCommitmentInclusionProof(t *testing.T) {
+	body := testBody()
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	proof, err := body.KZGCommitmentInclusionProof(1)
+	require.NoError(t, err)
+
+	sidecar := func() *deneb.BlobSidecar {
+		return &deneb.BlobSidecar{
+			Index:         1,
+			KZGCommitment: body.BlobKZGCommitments[1],
+			SignedBlockHeader: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					BodyRoot: bodyRoot,
+				},
+			},
+			KZGCommitmentInclusionProof: proof,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		sidecar func() *deneb.BlobSidecar
+		valid   bool
+		err     string
+	}{
+		{
+			name:    "Nil",
+			sidecar: func() *deneb.BlobSidecar { return nil },
+			err:     "sidecar missing",
+		},
+		{
+			name: "HeaderMissing",
+			sidecar: func() *deneb.BlobSidecar {
+				s := sidecar()
+				s.SignedBlockHeader = nil
+
+				return s
+			},
+			err: "signed block header missing",
+		},
+		{
+			name:    "Good",
+			sidecar: sidecar,
+			valid:   true,
+		},
+		{
+			name: "IndexIncorrect",
+			sidecar: func() *deneb.BlobSidecar {
+				s := sidecar()
+				s.Index = 2
+
+				return s
+			},
+		},
+		{
+			name: "IndexOutOfRange",
+			sidecar: func() *deneb.BlobSidecar {
+				s := sidecar()
+				s.Index = 4096
+
+				return s
+			},
+		},
+		{
+			name: "CommitmentIncorrect",
+			sidecar: func() *deneb.BlobSidecar {
+				s := sidecar()
+				s.KZGCommitment = deneb.KZGCommitment{0x04}
+
+				return s
+			},
+		},
+		{
+			name: "ProofIncorrect",
+			sidecar: func() *deneb.BlobSidecar {
+				s := sidecar()
+				s.KZGCommitmentInclusionProof[16][0] ^= 0x01
+
+				return s
+			},
+		},
+		{
+			name: "BodyRootIncorrect",
+			sidecar: func() *deneb.BlobSidecar {
+				s := sidecar()
+				s.SignedBlockHeader.Message.BodyRoot = phase0.Root{0x01}
+
+				return s
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			valid, err := deneb.VerifyKZGCommitmentInclusionProof(test.sidecar())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.valid, valid)
+		})
+	}
+}
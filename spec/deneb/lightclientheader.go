@@ -0,0 +1,43 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+)
+
+const (
+	// executionBranchLength is the number of elements in the execution branch.
+	executionBranchLength = 4
+)
+
+// LightClientHeader is the header of a beacon block for light clients.
+type LightClientHeader struct {
+	Beacon          *phase0.BeaconBlockHeader
+	Execution       *ExecutionPayloadHeader
+	ExecutionBranch []phase0.Root `ssz-size:"4,32"`
+}
+
+// String returns a string version of the structure.
+func (h *LightClientHeader) String() string {
+	data, err := yaml.Marshal(h)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
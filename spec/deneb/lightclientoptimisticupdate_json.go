@@ -0,0 +1,63 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/pkg/errors"
+)
+
+// lightClientOptimisticUpdateJSON is the spec representation of the struct.
+type lightClientOptimisticUpdateJSON struct {
+	AttestedHeader *LightClientHeader    `json:"attested_header"`
+	SyncAggregate  *altair.SyncAggregate `json:"sync_aggregate"`
+	SignatureSlot  string                `json:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *LightClientOptimisticUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientOptimisticUpdateJSON{
+		AttestedHeader: o.AttestedHeader,
+		SyncAggregate:  o.SyncAggregate,
+		SignatureSlot:  fmt.Sprintf("%d", o.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *LightClientOptimisticUpdate) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&lightClientOptimisticUpdateJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	o.AttestedHeader = &LightClientHeader{}
+	if err := o.AttestedHeader.UnmarshalJSON(raw["attested_header"]); err != nil {
+		return errors.Wrap(err, "attested_header")
+	}
+
+	o.SyncAggregate = &altair.SyncAggregate{}
+	if err := o.SyncAggregate.UnmarshalJSON(raw["sync_aggregate"]); err != nil {
+		return errors.Wrap(err, "sync_aggregate")
+	}
+
+	if err := o.SignatureSlot.UnmarshalJSON(raw["signature_slot"]); err != nil {
+		return errors.Wrap(err, "signature_slot")
+	}
+
+	return nil
+}
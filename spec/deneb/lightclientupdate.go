@@ -0,0 +1,50 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+)
+
+const (
+	// nextSyncCommitteeBranchLength is the number of elements in the next sync committee branch.
+	nextSyncCommitteeBranchLength = 5
+	// finalityBranchLength is the number of elements in the finality branch.
+	finalityBranchLength = 6
+)
+
+// LightClientUpdate is an update for light clients.
+type LightClientUpdate struct {
+	AttestedHeader          *LightClientHeader
+	NextSyncCommittee       *altair.SyncCommittee
+	NextSyncCommitteeBranch []phase0.Root `ssz-size:"5,32"`
+	FinalizedHeader         *LightClientHeader
+	FinalityBranch          []phase0.Root `ssz-size:"6,32"`
+	SyncAggregate           *altair.SyncAggregate
+	SignatureSlot           phase0.Slot
+}
+
+// String returns a string version of the structure.
+func (u *LightClientUpdate) String() string {
+	data, err := yaml.Marshal(u)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 
 	"github.com/goccy/go-yaml"
@@ -60,7 +61,7 @@ func (a *AggregateAndProof) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *AggregateAndProof) UnmarshalJSON(input []byte) error {
 	var aggregateAndProofJSON aggregateAndProofJSON
-	if err := json.Unmarshal(input, &aggregateAndProofJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &aggregateAndProofJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -51,7 +52,7 @@ func (a *AttesterSlashing) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *AttesterSlashing) UnmarshalJSON(input []byte) error {
 	var attesterSlashingJSON attesterSlashingJSON
-	if err := json.Unmarshal(input, &attesterSlashingJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &attesterSlashingJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
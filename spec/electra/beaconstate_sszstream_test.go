@@ -0,0 +1,98 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestBeaconStateUnmarshalSSZFrom(t *testing.T) {
+	validators := 100
+	state := &electra.BeaconState{
+		GenesisTime:       12345,
+		Slot:              100,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		HistoricalRoots:   make([]phase0.Root, 0),
+		ETH1Data: &phase0.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		ETH1DataVotes:               make([]*phase0.ETH1Data, 0),
+		Validators:                  make([]*phase0.Validator, 0, validators),
+		Balances:                    make([]phase0.Gwei, 0, validators),
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		PreviousEpochParticipation:  make([]altair.ParticipationFlags, 0, validators),
+		CurrentEpochParticipation:   make([]altair.ParticipationFlags, 0, validators),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{Epoch: 1},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{Epoch: 2},
+		FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 1},
+		InactivityScores:            make([]uint64, 0, validators),
+		CurrentSyncCommittee: &altair.SyncCommittee{
+			Pubkeys: make([]phase0.BLSPubKey, 512),
+		},
+		NextSyncCommittee: &altair.SyncCommittee{
+			Pubkeys: make([]phase0.BLSPubKey, 512),
+		},
+		LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+			ExtraData:     []byte("extra"),
+			BaseFeePerGas: uint256.NewInt(7),
+		},
+		HistoricalSummaries: []*capella.HistoricalSummary{{}},
+		PendingDeposits: []*electra.PendingDeposit{
+			{WithdrawalCredentials: make([]byte, 32), Amount: 1},
+		},
+		PendingPartialWithdrawals: make([]*electra.PendingPartialWithdrawal, 0),
+		PendingConsolidations: []*electra.PendingConsolidation{
+			{SourceIndex: 1, TargetIndex: 2},
+		},
+	}
+	for i := 0; i < validators; i++ {
+		state.Validators = append(state.Validators, &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+		})
+		state.Balances = append(state.Balances, phase0.Gwei(32000000000+i))
+		state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, altair.ParticipationFlags(i%8))
+		state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, altair.ParticipationFlags(7-i%8))
+		state.InactivityScores = append(state.InactivityScores, uint64(i))
+	}
+
+	input, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	var expected electra.BeaconState
+	require.NoError(t, expected.UnmarshalSSZ(input))
+
+	var res electra.BeaconState
+	require.NoError(t, res.UnmarshalSSZFrom(bytes.NewReader(input)))
+	require.Equal(t, expected, res)
+
+	// Ensure that a truncated final field is caught.
+	require.Error(t, res.UnmarshalSSZFrom(bytes.NewReader(input[:len(input)-1])))
+}
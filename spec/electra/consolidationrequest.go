@@ -19,6 +19,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
 )
 
 // ConsolidationRequest represents an execution layer consolidation request.
@@ -28,6 +29,46 @@ type ConsolidationRequest struct {
 	TargetPubkey  phase0.BLSPubKey           `ssz-size:"48"`
 }
 
+// NewConsolidationRequest creates a request to consolidate the source validator into the target validator.
+func NewConsolidationRequest(sourceAddress bellatrix.ExecutionAddress,
+	sourcePubkey phase0.BLSPubKey,
+	targetPubkey phase0.BLSPubKey,
+) *ConsolidationRequest {
+	return &ConsolidationRequest{
+		SourceAddress: sourceAddress,
+		SourcePubkey:  sourcePubkey,
+		TargetPubkey:  targetPubkey,
+	}
+}
+
+// NewSwitchToCompoundingRequest creates a request to switch a validator to compounding withdrawal credentials.
+func NewSwitchToCompoundingRequest(sourceAddress bellatrix.ExecutionAddress,
+	pubkey phase0.BLSPubKey,
+) *ConsolidationRequest {
+	return NewConsolidationRequest(sourceAddress, pubkey, pubkey)
+}
+
+// IsSwitchToCompounding returns true if the request is to switch the source validator
+// to compounding withdrawal credentials rather than to consolidate it.
+func (e *ConsolidationRequest) IsSwitchToCompounding() bool {
+	return e.SourcePubkey == e.TargetPubkey
+}
+
+// Validate returns an error if the request is not well-formed.
+func (e *ConsolidationRequest) Validate() error {
+	if e.SourceAddress.IsZero() {
+		return errors.New("source address missing")
+	}
+	if e.SourcePubkey.IsZero() {
+		return errors.New("source public key missing")
+	}
+	if e.TargetPubkey.IsZero() {
+		return errors.New("target public key missing")
+	}
+
+	return nil
+}
+
 // String returns a string version of the structure.
 func (e *ConsolidationRequest) String() string {
 	data, err := yaml.Marshal(e)
@@ -0,0 +1,73 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsolidationRequestValidate(t *testing.T) {
+	address := bellatrix.ExecutionAddress{0x01}
+	source := phase0.BLSPubKey{0x02}
+	target := phase0.BLSPubKey{0x03}
+
+	tests := []struct {
+		name        string
+		request     *electra.ConsolidationRequest
+		compounding bool
+		err         string
+	}{
+		{
+			name:    "SourceAddressMissing",
+			request: electra.NewConsolidationRequest(bellatrix.ExecutionAddress{}, source, target),
+			err:     "source address missing",
+		},
+		{
+			name:    "SourcePubkeyMissing",
+			request: electra.NewConsolidationRequest(address, phase0.BLSPubKey{}, target),
+			err:     "source public key missing",
+		},
+		{
+			name:    "TargetPubkeyMissing",
+			request: electra.NewConsolidationRequest(address, source, phase0.BLSPubKey{}),
+			err:     "target public key missing",
+		},
+		{
+			name:    "Good",
+			request: electra.NewConsolidationRequest(address, source, target),
+		},
+		{
+			name:        "SwitchToCompounding",
+			request:     electra.NewSwitchToCompoundingRequest(address, source),
+			compounding: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.request.Validate()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.compounding, test.request.IsSwitchToCompounding())
+			}
+		})
+	}
+}
@@ -18,8 +18,12 @@ import (
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
 )
 
+// MinDepositAmount is the minimum amount of a deposit accepted by the deposit contract.
+const MinDepositAmount = phase0.Gwei(1_000_000_000)
+
 // DepositRequest represents a deposit receipt.
 type DepositRequest struct {
 	Pubkey                phase0.BLSPubKey `ssz-size:"48"`
@@ -29,6 +33,37 @@ type DepositRequest struct {
 	Index                 uint64
 }
 
+// NewDepositRequest creates a deposit request.
+func NewDepositRequest(pubkey phase0.BLSPubKey,
+	withdrawalCredentials []byte,
+	amount phase0.Gwei,
+	signature phase0.BLSSignature,
+	index uint64,
+) *DepositRequest {
+	return &DepositRequest{
+		Pubkey:                pubkey,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                amount,
+		Signature:             signature,
+		Index:                 index,
+	}
+}
+
+// Validate returns an error if the request is not well-formed.
+func (d *DepositRequest) Validate() error {
+	if d.Pubkey.IsZero() {
+		return errors.New("public key missing")
+	}
+	if len(d.WithdrawalCredentials) != phase0.HashLength {
+		return fmt.Errorf("incorrect length %d for withdrawal credentials", len(d.WithdrawalCredentials))
+	}
+	if d.Amount < MinDepositAmount {
+		return fmt.Errorf("amount %d below minimum deposit amount %d", d.Amount, MinDepositAmount)
+	}
+
+	return nil
+}
+
 // String returns a string version of the structure.
 func (d *DepositRequest) String() string {
 	data, err := yaml.Marshal(d)
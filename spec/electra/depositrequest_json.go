@@ -20,6 +20,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -47,7 +48,7 @@ func (d *DepositRequest) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (d *DepositRequest) UnmarshalJSON(input []byte) error {
 	var depositReceipt depositRequestJSON
-	if err := json.Unmarshal(input, &depositReceipt); err != nil {
+	if err := codecs.UnmarshalJSON(input, &depositReceipt); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositRequestValidate(t *testing.T) {
+	pubkey := phase0.BLSPubKey{0x01}
+	credentials := make([]byte, 32)
+	signature := phase0.BLSSignature{0x02}
+
+	tests := []struct {
+		name    string
+		request *electra.DepositRequest
+		err     string
+	}{
+		{
+			name:    "PubkeyMissing",
+			request: electra.NewDepositRequest(phase0.BLSPubKey{}, credentials, electra.MinDepositAmount, signature, 0),
+			err:     "public key missing",
+		},
+		{
+			name:    "WithdrawalCredentialsShort",
+			request: electra.NewDepositRequest(pubkey, credentials[:31], electra.MinDepositAmount, signature, 0),
+			err:     "incorrect length 31 for withdrawal credentials",
+		},
+		{
+			name:    "AmountLow",
+			request: electra.NewDepositRequest(pubkey, credentials, electra.MinDepositAmount-1, signature, 0),
+			err:     "amount 999999999 below minimum deposit amount 1000000000",
+		},
+		{
+			name:    "Good",
+			request: electra.NewDepositRequest(pubkey, credentials, 32_000_000_000, signature, 5),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.request.Validate()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// KZGCommitmentInclusionProof generates the inclusion proof of the KZG commitment at
+// the given index in the block body.
+func (b *BeaconBlockBody) KZGCommitmentInclusionProof(index deneb.BlobIndex) (deneb.KZGCommitmentInclusionProof, error) {
+	if int(index) >= len(b.BlobKZGCommitments) {
+		return deneb.KZGCommitmentInclusionProof{}, fmt.Errorf("index %d out of range for %d commitments", index, len(b.BlobKZGCommitments))
+	}
+
+	return deneb.GenerateKZGCommitmentInclusionProof(b, index)
+}
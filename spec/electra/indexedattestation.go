@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 
 	"github.com/goccy/go-yaml"
@@ -66,7 +67,7 @@ func (i *IndexedAttestation) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (i *IndexedAttestation) UnmarshalJSON(input []byte) error {
 	var indexedAttestationJSON indexedAttestationJSON
-	if err := json.Unmarshal(input, &indexedAttestationJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &indexedAttestationJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
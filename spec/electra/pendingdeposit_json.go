@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -46,7 +47,7 @@ func (p *PendingDeposit) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (p *PendingDeposit) UnmarshalJSON(input []byte) error {
 	var pendingDeposit pendingDepositJSON
-	if err := json.Unmarshal(input, &pendingDeposit); err != nil {
+	if err := codecs.UnmarshalJSON(input, &pendingDeposit); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -20,6 +20,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -45,7 +46,7 @@ func (a *SingleAttestation) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *SingleAttestation) UnmarshalJSON(input []byte) error {
 	var singleAttestationJSON singleAttestationJSON
-	err := json.Unmarshal(input, &singleAttestationJSON)
+	err := codecs.UnmarshalJSON(input, &singleAttestationJSON)
 	if err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
@@ -19,8 +19,12 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
 )
 
+// FullExitRequestAmount is the amount of a withdrawal request that requests a full exit of the validator.
+const FullExitRequestAmount = phase0.Gwei(0)
+
 // WithdrawalRequest represents an execution layer withdraw request.
 type WithdrawalRequest struct {
 	SourceAddress   bellatrix.ExecutionAddress `ssz-size:"20"`
@@ -28,6 +32,42 @@ type WithdrawalRequest struct {
 	Amount          phase0.Gwei
 }
 
+// NewWithdrawalRequest creates a request to withdraw the given amount from a validator.
+func NewWithdrawalRequest(sourceAddress bellatrix.ExecutionAddress,
+	validatorPubkey phase0.BLSPubKey,
+	amount phase0.Gwei,
+) *WithdrawalRequest {
+	return &WithdrawalRequest{
+		SourceAddress:   sourceAddress,
+		ValidatorPubkey: validatorPubkey,
+		Amount:          amount,
+	}
+}
+
+// NewFullExitRequest creates a request to fully exit a validator.
+func NewFullExitRequest(sourceAddress bellatrix.ExecutionAddress,
+	validatorPubkey phase0.BLSPubKey,
+) *WithdrawalRequest {
+	return NewWithdrawalRequest(sourceAddress, validatorPubkey, FullExitRequestAmount)
+}
+
+// IsFullExit returns true if the request is for a full exit of the validator.
+func (e *WithdrawalRequest) IsFullExit() bool {
+	return e.Amount == FullExitRequestAmount
+}
+
+// Validate returns an error if the request is not well-formed.
+func (e *WithdrawalRequest) Validate() error {
+	if e.SourceAddress.IsZero() {
+		return errors.New("source address missing")
+	}
+	if e.ValidatorPubkey.IsZero() {
+		return errors.New("validator public key missing")
+	}
+
+	return nil
+}
+
 // String returns a string version of the structure.
 func (e *WithdrawalRequest) String() string {
 	data, err := yaml.Marshal(e)
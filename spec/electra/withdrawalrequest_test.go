@@ -0,0 +1,67 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalRequestValidate(t *testing.T) {
+	address := bellatrix.ExecutionAddress{0x01}
+	pubkey := phase0.BLSPubKey{0x02}
+
+	tests := []struct {
+		name     string
+		request  *electra.WithdrawalRequest
+		fullExit bool
+		err      string
+	}{
+		{
+			name:    "SourceAddressMissing",
+			request: electra.NewWithdrawalRequest(bellatrix.ExecutionAddress{}, pubkey, 1),
+			err:     "source address missing",
+		},
+		{
+			name:    "ValidatorPubkeyMissing",
+			request: electra.NewWithdrawalRequest(address, phase0.BLSPubKey{}, 1),
+			err:     "validator public key missing",
+		},
+		{
+			name:    "Partial",
+			request: electra.NewWithdrawalRequest(address, pubkey, 1_000_000_000),
+		},
+		{
+			name:     "FullExit",
+			request:  electra.NewFullExitRequest(address, pubkey),
+			fullExit: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.request.Validate()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.fullExit, test.request.IsFullExit())
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build epbs
+
+package epbs
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// executionPayloadEnvelopeYAML is the spec representation of the struct.
+type executionPayloadEnvelopeYAML struct {
+	Payload            *deneb.ExecutionPayload    `yaml:"payload"`
+	ExecutionRequests  *electra.ExecutionRequests `yaml:"execution_requests"`
+	BuilderIndex       uint64                     `yaml:"builder_index"`
+	BeaconBlockRoot    phase0.Root                `yaml:"beacon_block_root"`
+	BlobKZGCommitments []deneb.KZGCommitment      `yaml:"blob_kzg_commitments"`
+	PayloadWithheld    bool                       `yaml:"payload_withheld"`
+	StateRoot          phase0.Root                `yaml:"state_root"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (e *ExecutionPayloadEnvelope) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&executionPayloadEnvelopeYAML{
+		Payload:            e.Payload,
+		ExecutionRequests:  e.ExecutionRequests,
+		BuilderIndex:       uint64(e.BuilderIndex),
+		BeaconBlockRoot:    e.BeaconBlockRoot,
+		BlobKZGCommitments: e.BlobKZGCommitments,
+		PayloadWithheld:    e.PayloadWithheld,
+		StateRoot:          e.StateRoot,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (e *ExecutionPayloadEnvelope) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var unmarshaled executionPayloadEnvelopeJSON
+	if err := yaml.Unmarshal(input, &unmarshaled); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	marshaled, err := json.Marshal(&unmarshaled)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return e.UnmarshalJSON(marshaled)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build epbs
+
+package epbs
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// executionPayloadHeaderYAML is the spec representation of the struct.
+type executionPayloadHeaderYAML struct {
+	ParentBlockHash        phase0.Hash32 `yaml:"parent_block_hash"`
+	ParentBlockRoot        phase0.Root   `yaml:"parent_block_root"`
+	BlockHash              phase0.Hash32 `yaml:"block_hash"`
+	GasLimit               uint64        `yaml:"gas_limit"`
+	BuilderIndex           uint64        `yaml:"builder_index"`
+	Slot                   uint64        `yaml:"slot"`
+	Value                  uint64        `yaml:"value"`
+	BlobKZGCommitmentsRoot phase0.Root   `yaml:"blob_kzg_commitments_root"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (e *ExecutionPayloadHeader) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&executionPayloadHeaderYAML{
+		ParentBlockHash:        e.ParentBlockHash,
+		ParentBlockRoot:        e.ParentBlockRoot,
+		BlockHash:              e.BlockHash,
+		GasLimit:               e.GasLimit,
+		BuilderIndex:           uint64(e.BuilderIndex),
+		Slot:                   uint64(e.Slot),
+		Value:                  uint64(e.Value),
+		BlobKZGCommitmentsRoot: e.BlobKZGCommitmentsRoot,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (e *ExecutionPayloadHeader) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var unmarshaled executionPayloadHeaderJSON
+	if err := yaml.Unmarshal(input, &unmarshaled); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	marshaled, err := json.Marshal(&unmarshaled)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return e.UnmarshalJSON(marshaled)
+}
@@ -0,0 +1,62 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build epbs
+
+package epbs
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// payloadAttestationDataJSON is the spec representation of the struct.
+type payloadAttestationDataJSON struct {
+	BeaconBlockRoot phase0.Root   `json:"beacon_block_root"`
+	Slot            phase0.Slot   `json:"slot"`
+	PayloadStatus   PayloadStatus `json:"payload_status"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadAttestationData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&payloadAttestationDataJSON{
+		BeaconBlockRoot: p.BeaconBlockRoot,
+		Slot:            p.Slot,
+		PayloadStatus:   p.PayloadStatus,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadAttestationData) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&payloadAttestationDataJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := p.BeaconBlockRoot.UnmarshalJSON(raw["beacon_block_root"]); err != nil {
+		return errors.Wrap(err, "beacon_block_root")
+	}
+
+	if err := p.Slot.UnmarshalJSON(raw["slot"]); err != nil {
+		return errors.Wrap(err, "slot")
+	}
+
+	if err := p.PayloadStatus.UnmarshalJSON(raw["payload_status"]); err != nil {
+		return errors.Wrap(err, "payload_status")
+	}
+
+	return nil
+}
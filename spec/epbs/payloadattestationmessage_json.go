@@ -0,0 +1,63 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build epbs
+
+package epbs
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// payloadAttestationMessageJSON is the spec representation of the struct.
+type payloadAttestationMessageJSON struct {
+	ValidatorIndex phase0.ValidatorIndex   `json:"validator_index"`
+	Data           *PayloadAttestationData `json:"data"`
+	Signature      phase0.BLSSignature     `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadAttestationMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&payloadAttestationMessageJSON{
+		ValidatorIndex: p.ValidatorIndex,
+		Data:           p.Data,
+		Signature:      p.Signature,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadAttestationMessage) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&payloadAttestationMessageJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := p.ValidatorIndex.UnmarshalJSON(raw["validator_index"]); err != nil {
+		return errors.Wrap(err, "validator_index")
+	}
+
+	p.Data = &PayloadAttestationData{}
+	if err := p.Data.UnmarshalJSON(raw["data"]); err != nil {
+		return errors.Wrap(err, "data")
+	}
+
+	if err := p.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	return nil
+}
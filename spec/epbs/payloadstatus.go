@@ -0,0 +1,81 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build epbs
+
+package epbs
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// PayloadStatus is the status of an execution payload as seen by the payload
+// timeliness committee.
+type PayloadStatus uint8
+
+const (
+	// PayloadAbsent is the status when the payload was not seen in time.
+	PayloadAbsent PayloadStatus = iota
+	// PayloadPresent is the status when the payload was seen in time.
+	PayloadPresent
+	// PayloadWithheld is the status when the builder withheld the payload.
+	PayloadWithheld
+)
+
+var payloadStatusStrings = [...]string{
+	"absent",
+	"present",
+	"withheld",
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadStatus) UnmarshalJSON(input []byte) error {
+	if len(input) == 0 {
+		return errors.New("input missing")
+	}
+	if len(input) < 3 {
+		return errors.New("input malformed")
+	}
+	if !bytes.HasPrefix(input, []byte{'"'}) {
+		return errors.New("invalid prefix")
+	}
+	if !bytes.HasSuffix(input, []byte{'"'}) {
+		return errors.New("invalid suffix")
+	}
+
+	val, err := strconv.ParseUint(string(input[1:len(input)-1]), 10, 8)
+	if err != nil {
+		return errors.Wrapf(err, "invalid value %s", string(input[1:len(input)-1]))
+	}
+	*p = PayloadStatus(val)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p PayloadStatus) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%d"`, p)), nil
+}
+
+// String returns a string representation of the status.
+func (p PayloadStatus) String() string {
+	if int(p) < len(payloadStatusStrings) {
+		return payloadStatusStrings[p]
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,83 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build epbs
+
+package epbs_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/epbs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedExecutionPayloadEnvelopeRoundTrip(t *testing.T) {
+	envelope := &epbs.SignedExecutionPayloadEnvelope{
+		Message: &epbs.ExecutionPayloadEnvelope{
+			Payload: &deneb.ExecutionPayload{
+				ParentHash:    phase0.Hash32{0x01},
+				BlockNumber:   10,
+				GasLimit:      30000000,
+				ExtraData:     []byte{},
+				BaseFeePerGas: uint256.NewInt(7),
+				BlockHash:     phase0.Hash32{0x02},
+				Transactions:  []bellatrix.Transaction{},
+				Withdrawals:   []*capella.Withdrawal{},
+			},
+			ExecutionRequests: &electra.ExecutionRequests{
+				Deposits:       []*electra.DepositRequest{},
+				Withdrawals:    []*electra.WithdrawalRequest{},
+				Consolidations: []*electra.ConsolidationRequest{},
+			},
+			BuilderIndex:       3,
+			BeaconBlockRoot:    phase0.Root{0x03},
+			BlobKZGCommitments: []deneb.KZGCommitment{{0x04}, {0x05}},
+			PayloadWithheld:    true,
+			StateRoot:          phase0.Root{0x06},
+		},
+		Signature: phase0.BLSSignature{0x07},
+	}
+
+	data, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	var jsonRes epbs.SignedExecutionPayloadEnvelope
+	require.NoError(t, json.Unmarshal(data, &jsonRes))
+	require.Equal(t, envelope, &jsonRes)
+
+	data, err = yaml.Marshal(envelope)
+	require.NoError(t, err)
+	var yamlRes epbs.SignedExecutionPayloadEnvelope
+	require.NoError(t, yaml.Unmarshal(data, &yamlRes))
+	require.Equal(t, envelope, &yamlRes)
+
+	data, err = envelope.MarshalSSZ()
+	require.NoError(t, err)
+	var sszRes epbs.SignedExecutionPayloadEnvelope
+	require.NoError(t, sszRes.UnmarshalSSZ(data))
+	require.Equal(t, envelope, &sszRes)
+
+	root, err := envelope.HashTreeRoot()
+	require.NoError(t, err)
+	sszRoot, err := sszRes.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, sszRoot)
+}
@@ -0,0 +1,69 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build epbs
+
+package epbs_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/epbs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedExecutionPayloadHeaderJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "MessageMissing",
+			input: []byte(`{"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`),
+			err:   "message: missing",
+		},
+		{
+			name:  "GasLimitInvalid",
+			input: []byte(`{"message":{"parent_block_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_block_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","block_hash":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","gas_limit":"-1","builder_index":"7","slot":"100","value":"1000000000","blob_kzg_commitments_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`),
+			err:   "message: gas_limit: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"message":{"parent_block_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_block_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","block_hash":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","gas_limit":"30000000","builder_index":"7","slot":"100","value":"1000000000","blob_kzg_commitments_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res epbs.SignedExecutionPayloadHeader
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+
+				ssz, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				var sszRes epbs.SignedExecutionPayloadHeader
+				require.NoError(t, sszRes.UnmarshalSSZ(ssz))
+				require.Equal(t, res, sszRes)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package focil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// inclusionListJSON is the spec representation of the struct.
+type inclusionListJSON struct {
+	Slot                       phase0.Slot           `json:"slot"`
+	ValidatorIndex             phase0.ValidatorIndex `json:"validator_index"`
+	InclusionListCommitteeRoot phase0.Root           `json:"inclusion_list_committee_root"`
+	Transactions               []string              `json:"transactions"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *InclusionList) MarshalJSON() ([]byte, error) {
+	transactions := make([]string, len(i.Transactions))
+	for j := range i.Transactions {
+		transactions[j] = fmt.Sprintf("%#x", i.Transactions[j])
+	}
+
+	return json.Marshal(&inclusionListJSON{
+		Slot:                       i.Slot,
+		ValidatorIndex:             i.ValidatorIndex,
+		InclusionListCommitteeRoot: i.InclusionListCommitteeRoot,
+		Transactions:               transactions,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *InclusionList) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&inclusionListJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := i.Slot.UnmarshalJSON(raw["slot"]); err != nil {
+		return errors.Wrap(err, "slot")
+	}
+
+	if err := i.ValidatorIndex.UnmarshalJSON(raw["validator_index"]); err != nil {
+		return errors.Wrap(err, "validator_index")
+	}
+
+	if err := i.InclusionListCommitteeRoot.UnmarshalJSON(raw["inclusion_list_committee_root"]); err != nil {
+		return errors.Wrap(err, "inclusion_list_committee_root")
+	}
+
+	transactions := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(raw["transactions"], &transactions); err != nil {
+		return errors.Wrap(err, "transactions")
+	}
+	if len(transactions) > bellatrix.MaxTransactionsPerPayload {
+		return errors.New("incorrect length for transactions")
+	}
+	i.Transactions = make([]bellatrix.Transaction, len(transactions))
+	for j := range transactions {
+		if len(transactions[j]) == 0 ||
+			bytes.Equal(transactions[j], []byte{'"', '"'}) ||
+			bytes.Equal(transactions[j], []byte{'"', '0', 'x', '"'}) {
+			return fmt.Errorf("transaction %d: missing", j)
+		}
+		i.Transactions[j] = make([]byte, (len(transactions[j])-4)/2)
+		if err := json.Unmarshal(transactions[j], &i.Transactions[j]); err != nil {
+			return errors.Wrapf(err, "transaction %d", j)
+		}
+		if len(i.Transactions[j]) > bellatrix.MaxBytesPerTransaction {
+			return fmt.Errorf("incorrect length for transaction %d", j)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package focil_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/focil"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedInclusionListJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "MessageMissing",
+			input: []byte(`{"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`),
+			err:   "message: missing",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"message":{"validator_index":"7","inclusion_list_committee_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","transactions":[]},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`),
+			err:   "message: slot: missing",
+		},
+		{
+			name:  "TransactionMissing",
+			input: []byte(`{"message":{"slot":"100","validator_index":"7","inclusion_list_committee_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","transactions":["0x"]},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`),
+			err:   "message: transaction 0: missing",
+		},
+		{
+			name:  "Empty Transactions",
+			input: []byte(`{"message":{"slot":"100","validator_index":"7","inclusion_list_committee_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","transactions":[]},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"message":{"slot":"100","validator_index":"7","inclusion_list_committee_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","transactions":["0x02f87001","0x02f8700203"]},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res focil.SignedInclusionList
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+
+				ssz, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				var sszRes focil.SignedInclusionList
+				require.NoError(t, sszRes.UnmarshalSSZ(ssz))
+				require.Equal(t, res, sszRes)
+			}
+		})
+	}
+}
+
+func TestSignedInclusionListYAML(t *testing.T) {
+	input := []byte(`{message: {slot: 100, validator_index: 7, inclusion_list_committee_root: '0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f', transactions: ['0x02f87001']}, signature: '0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf'}`)
+
+	var res focil.SignedInclusionList
+	require.NoError(t, yaml.Unmarshal(input, &res))
+	rt, err := yaml.Marshal(&res)
+	require.NoError(t, err)
+	assert.Equal(t, string(rt), res.String())
+	rt = bytes.TrimSuffix(rt, []byte("\n"))
+	assert.Equal(t, string(input), string(rt))
+}
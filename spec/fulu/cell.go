@@ -0,0 +1,115 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Cell is a single cell of an extended blob.
+type Cell [2048]byte
+
+// CellLength is the number of bytes in a cell.
+const CellLength = 2048
+
+// String returns a string version of the structure.
+func (c Cell) String() string {
+	return fmt.Sprintf("%#x", c)
+}
+
+// Format formats the cell.
+func (c Cell) Format(state fmt.State, v rune) {
+	format := string(v)
+	switch v {
+	case 's':
+		fmt.Fprint(state, c.String())
+	case 'x', 'X':
+		if state.Flag('#') {
+			format = "#" + format
+		}
+		fmt.Fprintf(state, "%"+format, c[:])
+	default:
+		fmt.Fprintf(state, "%"+format, c[:])
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Cell) UnmarshalJSON(input []byte) error {
+	if len(input) == 0 {
+		return errors.New("input missing")
+	}
+
+	if !bytes.HasPrefix(input, []byte{'"', '0', 'x'}) {
+		return errors.New("invalid prefix")
+	}
+	if !bytes.HasSuffix(input, []byte{'"'}) {
+		return errors.New("invalid suffix")
+	}
+	if len(input) != 1+2+CellLength*2+1 {
+		return errors.New("incorrect length")
+	}
+
+	length, err := hex.Decode(c[:], input[3:3+CellLength*2])
+	if err != nil {
+		return errors.Wrapf(err, "invalid value %s", string(input[3:3+CellLength*2]))
+	}
+
+	if length != CellLength {
+		return errors.New("incorrect length")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Cell) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%#x"`, c)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (c *Cell) UnmarshalYAML(input []byte) error {
+	if len(input) == 0 {
+		return errors.New("input missing")
+	}
+
+	if !bytes.HasPrefix(input, []byte{'\'', '0', 'x'}) {
+		return errors.New("invalid prefix")
+	}
+	if !bytes.HasSuffix(input, []byte{'\''}) {
+		return errors.New("invalid suffix")
+	}
+	if len(input) != 1+2+CellLength*2+1 {
+		return errors.New("incorrect length")
+	}
+
+	length, err := hex.Decode(c[:], input[3:3+CellLength*2])
+	if err != nil {
+		return errors.Wrapf(err, "invalid value %s", string(input[3:3+CellLength*2]))
+	}
+
+	if length != CellLength {
+		return errors.New("incorrect length")
+	}
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (c Cell) MarshalYAML() ([]byte, error) {
+	return []byte(fmt.Sprintf(`'%#x'`, c)), nil
+}
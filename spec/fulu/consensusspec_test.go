@@ -0,0 +1,260 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spectests"
+)
+
+// TestConsensusSpec tests the types against the Ethereum consensus spec tests.
+func TestConsensusSpec(t *testing.T) {
+	if os.Getenv(spectests.DirEnv) == "" {
+		t.Skip("CONSENSUS_SPEC_TESTS_DIR not supplied, not running spec tests")
+	}
+
+	tests := []*spectests.Test{
+		{
+			Name:      "AggregateAndProof",
+			Container: &electra.AggregateAndProof{},
+		},
+		{
+			Name:      "Attestation",
+			Container: &electra.Attestation{},
+		},
+		{
+			Name:      "AttestationData",
+			Container: &phase0.AttestationData{},
+		},
+		{
+			Name:      "AttesterSlashing",
+			Container: &electra.AttesterSlashing{},
+		},
+		{
+			Name:      "BeaconBlock",
+			Container: &fulu.BeaconBlock{},
+		},
+		{
+			Name:      "BeaconBlockBody",
+			Container: &fulu.BeaconBlockBody{},
+		},
+		{
+			Name:      "BeaconBlockHeader",
+			Container: &phase0.BeaconBlockHeader{},
+		},
+		{
+			Name:      "BeaconState",
+			Container: &fulu.BeaconState{},
+		},
+		{
+			Name:      "BlobIdentifier",
+			Container: &deneb.BlobIdentifier{},
+		},
+		{
+			Name:      "BlobSidecar",
+			Container: &deneb.BlobSidecar{},
+		},
+		{
+			Name:      "BLSToExecutionChange",
+			Container: &capella.BLSToExecutionChange{},
+		},
+		{
+			Name:      "Checkpoint",
+			Container: &phase0.Checkpoint{},
+		},
+		{
+			Name:      "Consolidation",
+			Container: &electra.Consolidation{},
+		},
+		{
+			Name:      "ConsolidationRequest",
+			Container: &electra.ConsolidationRequest{},
+		},
+		{
+			Name:      "ContributionAndProof",
+			Container: &altair.ContributionAndProof{},
+		},
+		{
+			Name:      "DataColumnIdentifier",
+			Container: &fulu.DataColumnIdentifier{},
+		},
+		{
+			Name:      "DataColumnSidecar",
+			Container: &fulu.DataColumnSidecar{},
+		},
+		{
+			Name:      "Deposit",
+			Container: &phase0.Deposit{},
+		},
+		{
+			Name:      "DepositData",
+			Container: &phase0.DepositData{},
+		},
+		{
+			Name:      "DepositRequest",
+			Container: &electra.DepositRequest{},
+		},
+		{
+			Name:      "DepositMessage",
+			Container: &phase0.DepositMessage{},
+		},
+		{
+			Name:      "Eth1Data",
+			Container: &phase0.ETH1Data{},
+		},
+		{
+			Name:      "ExecutionRequests",
+			Container: &electra.ExecutionRequests{},
+		},
+		{
+			Name:      "Fork",
+			Container: &phase0.Fork{},
+		},
+		{
+			Name:      "ForkData",
+			Container: &phase0.ForkData{},
+		},
+		{
+			Name:      "HistoricalSummary",
+			Container: &capella.HistoricalSummary{},
+		},
+		{
+			Name:      "IndexedAttestation",
+			Container: &electra.IndexedAttestation{},
+		},
+		{
+			Name:      "LightClientBootstrap",
+			Container: &electra.LightClientBootstrap{},
+		},
+		{
+			Name:      "LightClientFinalityUpdate",
+			Container: &electra.LightClientFinalityUpdate{},
+		},
+		{
+			Name:      "LightClientHeader",
+			Container: &deneb.LightClientHeader{},
+		},
+		{
+			Name:      "LightClientOptimisticUpdate",
+			Container: &deneb.LightClientOptimisticUpdate{},
+		},
+		{
+			Name:      "LightClientUpdate",
+			Container: &electra.LightClientUpdate{},
+		},
+		{
+			Name:      "MatrixEntry",
+			Container: &fulu.MatrixEntry{},
+		},
+		{
+			Name:      "PendingAttestation",
+			Container: &phase0.PendingAttestation{},
+		},
+		{
+			Name:      "PendingDeposit",
+			Container: &electra.PendingDeposit{},
+		},
+		{
+			Name:      "PendingConsolidation",
+			Container: &electra.PendingConsolidation{},
+		},
+		{
+			Name:      "PendingPartialWithdrawal",
+			Container: &electra.PendingPartialWithdrawal{},
+		},
+		{
+			Name:      "ProposerSlashing",
+			Container: &phase0.ProposerSlashing{},
+		},
+		{
+			Name:      "SignedAggregateAndProof",
+			Container: &electra.SignedAggregateAndProof{},
+		},
+		{
+			Name:      "SignedBeaconBlock",
+			Container: &fulu.SignedBeaconBlock{},
+		},
+		{
+			Name:      "SignedBeaconBlockHeader",
+			Container: &phase0.SignedBeaconBlockHeader{},
+		},
+		{
+			Name:      "SignedBLSToExecutionChange",
+			Container: &capella.SignedBLSToExecutionChange{},
+		},
+		{
+			Name:      "SignedContributionAndProof",
+			Container: &altair.SignedContributionAndProof{},
+		},
+		{
+			Name:      "SignedVoluntaryExit",
+			Container: &phase0.SignedVoluntaryExit{},
+		},
+		{
+			Name:      "SyncAggregate",
+			Container: &altair.SyncAggregate{},
+		},
+		{
+			Name:      "SyncAggregatorSelectionData",
+			Container: &altair.SyncAggregatorSelectionData{},
+		},
+		{
+			Name:      "SyncCommittee",
+			Container: &altair.SyncCommittee{},
+		},
+		{
+			Name:      "SyncCommitteeContribution",
+			Container: &altair.SyncCommitteeContribution{},
+		},
+		{
+			Name:      "SyncCommitteeMessage",
+			Container: &altair.SyncCommitteeMessage{},
+		},
+		{
+			Name:      "Validator",
+			Container: &phase0.Validator{},
+		},
+		{
+			Name:      "VoluntaryExit",
+			Container: &phase0.VoluntaryExit{},
+		},
+		{
+			Name:      "Withdrawal",
+			Container: &capella.Withdrawal{},
+		},
+		{
+			Name:      "WithdrawalRequest",
+			Container: &electra.WithdrawalRequest{},
+		},
+	}
+
+	spectests.Run(t, spectests.SSZStaticDir(os.Getenv(spectests.DirEnv), "mainnet", "fulu"), "ssz_random", tests)
+}
+
+func testYAMLFormat(input []byte) string {
+	res, err := spectests.YAMLFormat(input)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
@@ -0,0 +1,42 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+)
+
+// DataColumnSidecar represents a data column sidecar.
+type DataColumnSidecar struct {
+	Index                        ColumnIndex
+	Column                       []Cell                `ssz-max:"4096" ssz-size:"?,2048"`
+	KZGCommitments               []deneb.KZGCommitment `ssz-max:"4096" ssz-size:"?,48"`
+	KZGProofs                    []deneb.KZGProof      `ssz-max:"4096" ssz-size:"?,48"`
+	SignedBlockHeader            *phase0.SignedBeaconBlockHeader
+	KZGCommitmentsInclusionProof KZGCommitmentsInclusionProof `ssz-size:"4,32"`
+}
+
+// String returns a string version of the structure.
+func (d *DataColumnSidecar) String() string {
+	data, err := yaml.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,146 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestDataColumnSidecarJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		output []byte
+		err    string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type map[string]json.RawMessage",
+		},
+		{
+			name:  "IndexMissing",
+			input: []byte(`{"column":[],"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "index: missing",
+		},
+		{
+			name:  "IndexWrongType",
+			input: []byte(`{"index":true,"column":[],"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "index: invalid prefix",
+		},
+		{
+			name:  "IndexInvalid",
+			input: []byte(`{"index":"-1","column":[],"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "index: invalid value -1: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ColumnMissing",
+			input: []byte(`{"index":"5","kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "column: missing",
+		},
+		{
+			name:  "ColumnWrongType",
+			input: []byte(`{"index":"5","column":true,"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "column: json: cannot unmarshal bool into Go value of type []fulu.Cell",
+		},
+		{
+			name:  "KZGCommitmentsMissing",
+			input: []byte(`{"index":"5","column":[],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "kzg_commitments: missing",
+		},
+		{
+			name:  "KZGProofsMissing",
+			input: []byte(`{"index":"5","column":[],"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "kzg_proofs: missing",
+		},
+		{
+			name:  "SignedBlockHeaderMissing",
+			input: []byte(`{"index":"5","column":[],"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "signed_block_header: missing",
+		},
+		{
+			name:  "KZGCommitmentsInclusionProofMissing",
+			input: []byte(`{"index":"5","column":[],"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}`),
+			err:   "kzg_commitments_inclusion_proof: missing",
+		},
+		{
+			name:  "KZGCommitmentsInclusionProofShort",
+			input: []byte(`{"index":"5","column":[],"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+			err:   "kzg_commitments_inclusion_proof: incorrect number of elements",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"index":"5","column":[],"kzg_commitments":["0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"kzg_proofs":["0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"],"signed_block_header":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_root":"0x0200000000000000000000000000000000000000000000000000000000000000","body_root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"signature":"0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"kzg_commitments_inclusion_proof":["0xaa00000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000000"]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res fulu.DataColumnSidecar
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				if len(test.output) > 0 {
+					assert.Equal(t, string(test.output), string(rt))
+				} else {
+					assert.Equal(t, string(test.input), string(rt))
+				}
+			}
+		})
+	}
+}
+
+func TestDataColumnSidecarYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		root  []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{index: 5, column: [], kzg_commitments: ['0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000'], kzg_proofs: ['0x030400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000'], signed_block_header: {message: {slot: 1, proposer_index: 2, parent_root: '0x0100000000000000000000000000000000000000000000000000000000000000', state_root: '0x0200000000000000000000000000000000000000000000000000000000000000', body_root: '0x0300000000000000000000000000000000000000000000000000000000000000'}, signature: '0x040000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000'}, kzg_commitments_inclusion_proof: ['0xaa00000000000000000000000000000000000000000000000000000000000000', '0x0000000000000000000000000000000000000000000000000000000000000000', '0x0000000000000000000000000000000000000000000000000000000000000000', '0x0000000000000000000000000000000000000000000000000000000000000000']}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res fulu.DataColumnSidecar
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, testYAMLFormat([]byte(res.String())), testYAMLFormat(rt))
+				assert.Equal(t, testYAMLFormat(test.input), testYAMLFormat(rt))
+			}
+		})
+	}
+}
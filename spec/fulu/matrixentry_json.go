@@ -0,0 +1,67 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+// matrixEntryJSON is the spec representation of the struct.
+type matrixEntryJSON struct {
+	Cell        Cell           `json:"cell"`
+	KZGProof    deneb.KZGProof `json:"kzg_proof"`
+	ColumnIndex string         `json:"column_index"`
+	RowIndex    string         `json:"row_index"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m *MatrixEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&matrixEntryJSON{
+		Cell:        m.Cell,
+		KZGProof:    m.KZGProof,
+		ColumnIndex: fmt.Sprintf("%d", m.ColumnIndex),
+		RowIndex:    fmt.Sprintf("%d", m.RowIndex),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *MatrixEntry) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&matrixEntryJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Cell.UnmarshalJSON(raw["cell"]); err != nil {
+		return errors.Wrap(err, "cell")
+	}
+
+	if err := m.KZGProof.UnmarshalJSON(raw["kzg_proof"]); err != nil {
+		return errors.Wrap(err, "kzg_proof")
+	}
+
+	if err := m.ColumnIndex.UnmarshalJSON(raw["column_index"]); err != nil {
+		return errors.Wrap(err, "column_index")
+	}
+
+	if err := m.RowIndex.UnmarshalJSON(raw["row_index"]); err != nil {
+		return errors.Wrap(err, "row_index")
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testutil"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// fuzzContainer is a container that can be fuzzed.
+type fuzzContainer interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// fuzzTypes provides a constructor for each fork in which a type exists.
+type fuzzTypes []func() fuzzContainer
+
+var (
+	attestationTypes = fuzzTypes{
+		func() fuzzContainer { return &phase0.Attestation{} },
+		func() fuzzContainer { return &electra.Attestation{} },
+	}
+	attesterSlashingTypes = fuzzTypes{
+		func() fuzzContainer { return &phase0.AttesterSlashing{} },
+		func() fuzzContainer { return &electra.AttesterSlashing{} },
+	}
+	beaconBlockBodyTypes = fuzzTypes{
+		func() fuzzContainer { return &phase0.BeaconBlockBody{} },
+		func() fuzzContainer { return &altair.BeaconBlockBody{} },
+		func() fuzzContainer { return &bellatrix.BeaconBlockBody{} },
+		func() fuzzContainer { return &capella.BeaconBlockBody{} },
+		func() fuzzContainer { return &deneb.BeaconBlockBody{} },
+		func() fuzzContainer { return &electra.BeaconBlockBody{} },
+		func() fuzzContainer { return &fulu.BeaconBlockBody{} },
+	}
+	beaconBlockTypes = fuzzTypes{
+		func() fuzzContainer { return &phase0.BeaconBlock{} },
+		func() fuzzContainer { return &altair.BeaconBlock{} },
+		func() fuzzContainer { return &bellatrix.BeaconBlock{} },
+		func() fuzzContainer { return &capella.BeaconBlock{} },
+		func() fuzzContainer { return &deneb.BeaconBlock{} },
+		func() fuzzContainer { return &electra.BeaconBlock{} },
+		func() fuzzContainer { return &fulu.BeaconBlock{} },
+	}
+	signedBeaconBlockTypes = fuzzTypes{
+		func() fuzzContainer { return &phase0.SignedBeaconBlock{} },
+		func() fuzzContainer { return &altair.SignedBeaconBlock{} },
+		func() fuzzContainer { return &bellatrix.SignedBeaconBlock{} },
+		func() fuzzContainer { return &capella.SignedBeaconBlock{} },
+		func() fuzzContainer { return &deneb.SignedBeaconBlock{} },
+		func() fuzzContainer { return &electra.SignedBeaconBlock{} },
+		func() fuzzContainer { return &fulu.SignedBeaconBlock{} },
+	}
+	beaconStateTypes = fuzzTypes{
+		func() fuzzContainer { return &phase0.BeaconState{} },
+		func() fuzzContainer { return &altair.BeaconState{} },
+		func() fuzzContainer { return &bellatrix.BeaconState{} },
+		func() fuzzContainer { return &capella.BeaconState{} },
+		func() fuzzContainer { return &deneb.BeaconState{} },
+		func() fuzzContainer { return &electra.BeaconState{} },
+		func() fuzzContainer { return &fulu.BeaconState{} },
+	}
+	executionPayloadTypes = fuzzTypes{
+		func() fuzzContainer { return &bellatrix.ExecutionPayload{} },
+		func() fuzzContainer { return &capella.ExecutionPayload{} },
+		func() fuzzContainer { return &deneb.ExecutionPayload{} },
+	}
+	blobSidecarTypes = fuzzTypes{
+		func() fuzzContainer { return &deneb.BlobSidecar{} },
+	}
+	dataColumnSidecarTypes = fuzzTypes{
+		func() fuzzContainer { return &fulu.DataColumnSidecar{} },
+	}
+)
+
+// seeds generates a valid and a truncated encoding of each type as a seed corpus.
+func (types fuzzTypes) seeds(f *testing.F, encode func(fuzzContainer) ([]byte, error)) {
+	f.Helper()
+
+	generator := testutil.NewGenerator(0)
+	for i, constructor := range types {
+		data := constructor()
+		if err := generator.Fill(data); err != nil {
+			f.Fatalf("failed to generate seed: %v", err)
+		}
+		encoded, err := encode(data)
+		if err != nil {
+			f.Fatalf("failed to encode seed: %v", err)
+		}
+		f.Add(uint8(i), encoded)
+		f.Add(uint8(i), encoded[:len(encoded)/2])
+	}
+	f.Add(uint8(0), []byte{})
+}
+
+// fuzzSSZ fuzzes SSZ unmarshalling of the types.
+func fuzzSSZ(f *testing.F, types fuzzTypes) {
+	f.Helper()
+
+	types.seeds(f, func(data fuzzContainer) ([]byte, error) { return data.MarshalSSZ() })
+	f.Fuzz(func(_ *testing.T, fork uint8, input []byte) {
+		data := types[int(fork)%len(types)]()
+		if err := data.UnmarshalSSZ(input); err != nil {
+			return
+		}
+		// Successfully decoded data must be usable.
+		_, _ = data.MarshalSSZ()
+		_, _ = data.HashTreeRoot()
+	})
+}
+
+// fuzzJSON fuzzes JSON unmarshalling of the types.
+func fuzzJSON(f *testing.F, types fuzzTypes) {
+	f.Helper()
+
+	types.seeds(f, func(data fuzzContainer) ([]byte, error) { return json.Marshal(data) })
+	f.Fuzz(func(_ *testing.T, fork uint8, input []byte) {
+		data := types[int(fork)%len(types)]()
+		if err := json.Unmarshal(input, data); err != nil {
+			return
+		}
+		// Successfully decoded data must be usable.
+		_, _ = json.Marshal(data)
+		_, _ = data.MarshalSSZ()
+		_, _ = data.HashTreeRoot()
+	})
+}
+
+func FuzzAttestationSSZ(f *testing.F)        { fuzzSSZ(f, attestationTypes) }
+func FuzzAttestationJSON(f *testing.F)       { fuzzJSON(f, attestationTypes) }
+func FuzzAttesterSlashingSSZ(f *testing.F)   { fuzzSSZ(f, attesterSlashingTypes) }
+func FuzzAttesterSlashingJSON(f *testing.F)  { fuzzJSON(f, attesterSlashingTypes) }
+func FuzzBeaconBlockBodySSZ(f *testing.F)    { fuzzSSZ(f, beaconBlockBodyTypes) }
+func FuzzBeaconBlockBodyJSON(f *testing.F)   { fuzzJSON(f, beaconBlockBodyTypes) }
+func FuzzBeaconBlockSSZ(f *testing.F)        { fuzzSSZ(f, beaconBlockTypes) }
+func FuzzBeaconBlockJSON(f *testing.F)       { fuzzJSON(f, beaconBlockTypes) }
+func FuzzSignedBeaconBlockSSZ(f *testing.F)  { fuzzSSZ(f, signedBeaconBlockTypes) }
+func FuzzSignedBeaconBlockJSON(f *testing.F) { fuzzJSON(f, signedBeaconBlockTypes) }
+func FuzzBeaconStateSSZ(f *testing.F)        { fuzzSSZ(f, beaconStateTypes) }
+func FuzzBeaconStateJSON(f *testing.F)       { fuzzJSON(f, beaconStateTypes) }
+func FuzzExecutionPayloadSSZ(f *testing.F)   { fuzzSSZ(f, executionPayloadTypes) }
+func FuzzExecutionPayloadJSON(f *testing.F)  { fuzzJSON(f, executionPayloadTypes) }
+func FuzzBlobSidecarSSZ(f *testing.F)        { fuzzSSZ(f, blobSidecarTypes) }
+func FuzzBlobSidecarJSON(f *testing.F)       { fuzzJSON(f, blobSidecarTypes) }
+func FuzzDataColumnSidecarSSZ(f *testing.F)  { fuzzSSZ(f, dataColumnSidecarTypes) }
+func FuzzDataColumnSidecarJSON(f *testing.F) { fuzzJSON(f, dataColumnSidecarTypes) }
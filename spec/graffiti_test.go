@@ -0,0 +1,116 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraffitiJSON(t *testing.T) {
+	defer codecs.SetJSONMode(codecs.JSONModeStandard)
+
+	types := append(fuzzTypes{
+		func() fuzzContainer { return &apiv1bellatrix.BlindedBeaconBlockBody{} },
+		func() fuzzContainer { return &apiv1capella.BlindedBeaconBlockBody{} },
+		func() fuzzContainer { return &apiv1deneb.BlindedBeaconBlockBody{} },
+		func() fuzzContainer { return &apiv1electra.BlindedBeaconBlockBody{} },
+		func() fuzzContainer { return &apiv1fulu.BlindedBeaconBlockBody{} },
+	}, beaconBlockBodyTypes...)
+
+	tests := []struct {
+		name     string
+		mode     codecs.JSONMode
+		graffiti string
+		expected [32]byte
+		err      bool
+	}{
+		{
+			name:     "Full",
+			graffiti: "0x6869000000000000000000000000000000000000000000000000000000000000",
+			expected: [32]byte{'h', 'i'},
+		},
+		{
+			name:     "Short",
+			graffiti: "0x6869",
+			expected: [32]byte{'h', 'i'},
+		},
+		{
+			name:     "Empty",
+			graffiti: "0x",
+		},
+		{
+			name:     "Long",
+			graffiti: "0x686900000000000000000000000000000000000000000000000000000000000000",
+			err:      true,
+		},
+		{
+			name:     "Text",
+			graffiti: "hi",
+			err:      true,
+		},
+		{
+			name:     "TextLenient",
+			mode:     codecs.JSONModeLenient,
+			graffiti: "hi",
+			expected: [32]byte{'h', 'i'},
+		},
+		{
+			name:     "TextLenientLong",
+			mode:     codecs.JSONModeLenient,
+			graffiti: "this graffiti is longer than 32 bytes",
+			err:      true,
+		},
+	}
+
+	generator := testutil.NewGenerator(0)
+	for _, constructor := range types {
+		body := constructor()
+		require.NoError(t, generator.Fill(body))
+		input, err := json.Marshal(body)
+		require.NoError(t, err)
+		fields := make(map[string]any)
+		require.NoError(t, json.Unmarshal(input, &fields))
+
+		for _, test := range tests {
+			t.Run(reflect.TypeOf(body).Elem().String()+"/"+test.name, func(t *testing.T) {
+				codecs.SetJSONMode(test.mode)
+				defer codecs.SetJSONMode(codecs.JSONModeStandard)
+
+				fields["graffiti"] = test.graffiti
+				input, err := json.Marshal(fields)
+				require.NoError(t, err)
+
+				res := constructor()
+				err = json.Unmarshal(input, res)
+				if test.err {
+					require.ErrorContains(t, err, "graffiti")
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, test.expected, reflect.ValueOf(res).Elem().FieldByName("Graffiti").Interface())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sszstream decodes large SSZ containers from a stream, without holding
+// the entire encoding in memory.
+package sszstream
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Field is a variable-size field of a container.
+type Field struct {
+	// Offset is the position of the field's offset in the fixed part of the container.
+	Offset int
+	// ElementSize is the size of each element of the field, if it is streamed.
+	// If 0 the field is not streamed, and is instead decoded with the rest of the
+	// container.
+	ElementSize int
+	// MaxElements is the maximum number of elements in the field, if it is streamed.
+	MaxElements uint64
+	// Element is called with the encoding of each element of the field, if it is
+	// streamed.  The data is only valid for the duration of the call.
+	Element func(data []byte) error
+}
+
+// Unmarshal decodes an SSZ container from a reader.
+//
+// fixedSize is the size of the fixed part of the container, and fields are its
+// variable-size fields in order.  Streamed fields are passed element by element
+// to their handlers as they are read.  The remainder of the container, with the
+// streamed fields empty, is passed to unmarshal once the reader is exhausted.
+func Unmarshal(r io.Reader, fixedSize int, fields []*Field, unmarshal func([]byte) error) error {
+	reader := bufio.NewReaderSize(r, 64*1024)
+
+	buf := make([]byte, fixedSize)
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return ssz.ErrSize
+		}
+
+		return err
+	}
+
+	offsets := make([]uint64, len(fields))
+	for i, field := range fields {
+		offsets[i] = uint64(binary.LittleEndian.Uint32(buf[field.Offset : field.Offset+4]))
+		if i == 0 && offsets[i] != uint64(fixedSize) {
+			return ssz.ErrInvalidVariableOffset
+		}
+		if i > 0 && offsets[i] < offsets[i-1] {
+			return ssz.ErrOffset
+		}
+	}
+
+	// The new offset of each field in the encoding passed to unmarshal.
+	offset := uint64(fixedSize)
+	for i, field := range fields {
+		binary.LittleEndian.PutUint32(buf[field.Offset:field.Offset+4], uint32(offset))
+
+		// The length of the last field is not known, so it runs to the end of the reader.
+		length := int64(-1)
+		if i < len(fields)-1 {
+			length = int64(offsets[i+1] - offsets[i])
+		}
+
+		fieldReader := io.Reader(reader)
+		if length >= 0 {
+			fieldReader = io.LimitReader(reader, length)
+		}
+
+		if field.Element == nil {
+			data, err := io.ReadAll(fieldReader)
+			if err != nil {
+				return err
+			}
+			if int64(len(data)) < length {
+				return ssz.ErrSize
+			}
+			buf = append(buf, data...)
+			offset += uint64(len(data))
+
+			continue
+		}
+
+		if err := streamElements(fieldReader, length, field); err != nil {
+			return err
+		}
+	}
+
+	return unmarshal(buf)
+}
+
+// streamElements passes the elements of a field to its handler.
+func streamElements(r io.Reader, length int64, field *Field) error {
+	if length >= 0 && length%int64(field.ElementSize) != 0 {
+		return ssz.ErrSize
+	}
+
+	element := make([]byte, field.ElementSize)
+	for elements := uint64(0); ; elements++ {
+		if _, err := io.ReadFull(r, element); err != nil {
+			if errors.Is(err, io.EOF) {
+				if length >= 0 && int64(elements)*int64(field.ElementSize) < length {
+					return ssz.ErrSize
+				}
+
+				return nil
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return ssz.ErrSize
+			}
+
+			return err
+		}
+		if elements == field.MaxElements {
+			return ssz.ErrListTooBig
+		}
+		if err := field.Element(element); err != nil {
+			return err
+		}
+	}
+}
+
+// Uint64s returns an element handler that appends 8-byte elements to dst.
+func Uint64s[T ~uint64](dst *[]T) func([]byte) error {
+	return func(data []byte) error {
+		*dst = append(*dst, T(binary.LittleEndian.Uint64(data)))
+
+		return nil
+	}
+}
+
+// Uint8s returns an element handler that appends 1-byte elements to dst.
+func Uint8s[T ~uint8](dst *[]T) func([]byte) error {
+	return func(data []byte) error {
+		*dst = append(*dst, T(data[0]))
+
+		return nil
+	}
+}
+
+// Objects returns an element handler that unmarshals fixed-size objects and
+// appends them to dst.
+func Objects[T any, PT interface {
+	*T
+	UnmarshalSSZ([]byte) error
+}](dst *[]PT,
+) func([]byte) error {
+	return func(data []byte) error {
+		obj := PT(new(T))
+		if err := obj.UnmarshalSSZ(data); err != nil {
+			return err
+		}
+		*dst = append(*dst, obj)
+
+		return nil
+	}
+}
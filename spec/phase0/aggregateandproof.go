@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -58,7 +59,7 @@ func (a *AggregateAndProof) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *AggregateAndProof) UnmarshalJSON(input []byte) error {
 	var aggregateAndProofJSON aggregateAndProofJSON
-	if err := json.Unmarshal(input, &aggregateAndProofJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &aggregateAndProofJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 	bitfield "github.com/prysmaticlabs/go-bitfield"
@@ -58,7 +59,7 @@ func (a *Attestation) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *Attestation) UnmarshalJSON(input []byte) error {
 	var attestationJSON attestationJSON
-	err := json.Unmarshal(input, &attestationJSON)
+	err := codecs.UnmarshalJSON(input, &attestationJSON)
 	if err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -66,7 +67,7 @@ func (a *AttestationData) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *AttestationData) UnmarshalJSON(input []byte) error {
 	var attestationDataJSON attestationDataJSON
-	if err := json.Unmarshal(input, &attestationDataJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &attestationDataJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
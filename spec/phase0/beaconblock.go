@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -66,7 +67,7 @@ func (b *BeaconBlock) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (b *BeaconBlock) UnmarshalJSON(input []byte) error {
 	var beaconBlockJSON beaconBlockJSON
-	if err := json.Unmarshal(input, &beaconBlockJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &beaconBlockJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
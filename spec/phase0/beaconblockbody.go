@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -77,7 +78,7 @@ func (b *BeaconBlockBody) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (b *BeaconBlockBody) UnmarshalJSON(input []byte) error {
 	var beaconBlockBodyJSON beaconBlockBodyJSON
-	if err := json.Unmarshal(input, &beaconBlockBodyJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &beaconBlockBodyJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -103,13 +104,14 @@ func (b *BeaconBlockBody) unpack(beaconBlockBodyJSON *beaconBlockBodyJSON) error
 	if beaconBlockBodyJSON.Graffiti == "" {
 		return errors.New("graffiti missing")
 	}
-	graffiti, err := hex.DecodeString(strings.TrimPrefix(beaconBlockBodyJSON.Graffiti, "0x"))
+	graffiti, err := codecs.DecodeGraffiti(beaconBlockBodyJSON.Graffiti)
 	if err != nil {
 		return errors.Wrap(err, "invalid value for graffiti")
 	}
-	if len(graffiti) != GraffitiLength {
+	if len(graffiti) > GraffitiLength {
 		return errors.New("incorrect length for graffiti")
 	}
+	b.Graffiti = [32]byte{}
 	copy(b.Graffiti[:], graffiti)
 	if beaconBlockBodyJSON.ProposerSlashings == nil {
 		return errors.New("proposer slashings missing")
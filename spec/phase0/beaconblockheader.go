@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -66,7 +67,7 @@ func (b *BeaconBlockHeader) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (b *BeaconBlockHeader) UnmarshalJSON(input []byte) error {
 	var beaconBlockHeaderJSON beaconBlockHeaderJSON
-	if err := json.Unmarshal(input, &beaconBlockHeaderJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &beaconBlockHeaderJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
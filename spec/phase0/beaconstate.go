@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 	bitfield "github.com/prysmaticlabs/go-bitfield"
@@ -158,7 +159,7 @@ func (s *BeaconState) UnmarshalJSON(input []byte) error {
 	var err error
 
 	var data beaconStateJSON
-	if err = json.Unmarshal(input, &data); err != nil {
+	if err = codecs.UnmarshalJSON(input, &data); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
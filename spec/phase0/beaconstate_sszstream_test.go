@@ -0,0 +1,124 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+func testBeaconState(validators int) *phase0.BeaconState {
+	state := &phase0.BeaconState{
+		GenesisTime:       12345,
+		Slot:              100,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		HistoricalRoots:   []phase0.Root{{0x01}, {0x02}},
+		ETH1Data: &phase0.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		ETH1DataVotes: []*phase0.ETH1Data{
+			{DepositCount: 3, BlockHash: make([]byte, 32)},
+		},
+		Validators:  make([]*phase0.Validator, 0, validators),
+		Balances:    make([]phase0.Gwei, 0, validators),
+		RANDAOMixes: make([]phase0.Root, 65536),
+		Slashings:   make([]phase0.Gwei, 8192),
+		PreviousEpochAttestations: []*phase0.PendingAttestation{
+			{
+				AggregationBits: bitfield.NewBitlist(8),
+				Data: &phase0.AttestationData{
+					Source: &phase0.Checkpoint{},
+					Target: &phase0.Checkpoint{},
+				},
+				InclusionDelay: 1,
+			},
+		},
+		CurrentEpochAttestations:    make([]*phase0.PendingAttestation, 0),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{Epoch: 1},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{Epoch: 2},
+		FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 1},
+	}
+	for i := 0; i < validators; i++ {
+		state.Validators = append(state.Validators, &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ExitEpoch:             phase0.Epoch(i),
+		})
+		state.Balances = append(state.Balances, phase0.Gwei(32000000000+i))
+	}
+
+	return state
+}
+
+func TestBeaconStateUnmarshalSSZFrom(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+			err:   "incorrect size",
+		},
+		{
+			name:  "NoValidators",
+			input: mustMarshalSSZ(t, testBeaconState(0)),
+		},
+		{
+			name:  "Good",
+			input: mustMarshalSSZ(t, testBeaconState(100)),
+		},
+		{
+			name:  "Truncated",
+			input: mustMarshalSSZ(t, testBeaconState(100))[:2687377+200],
+			err:   "incorrect size",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res phase0.BeaconState
+			err := res.UnmarshalSSZFrom(bytes.NewReader(test.input))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			var expected phase0.BeaconState
+			require.NoError(t, expected.UnmarshalSSZ(test.input))
+			require.Equal(t, expected, res)
+		})
+	}
+}
+
+func mustMarshalSSZ(t *testing.T, state *phase0.BeaconState) []byte {
+	t.Helper()
+
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	return data
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -54,7 +55,7 @@ func (c *Checkpoint) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (c *Checkpoint) UnmarshalJSON(input []byte) error {
 	var checkpointJSON checkpointJSON
-	err := json.Unmarshal(input, &checkpointJSON)
+	err := codecs.UnmarshalJSON(input, &checkpointJSON)
 	if err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
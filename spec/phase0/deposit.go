@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -58,7 +59,7 @@ func (d *Deposit) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (d *Deposit) UnmarshalJSON(input []byte) error {
 	var depositJSON depositJSON
-	if err := json.Unmarshal(input, &depositJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &depositJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
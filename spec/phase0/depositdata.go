@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -62,7 +63,7 @@ func (d *DepositData) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (d *DepositData) UnmarshalJSON(input []byte) error {
 	var depositDataJSON depositDataJSON
-	if err := json.Unmarshal(input, &depositDataJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &depositDataJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
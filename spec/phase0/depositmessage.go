@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -58,7 +59,7 @@ func (d *DepositMessage) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (d *DepositMessage) UnmarshalJSON(input []byte) error {
 	var depositMessageJSON depositMessageJSON
-	if err := json.Unmarshal(input, &depositMessageJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &depositMessageJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
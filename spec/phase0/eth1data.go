@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -59,7 +60,7 @@ func (e *ETH1Data) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (e *ETH1Data) UnmarshalJSON(input []byte) error {
 	var eth1DataJSON eth1DataJSON
-	if err := json.Unmarshal(input, &eth1DataJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &eth1DataJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -61,7 +62,7 @@ func (f *Fork) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (f *Fork) UnmarshalJSON(input []byte) error {
 	var forkJSON forkJSON
-	if err := json.Unmarshal(input, &forkJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &forkJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
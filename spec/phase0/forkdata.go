@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -55,7 +56,7 @@ func (f *ForkData) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (f *ForkData) UnmarshalJSON(input []byte) error {
 	var forkDataJSON forkDataJSON
-	if err := json.Unmarshal(input, &forkDataJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &forkDataJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
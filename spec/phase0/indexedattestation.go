@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -64,7 +65,7 @@ func (i *IndexedAttestation) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (i *IndexedAttestation) UnmarshalJSON(input []byte) error {
 	var indexedAttestationJSON indexedAttestationJSON
-	if err := json.Unmarshal(input, &indexedAttestationJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &indexedAttestationJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
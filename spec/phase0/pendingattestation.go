@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 	bitfield "github.com/prysmaticlabs/go-bitfield"
@@ -63,7 +64,7 @@ func (p *PendingAttestation) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (p *PendingAttestation) UnmarshalJSON(input []byte) error {
 	var pendingAttestationJSON pendingAttestationJSON
-	if err := json.Unmarshal(input, &pendingAttestationJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &pendingAttestationJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -51,7 +52,7 @@ func (p *ProposerSlashing) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (p *ProposerSlashing) UnmarshalJSON(input []byte) error {
 	var proposerSlashingJSON proposerSlashingJSON
-	if err := json.Unmarshal(input, &proposerSlashingJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &proposerSlashingJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
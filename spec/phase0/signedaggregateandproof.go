@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -53,7 +54,7 @@ func (s *SignedAggregateAndProof) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SignedAggregateAndProof) UnmarshalJSON(input []byte) error {
 	var signedAggregateAndProofJSON signedAggregateAndProofJSON
-	if err := json.Unmarshal(input, &signedAggregateAndProofJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &signedAggregateAndProofJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -53,7 +54,7 @@ func (s *SignedBeaconBlockHeader) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SignedBeaconBlockHeader) UnmarshalJSON(input []byte) error {
 	var signedBeaconBlockHeaderJSON signedBeaconBlockHeaderJSON
-	if err := json.Unmarshal(input, &signedBeaconBlockHeaderJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &signedBeaconBlockHeaderJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -53,7 +54,7 @@ func (s *SignedVoluntaryExit) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SignedVoluntaryExit) UnmarshalJSON(input []byte) error {
 	var signedVoluntaryExitJSON signedVoluntaryExitJSON
-	if err := json.Unmarshal(input, &signedVoluntaryExitJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &signedVoluntaryExitJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -53,7 +54,7 @@ func (s *SigningData) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *SigningData) UnmarshalJSON(input []byte) error {
 	var signingDataJSON signingDataJSON
-	if err := json.Unmarshal(input, &signingDataJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &signingDataJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -21,10 +21,20 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
 
+const (
+	// eth1AddressWithdrawalPrefix is the prefix of withdrawal credentials that
+	// withdraw to an execution address.
+	eth1AddressWithdrawalPrefix = 0x01
+	// compoundingWithdrawalPrefix is the prefix of withdrawal credentials that
+	// withdraw to an execution address and compound rewards (EIP-7251).
+	compoundingWithdrawalPrefix = 0x02
+)
+
 // Validator is the Ethereum 2 validator structure.
 type Validator struct {
 	PublicKey                  BLSPubKey `ssz-size:"48"`
@@ -78,7 +88,7 @@ func (v *Validator) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (v *Validator) UnmarshalJSON(input []byte) error {
 	var validatorJSON validatorJSON
-	if err := json.Unmarshal(input, &validatorJSON); err != nil {
+	if err := codecs.UnmarshalJSON(input, &validatorJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
 
@@ -191,3 +201,24 @@ func (v *Validator) String() string {
 
 	return string(data)
 }
+
+// HasETH1WithdrawalCredentials returns true if the validator has 0x01 withdrawal credentials.
+func (v *Validator) HasETH1WithdrawalCredentials() bool {
+	return len(v.WithdrawalCredentials) > 0 && v.WithdrawalCredentials[0] == eth1AddressWithdrawalPrefix
+}
+
+// HasCompoundingCredentials returns true if the validator has 0x02 withdrawal credentials.
+func (v *Validator) HasCompoundingCredentials() bool {
+	return len(v.WithdrawalCredentials) > 0 && v.WithdrawalCredentials[0] == compoundingWithdrawalPrefix
+}
+
+// HasExecutionWithdrawalCredentials returns true if the validator has either
+// 0x01 or 0x02 withdrawal credentials.
+func (v *Validator) HasExecutionWithdrawalCredentials() bool {
+	return v.HasETH1WithdrawalCredentials() || v.HasCompoundingCredentials()
+}
+
+// IsActive returns true if the validator is active at the given epoch.
+func (v *Validator) IsActive(epoch Epoch) bool {
+	return v.ActivationEpoch <= epoch && epoch < v.ExitEpoch
+}
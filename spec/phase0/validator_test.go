@@ -221,3 +221,54 @@ func TestValidatorYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorWithdrawalCredentials(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials []byte
+		eth1        bool
+		compounding bool
+	}{
+		{
+			name: "Missing",
+		},
+		{
+			name:        "BLS",
+			credentials: bytes.Repeat([]byte{0x00}, 32),
+		},
+		{
+			name:        "ETH1",
+			credentials: append([]byte{0x01}, bytes.Repeat([]byte{0x00}, 31)...),
+			eth1:        true,
+		},
+		{
+			name:        "Compounding",
+			credentials: append([]byte{0x02}, bytes.Repeat([]byte{0x00}, 31)...),
+			compounding: true,
+		},
+		{
+			name:        "Unknown",
+			credentials: append([]byte{0x03}, bytes.Repeat([]byte{0x00}, 31)...),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := &phase0.Validator{WithdrawalCredentials: test.credentials}
+			require.Equal(t, test.eth1, validator.HasETH1WithdrawalCredentials())
+			require.Equal(t, test.compounding, validator.HasCompoundingCredentials())
+			require.Equal(t, test.eth1 || test.compounding, validator.HasExecutionWithdrawalCredentials())
+		})
+	}
+}
+
+func TestValidatorIsActive(t *testing.T) {
+	validator := &phase0.Validator{
+		ActivationEpoch: 10,
+		ExitEpoch:       20,
+	}
+	require.False(t, validator.IsActive(9))
+	require.True(t, validator.IsActive(10))
+	require.True(t, validator.IsActive(19))
+	require.False(t, validator.IsActive(20))
+}
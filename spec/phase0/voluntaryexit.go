@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
@@ -52,7 +53,7 @@ func (v *VoluntaryExit) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 func (v *VoluntaryExit) UnmarshalJSON(input []byte) error {
 	var voluntaryExitJSON voluntaryExitJSON
-	err := json.Unmarshal(input, &voluntaryExitJSON)
+	err := codecs.UnmarshalJSON(input, &voluntaryExitJSON)
 	if err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
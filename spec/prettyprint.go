@@ -0,0 +1,306 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+const (
+	// prettyPrintMaxBytes is the number of bytes above which byte values are truncated.
+	prettyPrintMaxBytes = 32
+	// prettyPrintMaxInline is the number of scalar items above which lists are truncated.
+	prettyPrintMaxInline = 16
+	// gweiPerEther is the number of Gwei in an Ether.
+	gweiPerEther = 1e9
+)
+
+var (
+	slotType     = reflect.TypeOf(phase0.Slot(0))
+	epochType    = reflect.TypeOf(phase0.Epoch(0))
+	gweiType     = reflect.TypeOf(phase0.Gwei(0))
+	bitlistType  = reflect.TypeOf(bitfield.Bitlist{})
+	timeType     = reflect.TypeOf(time.Time{})
+	bigIntType   = reflect.TypeOf(big.Int{})
+	uint256Type  = reflect.TypeOf(uint256.Int{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// PrettyPrint returns an indented, human-readable rendering of the supplied
+// value, with one field per line.  Slots, epochs and Gwei amounts are
+// annotated, byte values are rendered as hex and truncated if long, and
+// unset forks of versioned containers are omitted.
+func PrettyPrint(v any) string {
+	builder := &strings.Builder{}
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return "<nil>\n"
+	}
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "<nil>\n"
+		}
+		value = value.Elem()
+	}
+
+	if isCompound(value) {
+		prettyPrintCompound(builder, value, 0)
+	} else {
+		builder.WriteString(prettyPrintScalar(value, ""))
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+// isCompound returns true if the value is rendered over multiple lines.
+func isCompound(value reflect.Value) bool {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return false
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		switch value.Type() {
+		case timeType, bigIntType, uint256Type:
+			return false
+		}
+
+		return hasExportedFields(value.Type())
+	case reflect.Map:
+		return value.Len() > 0
+	case reflect.Slice, reflect.Array:
+		if isBytes(value.Type()) || value.Len() == 0 {
+			return false
+		}
+
+		return isCompound(value.Index(0))
+	default:
+		return false
+	}
+}
+
+// prettyPrintCompound writes a struct, map or list of compound items.
+func prettyPrintCompound(builder *strings.Builder, value reflect.Value, indent int) {
+	prefix := strings.Repeat("  ", indent)
+	switch value.Kind() {
+	case reflect.Struct:
+		versioned := value.FieldByName("Version").IsValid()
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldValue := value.Field(i)
+			if versioned && isNil(fieldValue) {
+				continue
+			}
+			prettyPrintEntry(builder, prefix, field.Name, fieldValue, indent)
+		}
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			prettyPrintEntry(builder, prefix, fmt.Sprint(key.Interface()), value.MapIndex(key), indent)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			prettyPrintEntry(builder, prefix, fmt.Sprintf("[%d]", i), value.Index(i), indent)
+		}
+	}
+}
+
+// prettyPrintEntry writes a single named entry.
+func prettyPrintEntry(builder *strings.Builder, prefix string, name string, value reflect.Value, indent int) {
+	builder.WriteString(prefix)
+	builder.WriteString(name)
+	builder.WriteString(":")
+	if !isCompound(value) {
+		builder.WriteString(" ")
+		builder.WriteString(prettyPrintScalar(value, name))
+		builder.WriteString("\n")
+
+		return
+	}
+
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		if value.Len() == 1 {
+			builder.WriteString(" (1 item)")
+		} else {
+			builder.WriteString(fmt.Sprintf(" (%d items)", value.Len()))
+		}
+	}
+	builder.WriteString("\n")
+	prettyPrintCompound(builder, value, indent+1)
+}
+
+// prettyPrintScalar returns the single-line rendering of a value.
+func prettyPrintScalar(value reflect.Value, name string) string {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "<nil>"
+		}
+		value = value.Elem()
+	}
+
+	switch value.Type() {
+	case slotType:
+		return fmt.Sprintf("%d", value.Uint())
+	case epochType:
+		if value.Uint() == math.MaxUint64 {
+			return "far future"
+		}
+
+		return fmt.Sprintf("%d", value.Uint())
+	case gweiType:
+		return fmt.Sprintf("%d Gwei (%s ETH)", value.Uint(),
+			big.NewFloat(float64(value.Uint())/gweiPerEther).Text('f', -1))
+	case bitlistType:
+		bits := bitfield.Bitlist(value.Bytes())
+		if len(bits) == 0 {
+			return "<empty bitlist>"
+		}
+
+		return fmt.Sprintf("%d bits, %d set", bits.Len(), bits.Count())
+	case timeType:
+		return value.Interface().(time.Time).Format(time.RFC3339)
+	case bigIntType:
+		bigInt := value.Interface().(big.Int)
+
+		return bigInt.String()
+	case uint256Type:
+		uint256Int := value.Interface().(uint256.Int)
+
+		return uint256Int.Dec()
+	}
+
+	if isBytes(value.Type()) {
+		data := bytesOf(value)
+		if name == "Graffiti" {
+			if text, ok := graffitiText(data); ok {
+				return fmt.Sprintf("%q", text)
+			}
+		}
+
+		return prettyPrintBytes(data)
+	}
+
+	if value.Type().Implements(stringerType) && value.Kind() != reflect.Struct {
+		return value.Interface().(fmt.Stringer).String()
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		if value.Len() == 0 {
+			return "[]"
+		}
+		items := make([]string, 0, min(value.Len(), prettyPrintMaxInline))
+		for i := 0; i < value.Len() && i < prettyPrintMaxInline; i++ {
+			items = append(items, prettyPrintScalar(value.Index(i), ""))
+		}
+		if value.Len() > prettyPrintMaxInline {
+			return fmt.Sprintf("[%s ...] (%d items)", strings.Join(items, ", "), value.Len())
+		}
+
+		return fmt.Sprintf("[%s]", strings.Join(items, ", "))
+	case reflect.Map:
+		return "{}"
+	case reflect.String:
+		return fmt.Sprintf("%q", value.String())
+	default:
+		return fmt.Sprintf("%v", value.Interface())
+	}
+}
+
+// prettyPrintBytes returns the hex rendering of a byte value.
+func prettyPrintBytes(data []byte) string {
+	if len(data) > prettyPrintMaxBytes {
+		return fmt.Sprintf("%#x... (%d bytes)", data[:prettyPrintMaxBytes/2], len(data))
+	}
+
+	return "0x" + hex.EncodeToString(data)
+}
+
+// graffitiText returns the graffiti as text if it is printable.
+func graffitiText(data []byte) (string, bool) {
+	trimmed := strings.TrimRight(string(data), "\x00")
+	if !utf8.ValidString(trimmed) {
+		return "", false
+	}
+	for _, r := range trimmed {
+		if !unicode.IsPrint(r) {
+			return "", false
+		}
+	}
+
+	return trimmed, true
+}
+
+// isBytes returns true if the type is a byte slice or array.
+func isBytes(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8
+}
+
+// bytesOf returns the contents of a byte slice or array.
+func bytesOf(value reflect.Value) []byte {
+	if value.Kind() == reflect.Slice {
+		return value.Bytes()
+	}
+	data := make([]byte, value.Len())
+	reflect.Copy(reflect.ValueOf(data), value)
+
+	return data
+}
+
+// isNil returns true if the value is a nil pointer, interface, slice or map.
+func isNil(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
+
+// hasExportedFields returns true if the struct type has any exported fields.
+func hasExportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,173 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrettyPrint(t *testing.T) {
+	graffiti := [32]byte{}
+	copy(graffiti[:], "hello")
+
+	tests := []struct {
+		name     string
+		input    any
+		expected string
+	}{
+		{
+			name:     "Nil",
+			input:    nil,
+			expected: "<nil>\n",
+		},
+		{
+			name:     "NilPointer",
+			input:    (*phase0.Checkpoint)(nil),
+			expected: "<nil>\n",
+		},
+		{
+			name:     "Scalar",
+			input:    phase0.Gwei(1500000000),
+			expected: "1500000000 Gwei (1.5 ETH)\n",
+		},
+		{
+			name: "Validator",
+			input: &phase0.Validator{
+				PublicKey:                  phase0.BLSPubKey{0x01, 0x02},
+				WithdrawalCredentials:      []byte{0x01, 0x02},
+				EffectiveBalance:           32000000000,
+				ActivationEligibilityEpoch: 5,
+				ActivationEpoch:            10,
+				ExitEpoch:                  0xffffffffffffffff,
+				WithdrawableEpoch:          0xffffffffffffffff,
+			},
+			expected: `PublicKey: 0x01020000000000000000000000000000... (48 bytes)
+WithdrawalCredentials: 0x0102
+EffectiveBalance: 32000000000 Gwei (32 ETH)
+Slashed: false
+ActivationEligibilityEpoch: 5
+ActivationEpoch: 10
+ExitEpoch: far future
+WithdrawableEpoch: far future
+`,
+		},
+		{
+			name: "Nested",
+			input: &phase0.PendingAttestation{
+				AggregationBits: bitfield.Bitlist{0x0d},
+				Data: &phase0.AttestationData{
+					Slot:   12,
+					Index:  1,
+					Source: &phase0.Checkpoint{Epoch: 0},
+				},
+				InclusionDelay: 1,
+				ProposerIndex:  7,
+			},
+			expected: `AggregationBits: 3 bits, 2 set
+Data:
+  Slot: 12
+  Index: 1
+  BeaconBlockRoot: 0x0000000000000000000000000000000000000000000000000000000000000000
+  Source:
+    Epoch: 0
+    Root: 0x0000000000000000000000000000000000000000000000000000000000000000
+  Target: <nil>
+InclusionDelay: 1
+ProposerIndex: 7
+`,
+		},
+		{
+			name: "Graffiti",
+			input: &phase0.BeaconBlockBody{
+				Graffiti:          graffiti,
+				ETH1Data:          &phase0.ETH1Data{DepositCount: 3},
+				ProposerSlashings: []*phase0.ProposerSlashing{},
+				AttesterSlashings: []*phase0.AttesterSlashing{},
+				Attestations: []*phase0.Attestation{
+					{
+						AggregationBits: bitfield.NewBitlist(4),
+					},
+				},
+				Deposits:       []*phase0.Deposit{},
+				VoluntaryExits: []*phase0.SignedVoluntaryExit{},
+			},
+			expected: `RANDAOReveal: 0x00000000000000000000000000000000... (96 bytes)
+ETH1Data:
+  DepositRoot: 0x0000000000000000000000000000000000000000000000000000000000000000
+  DepositCount: 3
+  BlockHash: 0x
+Graffiti: "hello"
+ProposerSlashings: []
+AttesterSlashings: []
+Attestations: (1 item)
+  [0]:
+    AggregationBits: 4 bits, 0 set
+    Data: <nil>
+    Signature: 0x00000000000000000000000000000000... (96 bytes)
+Deposits: []
+VoluntaryExits: []
+`,
+		},
+		{
+			name: "Versioned",
+			input: &spec.VersionedAttestation{
+				Version: spec.DataVersionPhase0,
+				Phase0: &phase0.Attestation{
+					AggregationBits: bitfield.NewBitlist(2),
+					Data:            &phase0.AttestationData{Slot: 3},
+				},
+			},
+			expected: `Version: phase0
+Phase0:
+  AggregationBits: 2 bits, 0 set
+  Data:
+    Slot: 3
+    Index: 0
+    BeaconBlockRoot: 0x0000000000000000000000000000000000000000000000000000000000000000
+    Source: <nil>
+    Target: <nil>
+  Signature: 0x00000000000000000000000000000000... (96 bytes)
+`,
+		},
+		{
+			name: "LongList",
+			input: &phase0.IndexedAttestation{
+				AttestingIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18},
+			},
+			expected: `AttestingIndices: [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16 ...] (18 items)
+Data: <nil>
+Signature: 0x00000000000000000000000000000000... (96 bytes)
+`,
+		},
+		{
+			name: "Map",
+			input: map[string]phase0.Slot{
+				"b": 2,
+				"a": 1,
+			},
+			expected: "a: 1\nb: 2\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, spec.PrettyPrint(test.input))
+		})
+	}
+}
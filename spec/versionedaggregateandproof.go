@@ -29,6 +29,78 @@ type VersionedAggregateAndProof struct {
 	Capella   *phase0.AggregateAndProof
 	Deneb     *phase0.AggregateAndProof
 	Electra   *electra.AggregateAndProof
+	Fulu      *electra.AggregateAndProof
+}
+
+// Aggregate returns the aggregate attestation of the aggregate and proof.
+func (v *VersionedAggregateAndProof) Aggregate() (*VersionedAttestation, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Phase0:  v.Phase0.Aggregate,
+		}, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Altair:  v.Altair.Aggregate,
+		}, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version:   v.Version,
+			Bellatrix: v.Bellatrix.Aggregate,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Capella: v.Capella.Aggregate,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Deneb:   v.Deneb.Aggregate,
+		}, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Electra: v.Electra.Aggregate,
+		}, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Fulu:    v.Fulu.Aggregate,
+		}, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
 }
 
 // AggregatorIndex returns the aggregator index of the aggregate.
@@ -70,6 +142,12 @@ func (v *VersionedAggregateAndProof) AggregatorIndex() (phase0.ValidatorIndex, e
 		}
 
 		return v.Electra.AggregatorIndex, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu aggregate and proof")
+		}
+
+		return v.Fulu.AggregatorIndex, nil
 	default:
 		return 0, errors.New("unknown version for aggregate and proof")
 	}
@@ -114,6 +192,12 @@ func (v *VersionedAggregateAndProof) HashTreeRoot() ([32]byte, error) {
 		}
 
 		return v.Electra.HashTreeRoot()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return [32]byte{}, errors.New("no fulu aggregate and proof")
+		}
+
+		return v.Fulu.HashTreeRoot()
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
@@ -163,6 +247,12 @@ func (v *VersionedAggregateAndProof) String() string {
 		}
 
 		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
@@ -207,6 +297,12 @@ func (v *VersionedAggregateAndProof) SelectionProof() (phase0.BLSSignature, erro
 		}
 
 		return v.Electra.SelectionProof, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.BLSSignature{}, errors.New("no fulu aggregate and proof")
+		}
+
+		return v.Fulu.SelectionProof, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -33,11 +33,12 @@ type VersionedAttestation struct {
 	Capella        *phase0.Attestation
 	Deneb          *phase0.Attestation
 	Electra        *electra.Attestation
+	Fulu           *electra.Attestation
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedAttestation) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Fulu == nil
 }
 
 // AggregationBits returns the aggregation bits of the attestation.
@@ -79,6 +80,12 @@ func (v *VersionedAttestation) AggregationBits() (bitfield.Bitlist, error) {
 		}
 
 		return v.Electra.AggregationBits, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu attestation")
+		}
+
+		return v.Fulu.AggregationBits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -123,6 +130,12 @@ func (v *VersionedAttestation) Data() (*phase0.AttestationData, error) {
 		}
 
 		return v.Electra.Data, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu attestation")
+		}
+
+		return v.Fulu.Data, nil
 	default:
 		return nil, fmt.Errorf("unknown version: %d", v.Version)
 	}
@@ -139,6 +152,12 @@ func (v *VersionedAttestation) CommitteeBits() (bitfield.Bitvector64, error) {
 		}
 
 		return v.Electra.CommitteeBits, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu attestation")
+		}
+
+		return v.Fulu.CommitteeBits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -183,11 +202,115 @@ func (v *VersionedAttestation) CommitteeIndex() (phase0.CommitteeIndex, error) {
 		}
 
 		return v.Electra.CommitteeIndex()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu attestation")
+		}
+
+		return v.Fulu.CommitteeIndex()
 	default:
 		return 0, errors.New("unknown version")
 	}
 }
 
+// CommitteeIndices returns the indices of the committees covered by the attestation.
+func (v *VersionedAttestation) CommitteeIndices() ([]phase0.CommitteeIndex, error) {
+	if v.Version < DataVersionElectra {
+		data, err := v.Data()
+		if err != nil {
+			return nil, err
+		}
+
+		return []phase0.CommitteeIndex{data.Index}, nil
+	}
+
+	committeeBits, err := v.CommitteeBits()
+	if err != nil {
+		return nil, err
+	}
+
+	indices := committeeBits.BitIndices()
+	committeeIndices := make([]phase0.CommitteeIndex, len(indices))
+	for i, index := range indices {
+		committeeIndices[i] = phase0.CommitteeIndex(index)
+	}
+
+	return committeeIndices, nil
+}
+
+// ToSingleAttestation returns a SingleAttestation representation of the attestation.
+// Attestations from before Electra are converted by taking the committee index from
+// their data, and zeroing it in the data of the single attestation as required from
+// Electra onwards.  The signature is carried over unchanged, so must be over the
+// zeroed data for the single attestation to be valid.
+func (v *VersionedAttestation) ToSingleAttestation() (*electra.SingleAttestation, error) {
+	if v.ValidatorIndex == nil {
+		return nil, errors.New("validator index is nil")
+	}
+
+	switch v.Version {
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra attestation")
+		}
+
+		return v.Electra.ToSingleAttestation(v.ValidatorIndex)
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu attestation")
+		}
+
+		return v.Fulu.ToSingleAttestation(v.ValidatorIndex)
+	}
+
+	data, err := v.Data()
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("no attestation data")
+	}
+	signature, err := v.Signature()
+	if err != nil {
+		return nil, err
+	}
+
+	return &electra.SingleAttestation{
+		CommitteeIndex: data.Index,
+		AttesterIndex:  *v.ValidatorIndex,
+		Data: &phase0.AttestationData{
+			Slot:            data.Slot,
+			Index:           0,
+			BeaconBlockRoot: data.BeaconBlockRoot,
+			Source:          data.Source,
+			Target:          data.Target,
+		},
+		Signature: signature,
+	}, nil
+}
+
+// AttestingIndices returns the indices of the validators that attested.  The
+// committee is the list of validators covered by the aggregation bits; for
+// Electra onwards this is the concatenation of the members of the committees
+// returned by CommitteeIndices, in order.
+func (v *VersionedAttestation) AttestingIndices(committee []phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+	aggregationBits, err := v.AggregationBits()
+	if err != nil {
+		return nil, err
+	}
+	if aggregationBits.Len() != uint64(len(committee)) {
+		return nil, fmt.Errorf("aggregation bits length %d does not match committee size %d", aggregationBits.Len(), len(committee))
+	}
+
+	indices := aggregationBits.BitIndices()
+	attestingIndices := make([]phase0.ValidatorIndex, len(indices))
+	for i, index := range indices {
+		attestingIndices[i] = committee[index]
+	}
+
+	return attestingIndices, nil
+}
+
 func (v *VersionedAttestation) HashTreeRoot() ([32]byte, error) {
 	switch v.Version {
 	case DataVersionPhase0:
@@ -226,6 +349,12 @@ func (v *VersionedAttestation) HashTreeRoot() ([32]byte, error) {
 		}
 
 		return v.Electra.HashTreeRoot()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return [32]byte{}, errors.New("no Fulu attestation")
+		}
+
+		return v.Fulu.HashTreeRoot()
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
@@ -270,6 +399,12 @@ func (v *VersionedAttestation) Signature() (phase0.BLSSignature, error) {
 		}
 
 		return v.Electra.Signature, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.BLSSignature{}, errors.New("no Fulu attestation")
+		}
+
+		return v.Fulu.Signature, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -314,6 +449,12 @@ func (v *VersionedAttestation) String() string {
 		}
 
 		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
@@ -85,3 +85,139 @@ func TestVersionedAttestation_CommitteeIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionedAttestationCommitteeIndices(t *testing.T) {
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(2, true)
+	committeeBits.SetBitAt(5, true)
+
+	tests := []struct {
+		name        string
+		attestation *spec.VersionedAttestation
+		expected    []phase0.CommitteeIndex
+		err         string
+	}{
+		{
+			name:        "Phase0",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionPhase0, Phase0: &phase0.Attestation{Data: &phase0.AttestationData{Index: 3}}},
+			expected:    []phase0.CommitteeIndex{3},
+		},
+		{
+			name:        "DenebMissing",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionDeneb},
+			err:         "no Deneb attestation",
+		},
+		{
+			name:        "Electra",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionElectra, Electra: &electra.Attestation{CommitteeBits: committeeBits}},
+			expected:    []phase0.CommitteeIndex{2, 5},
+		},
+		{
+			name:        "Fulu",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionFulu, Fulu: &electra.Attestation{CommitteeBits: committeeBits}},
+			expected:    []phase0.CommitteeIndex{2, 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indices, err := test.attestation.CommitteeIndices()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, indices)
+			}
+		})
+	}
+}
+
+func TestVersionedAttestationAttestingIndices(t *testing.T) {
+	aggregationBits := bitfield.NewBitlist(4)
+	aggregationBits.SetBitAt(1, true)
+	aggregationBits.SetBitAt(3, true)
+
+	tests := []struct {
+		name        string
+		attestation *spec.VersionedAttestation
+		committee   []phase0.ValidatorIndex
+		expected    []phase0.ValidatorIndex
+		err         string
+	}{
+		{
+			name:        "Missing",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionElectra},
+			err:         "no Electra attestation",
+		},
+		{
+			name:        "CommitteeSizeMismatch",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionPhase0, Phase0: &phase0.Attestation{AggregationBits: aggregationBits}},
+			committee:   []phase0.ValidatorIndex{10, 11, 12},
+			err:         "aggregation bits length 4 does not match committee size 3",
+		},
+		{
+			name:        "Phase0",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionPhase0, Phase0: &phase0.Attestation{AggregationBits: aggregationBits}},
+			committee:   []phase0.ValidatorIndex{10, 11, 12, 13},
+			expected:    []phase0.ValidatorIndex{11, 13},
+		},
+		{
+			name:        "Electra",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionElectra, Electra: &electra.Attestation{AggregationBits: aggregationBits}},
+			committee:   []phase0.ValidatorIndex{20, 21, 30, 31},
+			expected:    []phase0.ValidatorIndex{21, 31},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indices, err := test.attestation.AttestingIndices(test.committee)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, indices)
+			}
+		})
+	}
+}
+
+func TestVersionedAttestationToSingleAttestation(t *testing.T) {
+	validatorIndex := phase0.ValidatorIndex(7)
+	data := &phase0.AttestationData{
+		Slot:   10,
+		Index:  3,
+		Source: &phase0.Checkpoint{},
+		Target: &phase0.Checkpoint{},
+	}
+
+	_, err := (&spec.VersionedAttestation{
+		Version: spec.DataVersionDeneb,
+		Deneb:   &phase0.Attestation{Data: data},
+	}).ToSingleAttestation()
+	require.EqualError(t, err, "validator index is nil")
+
+	singleAttestation, err := (&spec.VersionedAttestation{
+		Version:        spec.DataVersionDeneb,
+		ValidatorIndex: &validatorIndex,
+		Deneb:          &phase0.Attestation{Data: data},
+	}).ToSingleAttestation()
+	require.NoError(t, err)
+	require.Equal(t, phase0.CommitteeIndex(3), singleAttestation.CommitteeIndex)
+	require.Equal(t, validatorIndex, singleAttestation.AttesterIndex)
+	require.Equal(t, phase0.CommitteeIndex(0), singleAttestation.Data.Index)
+	require.Equal(t, phase0.CommitteeIndex(3), data.Index)
+
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(4, true)
+	singleAttestation, err = (&spec.VersionedAttestation{
+		Version:        spec.DataVersionElectra,
+		ValidatorIndex: &validatorIndex,
+		Electra: &electra.Attestation{
+			Data:          &phase0.AttestationData{Slot: 10},
+			CommitteeBits: committeeBits,
+		},
+	}).ToSingleAttestation()
+	require.NoError(t, err)
+	require.Equal(t, phase0.CommitteeIndex(4), singleAttestation.CommitteeIndex)
+}
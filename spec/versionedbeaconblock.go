@@ -17,6 +17,7 @@ import (
 	"errors"
 
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -34,6 +35,7 @@ type VersionedBeaconBlock struct {
 	Capella   *capella.BeaconBlock
 	Deneb     *deneb.BeaconBlock
 	Electra   *electra.BeaconBlock
+	Fulu      *fulu.BeaconBlock
 }
 
 // IsEmpty returns true if there is no block.
@@ -80,6 +82,12 @@ func (v *VersionedBeaconBlock) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Electra.Slot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu block")
+		}
+
+		return v.Fulu.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -142,6 +150,15 @@ func (v *VersionedBeaconBlock) RandaoReveal() (phase0.BLSSignature, error) {
 		}
 
 		return v.Electra.Body.RANDAOReveal, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.BLSSignature{}, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return phase0.BLSSignature{}, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -204,6 +221,15 @@ func (v *VersionedBeaconBlock) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Electra.Body.Graffiti, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return [32]byte{}, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return [32]byte{}, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.Graffiti, nil
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
@@ -248,6 +274,12 @@ func (v *VersionedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
 		}
 
 		return v.Electra.ProposerIndex, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu block")
+		}
+
+		return v.Fulu.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -292,6 +324,12 @@ func (v *VersionedBeaconBlock) Root() (phase0.Root, error) {
 		}
 
 		return v.Electra.HashTreeRoot()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, errors.New("no fulu block")
+		}
+
+		return v.Fulu.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -354,6 +392,15 @@ func (v *VersionedBeaconBlock) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.Body.HashTreeRoot()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return phase0.Root{}, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -398,6 +445,12 @@ func (v *VersionedBeaconBlock) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.ParentRoot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, errors.New("no fulu block")
+		}
+
+		return v.Fulu.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -442,6 +495,12 @@ func (v *VersionedBeaconBlock) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Electra.StateRoot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, errors.New("no fulu block")
+		}
+
+		return v.Fulu.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -533,6 +592,20 @@ func (v *VersionedBeaconBlock) Attestations() ([]VersionedAttestation, error) {
 			}
 		}
 
+		return versionedAttestations, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block")
+		}
+
+		versionedAttestations := make([]VersionedAttestation, len(v.Fulu.Body.Attestations))
+		for i, attestation := range v.Fulu.Body.Attestations {
+			versionedAttestations[i] = VersionedAttestation{
+				Version: DataVersionFulu,
+				Fulu:    attestation,
+			}
+		}
+
 		return versionedAttestations, nil
 	default:
 		return nil, errors.New("unknown version")
@@ -625,6 +698,20 @@ func (v *VersionedBeaconBlock) AttesterSlashings() ([]VersionedAttesterSlashing,
 			}
 		}
 
+		return versionedAttesterSlashings, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block")
+		}
+
+		versionedAttesterSlashings := make([]VersionedAttesterSlashing, len(v.Fulu.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Fulu.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = VersionedAttesterSlashing{
+				Version: DataVersionFulu,
+				Fulu:    attesterSlashing,
+			}
+		}
+
 		return versionedAttesterSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
@@ -670,6 +757,567 @@ func (v *VersionedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing,
 		}
 
 		return v.Electra.Body.ProposerSlashings, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block")
+		}
+
+		return v.Fulu.Body.ProposerSlashings, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// ETH1Data returns the eth1 data of the beacon block.
+func (v *VersionedBeaconBlock) ETH1Data() (*phase0.ETH1Data, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+		if v.Phase0.Body == nil {
+			return nil, errors.New("no phase0 block body")
+		}
+
+		return v.Phase0.Body.ETH1Data, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+		if v.Altair.Body == nil {
+			return nil, errors.New("no altair block body")
+		}
+
+		return v.Altair.Body.ETH1Data, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		if v.Bellatrix.Body == nil {
+			return nil, errors.New("no bellatrix block body")
+		}
+
+		return v.Bellatrix.Body.ETH1Data, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		if v.Capella.Body == nil {
+			return nil, errors.New("no capella block body")
+		}
+
+		return v.Capella.Body.ETH1Data, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block body")
+		}
+
+		return v.Deneb.Body.ETH1Data, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.ETH1Data, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.ETH1Data, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// Deposits returns the deposits of the beacon block.
+func (v *VersionedBeaconBlock) Deposits() ([]*phase0.Deposit, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+		if v.Phase0.Body == nil {
+			return nil, errors.New("no phase0 block body")
+		}
+
+		return v.Phase0.Body.Deposits, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+		if v.Altair.Body == nil {
+			return nil, errors.New("no altair block body")
+		}
+
+		return v.Altair.Body.Deposits, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		if v.Bellatrix.Body == nil {
+			return nil, errors.New("no bellatrix block body")
+		}
+
+		return v.Bellatrix.Body.Deposits, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		if v.Capella.Body == nil {
+			return nil, errors.New("no capella block body")
+		}
+
+		return v.Capella.Body.Deposits, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block body")
+		}
+
+		return v.Deneb.Body.Deposits, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.Deposits, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.Deposits, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// VoluntaryExits returns the voluntary exits of the beacon block.
+func (v *VersionedBeaconBlock) VoluntaryExits() ([]*phase0.SignedVoluntaryExit, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+		if v.Phase0.Body == nil {
+			return nil, errors.New("no phase0 block body")
+		}
+
+		return v.Phase0.Body.VoluntaryExits, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+		if v.Altair.Body == nil {
+			return nil, errors.New("no altair block body")
+		}
+
+		return v.Altair.Body.VoluntaryExits, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		if v.Bellatrix.Body == nil {
+			return nil, errors.New("no bellatrix block body")
+		}
+
+		return v.Bellatrix.Body.VoluntaryExits, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		if v.Capella.Body == nil {
+			return nil, errors.New("no capella block body")
+		}
+
+		return v.Capella.Body.VoluntaryExits, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block body")
+		}
+
+		return v.Deneb.Body.VoluntaryExits, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.VoluntaryExits, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.VoluntaryExits, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// SyncAggregate returns the sync aggregate of the beacon block.
+func (v *VersionedBeaconBlock) SyncAggregate() (*altair.SyncAggregate, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have sync aggregate")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+		if v.Altair.Body == nil {
+			return nil, errors.New("no altair block body")
+		}
+
+		return v.Altair.Body.SyncAggregate, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		if v.Bellatrix.Body == nil {
+			return nil, errors.New("no bellatrix block body")
+		}
+
+		return v.Bellatrix.Body.SyncAggregate, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		if v.Capella.Body == nil {
+			return nil, errors.New("no capella block body")
+		}
+
+		return v.Capella.Body.SyncAggregate, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block body")
+		}
+
+		return v.Deneb.Body.SyncAggregate, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.SyncAggregate, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.SyncAggregate, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// BLSToExecutionChanges returns the bls to execution changes of the beacon block.
+func (v *VersionedBeaconBlock) BLSToExecutionChanges() ([]*capella.SignedBLSToExecutionChange, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have bls to execution changes")
+	case DataVersionAltair:
+		return nil, errors.New("altair block does not have bls to execution changes")
+	case DataVersionBellatrix:
+		return nil, errors.New("bellatrix block does not have bls to execution changes")
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		if v.Capella.Body == nil {
+			return nil, errors.New("no capella block body")
+		}
+
+		return v.Capella.Body.BLSToExecutionChanges, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block body")
+		}
+
+		return v.Deneb.Body.BLSToExecutionChanges, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.BLSToExecutionChanges, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.BLSToExecutionChanges, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// Withdrawals returns the withdrawals of the beacon block.
+func (v *VersionedBeaconBlock) Withdrawals() ([]*capella.Withdrawal, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have execution withdrawals")
+	case DataVersionAltair:
+		return nil, errors.New("altair block does not have execution withdrawals")
+	case DataVersionBellatrix:
+		return nil, errors.New("bellatrix block does not have execution withdrawals")
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		if v.Capella.Body == nil {
+			return nil, errors.New("no capella block body")
+		}
+		if v.Capella.Body.ExecutionPayload == nil {
+			return nil, errors.New("no capella block execution payload")
+		}
+
+		return v.Capella.Body.ExecutionPayload.Withdrawals, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block body")
+		}
+		if v.Deneb.Body.ExecutionPayload == nil {
+			return nil, errors.New("no deneb block execution payload")
+		}
+
+		return v.Deneb.Body.ExecutionPayload.Withdrawals, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+		if v.Electra.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block execution payload")
+		}
+
+		return v.Electra.Body.ExecutionPayload.Withdrawals, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+		if v.Fulu.Body.ExecutionPayload == nil {
+			return nil, errors.New("no fulu block execution payload")
+		}
+
+		return v.Fulu.Body.ExecutionPayload.Withdrawals, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// BlobKZGCommitments returns the blob KZG commitments of the beacon block.
+func (v *VersionedBeaconBlock) BlobKZGCommitments() ([]deneb.KZGCommitment, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have kzg commitments")
+	case DataVersionAltair:
+		return nil, errors.New("altair block does not have kzg commitments")
+	case DataVersionBellatrix:
+		return nil, errors.New("bellatrix block does not have kzg commitments")
+	case DataVersionCapella:
+		return nil, errors.New("capella block does not have kzg commitments")
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block body")
+		}
+
+		return v.Deneb.Body.BlobKZGCommitments, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.BlobKZGCommitments, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.BlobKZGCommitments, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// ExecutionRequests returns the execution requests of the beacon block.
+func (v *VersionedBeaconBlock) ExecutionRequests() (*electra.ExecutionRequests, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have execution requests")
+	case DataVersionAltair:
+		return nil, errors.New("altair block does not have execution requests")
+	case DataVersionBellatrix:
+		return nil, errors.New("bellatrix block does not have execution requests")
+	case DataVersionCapella:
+		return nil, errors.New("capella block does not have execution requests")
+	case DataVersionDeneb:
+		return nil, errors.New("deneb block does not have execution requests")
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.ExecutionRequests, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+
+		return v.Fulu.Body.ExecutionRequests, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// ExecutionPayload returns the execution payload of the beacon block.
+func (v *VersionedBeaconBlock) ExecutionPayload() (*VersionedExecutionPayload, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("phase0 block does not have execution payload")
+	case DataVersionAltair:
+		return nil, errors.New("altair block does not have execution payload")
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		if v.Bellatrix.Body == nil {
+			return nil, errors.New("no bellatrix block body")
+		}
+		if v.Bellatrix.Body.ExecutionPayload == nil {
+			return nil, errors.New("no bellatrix block execution payload")
+		}
+
+		return &VersionedExecutionPayload{
+			Version:   v.Version,
+			Bellatrix: v.Bellatrix.Body.ExecutionPayload,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		if v.Capella.Body == nil {
+			return nil, errors.New("no capella block body")
+		}
+		if v.Capella.Body.ExecutionPayload == nil {
+			return nil, errors.New("no capella block execution payload")
+		}
+
+		return &VersionedExecutionPayload{
+			Version: v.Version,
+			Capella: v.Capella.Body.ExecutionPayload,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block body")
+		}
+		if v.Deneb.Body.ExecutionPayload == nil {
+			return nil, errors.New("no deneb block execution payload")
+		}
+
+		return &VersionedExecutionPayload{
+			Version: v.Version,
+			Deneb:   v.Deneb.Body.ExecutionPayload,
+		}, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return nil, errors.New("no electra block body")
+		}
+		if v.Electra.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block execution payload")
+		}
+
+		return &VersionedExecutionPayload{
+			Version: v.Version,
+			Electra: v.Electra.Body.ExecutionPayload,
+		}, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu block")
+		}
+		if v.Fulu.Body == nil {
+			return nil, errors.New("no fulu block body")
+		}
+		if v.Fulu.Body.ExecutionPayload == nil {
+			return nil, errors.New("no fulu block execution payload")
+		}
+
+		return &VersionedExecutionPayload{
+			Version: v.Version,
+			Fulu:    v.Fulu.Body.ExecutionPayload,
+		}, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -714,6 +1362,12 @@ func (v *VersionedBeaconBlock) String() string {
 		}
 
 		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
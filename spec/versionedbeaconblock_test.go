@@ -0,0 +1,127 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBeaconBlockBodyAccessors(t *testing.T) {
+	generator := testutil.NewGenerator(1)
+
+	for version := spec.DataVersionPhase0; version <= spec.DataVersionFulu; version++ {
+		t.Run(version.String(), func(t *testing.T) {
+			block, err := generator.BeaconBlock(version)
+			require.NoError(t, err)
+			signedBlock, err := generator.SignedBeaconBlock(version)
+			require.NoError(t, err)
+
+			// The signed block accessors provide the reference values.
+			switch version {
+			case spec.DataVersionPhase0:
+				signedBlock.Phase0.Message = block.Phase0
+			case spec.DataVersionAltair:
+				signedBlock.Altair.Message = block.Altair
+			case spec.DataVersionBellatrix:
+				signedBlock.Bellatrix.Message = block.Bellatrix
+			case spec.DataVersionCapella:
+				signedBlock.Capella.Message = block.Capella
+			case spec.DataVersionDeneb:
+				signedBlock.Deneb.Message = block.Deneb
+			case spec.DataVersionElectra:
+				signedBlock.Electra.Message = block.Electra
+			case spec.DataVersionFulu:
+				signedBlock.Fulu.Message = block.Fulu
+			}
+
+			eth1Data, err := block.ETH1Data()
+			require.NoError(t, err)
+			expectedETH1Data, err := signedBlock.ETH1Data()
+			require.NoError(t, err)
+			require.Equal(t, expectedETH1Data, eth1Data)
+
+			deposits, err := block.Deposits()
+			require.NoError(t, err)
+			expectedDeposits, err := signedBlock.Deposits()
+			require.NoError(t, err)
+			require.Equal(t, expectedDeposits, deposits)
+
+			voluntaryExits, err := block.VoluntaryExits()
+			require.NoError(t, err)
+			expectedVoluntaryExits, err := signedBlock.VoluntaryExits()
+			require.NoError(t, err)
+			require.Equal(t, expectedVoluntaryExits, voluntaryExits)
+
+			syncAggregate, err := block.SyncAggregate()
+			expectedSyncAggregate, expectedErr := signedBlock.SyncAggregate()
+			require.Equal(t, expectedErr == nil, err == nil)
+			require.Equal(t, expectedSyncAggregate, syncAggregate)
+
+			changes, err := block.BLSToExecutionChanges()
+			expectedChanges, expectedErr := signedBlock.BLSToExecutionChanges()
+			require.Equal(t, expectedErr == nil, err == nil)
+			require.Equal(t, expectedChanges, changes)
+
+			withdrawals, err := block.Withdrawals()
+			expectedWithdrawals, expectedErr := signedBlock.Withdrawals()
+			require.Equal(t, expectedErr == nil, err == nil)
+			require.Equal(t, expectedWithdrawals, withdrawals)
+
+			commitments, err := block.BlobKZGCommitments()
+			expectedCommitments, expectedErr := signedBlock.BlobKZGCommitments()
+			require.Equal(t, expectedErr == nil, err == nil)
+			require.Equal(t, expectedCommitments, commitments)
+
+			requests, err := block.ExecutionRequests()
+			expectedRequests, expectedErr := signedBlock.ExecutionRequests()
+			require.Equal(t, expectedErr == nil, err == nil)
+			require.Equal(t, expectedRequests, requests)
+
+			payload, err := block.ExecutionPayload()
+			expectedPayload, expectedErr := signedBlock.ExecutionPayload()
+			require.Equal(t, expectedErr == nil, err == nil)
+			require.Equal(t, expectedPayload, payload)
+			if version < spec.DataVersionBellatrix {
+				require.EqualError(t, err, version.String()+" block does not have execution payload")
+			} else {
+				require.Equal(t, version, payload.Version)
+				require.False(t, payload.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestVersionedSignedBeaconBlockExecutionPayload(t *testing.T) {
+	generator := testutil.NewGenerator(2)
+
+	block, err := generator.SignedBeaconBlock(spec.DataVersionDeneb)
+	require.NoError(t, err)
+
+	payload, err := block.ExecutionPayload()
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionDeneb, payload.Version)
+	require.Same(t, block.Deneb.Message.Body.ExecutionPayload, payload.Deneb)
+
+	block.Deneb.Message.Body.ExecutionPayload = nil
+	_, err = block.ExecutionPayload()
+	require.EqualError(t, err, "no deneb block")
+
+	block.Version = spec.DataVersionUnknown
+	_, err = block.ExecutionPayload()
+	require.EqualError(t, err, "unknown version")
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,6 +32,7 @@ type VersionedBeaconBlockBody struct {
 	Capella   *capella.BeaconBlockBody
 	Deneb     *deneb.BeaconBlockBody
 	Electra   *electra.BeaconBlockBody
+	Fulu      *fulu.BeaconBlockBody
 }
 
 // String returns a string version of the structure.
@@ -72,6 +74,12 @@ func (v *VersionedBeaconBlockBody) String() string {
 		}
 
 		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
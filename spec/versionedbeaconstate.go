@@ -21,6 +21,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -33,6 +34,7 @@ type VersionedBeaconState struct {
 	Capella   *capella.BeaconState
 	Deneb     *deneb.BeaconState
 	Electra   *electra.BeaconState
+	Fulu      *fulu.BeaconState
 }
 
 // IsEmpty returns true if there is no block.
@@ -79,6 +81,12 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Electra.Slot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -107,11 +115,72 @@ func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorI
 		}
 
 		return v.Electra.NextWithdrawalValidatorIndex, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.NextWithdrawalValidatorIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
 }
 
+// LatestExecutionPayloadHeader returns the latest execution payload header of the state.
+func (v *VersionedBeaconState) LatestExecutionPayloadHeader() (*VersionedExecutionPayloadHeader, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair:
+		return nil, errors.New("state does not provide latest execution payload header")
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version:   v.Version,
+			Bellatrix: v.Bellatrix.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Capella: v.Capella.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Deneb:   v.Deneb.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Electra: v.Electra.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Fulu:    v.Fulu.LatestExecutionPayloadHeader,
+		}, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
 // Validators returns the validators of the state.
 func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
 	switch v.Version {
@@ -151,6 +220,12 @@ func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
 		}
 
 		return v.Electra.Validators, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.Validators, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -195,6 +270,12 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 		}
 
 		return v.Electra.Balances, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.Balances, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -211,6 +292,12 @@ func (v *VersionedBeaconState) DepositRequestsStartIndex() (uint64, error) {
 		}
 
 		return v.Electra.DepositRequestsStartIndex, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.DepositRequestsStartIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -227,6 +314,12 @@ func (v *VersionedBeaconState) DepositBalanceToConsume() (phase0.Gwei, error) {
 		}
 
 		return v.Electra.DepositBalanceToConsume, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.DepositBalanceToConsume, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -243,6 +336,12 @@ func (v *VersionedBeaconState) ExitBalanceToConsume() (phase0.Gwei, error) {
 		}
 
 		return v.Electra.ExitBalanceToConsume, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.ExitBalanceToConsume, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -259,6 +358,12 @@ func (v *VersionedBeaconState) EarliestExitEpoch() (phase0.Epoch, error) {
 		}
 
 		return v.Electra.EarliestExitEpoch, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.EarliestExitEpoch, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -275,6 +380,12 @@ func (v *VersionedBeaconState) ConsolidationBalanceToConsume() (phase0.Gwei, err
 		}
 
 		return v.Electra.ConsolidationBalanceToConsume, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.ConsolidationBalanceToConsume, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -291,6 +402,12 @@ func (v *VersionedBeaconState) EarliestConsolidationEpoch() (phase0.Epoch, error
 		}
 
 		return v.Electra.EarliestConsolidationEpoch, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.EarliestConsolidationEpoch, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -307,6 +424,12 @@ func (v *VersionedBeaconState) PendingDeposits() ([]*electra.PendingDeposit, err
 		}
 
 		return v.Electra.PendingDeposits, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.PendingDeposits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -323,6 +446,12 @@ func (v *VersionedBeaconState) PendingPartialWithdrawals() ([]*electra.PendingPa
 		}
 
 		return v.Electra.PendingPartialWithdrawals, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.PendingPartialWithdrawals, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -339,11 +468,163 @@ func (v *VersionedBeaconState) PendingConsolidations() ([]*electra.PendingConsol
 		}
 
 		return v.Electra.PendingConsolidations, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.PendingConsolidations, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CurrentSyncCommittee returns the current sync committee of the state.
+func (v *VersionedBeaconState) CurrentSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide current sync committee")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.CurrentSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.CurrentSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.CurrentSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.CurrentSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.CurrentSyncCommittee, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.CurrentSyncCommittee, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// NextSyncCommittee returns the next sync committee of the state.
+func (v *VersionedBeaconState) NextSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide next sync committee")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.NextSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.NextSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.NextSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.NextSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.NextSyncCommittee, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu state")
+		}
+
+		return v.Fulu.NextSyncCommittee, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
+// SyncCommitteePositions returns the positions of the validator with the given
+// index in the given sync committee, which is usually the current or next sync
+// committee of the state.
+func (v *VersionedBeaconState) SyncCommitteePositions(committee *altair.SyncCommittee,
+	index phase0.ValidatorIndex,
+) (
+	[]uint64,
+	error,
+) {
+	if committee == nil {
+		return nil, errors.New("no sync committee")
+	}
+	pubKey, err := v.validatorPubKey(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return committee.Positions(pubKey), nil
+}
+
+// SyncSubcommittees returns the indices of the subcommittees, in ascending
+// order, of the validator with the given index in the given sync committee,
+// given the number of sync committee subnets.
+func (v *VersionedBeaconState) SyncSubcommittees(committee *altair.SyncCommittee,
+	index phase0.ValidatorIndex,
+	subnetCount uint64,
+) (
+	[]uint64,
+	error,
+) {
+	if committee == nil {
+		return nil, errors.New("no sync committee")
+	}
+	pubKey, err := v.validatorPubKey(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return committee.Subcommittees(pubKey, subnetCount), nil
+}
+
+// validatorPubKey returns the public key of the validator with the given index.
+func (v *VersionedBeaconState) validatorPubKey(index phase0.ValidatorIndex) (phase0.BLSPubKey, error) {
+	validators, err := v.Validators()
+	if err != nil {
+		return phase0.BLSPubKey{}, err
+	}
+	if uint64(index) >= uint64(len(validators)) {
+		return phase0.BLSPubKey{}, errors.New("validator index out of range")
+	}
+
+	return validators[index].PublicKey, nil
+}
+
 // String returns a string version of the structure.
 func (v *VersionedBeaconState) String() string {
 	switch v.Version {
@@ -383,6 +664,12 @@ func (v *VersionedBeaconState) String() string {
 		}
 
 		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
@@ -0,0 +1,157 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+	"io"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// beaconStateSSZObject is a beacon state that can be SSZ encoded, decoded and hashed.
+type beaconStateSSZObject interface {
+	sszObject
+	UnmarshalSSZFrom(r io.Reader) error
+}
+
+// MarshalSSZ ssz marshals the beacon state of the given version.
+func (v *VersionedBeaconState) MarshalSSZ() ([]byte, error) {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.MarshalSSZ()
+}
+
+// UnmarshalSSZ ssz unmarshals the beacon state.
+// The version must be set prior to calling this function.
+func (v *VersionedBeaconState) UnmarshalSSZ(buf []byte) error {
+	obj, err := v.sszObject(true)
+	if err != nil {
+		return err
+	}
+
+	return obj.UnmarshalSSZ(buf)
+}
+
+// UnmarshalSSZFrom ssz unmarshals the beacon state from a reader, without
+// holding the full encoded state in memory.
+// The version must be set prior to calling this function.
+func (v *VersionedBeaconState) UnmarshalSSZFrom(r io.Reader) error {
+	obj, err := v.sszObject(true)
+	if err != nil {
+		return err
+	}
+
+	return obj.UnmarshalSSZFrom(r)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the beacon state.
+func (v *VersionedBeaconState) SizeSSZ() int {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return 0
+	}
+
+	return obj.SizeSSZ()
+}
+
+// HashTreeRoot ssz hashes the beacon state of the given version.
+func (v *VersionedBeaconState) HashTreeRoot() ([32]byte, error) {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return obj.HashTreeRoot()
+}
+
+// sszObject returns the versioned data, allocating it if required.
+func (v *VersionedBeaconState) sszObject(allocate bool) (beaconStateSSZObject, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			if !allocate {
+				return nil, errors.New("no phase0 beacon state")
+			}
+			v.Phase0 = &phase0.BeaconState{}
+		}
+
+		return v.Phase0, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			if !allocate {
+				return nil, errors.New("no altair beacon state")
+			}
+			v.Altair = &altair.BeaconState{}
+		}
+
+		return v.Altair, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			if !allocate {
+				return nil, errors.New("no bellatrix beacon state")
+			}
+			v.Bellatrix = &bellatrix.BeaconState{}
+		}
+
+		return v.Bellatrix, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			if !allocate {
+				return nil, errors.New("no capella beacon state")
+			}
+			v.Capella = &capella.BeaconState{}
+		}
+
+		return v.Capella, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			if !allocate {
+				return nil, errors.New("no deneb beacon state")
+			}
+			v.Deneb = &deneb.BeaconState{}
+		}
+
+		return v.Deneb, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			if !allocate {
+				return nil, errors.New("no electra beacon state")
+			}
+			v.Electra = &electra.BeaconState{}
+		}
+
+		return v.Electra, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			if !allocate {
+				return nil, errors.New("no fulu beacon state")
+			}
+			v.Fulu = &fulu.BeaconState{}
+		}
+
+		return v.Fulu, nil
+	default:
+		return nil, errors.New("unknown version for beacon state")
+	}
+}
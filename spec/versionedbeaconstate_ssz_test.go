@@ -0,0 +1,101 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBeaconStateSSZ(t *testing.T) {
+	phase0State := &phase0.BeaconState{
+		Slot:              12,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		HistoricalRoots:   []phase0.Root{},
+		ETH1Data: &phase0.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		ETH1DataVotes: []*phase0.ETH1Data{},
+		Validators: []*phase0.Validator{
+			{WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 32000000000},
+		},
+		Balances:                    []phase0.Gwei{32000000001},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		PreviousEpochAttestations:   []*phase0.PendingAttestation{},
+		CurrentEpochAttestations:    []*phase0.PendingAttestation{},
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+	}
+
+	tests := []struct {
+		name  string
+		state *spec.VersionedBeaconState
+		err   string
+	}{
+		{
+			name:  "VersionUnknown",
+			state: &spec.VersionedBeaconState{},
+			err:   "unknown version for beacon state",
+		},
+		{
+			name:  "DataMissing",
+			state: &spec.VersionedBeaconState{Version: spec.DataVersionPhase0},
+			err:   "no phase0 beacon state",
+		},
+		{
+			name:  "Phase0",
+			state: &spec.VersionedBeaconState{Version: spec.DataVersionPhase0, Phase0: phase0State},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := test.state.MarshalSSZ()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				_, err = test.state.HashTreeRoot()
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, encoded, test.state.SizeSSZ())
+
+			root, err := test.state.HashTreeRoot()
+			require.NoError(t, err)
+			expectedRoot, err := phase0State.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, expectedRoot, root)
+
+			decoded := &spec.VersionedBeaconState{Version: test.state.Version}
+			require.NoError(t, decoded.UnmarshalSSZ(encoded))
+			require.Equal(t, test.state, decoded)
+
+			streamed := &spec.VersionedBeaconState{Version: test.state.Version}
+			require.NoError(t, streamed.UnmarshalSSZFrom(bytes.NewReader(encoded)))
+			require.Equal(t, test.state, streamed)
+		})
+	}
+}
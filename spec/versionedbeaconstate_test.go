@@ -0,0 +1,87 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBeaconStateSyncCommittees(t *testing.T) {
+	// A committee of 8 with 4 subcommittees of 2.
+	current := &altair.SyncCommittee{
+		Pubkeys: []phase0.BLSPubKey{{0x01}, {0x02}, {0x03}, {0x01}, {0x04}, {0x05}, {0x06}, {0x01}},
+	}
+	next := &altair.SyncCommittee{
+		Pubkeys: []phase0.BLSPubKey{{0x02}, {0x02}, {0x03}, {0x04}, {0x05}, {0x06}, {0x07}, {0x08}},
+	}
+	validators := make([]*phase0.Validator, 0, 9)
+	for i := 0; i < 9; i++ {
+		validators = append(validators, &phase0.Validator{PublicKey: phase0.BLSPubKey{byte(i)}})
+	}
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionDeneb,
+		Deneb: &deneb.BeaconState{
+			Validators:           validators,
+			CurrentSyncCommittee: current,
+			NextSyncCommittee:    next,
+		},
+	}
+
+	res, err := state.CurrentSyncCommittee()
+	require.NoError(t, err)
+	require.Equal(t, current, res)
+	res, err = state.NextSyncCommittee()
+	require.NoError(t, err)
+	require.Equal(t, next, res)
+
+	positions, err := state.SyncCommitteePositions(current, 1)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 3, 7}, positions)
+	subcommittees, err := state.SyncSubcommittees(current, 1, 4)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 3}, subcommittees)
+
+	// Duplicate positions in the same subcommittee.
+	subcommittees, err = state.SyncSubcommittees(next, 2, 4)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0}, subcommittees)
+
+	// Not in the committee.
+	positions, err = state.SyncCommitteePositions(current, 8)
+	require.NoError(t, err)
+	require.Empty(t, positions)
+
+	_, err = state.SyncCommitteePositions(current, 9)
+	require.EqualError(t, err, "validator index out of range")
+	_, err = state.SyncSubcommittees(nil, 1, 4)
+	require.EqualError(t, err, "no sync committee")
+
+	phase0State := &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0:  &phase0.BeaconState{},
+	}
+	_, err = phase0State.CurrentSyncCommittee()
+	require.EqualError(t, err, "state does not provide current sync committee")
+	_, err = phase0State.NextSyncCommittee()
+	require.EqualError(t, err, "state does not provide next sync committee")
+
+	_, err = (&spec.VersionedBeaconState{Version: spec.DataVersionElectra}).CurrentSyncCommittee()
+	require.EqualError(t, err, "no Electra state")
+}
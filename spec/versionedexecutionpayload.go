@@ -0,0 +1,523 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// VersionedExecutionPayload contains a versioned execution payload.
+type VersionedExecutionPayload struct {
+	Version   DataVersion
+	Bellatrix *bellatrix.ExecutionPayload
+	Capella   *capella.ExecutionPayload
+	Deneb     *deneb.ExecutionPayload
+	Electra   *deneb.ExecutionPayload
+	Fulu      *deneb.ExecutionPayload
+}
+
+// IsEmpty returns true if there is no payload.
+func (v *VersionedExecutionPayload) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Fulu == nil
+}
+
+// ParentHash returns the parent hash of the execution payload.
+func (v *VersionedExecutionPayload) ParentHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix execution payload")
+		}
+
+		return v.Bellatrix.ParentHash, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.ParentHash, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Hash32{}, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.ParentHash, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Hash32{}, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.ParentHash, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Hash32{}, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.ParentHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unknown version")
+	}
+}
+
+// FeeRecipient returns the fee recipient of the execution payload.
+func (v *VersionedExecutionPayload) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no bellatrix execution payload")
+		}
+
+		return v.Bellatrix.FeeRecipient, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.FeeRecipient, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.FeeRecipient, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.FeeRecipient, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.FeeRecipient, nil
+	default:
+		return bellatrix.ExecutionAddress{}, errors.New("unknown version")
+	}
+}
+
+// BlockNumber returns the block number of the execution payload.
+func (v *VersionedExecutionPayload) BlockNumber() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix execution payload")
+		}
+
+		return v.Bellatrix.BlockNumber, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.BlockNumber, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.BlockNumber, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.BlockNumber, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.BlockNumber, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// GasLimit returns the gas limit of the execution payload.
+func (v *VersionedExecutionPayload) GasLimit() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix execution payload")
+		}
+
+		return v.Bellatrix.GasLimit, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.GasLimit, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.GasLimit, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.GasLimit, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.GasLimit, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// GasUsed returns the gas used of the execution payload.
+func (v *VersionedExecutionPayload) GasUsed() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix execution payload")
+		}
+
+		return v.Bellatrix.GasUsed, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.GasUsed, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.GasUsed, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.GasUsed, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.GasUsed, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// Timestamp returns the timestamp of the execution payload.
+func (v *VersionedExecutionPayload) Timestamp() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix execution payload")
+		}
+
+		return v.Bellatrix.Timestamp, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.Timestamp, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.Timestamp, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.Timestamp, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.Timestamp, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// BaseFeePerGas returns the base fee per gas of the execution payload.
+func (v *VersionedExecutionPayload) BaseFeePerGas() (*uint256.Int, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix execution payload")
+		}
+
+		return uint256FromLittleEndian(v.Bellatrix.BaseFeePerGas), nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella execution payload")
+		}
+
+		return uint256FromLittleEndian(v.Capella.BaseFeePerGas), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.BaseFeePerGas, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.BaseFeePerGas, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.BaseFeePerGas, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// BlockHash returns the block hash of the execution payload.
+func (v *VersionedExecutionPayload) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix execution payload")
+		}
+
+		return v.Bellatrix.BlockHash, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.BlockHash, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Hash32{}, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.BlockHash, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Hash32{}, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.BlockHash, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Hash32{}, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unknown version")
+	}
+}
+
+// Transactions returns the transactions of the execution payload.
+func (v *VersionedExecutionPayload) Transactions() ([]bellatrix.Transaction, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix execution payload")
+		}
+
+		return v.Bellatrix.Transactions, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.Transactions, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.Transactions, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.Transactions, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.Transactions, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// Withdrawals returns the withdrawals of the execution payload.
+func (v *VersionedExecutionPayload) Withdrawals() ([]*capella.Withdrawal, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		return nil, errors.New("bellatrix execution payload does not have withdrawals")
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella execution payload")
+		}
+
+		return v.Capella.Withdrawals, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.Withdrawals, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.Withdrawals, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.Withdrawals, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// BlobGasUsed returns the blob gas used of the execution payload.
+func (v *VersionedExecutionPayload) BlobGasUsed() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		return 0, errors.New("bellatrix execution payload does not have blob gas used")
+	case DataVersionCapella:
+		return 0, errors.New("capella execution payload does not have blob gas used")
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.BlobGasUsed, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.BlobGasUsed, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.BlobGasUsed, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// ExcessBlobGas returns the excess blob gas of the execution payload.
+func (v *VersionedExecutionPayload) ExcessBlobGas() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		return 0, errors.New("bellatrix execution payload does not have excess blob gas")
+	case DataVersionCapella:
+		return 0, errors.New("capella execution payload does not have excess blob gas")
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload")
+		}
+
+		return v.Deneb.ExcessBlobGas, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload")
+		}
+
+		return v.Electra.ExcessBlobGas, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload")
+		}
+
+		return v.Fulu.ExcessBlobGas, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedExecutionPayload) String() string {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
+	default:
+		return "unknown version"
+	}
+}
+
+// uint256FromLittleEndian converts a little-endian 32-byte value to a uint256.
+func uint256FromLittleEndian(input [32]byte) *uint256.Int {
+	var data [32]byte
+	for i := range input {
+		data[len(input)-1-i] = input[i]
+	}
+
+	return new(uint256.Int).SetBytes32(data[:])
+}
@@ -0,0 +1,159 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedExecutionPayload(t *testing.T) {
+	blockHash := phase0.Hash32{0x01}
+	feeRecipient := bellatrix.ExecutionAddress{0x02}
+	withdrawals := []*capella.Withdrawal{{Index: 3}}
+
+	tests := []struct {
+		name           string
+		payload        *spec.VersionedExecutionPayload
+		withdrawalsErr string
+		blobGasUsedErr string
+	}{
+		{
+			name: "Bellatrix",
+			payload: &spec.VersionedExecutionPayload{
+				Version: spec.DataVersionBellatrix,
+				Bellatrix: &bellatrix.ExecutionPayload{
+					BlockHash:     blockHash,
+					FeeRecipient:  feeRecipient,
+					GasUsed:       21000,
+					BaseFeePerGas: [32]byte{0x07},
+				},
+			},
+			withdrawalsErr: "bellatrix execution payload does not have withdrawals",
+			blobGasUsedErr: "bellatrix execution payload does not have blob gas used",
+		},
+		{
+			name: "Capella",
+			payload: &spec.VersionedExecutionPayload{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.ExecutionPayload{
+					BlockHash:     blockHash,
+					FeeRecipient:  feeRecipient,
+					GasUsed:       21000,
+					BaseFeePerGas: [32]byte{0x07},
+					Withdrawals:   withdrawals,
+				},
+			},
+			blobGasUsedErr: "capella execution payload does not have blob gas used",
+		},
+		{
+			name: "Electra",
+			payload: &spec.VersionedExecutionPayload{
+				Version: spec.DataVersionElectra,
+				Electra: &deneb.ExecutionPayload{
+					BlockHash:     blockHash,
+					FeeRecipient:  feeRecipient,
+					GasUsed:       21000,
+					BaseFeePerGas: uint256.NewInt(7),
+					Withdrawals:   withdrawals,
+					BlobGasUsed:   131072,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.False(t, test.payload.IsEmpty())
+
+			hash, err := test.payload.BlockHash()
+			require.NoError(t, err)
+			require.Equal(t, blockHash, hash)
+
+			recipient, err := test.payload.FeeRecipient()
+			require.NoError(t, err)
+			require.Equal(t, feeRecipient, recipient)
+
+			gasUsed, err := test.payload.GasUsed()
+			require.NoError(t, err)
+			require.Equal(t, uint64(21000), gasUsed)
+
+			baseFee, err := test.payload.BaseFeePerGas()
+			require.NoError(t, err)
+			require.Equal(t, uint256.NewInt(7), baseFee)
+
+			payloadWithdrawals, err := test.payload.Withdrawals()
+			if test.withdrawalsErr != "" {
+				require.EqualError(t, err, test.withdrawalsErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, withdrawals, payloadWithdrawals)
+			}
+
+			blobGasUsed, err := test.payload.BlobGasUsed()
+			if test.blobGasUsedErr != "" {
+				require.EqualError(t, err, test.blobGasUsedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, uint64(131072), blobGasUsed)
+			}
+		})
+	}
+}
+
+func TestVersionedExecutionPayloadMissing(t *testing.T) {
+	payload := &spec.VersionedExecutionPayload{
+		Version: spec.DataVersionDeneb,
+	}
+	require.True(t, payload.IsEmpty())
+	_, err := payload.BlockHash()
+	require.EqualError(t, err, "no deneb execution payload")
+
+	payload.Version = spec.DataVersionPhase0
+	_, err = payload.BlockHash()
+	require.EqualError(t, err, "unknown version")
+}
+
+func TestVersionedExecutionPayloadHeader(t *testing.T) {
+	header := &spec.VersionedExecutionPayloadHeader{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.ExecutionPayloadHeader{
+			BlockHash:       phase0.Hash32{0x01},
+			BaseFeePerGas:   [32]byte{0x00, 0x01},
+			WithdrawalsRoot: phase0.Root{0x02},
+		},
+	}
+
+	hash, err := header.BlockHash()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Hash32{0x01}, hash)
+
+	baseFee, err := header.BaseFeePerGas()
+	require.NoError(t, err)
+	require.Equal(t, uint256.NewInt(256), baseFee)
+
+	withdrawalsRoot, err := header.WithdrawalsRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{0x02}, withdrawalsRoot)
+
+	_, err = header.BlobGasUsed()
+	require.EqualError(t, err, "capella execution payload header does not have blob gas used")
+}
@@ -0,0 +1,513 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// VersionedExecutionPayloadHeader contains a versioned execution payload header.
+type VersionedExecutionPayloadHeader struct {
+	Version   DataVersion
+	Bellatrix *bellatrix.ExecutionPayloadHeader
+	Capella   *capella.ExecutionPayloadHeader
+	Deneb     *deneb.ExecutionPayloadHeader
+	Electra   *deneb.ExecutionPayloadHeader
+	Fulu      *deneb.ExecutionPayloadHeader
+}
+
+// IsEmpty returns true if there is no header.
+func (v *VersionedExecutionPayloadHeader) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Fulu == nil
+}
+
+// ParentHash returns the parent hash of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) ParentHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix execution payload header")
+		}
+
+		return v.Bellatrix.ParentHash, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.ParentHash, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Hash32{}, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.ParentHash, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Hash32{}, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.ParentHash, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Hash32{}, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.ParentHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unknown version")
+	}
+}
+
+// FeeRecipient returns the fee recipient of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no bellatrix execution payload header")
+		}
+
+		return v.Bellatrix.FeeRecipient, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.FeeRecipient, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.FeeRecipient, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.FeeRecipient, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.FeeRecipient, nil
+	default:
+		return bellatrix.ExecutionAddress{}, errors.New("unknown version")
+	}
+}
+
+// BlockNumber returns the block number of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) BlockNumber() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix execution payload header")
+		}
+
+		return v.Bellatrix.BlockNumber, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.BlockNumber, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.BlockNumber, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.BlockNumber, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.BlockNumber, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// GasLimit returns the gas limit of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) GasLimit() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix execution payload header")
+		}
+
+		return v.Bellatrix.GasLimit, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.GasLimit, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.GasLimit, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.GasLimit, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.GasLimit, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// GasUsed returns the gas used of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) GasUsed() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix execution payload header")
+		}
+
+		return v.Bellatrix.GasUsed, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.GasUsed, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.GasUsed, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.GasUsed, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.GasUsed, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// Timestamp returns the timestamp of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) Timestamp() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix execution payload header")
+		}
+
+		return v.Bellatrix.Timestamp, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.Timestamp, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.Timestamp, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.Timestamp, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.Timestamp, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// BaseFeePerGas returns the base fee per gas of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) BaseFeePerGas() (*uint256.Int, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix execution payload header")
+		}
+
+		return uint256FromLittleEndian(v.Bellatrix.BaseFeePerGas), nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella execution payload header")
+		}
+
+		return uint256FromLittleEndian(v.Capella.BaseFeePerGas), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.BaseFeePerGas, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.BaseFeePerGas, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.BaseFeePerGas, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// BlockHash returns the block hash of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix execution payload header")
+		}
+
+		return v.Bellatrix.BlockHash, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.BlockHash, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Hash32{}, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.BlockHash, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Hash32{}, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.BlockHash, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Hash32{}, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unknown version")
+	}
+}
+
+// TransactionsRoot returns the transactions root of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) TransactionsRoot() (phase0.Root, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Root{}, errors.New("no bellatrix execution payload header")
+		}
+
+		return v.Bellatrix.TransactionsRoot, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Root{}, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.TransactionsRoot, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Root{}, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.TransactionsRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.TransactionsRoot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.TransactionsRoot, nil
+	default:
+		return phase0.Root{}, errors.New("unknown version")
+	}
+}
+
+// WithdrawalsRoot returns the withdrawals root of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) WithdrawalsRoot() (phase0.Root, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		return phase0.Root{}, errors.New("bellatrix execution payload header does not have withdrawals root")
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Root{}, errors.New("no capella execution payload header")
+		}
+
+		return v.Capella.WithdrawalsRoot, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Root{}, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.WithdrawalsRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.WithdrawalsRoot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.Root{}, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.WithdrawalsRoot, nil
+	default:
+		return phase0.Root{}, errors.New("unknown version")
+	}
+}
+
+// BlobGasUsed returns the blob gas used of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) BlobGasUsed() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		return 0, errors.New("bellatrix execution payload header does not have blob gas used")
+	case DataVersionCapella:
+		return 0, errors.New("capella execution payload header does not have blob gas used")
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.BlobGasUsed, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.BlobGasUsed, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.BlobGasUsed, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// ExcessBlobGas returns the excess blob gas of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) ExcessBlobGas() (uint64, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		return 0, errors.New("bellatrix execution payload header does not have excess blob gas")
+	case DataVersionCapella:
+		return 0, errors.New("capella execution payload header does not have excess blob gas")
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb execution payload header")
+		}
+
+		return v.Deneb.ExcessBlobGas, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra execution payload header")
+		}
+
+		return v.Electra.ExcessBlobGas, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu execution payload header")
+		}
+
+		return v.Fulu.ExcessBlobGas, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedExecutionPayloadHeader) String() string {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
+	default:
+		return "unknown version"
+	}
+}
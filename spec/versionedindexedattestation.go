@@ -29,6 +29,7 @@ type VersionedIndexedAttestation struct {
 	Capella   *phase0.IndexedAttestation
 	Deneb     *phase0.IndexedAttestation
 	Electra   *electra.IndexedAttestation
+	Fulu      *electra.IndexedAttestation
 }
 
 // IsEmpty returns true if there is no block.
@@ -75,6 +76,12 @@ func (v *VersionedIndexedAttestation) AttestingIndices() ([]uint64, error) {
 		}
 
 		return v.Electra.AttestingIndices, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu indexed attestation")
+		}
+
+		return v.Fulu.AttestingIndices, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -119,6 +126,12 @@ func (v *VersionedIndexedAttestation) Data() (*phase0.AttestationData, error) {
 		}
 
 		return v.Electra.Data, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return nil, errors.New("no Fulu indexed attestation")
+		}
+
+		return v.Fulu.Data, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -163,6 +176,12 @@ func (v *VersionedIndexedAttestation) Signature() (phase0.BLSSignature, error) {
 		}
 
 		return v.Electra.Signature, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.BLSSignature{}, errors.New("no Fulu indexed attestation")
+		}
+
+		return v.Fulu.Signature, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -207,6 +226,12 @@ func (v *VersionedIndexedAttestation) String() string {
 		}
 
 		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
@@ -0,0 +1,123 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedLightClientBootstrap contains a versioned light client bootstrap.
+type VersionedLightClientBootstrap struct {
+	Version   DataVersion
+	Altair    *altair.LightClientBootstrap
+	Bellatrix *altair.LightClientBootstrap
+	Capella   *capella.LightClientBootstrap
+	Deneb     *deneb.LightClientBootstrap
+	Electra   *electra.LightClientBootstrap
+	Fulu      *electra.LightClientBootstrap
+}
+
+// Slot returns the slot of the bootstrap header.
+func (v *VersionedLightClientBootstrap) Slot() (phase0.Slot, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Header == nil || v.Altair.Header.Beacon == nil {
+			return 0, errors.New("no altair light client bootstrap")
+		}
+
+		return v.Altair.Header.Beacon.Slot, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Header == nil || v.Bellatrix.Header.Beacon == nil {
+			return 0, errors.New("no bellatrix light client bootstrap")
+		}
+
+		return v.Bellatrix.Header.Beacon.Slot, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Header == nil || v.Capella.Header.Beacon == nil {
+			return 0, errors.New("no capella light client bootstrap")
+		}
+
+		return v.Capella.Header.Beacon.Slot, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Header == nil || v.Deneb.Header.Beacon == nil {
+			return 0, errors.New("no deneb light client bootstrap")
+		}
+
+		return v.Deneb.Header.Beacon.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Header == nil || v.Electra.Header.Beacon == nil {
+			return 0, errors.New("no electra light client bootstrap")
+		}
+
+		return v.Electra.Header.Beacon.Slot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.Header == nil || v.Fulu.Header.Beacon == nil {
+			return 0, errors.New("no fulu light client bootstrap")
+		}
+
+		return v.Fulu.Header.Beacon.Slot, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedLightClientBootstrap) String() string {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return ""
+		}
+
+		return v.Altair.String()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
+	default:
+		return "unknown version"
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedLightClientUpdate contains a versioned light client update.
+type VersionedLightClientUpdate struct {
+	Version   DataVersion
+	Altair    *altair.LightClientUpdate
+	Bellatrix *altair.LightClientUpdate
+	Capella   *capella.LightClientUpdate
+	Deneb     *deneb.LightClientUpdate
+	Electra   *electra.LightClientUpdate
+	Fulu      *electra.LightClientUpdate
+}
+
+// AttestedSlot returns the slot of the attested header.
+func (v *VersionedLightClientUpdate) AttestedSlot() (phase0.Slot, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.AttestedHeader == nil || v.Altair.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no altair light client update")
+		}
+
+		return v.Altair.AttestedHeader.Beacon.Slot, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.AttestedHeader == nil || v.Bellatrix.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no bellatrix light client update")
+		}
+
+		return v.Bellatrix.AttestedHeader.Beacon.Slot, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.AttestedHeader == nil || v.Capella.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no capella light client update")
+		}
+
+		return v.Capella.AttestedHeader.Beacon.Slot, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.AttestedHeader == nil || v.Deneb.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no deneb light client update")
+		}
+
+		return v.Deneb.AttestedHeader.Beacon.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.AttestedHeader == nil || v.Electra.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no electra light client update")
+		}
+
+		return v.Electra.AttestedHeader.Beacon.Slot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.AttestedHeader == nil || v.Fulu.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no fulu light client update")
+		}
+
+		return v.Fulu.AttestedHeader.Beacon.Slot, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// SignatureSlot returns the signature slot.
+func (v *VersionedLightClientUpdate) SignatureSlot() (phase0.Slot, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no altair light client update")
+		}
+
+		return v.Altair.SignatureSlot, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix light client update")
+		}
+
+		return v.Bellatrix.SignatureSlot, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella light client update")
+		}
+
+		return v.Capella.SignatureSlot, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb light client update")
+		}
+
+		return v.Deneb.SignatureSlot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra light client update")
+		}
+
+		return v.Electra.SignatureSlot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu light client update")
+		}
+
+		return v.Fulu.SignatureSlot, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedLightClientUpdate) String() string {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return ""
+		}
+
+		return v.Altair.String()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
+	default:
+		return "unknown version"
+	}
+}
@@ -29,6 +29,78 @@ type VersionedSignedAggregateAndProof struct {
 	Capella   *phase0.SignedAggregateAndProof
 	Deneb     *phase0.SignedAggregateAndProof
 	Electra   *electra.SignedAggregateAndProof
+	Fulu      *electra.SignedAggregateAndProof
+}
+
+// Aggregate returns the aggregate attestation of the signed aggregate and proof.
+func (v *VersionedSignedAggregateAndProof) Aggregate() (*VersionedAttestation, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Message == nil {
+			return nil, errors.New("no phase0 signed aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Phase0:  v.Phase0.Message.Aggregate,
+		}, nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil {
+			return nil, errors.New("no altair signed aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Altair:  v.Altair.Message.Aggregate,
+		}, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return nil, errors.New("no bellatrix signed aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version:   v.Version,
+			Bellatrix: v.Bellatrix.Message.Aggregate,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil {
+			return nil, errors.New("no capella signed aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Capella: v.Capella.Message.Aggregate,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil {
+			return nil, errors.New("no deneb signed aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Deneb:   v.Deneb.Message.Aggregate,
+		}, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return nil, errors.New("no electra signed aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Electra: v.Electra.Message.Aggregate,
+		}, nil
+	case DataVersionFulu:
+		if v.Fulu == nil || v.Fulu.Message == nil {
+			return nil, errors.New("no fulu signed aggregate and proof")
+		}
+
+		return &VersionedAttestation{
+			Version: v.Version,
+			Fulu:    v.Fulu.Message.Aggregate,
+		}, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
 }
 
 // AggregatorIndex returns the aggregator index of the aggregate.
@@ -70,6 +142,12 @@ func (v *VersionedSignedAggregateAndProof) AggregatorIndex() (phase0.ValidatorIn
 		}
 
 		return v.Electra.Message.AggregatorIndex, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu signed aggregate and proof")
+		}
+
+		return v.Fulu.Message.AggregatorIndex, nil
 	default:
 		return 0, errors.New("unknown version for signed aggregate and proof")
 	}
@@ -119,6 +197,12 @@ func (v *VersionedSignedAggregateAndProof) SelectionProof() (phase0.BLSSignature
 		}
 
 		return v.Electra.Message.SelectionProof, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.BLSSignature{}, errors.New("no fulu signed aggregate and proof")
+		}
+
+		return v.Fulu.Message.SelectionProof, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -163,6 +247,12 @@ func (v *VersionedSignedAggregateAndProof) Signature() (phase0.BLSSignature, err
 		}
 
 		return v.Electra.Signature, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return phase0.BLSSignature{}, errors.New("no fulu signed aggregate and proof")
+		}
+
+		return v.Fulu.Signature, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -207,6 +297,12 @@ func (v *VersionedSignedAggregateAndProof) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Electra.Message.Aggregate.Data.Slot, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return 0, errors.New("no fulu signed aggregate and proof")
+		}
+
+		return v.Fulu.Message.Aggregate.Data.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -251,6 +347,12 @@ func (v *VersionedSignedAggregateAndProof) String() string {
 		}
 
 		return v.Electra.String()
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			return ""
+		}
+
+		return v.Fulu.String()
 	default:
 		return "unknown version"
 	}
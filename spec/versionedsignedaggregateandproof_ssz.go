@@ -0,0 +1,141 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// sszObject is an object that can be SSZ encoded, decoded and hashed.
+type sszObject interface {
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+	SizeSSZ() int
+	HashTreeRoot() ([32]byte, error)
+}
+
+// MarshalSSZ ssz marshals the signed aggregate and proof of the given version.
+func (v *VersionedSignedAggregateAndProof) MarshalSSZ() ([]byte, error) {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.MarshalSSZ()
+}
+
+// UnmarshalSSZ ssz unmarshals the signed aggregate and proof.
+// The version must be set prior to calling this function.
+func (v *VersionedSignedAggregateAndProof) UnmarshalSSZ(buf []byte) error {
+	obj, err := v.sszObject(true)
+	if err != nil {
+		return err
+	}
+
+	return obj.UnmarshalSSZ(buf)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the signed aggregate and proof.
+func (v *VersionedSignedAggregateAndProof) SizeSSZ() int {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return 0
+	}
+
+	return obj.SizeSSZ()
+}
+
+// HashTreeRoot ssz hashes the signed aggregate and proof of the given version.
+func (v *VersionedSignedAggregateAndProof) HashTreeRoot() ([32]byte, error) {
+	obj, err := v.sszObject(false)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return obj.HashTreeRoot()
+}
+
+// sszObject returns the versioned data, allocating it if required.
+func (v *VersionedSignedAggregateAndProof) sszObject(allocate bool) (sszObject, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			if !allocate {
+				return nil, errors.New("no phase0 signed aggregate and proof")
+			}
+			v.Phase0 = &phase0.SignedAggregateAndProof{}
+		}
+
+		return v.Phase0, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			if !allocate {
+				return nil, errors.New("no altair signed aggregate and proof")
+			}
+			v.Altair = &phase0.SignedAggregateAndProof{}
+		}
+
+		return v.Altair, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			if !allocate {
+				return nil, errors.New("no bellatrix signed aggregate and proof")
+			}
+			v.Bellatrix = &phase0.SignedAggregateAndProof{}
+		}
+
+		return v.Bellatrix, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			if !allocate {
+				return nil, errors.New("no capella signed aggregate and proof")
+			}
+			v.Capella = &phase0.SignedAggregateAndProof{}
+		}
+
+		return v.Capella, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			if !allocate {
+				return nil, errors.New("no deneb signed aggregate and proof")
+			}
+			v.Deneb = &phase0.SignedAggregateAndProof{}
+		}
+
+		return v.Deneb, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			if !allocate {
+				return nil, errors.New("no electra signed aggregate and proof")
+			}
+			v.Electra = &electra.SignedAggregateAndProof{}
+		}
+
+		return v.Electra, nil
+	case DataVersionFulu:
+		if v.Fulu == nil {
+			if !allocate {
+				return nil, errors.New("no fulu signed aggregate and proof")
+			}
+			v.Fulu = &electra.SignedAggregateAndProof{}
+		}
+
+		return v.Fulu, nil
+	default:
+		return nil, errors.New("unknown version for signed aggregate and proof")
+	}
+}
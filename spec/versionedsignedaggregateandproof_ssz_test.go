@@ -0,0 +1,122 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedSignedAggregateAndProofSSZ(t *testing.T) {
+	data := &phase0.AttestationData{
+		Slot:            1,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{Epoch: 0},
+		Target:          &phase0.Checkpoint{Epoch: 1},
+	}
+	phase0Aggregate := &phase0.SignedAggregateAndProof{
+		Message: &phase0.AggregateAndProof{
+			AggregatorIndex: 2,
+			Aggregate: &phase0.Attestation{
+				AggregationBits: bitfield.NewBitlist(8),
+				Data:            data,
+			},
+		},
+	}
+	electraAggregate := &electra.SignedAggregateAndProof{
+		Message: &electra.AggregateAndProof{
+			AggregatorIndex: 3,
+			Aggregate: &electra.Attestation{
+				AggregationBits: bitfield.NewBitlist(8),
+				Data:            data,
+				CommitteeBits:   bitfield.NewBitvector64(),
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		aggregate *spec.VersionedSignedAggregateAndProof
+		inner     interface {
+			MarshalSSZ() ([]byte, error)
+			HashTreeRoot() ([32]byte, error)
+		}
+		err string
+	}{
+		{
+			name:      "VersionUnknown",
+			aggregate: &spec.VersionedSignedAggregateAndProof{},
+			err:       "unknown version for signed aggregate and proof",
+		},
+		{
+			name:      "DataMissing",
+			aggregate: &spec.VersionedSignedAggregateAndProof{Version: spec.DataVersionDeneb},
+			err:       "no deneb signed aggregate and proof",
+		},
+		{
+			name:      "Phase0",
+			aggregate: &spec.VersionedSignedAggregateAndProof{Version: spec.DataVersionPhase0, Phase0: phase0Aggregate},
+			inner:     phase0Aggregate,
+		},
+		{
+			name:      "Deneb",
+			aggregate: &spec.VersionedSignedAggregateAndProof{Version: spec.DataVersionDeneb, Deneb: phase0Aggregate},
+			inner:     phase0Aggregate,
+		},
+		{
+			name:      "Electra",
+			aggregate: &spec.VersionedSignedAggregateAndProof{Version: spec.DataVersionElectra, Electra: electraAggregate},
+			inner:     electraAggregate,
+		},
+		{
+			name:      "Fulu",
+			aggregate: &spec.VersionedSignedAggregateAndProof{Version: spec.DataVersionFulu, Fulu: electraAggregate},
+			inner:     electraAggregate,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := test.aggregate.MarshalSSZ()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				_, err = test.aggregate.HashTreeRoot()
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, encoded, test.aggregate.SizeSSZ())
+
+			expected, err := test.inner.MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, expected, encoded)
+
+			root, err := test.aggregate.HashTreeRoot()
+			require.NoError(t, err)
+			expectedRoot, err := test.inner.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, expectedRoot, root)
+
+			decoded := &spec.VersionedSignedAggregateAndProof{Version: test.aggregate.Version}
+			require.NoError(t, decoded.UnmarshalSSZ(encoded))
+			require.Equal(t, test.aggregate, decoded)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedSignedAggregateAndProofAggregate(t *testing.T) {
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(6, true)
+	aggregate := &electra.Attestation{
+		AggregationBits: bitfield.NewBitlist(8),
+		Data:            &phase0.AttestationData{Slot: 5},
+		CommitteeBits:   committeeBits,
+	}
+
+	versioned := &spec.VersionedSignedAggregateAndProof{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.SignedAggregateAndProof{
+			Message: &electra.AggregateAndProof{
+				AggregatorIndex: 3,
+				Aggregate:       aggregate,
+			},
+		},
+	}
+	res, err := versioned.Aggregate()
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionElectra, res.Version)
+	committeeIndex, err := res.CommitteeIndex()
+	require.NoError(t, err)
+	require.Equal(t, phase0.CommitteeIndex(6), committeeIndex)
+
+	res, err = (&spec.VersionedAggregateAndProof{
+		Version: spec.DataVersionElectra,
+		Electra: versioned.Electra.Message,
+	}).Aggregate()
+	require.NoError(t, err)
+	require.Equal(t, aggregate, res.Electra)
+
+	_, err = (&spec.VersionedSignedAggregateAndProof{
+		Version: spec.DataVersionDeneb,
+	}).Aggregate()
+	require.EqualError(t, err, "no deneb signed aggregate and proof")
+
+	_, err = (&spec.VersionedSignedAggregateAndProof{}).Aggregate()
+	require.EqualError(t, err, "unknown version")
+}
@@ -0,0 +1,264 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spectests provides helpers to test types against the Ethereum
+// consensus spec tests, as found at https://github.com/ethereum/consensus-spec-tests
+package spectests
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/goccy/go-yaml"
+	clone "github.com/huandu/go-clone/generic"
+)
+
+// DirEnv is the environment variable that holds the location of the
+// consensus spec tests.
+const DirEnv = "CONSENSUS_SPEC_TESTS_DIR"
+
+// Container is a type that can be tested against the spec tests.
+type Container interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// Test is a spec test for a single type.
+type Test struct {
+	// Name is the name of the type in the spec tests, for example "BeaconBlock".
+	Name string
+	// Container is an empty instance of the type.  It is cloned for each case.
+	Container Container
+}
+
+// Case is a single spec test case.
+type Case struct {
+	// Name is the name of the case, for example "case_0".
+	Name string
+	// Suite is the name of the suite containing the case, for example "ssz_random".
+	Suite string
+	// Path is the directory holding the case's files.
+	Path string
+}
+
+// SSZStaticDir returns the directory holding the ssz_static tests for the
+// given preset and fork, for example "mainnet" and "deneb".
+func SSZStaticDir(baseDir string, preset string, fork string) string {
+	return filepath.Join(baseDir, "tests", preset, fork, "ssz_static")
+}
+
+// Cases returns the test cases for the named type in the given suite directory.
+// If suite is empty then cases from all suites are returned.
+func Cases(dir string, name string, suite string) ([]*Case, error) {
+	suites := []string{suite}
+	if suite == "" {
+		entries, err := os.ReadDir(filepath.Join(dir, name))
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to read suites for %s", name), err)
+		}
+		suites = make([]string, 0, len(entries))
+		for _, entry := range entries {
+			if entry.IsDir() {
+				suites = append(suites, entry.Name())
+			}
+		}
+	}
+
+	cases := make([]*Case, 0)
+	for _, suite := range suites {
+		suiteDir := filepath.Join(dir, name, suite)
+		entries, err := os.ReadDir(suiteDir)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to read cases for %s/%s", name, suite), err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			cases = append(cases, &Case{
+				Name:  entry.Name(),
+				Suite: suite,
+				Path:  filepath.Join(suiteDir, entry.Name()),
+			})
+		}
+	}
+	sort.Slice(cases, func(i, j int) bool {
+		if cases[i].Suite != cases[j].Suite {
+			return cases[i].Suite < cases[j].Suite
+		}
+
+		return cases[i].Name < cases[j].Name
+	})
+
+	return cases, nil
+}
+
+// ValueYAML returns the YAML representation of the case's value.
+func (c *Case) ValueYAML() ([]byte, error) {
+	return os.ReadFile(filepath.Join(c.Path, "value.yaml"))
+}
+
+// SSZ returns the decompressed SSZ representation of the case's value.
+func (c *Case) SSZ() ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(c.Path, "serialized.ssz_snappy"))
+	if err != nil {
+		return nil, err
+	}
+
+	return Decompress(data)
+}
+
+// Root returns the expected hash tree root of the case's value.
+func (c *Case) Root() ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(c.Path, "roots.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	roots := struct {
+		Root string `yaml:"root"`
+	}{}
+	if err := yaml.Unmarshal(data, &roots); err != nil {
+		return nil, errors.Join(errors.New("invalid roots YAML"), err)
+	}
+
+	var root []byte
+	if _, err := fmt.Sscanf(roots.Root, "0x%x", &root); err != nil {
+		return nil, errors.Join(errors.New("invalid root"), err)
+	}
+
+	return root, nil
+}
+
+// Decompress decompresses snappy data, handling both the block and framed formats.
+func Decompress(data []byte) ([]byte, error) {
+	return codecs.DecodeSnappy(data)
+}
+
+// RoundTrip checks the container against the case.  It confirms that the
+// container can be decoded from and re-encoded to both the YAML and SSZ
+// provided by the case, and that its hash tree root matches the expected root.
+func RoundTrip(c *Case, container Container) error {
+	// Obtain the struct from the YAML.
+	specYAML, err := c.ValueYAML()
+	if err != nil {
+		return errors.Join(errors.New("failed to read YAML"), err)
+	}
+	yamlContainer := clone.Clone(container)
+	if err := yaml.Unmarshal(specYAML, yamlContainer); err != nil {
+		return errors.Join(errors.New("failed to unmarshal YAML"), err)
+	}
+	// Confirm we can return to the YAML.
+	remarshalledSpecYAML, err := yaml.Marshal(yamlContainer)
+	if err != nil {
+		return errors.Join(errors.New("failed to marshal YAML"), err)
+	}
+	expectedYAML, err := YAMLFormat(specYAML)
+	if err != nil {
+		return err
+	}
+	actualYAML, err := YAMLFormat(remarshalledSpecYAML)
+	if err != nil {
+		return err
+	}
+	if expectedYAML != actualYAML {
+		return fmt.Errorf("YAML mismatch: expected %s, got %s", expectedYAML, actualYAML)
+	}
+
+	// Obtain the struct from the SSZ.
+	specSSZ, err := c.SSZ()
+	if err != nil {
+		return errors.Join(errors.New("failed to read SSZ"), err)
+	}
+	sszContainer := clone.Clone(container)
+	if err := sszContainer.UnmarshalSSZ(specSSZ); err != nil {
+		return errors.Join(errors.New("failed to unmarshal SSZ"), err)
+	}
+	// Confirm we can return to the SSZ.
+	remarshalledSpecSSZ, err := sszContainer.MarshalSSZ()
+	if err != nil {
+		return errors.Join(errors.New("failed to marshal SSZ"), err)
+	}
+	if !bytes.Equal(specSSZ, remarshalledSpecSSZ) {
+		return errors.New("SSZ mismatch")
+	}
+
+	// Confirm we calculate the same root.
+	specRoot, err := c.Root()
+	if err != nil {
+		return errors.Join(errors.New("failed to read root"), err)
+	}
+	root, err := sszContainer.HashTreeRoot()
+	if err != nil {
+		return errors.Join(errors.New("failed to calculate hash tree root"), err)
+	}
+	if !bytes.Equal(specRoot, root[:]) {
+		return fmt.Errorf("root mismatch: expected %#x, got %#x", specRoot, root)
+	}
+
+	return nil
+}
+
+// Run runs the tests against the ssz_static tests in the given directory,
+// as returned by SSZStaticDir, for the given suite.  If suite is empty then
+// cases from all suites are run.  Each case is run as a subtest.
+func Run(t *testing.T, dir string, suite string, tests []*Test) {
+	t.Helper()
+
+	for _, test := range tests {
+		cases, err := Cases(dir, test.Name, suite)
+		if err != nil {
+			t.Fatalf("failed to obtain cases for %s: %v", test.Name, err)
+		}
+		for _, c := range cases {
+			t.Run(fmt.Sprintf("%s/%s/%s", test.Name, c.Suite, c.Name), func(t *testing.T) {
+				if err := RoundTrip(c, test.Container); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+	}
+}
+
+// YAMLFormat returns a normalised version of the YAML, allowing comparison
+// of YAML from the spec tests with YAML generated by this library.
+func YAMLFormat(input []byte) (string, error) {
+	val := make(map[string]any)
+	if err := yaml.UnmarshalWithOptions(input, &val, yaml.UseOrderedMap()); err != nil {
+		return "", errors.Join(errors.New("invalid YAML"), err)
+	}
+
+	res, err := yaml.MarshalWithOptions(val, yaml.Flow(true))
+	if err != nil {
+		return "", errors.Join(errors.New("failed to format YAML"), err)
+	}
+
+	replacements := [][][]byte{
+		{[]byte(`"`), []byte(`'`)},
+		// Field 'extra_data' in ExecutionPayloadHeader/case_1 has a non-standard format, fix here.
+		{[]byte(`extra_data: 0,`), []byte(`extra_data: '0x',`)},
+	}
+	for _, replacement := range replacements {
+		res = bytes.ReplaceAll(res, replacement[0], replacement[1])
+	}
+
+	return string(bytes.ToLower(res)), nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spectests"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCase writes a spec test case for the checkpoint.
+func writeCase(t *testing.T, dir string, checkpoint *phase0.Checkpoint, framed bool) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	value, err := checkpoint.MarshalYAML()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "value.yaml"), value, 0o600))
+
+	data, err := checkpoint.MarshalSSZ()
+	require.NoError(t, err)
+	var compressed []byte
+	if framed {
+		buf := new(bytes.Buffer)
+		writer := snappy.NewBufferedWriter(buf)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		compressed = buf.Bytes()
+	} else {
+		compressed = snappy.Encode(nil, data)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "serialized.ssz_snappy"), compressed, 0o600))
+
+	root, err := checkpoint.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roots.yaml"), []byte(fmt.Sprintf("{root: '%#x'}\n", root)), 0o600))
+}
+
+func TestRun(t *testing.T) {
+	baseDir := t.TempDir()
+	dir := spectests.SSZStaticDir(baseDir, "mainnet", "phase0")
+	writeCase(t, filepath.Join(dir, "Checkpoint", "ssz_random", "case_0"), &phase0.Checkpoint{
+		Epoch: 1,
+		Root:  phase0.Root{0x01},
+	}, false)
+	writeCase(t, filepath.Join(dir, "Checkpoint", "ssz_random", "case_1"), &phase0.Checkpoint{
+		Epoch: 2,
+		Root:  phase0.Root{0x02},
+	}, true)
+	writeCase(t, filepath.Join(dir, "Checkpoint", "ssz_zero", "case_0"), &phase0.Checkpoint{}, false)
+
+	cases, err := spectests.Cases(dir, "Checkpoint", "")
+	require.NoError(t, err)
+	require.Len(t, cases, 3)
+	require.Equal(t, "ssz_random", cases[0].Suite)
+	require.Equal(t, "case_1", cases[1].Name)
+	require.Equal(t, "ssz_zero", cases[2].Suite)
+
+	cases, err = spectests.Cases(dir, "Checkpoint", "ssz_zero")
+	require.NoError(t, err)
+	require.Len(t, cases, 1)
+
+	spectests.Run(t, dir, "", []*spectests.Test{
+		{
+			Name:      "Checkpoint",
+			Container: &phase0.Checkpoint{},
+		},
+	})
+}
+
+func TestRoundTripMismatch(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "case_0")
+	writeCase(t, dir, &phase0.Checkpoint{Epoch: 1}, false)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roots.yaml"), []byte(fmt.Sprintf("{root: '%#x'}\n", phase0.Root{})), 0o600))
+
+	err := spectests.RoundTrip(&spectests.Case{Name: "case_0", Path: dir}, &phase0.Checkpoint{})
+	require.ErrorContains(t, err, "root mismatch")
+}
+
+func TestCasesMissing(t *testing.T) {
+	_, err := spectests.Cases(t.TempDir(), "Checkpoint", "")
+	require.ErrorContains(t, err, "failed to read suites for Checkpoint")
+}
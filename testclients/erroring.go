@@ -25,7 +25,10 @@ import (
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/focil"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -250,7 +253,7 @@ func (s *Erroring) AttestationData(ctx context.Context,
 func (s *Erroring) AttestationPool(ctx context.Context,
 	opts *api.AttestationPoolOpts,
 ) (
-	*api.Response[[]*phase0.Attestation],
+	*api.Response[[]*spec.VersionedAttestation],
 	error,
 ) {
 	if err := s.maybeError(ctx); err != nil {
@@ -424,6 +427,37 @@ func (s *Erroring) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedS
 	return next.SubmitBeaconBlock(ctx, block)
 }
 
+// SubmitBeaconBlockWithOpts submits a beacon block with the given options.
+func (s *Erroring) SubmitBeaconBlockWithOpts(ctx context.Context, opts *api.SubmitBeaconBlockOpts) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.BeaconBlockWithOptsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBeaconBlockWithOpts(ctx, opts)
+}
+
+// BeaconCommitteeSelections combines partial beacon committee selection proofs into aggregated selection proofs.
+func (s *Erroring) BeaconCommitteeSelections(ctx context.Context,
+	opts *api.BeaconCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommitteeSelection],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconCommitteeSelections(ctx, opts)
+}
+
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
 func (s *Erroring) SubmitBeaconCommitteeSubscriptions(ctx context.Context,
 	subscriptions []*apiv1.BeaconCommitteeSubscription,
@@ -456,6 +490,21 @@ func (s *Erroring) SubmitBlindedBeaconBlock(ctx context.Context,
 	return next.SubmitBlindedBeaconBlock(ctx, block)
 }
 
+// SubmitBlindedProposal submits a blinded proposal.
+func (s *Erroring) SubmitBlindedProposal(ctx context.Context,
+	opts *api.SubmitBlindedProposalOpts,
+) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.BlindedProposalSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBlindedProposal(ctx, opts)
+}
+
 // SubmitValidatorRegistrations submits a validator registration.
 func (s *Erroring) SubmitValidatorRegistrations(ctx context.Context,
 	registrations []*api.VersionedSignedValidatorRegistration,
@@ -471,6 +520,24 @@ func (s *Erroring) SubmitValidatorRegistrations(ctx context.Context,
 	return next.SubmitValidatorRegistrations(ctx, registrations)
 }
 
+// SyncCommitteeSelections combines partial sync committee selection proofs into aggregated selection proofs.
+func (s *Erroring) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommitteeSelections(ctx, opts)
+}
+
 // SubmitSyncCommitteeSubscriptions subscribes to sync committees.
 func (s *Erroring) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.SyncCommitteeSubscription) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -502,6 +569,42 @@ func (s *Erroring) BeaconState(ctx context.Context,
 	return next.BeaconState(ctx, opts)
 }
 
+// DownloadBeaconState downloads a beacon state.
+func (s *Erroring) DownloadBeaconState(ctx context.Context,
+	opts *api.DownloadBeaconStateOpts,
+) (
+	*api.Response[*api.BeaconStateDownload],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconStateDownloader)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DownloadBeaconState(ctx, opts)
+}
+
+// DepositSnapshot provides a snapshot of the deposit tree.
+func (s *Erroring) DepositSnapshot(ctx context.Context,
+	opts *api.DepositSnapshotOpts,
+) (
+	*api.Response[*apiv1.DepositSnapshot],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DepositSnapshot(ctx, opts)
+}
+
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Erroring) Events(ctx context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -515,6 +618,37 @@ func (s *Erroring) Events(ctx context.Context, topics []string, handler consensu
 	return next.Events(ctx, topics, handler)
 }
 
+// EventsWithHandlers feeds events to the supplied typed handlers.
+func (s *Erroring) EventsWithHandlers(ctx context.Context, handlers *consensusclient.EventHandlers) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.EventsWithHandlersProvider)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.EventsWithHandlers(ctx, handlers)
+}
+
+// ExpectedWithdrawals provides the withdrawals expected to be included in a proposal built on the given state.
+func (s *Erroring) ExpectedWithdrawals(ctx context.Context,
+	opts *api.ExpectedWithdrawalsOpts,
+) (
+	*api.Response[[]*capella.Withdrawal],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ExpectedWithdrawals(ctx, opts)
+}
+
 // Finality provides the finality given a state ID.
 func (s *Erroring) Finality(ctx context.Context,
 	opts *api.FinalityOpts,
@@ -605,6 +739,60 @@ func (s *Erroring) NodeSyncing(ctx context.Context,
 	return next.NodeSyncing(ctx, opts)
 }
 
+// NodeIdentity provides the network identity of the node.
+func (s *Erroring) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
+// NodePeer provides a single peer of the node.
+func (s *Erroring) NodePeer(ctx context.Context,
+	opts *api.NodePeerOpts,
+) (
+	*api.Response[*apiv1.Peer],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodePeerProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodePeer(ctx, opts)
+}
+
+// NodePeerCount provides the number of peers of the node in each connection state.
+func (s *Erroring) NodePeerCount(ctx context.Context,
+	opts *api.NodePeerCountOpts,
+) (
+	*api.Response[*apiv1.PeerCount],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodePeerCount(ctx, opts)
+}
+
 // NodePeers provides the peers of the node.
 func (s *Erroring) NodePeers(ctx context.Context,
 	opts *api.NodePeersOpts,
@@ -750,6 +938,112 @@ func (s *Erroring) Validators(ctx context.Context,
 	return next.Validators(ctx, opts)
 }
 
+// ValidatorIdentities provides the identities of validators for the given options.
+func (s *Erroring) ValidatorIdentities(ctx context.Context,
+	opts *api.ValidatorIdentitiesOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorIdentity],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorIdentitiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorIdentities(ctx, opts)
+}
+
+// ValidatorLiveness provides the liveness of validators for the given options.
+func (s *Erroring) ValidatorLiveness(ctx context.Context,
+	opts *api.ValidatorLivenessOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorLiveness],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorLiveness(ctx, opts)
+}
+
+// ValidatorsIterator provides an iterator over the validators, with their balance and status, for the given options.
+func (s *Erroring) ValidatorsIterator(ctx context.Context,
+	opts *api.ValidatorsIteratorOpts,
+) (
+	*api.ValidatorsIterator,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorsIteratorProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorsIterator(ctx, opts)
+}
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Erroring) SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitAttesterSlashing(ctx, slashing)
+}
+
+// SubmitAttesterSlashings submits versioned attester slashings.
+func (s *Erroring) SubmitAttesterSlashings(ctx context.Context, opts *api.SubmitAttesterSlashingsOpts) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.AttesterSlashingsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitAttesterSlashings(ctx, opts)
+}
+
+// SubmitProposalSlashing submits a proposer slashing.
+func (s *Erroring) SubmitProposalSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.ProposalSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitProposalSlashing(ctx, slashing)
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Erroring) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitBLSToExecutionChanges(ctx, blsToExecutionChanges)
+}
+
 // SubmitVoluntaryExit submits a voluntary exit.
 func (s *Erroring) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -763,6 +1057,60 @@ func (s *Erroring) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase
 	return next.SubmitVoluntaryExit(ctx, voluntaryExit)
 }
 
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Erroring) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttesterSlashing],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttesterSlashingPool(ctx, opts)
+}
+
+// BLSToExecutionChangePool fetches the BLS to execution change pool.
+func (s *Erroring) BLSToExecutionChangePool(ctx context.Context,
+	opts *api.BLSToExecutionChangePoolOpts,
+) (
+	*api.Response[[]*capella.SignedBLSToExecutionChange],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BLSToExecutionChangePool(ctx, opts)
+}
+
+// ProposerSlashingPool fetches the proposer slashing pool.
+func (s *Erroring) ProposerSlashingPool(ctx context.Context,
+	opts *api.ProposerSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.ProposerSlashing],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ProposerSlashingPool(ctx, opts)
+}
+
 // VoluntaryExitPool fetches the voluntary exit pool.
 func (s *Erroring) VoluntaryExitPool(ctx context.Context,
 	opts *api.VoluntaryExitPoolOpts,
@@ -822,6 +1170,81 @@ func (s *Erroring) GenesisTime(ctx context.Context) (time.Time, error) {
 	return next.GenesisTime(ctx)
 }
 
+// InclusionList fetches an inclusion list for the given options.
+func (s *Erroring) InclusionList(ctx context.Context,
+	opts *api.InclusionListOpts,
+) (
+	*api.Response[*focil.InclusionList],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.InclusionListProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.InclusionList(ctx, opts)
+}
+
+// InclusionListDuties obtains inclusion list duties for the given options.
+func (s *Erroring) InclusionListDuties(ctx context.Context,
+	opts *api.InclusionListDutiesOpts,
+) (
+	*api.Response[[]*apiv1.InclusionListDuty],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.InclusionListDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.InclusionListDuties(ctx, opts)
+}
+
+// SubmitInclusionLists submits signed inclusion lists.
+func (s *Erroring) SubmitInclusionLists(ctx context.Context, opts *api.SubmitInclusionListsOpts) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.InclusionListsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitInclusionLists(ctx, opts)
+}
+
+// ClientInfo provides structured information about the implementation of the node.
+func (s *Erroring) ClientInfo(ctx context.Context) (*api.Response[*apiv1.ClientInfo], error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ClientInfoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ClientInfo(ctx)
+}
+
+// SupportedMethods provides the methods supported by the client.
+func (s *Erroring) SupportedMethods(ctx context.Context) (*api.SupportedMethods, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SupportedMethodsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SupportedMethods(ctx)
+}
+
 // DepositContract provides details of the Ethereum 1 deposit contract for the chain.
 func (s *Erroring) DepositContract(ctx context.Context,
 	opts *api.DepositContractOpts,
@@ -876,6 +1299,42 @@ func (s *Erroring) BlobSidecars(ctx context.Context,
 	return next.BlobSidecars(ctx, opts)
 }
 
+// VersionedBlobSidecars fetches the versioned blob sidecars given a block ID.
+func (s *Erroring) VersionedBlobSidecars(ctx context.Context,
+	opts *api.BlobSidecarsOpts,
+) (
+	*api.Response[*api.VersionedBlobSidecars],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.VersionedBlobSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.VersionedBlobSidecars(ctx, opts)
+}
+
+// DataColumnSidecars fetches the data column sidecars given a block ID.
+func (s *Erroring) DataColumnSidecars(ctx context.Context,
+	opts *api.DataColumnSidecarsOpts,
+) (
+	*api.Response[[]*fulu.DataColumnSidecar],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.DataColumnSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DataColumnSidecars(ctx, opts)
+}
+
 // BeaconStateRoot fetches a beacon state root given a state ID.
 func (s *Erroring) BeaconStateRoot(ctx context.Context,
 	opts *api.BeaconStateRootOpts,
@@ -894,6 +1353,24 @@ func (s *Erroring) BeaconStateRoot(ctx context.Context,
 	return next.BeaconStateRoot(ctx, opts)
 }
 
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Erroring) BeaconStateRandao(ctx context.Context,
+	opts *api.BeaconStateRandaoOpts,
+) (
+	*api.Response[*phase0.Root],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconStateRandao(ctx, opts)
+}
+
 // ForkChoice fetches the node's current fork choice context.
 func (s *Erroring) ForkChoice(ctx context.Context,
 	opts *api.ForkChoiceOpts,
@@ -965,3 +1442,75 @@ func (s *Erroring) SyncCommitteeRewards(ctx context.Context,
 
 	return next.SyncCommitteeRewards(ctx, opts)
 }
+
+// LightClientBootstrap fetches the light client bootstrap given options.
+func (s *Erroring) LightClientBootstrap(ctx context.Context,
+	opts *api.LightClientBootstrapOpts,
+) (
+	*api.Response[*spec.VersionedLightClientBootstrap],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientBootstrapProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientBootstrap(ctx, opts)
+}
+
+// LightClientUpdates fetches the light client updates given options.
+func (s *Erroring) LightClientUpdates(ctx context.Context,
+	opts *api.LightClientUpdatesOpts,
+) (
+	*api.Response[[]*spec.VersionedLightClientUpdate],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientUpdatesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientUpdates(ctx, opts)
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update given options.
+func (s *Erroring) LightClientFinalityUpdate(ctx context.Context,
+	opts *api.LightClientFinalityUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientFinalityUpdate],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientFinalityUpdate(ctx, opts)
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update given options.
+func (s *Erroring) LightClientOptimisticUpdate(ctx context.Context,
+	opts *api.LightClientOptimisticUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientOptimisticUpdate],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.LightClientOptimisticUpdate(ctx, opts)
+}
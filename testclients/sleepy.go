@@ -24,7 +24,10 @@ import (
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/focil"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -228,7 +231,7 @@ func (s *Sleepy) AttestationData(ctx context.Context,
 func (s *Sleepy) AttestationPool(ctx context.Context,
 	opts *api.AttestationPoolOpts,
 ) (
-	*api.Response[[]*phase0.Attestation],
+	*api.Response[[]*spec.VersionedAttestation],
 	error,
 ) {
 	s.sleep(ctx)
@@ -313,6 +316,33 @@ func (s *Sleepy) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSig
 	return next.SubmitBeaconBlock(ctx, block)
 }
 
+// SubmitBeaconBlockWithOpts submits a beacon block with the given options.
+func (s *Sleepy) SubmitBeaconBlockWithOpts(ctx context.Context, opts *api.SubmitBeaconBlockOpts) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconBlockWithOptsSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.SubmitBeaconBlockWithOpts(ctx, opts)
+}
+
+// BeaconCommitteeSelections combines partial beacon committee selection proofs into aggregated selection proofs.
+func (s *Sleepy) BeaconCommitteeSelections(ctx context.Context,
+	opts *api.BeaconCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommitteeSelection],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.BeaconCommitteeSelections(ctx, opts)
+}
+
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
 func (s *Sleepy) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
 	s.sleep(ctx)
@@ -377,6 +407,38 @@ func (s *Sleepy) BeaconState(ctx context.Context,
 	return next.BeaconState(ctx, opts)
 }
 
+// DownloadBeaconState downloads a beacon state.
+func (s *Sleepy) DownloadBeaconState(ctx context.Context,
+	opts *api.DownloadBeaconStateOpts,
+) (
+	*api.Response[*api.BeaconStateDownload],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconStateDownloader)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.DownloadBeaconState(ctx, opts)
+}
+
+// DepositSnapshot provides a snapshot of the deposit tree.
+func (s *Sleepy) DepositSnapshot(ctx context.Context,
+	opts *api.DepositSnapshotOpts,
+) (
+	*api.Response[*apiv1.DepositSnapshot],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.DepositSnapshot(ctx, opts)
+}
+
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Sleepy) Events(ctx context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
 	s.sleep(ctx)
@@ -388,6 +450,33 @@ func (s *Sleepy) Events(ctx context.Context, topics []string, handler consensusc
 	return next.Events(ctx, topics, handler)
 }
 
+// EventsWithHandlers feeds events to the supplied typed handlers.
+func (s *Sleepy) EventsWithHandlers(ctx context.Context, handlers *consensusclient.EventHandlers) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.EventsWithHandlersProvider)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.EventsWithHandlers(ctx, handlers)
+}
+
+// ExpectedWithdrawals provides the withdrawals expected to be included in a proposal built on the given state.
+func (s *Sleepy) ExpectedWithdrawals(ctx context.Context,
+	opts *api.ExpectedWithdrawalsOpts,
+) (
+	*api.Response[[]*capella.Withdrawal],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ExpectedWithdrawals(ctx, opts)
+}
+
 // Finality provides the finality given a state ID.
 func (s *Sleepy) Finality(ctx context.Context,
 	opts *api.FinalityOpts,
@@ -468,6 +557,54 @@ func (s *Sleepy) NodeSyncing(ctx context.Context,
 	return next.NodeSyncing(ctx, opts)
 }
 
+// NodeIdentity provides the network identity of the node.
+func (s *Sleepy) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
+// NodePeer provides a single peer of the node.
+func (s *Sleepy) NodePeer(ctx context.Context,
+	opts *api.NodePeerOpts,
+) (
+	*api.Response[*apiv1.Peer],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodePeerProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.NodePeer(ctx, opts)
+}
+
+// NodePeerCount provides the number of peers of the node in each connection state.
+func (s *Sleepy) NodePeerCount(ctx context.Context,
+	opts *api.NodePeerCountOpts,
+) (
+	*api.Response[*apiv1.PeerCount],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.NodePeerCount(ctx, opts)
+}
+
 // NodePeers provides the peers of the node.
 func (s *Sleepy) NodePeers(ctx context.Context,
 	opts *api.NodePeersOpts,
@@ -548,6 +685,98 @@ func (s *Sleepy) Validators(ctx context.Context,
 	return next.Validators(ctx, opts)
 }
 
+// ValidatorIdentities provides the identities of validators for the given options.
+func (s *Sleepy) ValidatorIdentities(ctx context.Context,
+	opts *api.ValidatorIdentitiesOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorIdentity],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatorIdentitiesProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ValidatorIdentities(ctx, opts)
+}
+
+// ValidatorLiveness provides the liveness of validators for the given options.
+func (s *Sleepy) ValidatorLiveness(ctx context.Context,
+	opts *api.ValidatorLivenessOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorLiveness],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ValidatorLiveness(ctx, opts)
+}
+
+// ValidatorsIterator provides an iterator over the validators, with their balance and status, for the given options.
+func (s *Sleepy) ValidatorsIterator(ctx context.Context,
+	opts *api.ValidatorsIteratorOpts,
+) (
+	*api.ValidatorsIterator,
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatorsIteratorProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ValidatorsIterator(ctx, opts)
+}
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Sleepy) SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.SubmitAttesterSlashing(ctx, slashing)
+}
+
+// SubmitAttesterSlashings submits versioned attester slashings.
+func (s *Sleepy) SubmitAttesterSlashings(ctx context.Context, opts *api.SubmitAttesterSlashingsOpts) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttesterSlashingsSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.SubmitAttesterSlashings(ctx, opts)
+}
+
+// SubmitProposalSlashing submits a proposer slashing.
+func (s *Sleepy) SubmitProposalSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposalSlashingSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.SubmitProposalSlashing(ctx, slashing)
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Sleepy) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangesSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.SubmitBLSToExecutionChanges(ctx, blsToExecutionChanges)
+}
+
 // SubmitVoluntaryExit submits a voluntary exit.
 func (s *Sleepy) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
 	s.sleep(ctx)
@@ -559,6 +788,54 @@ func (s *Sleepy) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.
 	return next.SubmitVoluntaryExit(ctx, voluntaryExit)
 }
 
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Sleepy) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttesterSlashing],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.AttesterSlashingPool(ctx, opts)
+}
+
+// BLSToExecutionChangePool fetches the BLS to execution change pool.
+func (s *Sleepy) BLSToExecutionChangePool(ctx context.Context,
+	opts *api.BLSToExecutionChangePoolOpts,
+) (
+	*api.Response[[]*capella.SignedBLSToExecutionChange],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.BLSToExecutionChangePool(ctx, opts)
+}
+
+// ProposerSlashingPool fetches the proposer slashing pool.
+func (s *Sleepy) ProposerSlashingPool(ctx context.Context,
+	opts *api.ProposerSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.ProposerSlashing],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ProposerSlashingPool(ctx, opts)
+}
+
 // VoluntaryExitPool fetches the voluntary exit pool.
 func (s *Sleepy) VoluntaryExitPool(ctx context.Context,
 	opts *api.VoluntaryExitPoolOpts,
@@ -610,6 +887,71 @@ func (s *Sleepy) GenesisTime(ctx context.Context) (time.Time, error) {
 	return next.GenesisTime(ctx)
 }
 
+// InclusionList fetches an inclusion list for the given options.
+func (s *Sleepy) InclusionList(ctx context.Context,
+	opts *api.InclusionListOpts,
+) (
+	*api.Response[*focil.InclusionList],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.InclusionListProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.InclusionList(ctx, opts)
+}
+
+// InclusionListDuties obtains inclusion list duties for the given options.
+func (s *Sleepy) InclusionListDuties(ctx context.Context,
+	opts *api.InclusionListDutiesOpts,
+) (
+	*api.Response[[]*apiv1.InclusionListDuty],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.InclusionListDutiesProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.InclusionListDuties(ctx, opts)
+}
+
+// SubmitInclusionLists submits signed inclusion lists.
+func (s *Sleepy) SubmitInclusionLists(ctx context.Context, opts *api.SubmitInclusionListsOpts) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.InclusionListsSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.SubmitInclusionLists(ctx, opts)
+}
+
+// ClientInfo provides structured information about the implementation of the node.
+func (s *Sleepy) ClientInfo(ctx context.Context) (*api.Response[*apiv1.ClientInfo], error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ClientInfoProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ClientInfo(ctx)
+}
+
+// SupportedMethods provides the methods supported by the client.
+func (s *Sleepy) SupportedMethods(ctx context.Context) (*api.SupportedMethods, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.SupportedMethodsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.SupportedMethods(ctx)
+}
+
 // ForkChoice fetches the node's current fork choice context.
 func (s *Sleepy) ForkChoice(ctx context.Context,
 	opts *api.ForkChoiceOpts,
@@ -642,6 +984,38 @@ func (s *Sleepy) BlobSidecars(ctx context.Context,
 	return next.BlobSidecars(ctx, opts)
 }
 
+// VersionedBlobSidecars fetches the versioned blob sidecars given options.
+func (s *Sleepy) VersionedBlobSidecars(ctx context.Context,
+	opts *api.BlobSidecarsOpts,
+) (
+	*api.Response[*api.VersionedBlobSidecars],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedBlobSidecarsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.VersionedBlobSidecars(ctx, opts)
+}
+
+// DataColumnSidecars fetches the data column sidecars given options.
+func (s *Sleepy) DataColumnSidecars(ctx context.Context,
+	opts *api.DataColumnSidecarsOpts,
+) (
+	*api.Response[[]*fulu.DataColumnSidecar],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.DataColumnSidecarsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.DataColumnSidecars(ctx, opts)
+}
+
 // AttestationRewards provides rewards to the given validators for attesting.
 func (s *Sleepy) AttestationRewards(ctx context.Context,
 	opts *api.AttestationRewardsOpts,
@@ -674,6 +1048,22 @@ func (s *Sleepy) BlockRewards(ctx context.Context,
 	return next.BlockRewards(ctx, opts)
 }
 
+// SyncCommitteeSelections combines partial sync committee selection proofs into aggregated selection proofs.
+func (s *Sleepy) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.SyncCommitteeSelections(ctx, opts)
+}
+
 // SyncCommitteeRewards provides rewards to the given validators for being members of a sync committee.
 func (s *Sleepy) SyncCommitteeRewards(ctx context.Context,
 	opts *api.SyncCommitteeRewardsOpts,
@@ -689,3 +1079,67 @@ func (s *Sleepy) SyncCommitteeRewards(ctx context.Context,
 
 	return next.SyncCommitteeRewards(ctx, opts)
 }
+
+// LightClientBootstrap fetches the light client bootstrap given options.
+func (s *Sleepy) LightClientBootstrap(ctx context.Context,
+	opts *api.LightClientBootstrapOpts,
+) (
+	*api.Response[*spec.VersionedLightClientBootstrap],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientBootstrapProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.LightClientBootstrap(ctx, opts)
+}
+
+// LightClientUpdates fetches the light client updates given options.
+func (s *Sleepy) LightClientUpdates(ctx context.Context,
+	opts *api.LightClientUpdatesOpts,
+) (
+	*api.Response[[]*spec.VersionedLightClientUpdate],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientUpdatesProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.LightClientUpdates(ctx, opts)
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update given options.
+func (s *Sleepy) LightClientFinalityUpdate(ctx context.Context,
+	opts *api.LightClientFinalityUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientFinalityUpdate],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.LightClientFinalityUpdate(ctx, opts)
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update given options.
+func (s *Sleepy) LightClientOptimisticUpdate(ctx context.Context,
+	opts *api.LightClientOptimisticUpdateOpts,
+) (
+	*api.Response[*spec.VersionedLightClientOptimisticUpdate],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.LightClientOptimisticUpdate(ctx, opts)
+}
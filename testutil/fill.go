@@ -0,0 +1,234 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+const (
+	// maxListLen is the maximum number of items generated for a variable-length list.
+	maxListLen = 4
+	// maxBytesLen is the maximum number of bytes generated for a variable-length byte list.
+	maxBytesLen = 32
+	// maxBitlistLen is the maximum number of bits generated for a bitlist.
+	maxBitlistLen = 64
+)
+
+var (
+	slotType           = reflect.TypeOf(phase0.Slot(0))
+	epochType          = reflect.TypeOf(phase0.Epoch(0))
+	validatorIndexType = reflect.TypeOf(phase0.ValidatorIndex(0))
+	committeeIndexType = reflect.TypeOf(phase0.CommitteeIndex(0))
+	gweiType           = reflect.TypeOf(phase0.Gwei(0))
+	bitlistType        = reflect.TypeOf(bitfield.Bitlist{})
+	bitvector4Type     = reflect.TypeOf(bitfield.Bitvector4{})
+)
+
+// dim is a single dimension of a list, either of fixed size or with a maximum.
+type dim struct {
+	size int
+	max  int
+}
+
+// parseDims parses the SSZ size and maximum tags of a field in to dimensions.
+func parseDims(tag reflect.StructTag) ([]dim, error) {
+	var sizes []string
+	if sizeTag := tag.Get("ssz-size"); sizeTag != "" {
+		sizes = strings.Split(sizeTag, ",")
+	}
+	var maxes []string
+	if maxTag := tag.Get("ssz-max"); maxTag != "" {
+		maxes = strings.Split(maxTag, ",")
+	}
+
+	if len(sizes) == 0 {
+		sizes = make([]string, len(maxes))
+		for i := range sizes {
+			sizes[i] = "?"
+		}
+	}
+
+	dims := make([]dim, len(sizes))
+	for i, size := range sizes {
+		if size != "?" {
+			val, err := strconv.Atoi(strings.TrimSpace(size))
+			if err != nil {
+				return nil, errors.Join(fmt.Errorf("invalid size %q", size), err)
+			}
+			dims[i].size = val
+
+			continue
+		}
+		if len(maxes) == 0 {
+			continue
+		}
+		val, err := strconv.ParseUint(strings.TrimSpace(maxes[0]), 10, 64)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("invalid maximum %q", maxes[0]), err)
+		}
+		dims[i].max = int(min(val, math.MaxInt32))
+		maxes = maxes[1:]
+	}
+
+	return dims, nil
+}
+
+// listLen returns the length of a list given its dimension.
+func (g *Generator) listLen(dims []dim, limit int) int {
+	if len(dims) == 0 {
+		return 1 + g.rand.Intn(limit)
+	}
+	if dims[0].size > 0 {
+		return dims[0].size
+	}
+	if dims[0].max > 0 {
+		limit = min(limit, dims[0].max)
+	}
+
+	return 1 + g.rand.Intn(limit)
+}
+
+func (g *Generator) fill(v reflect.Value, dims []dim) error {
+	switch v.Type() {
+	case slotType:
+		v.SetUint(uint64(g.rand.Int63n(1 << 24)))
+
+		return nil
+	case epochType:
+		v.SetUint(uint64(g.rand.Int63n(1 << 19)))
+
+		return nil
+	case validatorIndexType:
+		v.SetUint(uint64(g.rand.Int63n(1 << 20)))
+
+		return nil
+	case committeeIndexType:
+		v.SetUint(uint64(g.rand.Int63n(64)))
+
+		return nil
+	case gweiType:
+		v.SetUint(uint64(g.rand.Int63n(2048_000_000_000)))
+
+		return nil
+	case bitlistType:
+		bits := bitfield.NewBitlist(uint64(g.listLen(dims, maxBitlistLen)))
+		for i := uint64(0); i < bits.Len(); i++ {
+			bits.SetBitAt(i, g.rand.Intn(2) == 1)
+		}
+		v.SetBytes(bits)
+
+		return nil
+	case bitvector4Type:
+		v.SetBytes([]byte{byte(g.rand.Intn(16))})
+
+		return nil
+	}
+
+	//nolint:exhaustive
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		return g.fill(v.Elem(), dims)
+	case reflect.Struct:
+		return g.fillStruct(v)
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			g.rand.Read(v.Slice(0, v.Len()).Bytes())
+
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := g.fill(v.Index(i), nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case reflect.Slice:
+		return g.fillSlice(v, dims)
+	case reflect.Bool:
+		v.SetBool(g.rand.Intn(2) == 1)
+
+		return nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(g.rand.Uint64() >> (64 - v.Type().Bits()))
+
+		return nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		v.SetInt(int64(g.rand.Uint64() >> (65 - v.Type().Bits())))
+
+		return nil
+	case reflect.String:
+		v.SetString(fmt.Sprintf("%x", g.rand.Uint64()))
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+func (g *Generator) fillStruct(v reflect.Value) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		dims, err := parseDims(field.Tag)
+		if err != nil {
+			return errors.Join(fmt.Errorf("invalid tags for %s.%s", v.Type(), field.Name), err)
+		}
+		if err := g.fill(v.Field(i), dims); err != nil {
+			return errors.Join(fmt.Errorf("failed to fill %s.%s", v.Type(), field.Name), err)
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) fillSlice(v reflect.Value, dims []dim) error {
+	if v.Type().Elem().Kind() == reflect.Uint8 {
+		items := make([]byte, g.listLen(dims, maxBytesLen))
+		g.rand.Read(items)
+		v.SetBytes(items)
+
+		return nil
+	}
+
+	var elemDims []dim
+	if len(dims) > 1 {
+		elemDims = dims[1:]
+	}
+	itemsLen := g.listLen(dims, maxListLen)
+	items := reflect.MakeSlice(v.Type(), itemsLen, itemsLen)
+	for i := 0; i < items.Len(); i++ {
+		if err := g.fill(items.Index(i), elemDims); err != nil {
+			return err
+		}
+	}
+	v.Set(items)
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testutil"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+var versions = []spec.DataVersion{
+	spec.DataVersionPhase0,
+	spec.DataVersionAltair,
+	spec.DataVersionBellatrix,
+	spec.DataVersionCapella,
+	spec.DataVersionDeneb,
+	spec.DataVersionElectra,
+	spec.DataVersionFulu,
+}
+
+type container interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// versionedData returns the data for the version of a versioned container.
+func versionedData(t *testing.T, versioned any, version spec.DataVersion) container {
+	t.Helper()
+
+	name := version.String()
+	field := reflect.ValueOf(versioned).Elem().FieldByName(strings.ToUpper(name[:1]) + name[1:])
+	require.True(t, field.IsValid())
+	data, isContainer := field.Interface().(container)
+	require.True(t, isContainer)
+
+	return data
+}
+
+// roundTrip confirms that the data survives SSZ and JSON encoding.
+func roundTrip(t *testing.T, data container) {
+	t.Helper()
+
+	_, err := data.HashTreeRoot()
+	require.NoError(t, err)
+
+	sszData, err := data.MarshalSSZ()
+	require.NoError(t, err)
+	sszRes := reflect.New(reflect.TypeOf(data).Elem()).Interface().(container)
+	require.NoError(t, sszRes.UnmarshalSSZ(sszData))
+	require.Equal(t, data, sszRes)
+
+	jsonData, err := json.Marshal(data)
+	require.NoError(t, err)
+	jsonRes := reflect.New(reflect.TypeOf(data).Elem()).Interface().(container)
+	require.NoError(t, json.Unmarshal(jsonData, jsonRes))
+	require.Equal(t, data, jsonRes)
+}
+
+func TestGenerators(t *testing.T) {
+	for _, version := range versions {
+		t.Run(version.String(), func(t *testing.T) {
+			generator := testutil.NewGenerator(int64(version))
+
+			attestation, err := generator.Attestation(version)
+			require.NoError(t, err)
+			require.Equal(t, version, attestation.Version)
+			roundTrip(t, versionedData(t, attestation, version))
+
+			block, err := generator.BeaconBlock(version)
+			require.NoError(t, err)
+			roundTrip(t, versionedData(t, block, version))
+
+			signedBlock, err := generator.SignedBeaconBlock(version)
+			require.NoError(t, err)
+			roundTrip(t, versionedData(t, signedBlock, version))
+			slot, err := signedBlock.Slot()
+			require.NoError(t, err)
+			attestations, err := signedBlock.Attestations()
+			require.NoError(t, err)
+			for _, attestation := range attestations {
+				data, err := attestation.Data()
+				require.NoError(t, err)
+				require.Less(t, data.Slot, slot)
+				require.Equal(t, phase0.Epoch(data.Slot/32), data.Target.Epoch)
+			}
+
+			state, err := generator.BeaconState(version)
+			require.NoError(t, err)
+			roundTrip(t, versionedData(t, state, version))
+			validators, err := state.Validators()
+			require.NoError(t, err)
+			balances, err := state.ValidatorBalances()
+			require.NoError(t, err)
+			require.Len(t, balances, len(validators))
+		})
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	block1, err := testutil.NewGenerator(1).SignedBeaconBlock(spec.DataVersionDeneb)
+	require.NoError(t, err)
+	block2, err := testutil.NewGenerator(1).SignedBeaconBlock(spec.DataVersionDeneb)
+	require.NoError(t, err)
+	require.Equal(t, block1, block2)
+
+	block3, err := testutil.NewGenerator(2).SignedBeaconBlock(spec.DataVersionDeneb)
+	require.NoError(t, err)
+	require.NotEqual(t, block1, block3)
+}
+
+func TestGenerate(t *testing.T) {
+	generator := testutil.NewGenerator(1)
+
+	body, err := testutil.Generate[deneb.BeaconBlockBody](generator)
+	require.NoError(t, err)
+	require.NotEmpty(t, body.BlobKZGCommitments)
+	require.NotNil(t, body.ExecutionPayload.BaseFeePerGas)
+	roundTrip(t, body)
+
+	attestation, err := testutil.Generate[electra.Attestation](generator)
+	require.NoError(t, err)
+	roundTrip(t, attestation)
+}
+
+func TestUnsupportedVersion(t *testing.T) {
+	_, err := testutil.NewGenerator(1).BeaconState(spec.DataVersionUnknown)
+	require.EqualError(t, err, "unsupported version")
+}
+
+func TestFillNotPointer(t *testing.T) {
+	require.EqualError(t, testutil.NewGenerator(1).Fill(phase0.Checkpoint{}), "value must be a non-nil pointer")
+}
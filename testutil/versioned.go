@@ -0,0 +1,195 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// slotsPerEpoch is the number of slots per epoch used when making generated
+// data internally consistent.
+const slotsPerEpoch = 32
+
+// Attestation generates an attestation for the given version.
+func (g *Generator) Attestation(version spec.DataVersion) (*spec.VersionedAttestation, error) {
+	res := &spec.VersionedAttestation{}
+	attestation, err := g.fillVersioned(res, version)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := g.slot()
+	if err != nil {
+		return nil, err
+	}
+	g.fixAttestation(attestation, slot)
+
+	return res, nil
+}
+
+// BeaconBlock generates a beacon block for the given version.
+func (g *Generator) BeaconBlock(version spec.DataVersion) (*spec.VersionedBeaconBlock, error) {
+	res := &spec.VersionedBeaconBlock{}
+	block, err := g.fillVersioned(res, version)
+	if err != nil {
+		return nil, err
+	}
+	g.fixBeaconBlock(block)
+
+	return res, nil
+}
+
+// SignedBeaconBlock generates a signed beacon block for the given version.
+func (g *Generator) SignedBeaconBlock(version spec.DataVersion) (*spec.VersionedSignedBeaconBlock, error) {
+	res := &spec.VersionedSignedBeaconBlock{}
+	signedBlock, err := g.fillVersioned(res, version)
+	if err != nil {
+		return nil, err
+	}
+	g.fixBeaconBlock(signedBlock.Elem().FieldByName("Message"))
+
+	return res, nil
+}
+
+// BeaconState generates a beacon state for the given version.
+func (g *Generator) BeaconState(version spec.DataVersion) (*spec.VersionedBeaconState, error) {
+	res := &spec.VersionedBeaconState{}
+	state, err := g.fillVersioned(res, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.fixBeaconState(state); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// fillVersioned sets the version of a versioned container and fills the
+// field for that version, returning the field.
+func (g *Generator) fillVersioned(versioned any, version spec.DataVersion) (reflect.Value, error) {
+	name := version.String()
+	if version == spec.DataVersionUnknown || name == "" {
+		return reflect.Value{}, errors.New("unsupported version")
+	}
+
+	value := reflect.ValueOf(versioned).Elem()
+	field := value.FieldByName(strings.ToUpper(name[:1]) + name[1:])
+	if !field.IsValid() {
+		return reflect.Value{}, fmt.Errorf("unsupported version %v", version)
+	}
+	value.FieldByName("Version").Set(reflect.ValueOf(version))
+	if err := g.fill(field, nil); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return field, nil
+}
+
+// slot generates a slot.
+func (g *Generator) slot() (phase0.Slot, error) {
+	var slot phase0.Slot
+	if err := g.Fill(&slot); err != nil {
+		return 0, err
+	}
+
+	return slot, nil
+}
+
+// fixAttestation makes an attestation consistent with the given slot.
+func (g *Generator) fixAttestation(attestation reflect.Value, slot phase0.Slot) {
+	data, isData := attestation.Elem().FieldByName("Data").Interface().(*phase0.AttestationData)
+	if !isData {
+		return
+	}
+	data.Slot = slot
+	data.Target.Epoch = phase0.Epoch(slot / slotsPerEpoch)
+	data.Source.Epoch = 0
+	if data.Target.Epoch > 0 {
+		data.Source.Epoch = data.Target.Epoch - 1
+	}
+
+	committeeBits := attestation.Elem().FieldByName("CommitteeBits")
+	if committeeBits.IsValid() {
+		// From electra, the committee index is given by the committee bits.
+		bits := bitfield.NewBitvector64()
+		bits.SetBitAt(uint64(data.Index), true)
+		committeeBits.SetBytes(bits)
+		data.Index = 0
+	}
+}
+
+// fixBeaconBlock makes the attestations in a block consistent with its slot.
+func (g *Generator) fixBeaconBlock(block reflect.Value) {
+	slot, isSlot := block.Elem().FieldByName("Slot").Interface().(phase0.Slot)
+	if !isSlot {
+		return
+	}
+	if slot == 0 {
+		slot = 1
+		block.Elem().FieldByName("Slot").Set(reflect.ValueOf(slot))
+	}
+
+	attestations := block.Elem().FieldByName("Body").Elem().FieldByName("Attestations")
+	for i := 0; i < attestations.Len(); i++ {
+		inclusionDelay := phase0.Slot(1 + g.rand.Intn(slotsPerEpoch))
+		g.fixAttestation(attestations.Index(i), slot-min(slot, inclusionDelay))
+	}
+}
+
+// fixBeaconState makes the per-validator lists in a state the same length,
+// and its checkpoints consistent with its slot.
+func (g *Generator) fixBeaconState(state reflect.Value) error {
+	value := state.Elem()
+	validators := value.FieldByName("Validators").Len()
+	for _, name := range []string{"Balances", "PreviousEpochParticipation", "CurrentEpochParticipation", "InactivityScores"} {
+		field := value.FieldByName(name)
+		if !field.IsValid() {
+			continue
+		}
+		if err := g.fillSlice(field, []dim{{size: validators}}); err != nil {
+			return err
+		}
+	}
+
+	slot, isSlot := value.FieldByName("Slot").Interface().(phase0.Slot)
+	if !isSlot {
+		return nil
+	}
+	epoch := phase0.Epoch(slot / slotsPerEpoch)
+
+	if header, isHeader := value.FieldByName("LatestBlockHeader").Interface().(*phase0.BeaconBlockHeader); isHeader {
+		header.Slot = slot
+	}
+	if fork, isFork := value.FieldByName("Fork").Interface().(*phase0.Fork); isFork {
+		fork.Epoch = min(fork.Epoch, epoch)
+	}
+	current, isCurrent := value.FieldByName("CurrentJustifiedCheckpoint").Interface().(*phase0.Checkpoint)
+	previous, isPrevious := value.FieldByName("PreviousJustifiedCheckpoint").Interface().(*phase0.Checkpoint)
+	finalized, isFinalized := value.FieldByName("FinalizedCheckpoint").Interface().(*phase0.Checkpoint)
+	if isCurrent && isPrevious && isFinalized {
+		current.Epoch = min(current.Epoch, epoch)
+		previous.Epoch = min(previous.Epoch, current.Epoch)
+		finalized.Epoch = min(finalized.Epoch, previous.Epoch)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package attestationutil provides functions to aggregate attestations.
+package attestationutil
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// ErrIncompatible is returned when attestations cannot be merged because
+// they attest to different data or committees.
+var ErrIncompatible = errors.New("attestations are not compatible")
+
+// ErrOverlap is returned when attestations cannot be merged because their
+// aggregation bits overlap.
+var ErrOverlap = errors.New("attestations overlap")
+
+// SignatureAggregator aggregates BLS signatures.
+type SignatureAggregator interface {
+	// AggregateSignatures aggregates the supplied signatures in to a single signature.
+	AggregateSignatures(signatures []phase0.BLSSignature) (phase0.BLSSignature, error)
+}
+
+// Overlaps returns true if any validator is present in both sets of aggregation bits.
+func Overlaps(a bitfield.Bitlist, b bitfield.Bitlist) (bool, error) {
+	if a.Len() != b.Len() {
+		return false, fmt.Errorf("aggregation bits lengths %d and %d differ", a.Len(), b.Len())
+	}
+
+	return a.Overlaps(b)
+}
+
+// Merge merges two attestations for the same data in to a single attestation.
+func Merge(aggregator SignatureAggregator, a *phase0.Attestation, b *phase0.Attestation) (*phase0.Attestation, error) {
+	if a == nil || b == nil {
+		return nil, errors.New("attestation missing")
+	}
+	if err := checkData(a.Data, b.Data); err != nil {
+		return nil, err
+	}
+	aggregationBits, signature, err := merge(aggregator, a.AggregationBits, a.Signature, b.AggregationBits, b.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            a.Data,
+		Signature:       signature,
+	}, nil
+}
+
+// MergeElectra merges two Electra attestations for the same data and committees
+// in to a single attestation.
+func MergeElectra(aggregator SignatureAggregator, a *electra.Attestation, b *electra.Attestation) (*electra.Attestation, error) {
+	if a == nil || b == nil {
+		return nil, errors.New("attestation missing")
+	}
+	if err := checkData(a.Data, b.Data); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(a.CommitteeBits, b.CommitteeBits) {
+		return nil, errors.Join(errors.New("committee bits differ"), ErrIncompatible)
+	}
+	aggregationBits, signature, err := merge(aggregator, a.AggregationBits, a.Signature, b.AggregationBits, b.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	committeeBits := make(bitfield.Bitvector64, len(a.CommitteeBits))
+	copy(committeeBits, a.CommitteeBits)
+
+	return &electra.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            a.Data,
+		Signature:       signature,
+		CommitteeBits:   committeeBits,
+	}, nil
+}
+
+func checkData(a *phase0.AttestationData, b *phase0.AttestationData) error {
+	if a == nil || b == nil {
+		return errors.New("attestation data missing")
+	}
+	aRoot, err := a.HashTreeRoot()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain attestation data root"), err)
+	}
+	bRoot, err := b.HashTreeRoot()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain attestation data root"), err)
+	}
+	if aRoot != bRoot {
+		return errors.Join(errors.New("attestation data differs"), ErrIncompatible)
+	}
+
+	return nil
+}
+
+func merge(aggregator SignatureAggregator,
+	aBits bitfield.Bitlist,
+	aSignature phase0.BLSSignature,
+	bBits bitfield.Bitlist,
+	bSignature phase0.BLSSignature,
+) (
+	bitfield.Bitlist,
+	phase0.BLSSignature,
+	error,
+) {
+	if aggregator == nil {
+		return nil, phase0.BLSSignature{}, errors.New("no signature aggregator specified")
+	}
+	overlaps, err := Overlaps(aBits, bBits)
+	if err != nil {
+		return nil, phase0.BLSSignature{}, errors.Join(err, ErrIncompatible)
+	}
+	if overlaps {
+		return nil, phase0.BLSSignature{}, ErrOverlap
+	}
+
+	aggregationBits, err := aBits.Or(bBits)
+	if err != nil {
+		return nil, phase0.BLSSignature{}, errors.Join(errors.New("failed to merge aggregation bits"), err)
+	}
+	signature, err := aggregator.AggregateSignatures([]phase0.BLSSignature{aSignature, bSignature})
+	if err != nil {
+		return nil, phase0.BLSSignature{}, errors.Join(errors.New("failed to aggregate signatures"), err)
+	}
+
+	return aggregationBits, signature, nil
+}
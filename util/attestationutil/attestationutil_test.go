@@ -0,0 +1,181 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/attestationutil"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// xorAggregator is a signature aggregator that XORs signatures together.
+type xorAggregator struct{}
+
+func (xorAggregator) AggregateSignatures(signatures []phase0.BLSSignature) (phase0.BLSSignature, error) {
+	var res phase0.BLSSignature
+	for _, signature := range signatures {
+		for i := range signature {
+			res[i] ^= signature[i]
+		}
+	}
+
+	return res, nil
+}
+
+// erroringAggregator is a signature aggregator that always errors.
+type erroringAggregator struct{}
+
+func (erroringAggregator) AggregateSignatures(_ []phase0.BLSSignature) (phase0.BLSSignature, error) {
+	return phase0.BLSSignature{}, errors.New("bad signature")
+}
+
+func bits(length uint64, set ...uint64) bitfield.Bitlist {
+	res := bitfield.NewBitlist(length)
+	for _, index := range set {
+		res.SetBitAt(index, true)
+	}
+
+	return res
+}
+
+func TestMerge(t *testing.T) {
+	data := &phase0.AttestationData{
+		Slot:   1,
+		Source: &phase0.Checkpoint{},
+		Target: &phase0.Checkpoint{Epoch: 1},
+	}
+	otherData := &phase0.AttestationData{
+		Slot:   2,
+		Source: &phase0.Checkpoint{},
+		Target: &phase0.Checkpoint{Epoch: 1},
+	}
+
+	tests := []struct {
+		name       string
+		aggregator attestationutil.SignatureAggregator
+		a          *phase0.Attestation
+		b          *phase0.Attestation
+		expected   *phase0.Attestation
+		err        string
+		errIs      error
+	}{
+		{
+			name:       "Missing",
+			aggregator: xorAggregator{},
+			a:          &phase0.Attestation{AggregationBits: bits(4, 0), Data: data},
+			err:        "attestation missing",
+		},
+		{
+			name:       "DataDiffers",
+			aggregator: xorAggregator{},
+			a:          &phase0.Attestation{AggregationBits: bits(4, 0), Data: data},
+			b:          &phase0.Attestation{AggregationBits: bits(4, 1), Data: otherData},
+			errIs:      attestationutil.ErrIncompatible,
+		},
+		{
+			name:       "LengthDiffers",
+			aggregator: xorAggregator{},
+			a:          &phase0.Attestation{AggregationBits: bits(4, 0), Data: data},
+			b:          &phase0.Attestation{AggregationBits: bits(5, 1), Data: data},
+			errIs:      attestationutil.ErrIncompatible,
+		},
+		{
+			name:       "Overlap",
+			aggregator: xorAggregator{},
+			a:          &phase0.Attestation{AggregationBits: bits(4, 0, 1), Data: data},
+			b:          &phase0.Attestation{AggregationBits: bits(4, 1), Data: data},
+			errIs:      attestationutil.ErrOverlap,
+		},
+		{
+			name: "AggregatorMissing",
+			a:    &phase0.Attestation{AggregationBits: bits(4, 0), Data: data},
+			b:    &phase0.Attestation{AggregationBits: bits(4, 1), Data: data},
+			err:  "no signature aggregator specified",
+		},
+		{
+			name:       "AggregatorFails",
+			aggregator: erroringAggregator{},
+			a:          &phase0.Attestation{AggregationBits: bits(4, 0), Data: data},
+			b:          &phase0.Attestation{AggregationBits: bits(4, 1), Data: data},
+			err:        "failed to aggregate signatures\nbad signature",
+		},
+		{
+			name:       "Good",
+			aggregator: xorAggregator{},
+			a:          &phase0.Attestation{AggregationBits: bits(4, 0), Data: data, Signature: phase0.BLSSignature{0x01}},
+			b:          &phase0.Attestation{AggregationBits: bits(4, 3), Data: data, Signature: phase0.BLSSignature{0x02}},
+			expected:   &phase0.Attestation{AggregationBits: bits(4, 0, 3), Data: data, Signature: phase0.BLSSignature{0x03}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := attestationutil.Merge(test.aggregator, test.a, test.b)
+			switch {
+			case test.errIs != nil:
+				require.ErrorIs(t, err, test.errIs)
+			case test.err != "":
+				require.EqualError(t, err, test.err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestMergeElectra(t *testing.T) {
+	data := &phase0.AttestationData{
+		Slot:   1,
+		Source: &phase0.Checkpoint{},
+		Target: &phase0.Checkpoint{Epoch: 1},
+	}
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(1, true)
+	otherCommitteeBits := bitfield.NewBitvector64()
+	otherCommitteeBits.SetBitAt(2, true)
+
+	_, err := attestationutil.MergeElectra(xorAggregator{},
+		&electra.Attestation{AggregationBits: bits(4, 0), Data: data, CommitteeBits: committeeBits},
+		&electra.Attestation{AggregationBits: bits(4, 1), Data: data, CommitteeBits: otherCommitteeBits},
+	)
+	require.ErrorIs(t, err, attestationutil.ErrIncompatible)
+
+	res, err := attestationutil.MergeElectra(xorAggregator{},
+		&electra.Attestation{AggregationBits: bits(4, 0), Data: data, CommitteeBits: committeeBits, Signature: phase0.BLSSignature{0x01}},
+		&electra.Attestation{AggregationBits: bits(4, 2), Data: data, CommitteeBits: committeeBits, Signature: phase0.BLSSignature{0x04}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, bits(4, 0, 2), res.AggregationBits)
+	require.Equal(t, committeeBits, res.CommitteeBits)
+	require.Equal(t, phase0.BLSSignature{0x05}, res.Signature)
+}
+
+func TestOverlaps(t *testing.T) {
+	overlaps, err := attestationutil.Overlaps(bits(4, 0, 1), bits(4, 1))
+	require.NoError(t, err)
+	require.True(t, overlaps)
+
+	overlaps, err = attestationutil.Overlaps(bits(4, 0), bits(4, 1))
+	require.NoError(t, err)
+	require.False(t, overlaps)
+
+	_, err = attestationutil.Overlaps(bits(4, 0), bits(8, 1))
+	require.EqualError(t, err, "aggregation bits lengths 4 and 8 differ")
+}
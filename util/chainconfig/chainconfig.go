@@ -0,0 +1,257 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chainconfig provides functions to load chain configurations into
+// the same structure as that returned by Spec(), for use without a beacon
+// node.
+package chainconfig
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+)
+
+// Parse converts the string values of a chain configuration, as returned by
+// the spec endpoint of a beacon node, to typed values.
+func Parse(data map[string]string) map[string]any {
+	config := make(map[string]any, len(data))
+	for k, v := range data {
+		config[k] = parseValue(k, v)
+	}
+
+	// The application mask domain type is not provided by all nodes, so add it here if not present.
+	if _, exists := config["DOMAIN_APPLICATION_MASK"]; !exists {
+		config["DOMAIN_APPLICATION_MASK"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+	}
+	// The BLS to execution change domain type is not provided by all nodes, so add it here if not present.
+	if _, exists := config["DOMAIN_BLS_TO_EXECUTION_CHANGE"]; !exists {
+		config["DOMAIN_BLS_TO_EXECUTION_CHANGE"] = phase0.DomainType{0x0a, 0x00, 0x00, 0x00}
+	}
+	// The builder application domain type is not officially part of the spec, so add it here if not present.
+	if _, exists := config["DOMAIN_APPLICATION_BUILDER"]; !exists {
+		config["DOMAIN_APPLICATION_BUILDER"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+	}
+
+	return config
+}
+
+// parseValue converts a single configuration value to a typed value.
+func parseValue(k string, v string) any {
+	// Handle domains.
+	if strings.HasPrefix(k, "DOMAIN_") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			var domainType phase0.DomainType
+			copy(domainType[:], byteVal)
+
+			return domainType
+		}
+	}
+
+	// Handle fork versions.
+	if strings.HasSuffix(k, "_FORK_VERSION") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			var version phase0.Version
+			copy(version[:], byteVal)
+
+			return version
+		}
+	}
+
+	// Handle hex strings.
+	if strings.HasPrefix(v, "0x") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			return byteVal
+		}
+	}
+
+	// Handle times.
+	if strings.HasSuffix(k, "_TIME") {
+		intVal, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && intVal != 0 {
+			return time.Unix(intVal, 0)
+		}
+	}
+
+	// Handle durations.
+	if strings.HasPrefix(k, "SECONDS_PER_") || k == "GENESIS_DELAY" {
+		intVal, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && intVal >= 0 {
+			return time.Duration(intVal) * time.Second
+		}
+	}
+
+	// Handle integers.
+	if v == "0" {
+		return uint64(0)
+	}
+	intVal, err := strconv.ParseUint(v, 10, 64)
+	if err == nil && intVal != 0 {
+		return intVal
+	}
+
+	// Assume string.
+	return v
+}
+
+// LoadFile loads a chain configuration from a config.yaml file.
+func LoadFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to read chain configuration"), err)
+	}
+
+	return Load(data)
+}
+
+// Load loads a chain configuration from the contents of a config.yaml file.
+//
+// The values of the preset named by PRESET_BASE (mainnet if not supplied) and
+// the constants of the specification are added to those in the file, so the
+// result contains the same information as that returned by Spec().  Values
+// in the file take precedence, so a custom preset can be supplied by
+// appending it to the configuration.
+func Load(data []byte) (map[string]any, error) {
+	values, schedule, err := parseYAML(data)
+	if err != nil {
+		return nil, err
+	}
+
+	presetBase := "mainnet"
+	if value, exists := values["PRESET_BASE"]; exists {
+		presetBase = value
+	}
+	preset, exists := presets[presetBase]
+	if !exists {
+		return nil, fmt.Errorf("unknown preset %q", presetBase)
+	}
+
+	merged := make(map[string]string, len(values)+len(preset)+len(constants))
+	for k, v := range constants {
+		merged[k] = v
+	}
+	for k, v := range preset {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+
+	config := Parse(merged)
+	if schedule != nil {
+		config["BLOB_SCHEDULE"] = schedule
+	}
+
+	return config, nil
+}
+
+// Preset returns the values of the named preset, for example "mainnet" or "minimal".
+func Preset(name string) (map[string]any, error) {
+	preset, exists := presets[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown preset %q", name)
+	}
+
+	config := make(map[string]any, len(preset))
+	for k, v := range preset {
+		config[k] = parseValue(k, v)
+	}
+
+	return config, nil
+}
+
+// parseYAML parses a chain configuration file, returning its scalar values
+// as they are written, and the blob schedule if present.  The raw values are
+// required because YAML would otherwise treat values such as fork versions as
+// numbers.
+func parseYAML(data []byte) (map[string]string, []map[string]any, error) {
+	file, err := parser.ParseBytes(data, 0)
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("failed to parse chain configuration"), err)
+	}
+
+	values := make(map[string]string)
+	var schedule []map[string]any
+	for _, doc := range file.Docs {
+		if doc.Body == nil {
+			continue
+		}
+		entries, err := mappingValues(doc.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, entry := range entries {
+			key := entry.Key.GetToken().Value
+			switch value := entry.Value.(type) {
+			case *ast.SequenceNode:
+				if key != "BLOB_SCHEDULE" {
+					return nil, nil, fmt.Errorf("unexpected list for %s", key)
+				}
+				schedule, err = parseSchedule(value)
+				if err != nil {
+					return nil, nil, errors.Join(fmt.Errorf("invalid value for %s", key), err)
+				}
+			case *ast.MappingNode, *ast.MappingValueNode:
+				return nil, nil, fmt.Errorf("unexpected map for %s", key)
+			case *ast.NullNode:
+				values[key] = ""
+			default:
+				values[key] = value.GetToken().Value
+			}
+		}
+	}
+
+	return values, schedule, nil
+}
+
+// parseSchedule parses a list of maps of scalar values.
+func parseSchedule(node *ast.SequenceNode) ([]map[string]any, error) {
+	schedule := make([]map[string]any, 0, len(node.Values))
+	for i, value := range node.Values {
+		entries, err := mappingValues(value)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("entry %d", i), err)
+		}
+		entry := make(map[string]any, len(entries))
+		for _, field := range entries {
+			key := field.Key.GetToken().Value
+			entry[key] = parseValue(key, field.Value.GetToken().Value)
+		}
+		schedule = append(schedule, entry)
+	}
+
+	return schedule, nil
+}
+
+// mappingValues returns the key/value pairs of a mapping node.
+func mappingValues(node ast.Node) ([]*ast.MappingValueNode, error) {
+	switch mapping := node.(type) {
+	case *ast.MappingNode:
+		return mapping.Values, nil
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{mapping}, nil
+	default:
+		return nil, errors.New("chain configuration is not a map")
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainconfig_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/chainconfig"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = []byte(`# Test chain configuration.
+PRESET_BASE: 'minimal'
+CONFIG_NAME: 'testnet'
+
+TERMINAL_TOTAL_DIFFICULTY: 115792089237316195423570985008687907853269984665640564039457584007913129638912
+MIN_GENESIS_ACTIVE_VALIDATOR_COUNT: 64
+MIN_GENESIS_TIME: 1606824000
+GENESIS_FORK_VERSION: 0x00000064
+GENESIS_DELAY: 300
+
+ALTAIR_FORK_VERSION: 0x01000064
+ALTAIR_FORK_EPOCH: 0
+ELECTRA_FORK_VERSION: 0x05000064
+ELECTRA_FORK_EPOCH: 18446744073709551615
+
+SECONDS_PER_SLOT: 6
+DEPOSIT_CHAIN_ID: 1
+DEPOSIT_CONTRACT_ADDRESS: 0x00000000219ab540356cBB839Cbe05303d7705Fa
+
+BLOB_SCHEDULE:
+  - EPOCH: 269568
+    MAX_BLOBS_PER_BLOCK: 6
+  - EPOCH: 364032
+    MAX_BLOBS_PER_BLOCK: 9
+`)
+
+func TestParse(t *testing.T) {
+	config := chainconfig.Parse(map[string]string{
+		"DOMAIN_BEACON_PROPOSER": "0x00000000",
+		"GENESIS_FORK_VERSION":   "0x00000001",
+		"MIN_GENESIS_TIME":       "1606824000",
+		"SECONDS_PER_SLOT":       "12",
+		"SLOTS_PER_EPOCH":        "32",
+		"ALTAIR_FORK_EPOCH":      "0",
+		"CONFIG_NAME":            "mainnet",
+	})
+	require.Equal(t, phase0.DomainType{0x00, 0x00, 0x00, 0x00}, config["DOMAIN_BEACON_PROPOSER"])
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x00, 0x01}, config["GENESIS_FORK_VERSION"])
+	require.Equal(t, time.Unix(1606824000, 0), config["MIN_GENESIS_TIME"])
+	require.Equal(t, 12*time.Second, config["SECONDS_PER_SLOT"])
+	require.Equal(t, uint64(32), config["SLOTS_PER_EPOCH"])
+	require.Equal(t, uint64(0), config["ALTAIR_FORK_EPOCH"])
+	require.Equal(t, "mainnet", config["CONFIG_NAME"])
+	// Defaults.
+	require.Equal(t, phase0.DomainType{0x00, 0x00, 0x00, 0x01}, config["DOMAIN_APPLICATION_MASK"])
+	require.Equal(t, phase0.DomainType{0x0a, 0x00, 0x00, 0x00}, config["DOMAIN_BLS_TO_EXECUTION_CHANGE"])
+	require.Equal(t, phase0.DomainType{0x00, 0x00, 0x00, 0x01}, config["DOMAIN_APPLICATION_BUILDER"])
+}
+
+func TestLoad(t *testing.T) {
+	config, err := chainconfig.Load(testConfig)
+	require.NoError(t, err)
+
+	// Values from the file.
+	require.Equal(t, "testnet", config["CONFIG_NAME"])
+	require.Equal(t, "115792089237316195423570985008687907853269984665640564039457584007913129638912", config["TERMINAL_TOTAL_DIFFICULTY"])
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x00, 0x64}, config["GENESIS_FORK_VERSION"])
+	require.Equal(t, 300*time.Second, config["GENESIS_DELAY"])
+	require.Equal(t, 6*time.Second, config["SECONDS_PER_SLOT"])
+	require.Equal(t, uint64(0xffffffffffffffff), config["ELECTRA_FORK_EPOCH"])
+	require.Equal(t, []byte{
+		0x00, 0x00, 0x00, 0x00, 0x21, 0x9a, 0xb5, 0x40, 0x35, 0x6c,
+		0xbb, 0x83, 0x9c, 0xbe, 0x05, 0x30, 0x3d, 0x77, 0x05, 0xfa,
+	}, config["DEPOSIT_CONTRACT_ADDRESS"])
+	require.Equal(t, []map[string]any{
+		{"EPOCH": uint64(269568), "MAX_BLOBS_PER_BLOCK": uint64(6)},
+		{"EPOCH": uint64(364032), "MAX_BLOBS_PER_BLOCK": uint64(9)},
+	}, config["BLOB_SCHEDULE"])
+
+	// Values from the preset.
+	require.Equal(t, uint64(8), config["SLOTS_PER_EPOCH"])
+	require.Equal(t, uint64(32), config["SYNC_COMMITTEE_SIZE"])
+
+	// Constants.
+	require.Equal(t, phase0.DomainType{0x03, 0x00, 0x00, 0x00}, config["DOMAIN_DEPOSIT"])
+	require.Equal(t, []byte{0x02}, config["COMPOUNDING_WITHDRAWAL_PREFIX"])
+
+	// Typed access.
+	spec := apiv1.NewSpecConfig(config)
+	slotsPerEpoch, err := spec.SlotsPerEpoch()
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), slotsPerEpoch)
+	altairForkEpoch, err := spec.ForkEpoch("altair")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(0), altairForkEpoch)
+}
+
+func TestLoadDefaultPreset(t *testing.T) {
+	config, err := chainconfig.Load([]byte("CONFIG_NAME: 'mainnet'\n"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), config["SLOTS_PER_EPOCH"])
+	require.Equal(t, uint64(2048000000000), config["MAX_EFFECTIVE_BALANCE_ELECTRA"])
+}
+
+func TestLoadOverridePreset(t *testing.T) {
+	config, err := chainconfig.Load([]byte("PRESET_BASE: mainnet\nSLOTS_PER_EPOCH: 4\n"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), config["SLOTS_PER_EPOCH"])
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "UnknownPreset",
+			data: "PRESET_BASE: 'unknown'\n",
+			err:  `unknown preset "unknown"`,
+		},
+		{
+			name: "NotMap",
+			data: "- a\n- b\n",
+			err:  "chain configuration is not a map",
+		},
+		{
+			name: "UnexpectedMap",
+			data: "CONFIG_NAME:\n  a: b\n",
+			err:  "unexpected map for CONFIG_NAME",
+		},
+		{
+			name: "UnexpectedList",
+			data: "CONFIG_NAME:\n  - a\n",
+			err:  "unexpected list for CONFIG_NAME",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := chainconfig.Load([]byte(test.data))
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, testConfig, 0o600))
+
+	config, err := chainconfig.LoadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "testnet", config["CONFIG_NAME"])
+
+	_, err = chainconfig.LoadFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, err, "failed to read chain configuration")
+}
+
+func TestPreset(t *testing.T) {
+	preset, err := chainconfig.Preset("minimal")
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), preset["SLOTS_PER_EPOCH"])
+	require.Equal(t, uint64(10), preset["SHUFFLE_ROUND_COUNT"])
+
+	_, err = chainconfig.Preset("unknown")
+	require.EqualError(t, err, `unknown preset "unknown"`)
+}
@@ -0,0 +1,183 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package creds provides functions to parse, construct and validate
+// withdrawal credentials.
+package creds
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Type is the type of a set of withdrawal credentials, as defined by their
+// first byte.
+type Type uint8
+
+const (
+	// TypeBLS is for credentials that commit to a BLS withdrawal key (0x00).
+	TypeBLS Type = 0x00
+	// TypeExecution is for credentials that withdraw to an execution address (0x01).
+	TypeExecution Type = 0x01
+	// TypeCompounding is for credentials that withdraw to an execution address
+	// and compound rewards, as introduced by EIP-7251 (0x02).
+	TypeCompounding Type = 0x02
+)
+
+// credentialsLength is the length of withdrawal credentials.
+const credentialsLength = 32
+
+// addressOffset is the offset of the execution address in withdrawal credentials.
+const addressOffset = 12
+
+var (
+	// ErrInvalidLength is returned when withdrawal credentials are not 32 bytes.
+	ErrInvalidLength = errors.New("withdrawal credentials must be 32 bytes")
+	// ErrUnknownType is returned when withdrawal credentials have an unknown prefix.
+	ErrUnknownType = errors.New("unknown withdrawal credentials type")
+	// ErrNoExecutionAddress is returned when an execution address is requested
+	// from withdrawal credentials that do not contain one.
+	ErrNoExecutionAddress = errors.New("withdrawal credentials do not contain an execution address")
+	// ErrInvalidTransition is returned when withdrawal credentials cannot be
+	// changed from one value to another.
+	ErrInvalidTransition = errors.New("invalid withdrawal credentials transition")
+)
+
+// String returns a string version of the type.
+func (t Type) String() string {
+	switch t {
+	case TypeBLS:
+		return "BLS"
+	case TypeExecution:
+		return "execution"
+	case TypeCompounding:
+		return "compounding"
+	default:
+		return fmt.Sprintf("unknown (0x%02x)", uint8(t))
+	}
+}
+
+// HasExecutionAddress returns true if credentials of this type contain an
+// execution address.
+func (t Type) HasExecutionAddress() bool {
+	return t == TypeExecution || t == TypeCompounding
+}
+
+// Parse returns the type of the supplied withdrawal credentials, checking
+// that they are well-formed.
+func Parse(credentials []byte) (Type, error) {
+	if len(credentials) != credentialsLength {
+		return 0, ErrInvalidLength
+	}
+
+	credentialsType := Type(credentials[0])
+	switch credentialsType {
+	case TypeBLS:
+		return credentialsType, nil
+	case TypeExecution, TypeCompounding:
+		// Bytes between the prefix and the address must be zero.
+		if !bytes.Equal(credentials[1:addressOffset], make([]byte, addressOffset-1)) {
+			return 0, fmt.Errorf("%s withdrawal credentials have non-zero padding", credentialsType)
+		}
+
+		return credentialsType, nil
+	default:
+		return 0, errors.Join(ErrUnknownType, fmt.Errorf("prefix %#02x", credentials[0]))
+	}
+}
+
+// BLS returns BLS withdrawal credentials for the given withdrawal public key.
+func BLS(pubKey phase0.BLSPubKey) []byte {
+	hash := sha256.Sum256(pubKey[:])
+	credentials := make([]byte, credentialsLength)
+	credentials[0] = byte(TypeBLS)
+	copy(credentials[1:], hash[1:])
+
+	return credentials
+}
+
+// Execution returns execution withdrawal credentials for the given address.
+func Execution(address bellatrix.ExecutionAddress) []byte {
+	return withAddress(TypeExecution, address)
+}
+
+// Compounding returns compounding withdrawal credentials for the given address.
+func Compounding(address bellatrix.ExecutionAddress) []byte {
+	return withAddress(TypeCompounding, address)
+}
+
+// ExecutionAddress returns the execution address contained in the supplied
+// withdrawal credentials.
+func ExecutionAddress(credentials []byte) (bellatrix.ExecutionAddress, error) {
+	credentialsType, err := Parse(credentials)
+	if err != nil {
+		return bellatrix.ExecutionAddress{}, err
+	}
+	if !credentialsType.HasExecutionAddress() {
+		return bellatrix.ExecutionAddress{}, ErrNoExecutionAddress
+	}
+
+	var address bellatrix.ExecutionAddress
+	copy(address[:], credentials[addressOffset:])
+
+	return address, nil
+}
+
+// MatchesBLSPubKey returns true if the supplied withdrawal credentials are BLS
+// credentials for the given withdrawal public key.
+func MatchesBLSPubKey(credentials []byte, pubKey phase0.BLSPubKey) bool {
+	return bytes.Equal(credentials, BLS(pubKey))
+}
+
+// ValidateTransition checks that withdrawal credentials can change from one
+// value to another.  The specification allows BLS credentials to change to
+// execution credentials with a BLS to execution change, and execution
+// credentials to change to compounding credentials for the same address with
+// a consolidation request; no other transitions are possible.
+func ValidateTransition(from []byte, to []byte) error {
+	fromType, err := Parse(from)
+	if err != nil {
+		return errors.Join(errors.New("invalid current withdrawal credentials"), err)
+	}
+	toType, err := Parse(to)
+	if err != nil {
+		return errors.Join(errors.New("invalid new withdrawal credentials"), err)
+	}
+
+	switch {
+	case fromType == TypeBLS && toType == TypeExecution:
+		return nil
+	case fromType == TypeExecution && toType == TypeCompounding:
+		if !bytes.Equal(from[addressOffset:], to[addressOffset:]) {
+			return errors.Join(ErrInvalidTransition, errors.New("execution address cannot change"))
+		}
+
+		return nil
+	default:
+		return errors.Join(ErrInvalidTransition, fmt.Errorf("%s to %s", fromType, toType))
+	}
+}
+
+// withAddress returns withdrawal credentials of the given type for the given address.
+func withAddress(credentialsType Type, address bellatrix.ExecutionAddress) []byte {
+	credentials := make([]byte, credentialsLength)
+	credentials[0] = byte(credentialsType)
+	copy(credentials[addressOffset:], address[:])
+
+	return credentials
+}
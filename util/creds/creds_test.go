@@ -0,0 +1,205 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package creds_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/creds"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPubKey = phase0.BLSPubKey{
+		0xa9, 0x9a, 0x76, 0xed, 0x77, 0x96, 0xf7, 0xbe, 0x22, 0xd5, 0xb7, 0xe8, 0x5d, 0xee, 0xb7, 0xc5,
+		0x67, 0x7e, 0x88, 0xe5, 0x11, 0xe0, 0xb3, 0x37, 0x61, 0x8f, 0x8c, 0x4e, 0xb6, 0x13, 0x49, 0xb4,
+		0xbf, 0x2d, 0x15, 0x3f, 0x64, 0x9f, 0x7b, 0x53, 0x35, 0x9f, 0xe8, 0xb9, 0x4a, 0x38, 0xe4, 0x4c,
+	}
+	testAddress = bellatrix.ExecutionAddress{
+		0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09,
+		0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13,
+	}
+)
+
+func TestConstruct(t *testing.T) {
+	hash := sha256.Sum256(testPubKey[:])
+	bls := creds.BLS(testPubKey)
+	require.Len(t, bls, 32)
+	require.Equal(t, byte(0x00), bls[0])
+	require.Equal(t, hash[1:], bls[1:])
+	require.True(t, creds.MatchesBLSPubKey(bls, testPubKey))
+	require.False(t, creds.MatchesBLSPubKey(bls, phase0.BLSPubKey{}))
+
+	execution := creds.Execution(testAddress)
+	require.Equal(t, append([]byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, testAddress[:]...), execution)
+
+	compounding := creds.Compounding(testAddress)
+	require.Equal(t, append([]byte{0x02, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, testAddress[:]...), compounding)
+}
+
+func TestParse(t *testing.T) {
+	badPadding := creds.Execution(testAddress)
+	badPadding[5] = 0x01
+
+	tests := []struct {
+		name        string
+		credentials []byte
+		expected    creds.Type
+		err         string
+	}{
+		{
+			name: "Nil",
+			err:  "withdrawal credentials must be 32 bytes",
+		},
+		{
+			name:        "Short",
+			credentials: make([]byte, 31),
+			err:         "withdrawal credentials must be 32 bytes",
+		},
+		{
+			name:        "UnknownType",
+			credentials: append([]byte{0x03}, make([]byte, 31)...),
+			err:         "unknown withdrawal credentials type\nprefix 0x03",
+		},
+		{
+			name:        "BadPadding",
+			credentials: badPadding,
+			err:         "execution withdrawal credentials have non-zero padding",
+		},
+		{
+			name:        "BLS",
+			credentials: creds.BLS(testPubKey),
+			expected:    creds.TypeBLS,
+		},
+		{
+			name:        "Execution",
+			credentials: creds.Execution(testAddress),
+			expected:    creds.TypeExecution,
+		},
+		{
+			name:        "Compounding",
+			credentials: creds.Compounding(testAddress),
+			expected:    creds.TypeCompounding,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := creds.Parse(test.credentials)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestExecutionAddress(t *testing.T) {
+	address, err := creds.ExecutionAddress(creds.Execution(testAddress))
+	require.NoError(t, err)
+	require.Equal(t, testAddress, address)
+
+	address, err = creds.ExecutionAddress(creds.Compounding(testAddress))
+	require.NoError(t, err)
+	require.Equal(t, testAddress, address)
+
+	_, err = creds.ExecutionAddress(creds.BLS(testPubKey))
+	require.ErrorIs(t, err, creds.ErrNoExecutionAddress)
+
+	_, err = creds.ExecutionAddress([]byte{0x01})
+	require.ErrorIs(t, err, creds.ErrInvalidLength)
+}
+
+func TestValidateTransition(t *testing.T) {
+	otherAddress := bellatrix.ExecutionAddress{0x01}
+
+	tests := []struct {
+		name string
+		from []byte
+		to   []byte
+		err  string
+	}{
+		{
+			name: "FromInvalid",
+			from: []byte{0x00},
+			to:   creds.Execution(testAddress),
+			err:  "invalid current withdrawal credentials\nwithdrawal credentials must be 32 bytes",
+		},
+		{
+			name: "ToInvalid",
+			from: creds.BLS(testPubKey),
+			to:   []byte{0x01},
+			err:  "invalid new withdrawal credentials\nwithdrawal credentials must be 32 bytes",
+		},
+		{
+			name: "BLSToExecution",
+			from: creds.BLS(testPubKey),
+			to:   creds.Execution(testAddress),
+		},
+		{
+			name: "ExecutionToCompounding",
+			from: creds.Execution(testAddress),
+			to:   creds.Compounding(testAddress),
+		},
+		{
+			name: "ExecutionToCompoundingAddressChange",
+			from: creds.Execution(testAddress),
+			to:   creds.Compounding(otherAddress),
+			err:  "invalid withdrawal credentials transition\nexecution address cannot change",
+		},
+		{
+			name: "BLSToCompounding",
+			from: creds.BLS(testPubKey),
+			to:   creds.Compounding(testAddress),
+			err:  "invalid withdrawal credentials transition\nBLS to compounding",
+		},
+		{
+			name: "ExecutionToExecution",
+			from: creds.Execution(testAddress),
+			to:   creds.Execution(otherAddress),
+			err:  "invalid withdrawal credentials transition\nexecution to execution",
+		},
+		{
+			name: "CompoundingToExecution",
+			from: creds.Compounding(testAddress),
+			to:   creds.Execution(testAddress),
+			err:  "invalid withdrawal credentials transition\ncompounding to execution",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := creds.ValidateTransition(test.from, test.to)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTypeString(t *testing.T) {
+	require.Equal(t, "BLS", creds.TypeBLS.String())
+	require.Equal(t, "execution", creds.TypeExecution.String())
+	require.Equal(t, "compounding", creds.TypeCompounding.String())
+	require.Equal(t, "unknown (0x03)", creds.Type(3).String())
+	require.Equal(t, "unknown (0x05)", creds.Type(5).String())
+	require.Equal(t, "unknown (0xff)", creds.Type(0xff).String())
+}
@@ -0,0 +1,140 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package depositutil provides functions to construct deposits, compute their
+// signing roots and verify their inclusion proofs.
+package depositutil
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/creds"
+	"github.com/attestantio/go-eth2-client/util/proofs"
+	"github.com/attestantio/go-eth2-client/util/signing"
+)
+
+// depositContractTreeDepth is the depth of the deposit contract's Merkle tree,
+// as defined by DEPOSIT_CONTRACT_TREE_DEPTH in the specification.
+const depositContractTreeDepth = 32
+
+// NewDepositMessage creates a deposit message, checking that the withdrawal
+// credentials are well-formed.
+func NewDepositMessage(pubKey phase0.BLSPubKey,
+	withdrawalCredentials []byte,
+	amount phase0.Gwei,
+) (
+	*phase0.DepositMessage,
+	error,
+) {
+	if _, err := creds.Parse(withdrawalCredentials); err != nil {
+		return nil, err
+	}
+
+	return &phase0.DepositMessage{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                amount,
+	}, nil
+}
+
+// NewDepositData creates deposit data from a deposit message and its signature.
+func NewDepositData(message *phase0.DepositMessage, signature phase0.BLSSignature) (*phase0.DepositData, error) {
+	if message == nil {
+		return nil, errors.New("no deposit message supplied")
+	}
+
+	return &phase0.DepositData{
+		PublicKey:             message.PublicKey,
+		WithdrawalCredentials: message.WithdrawalCredentials,
+		Amount:                message.Amount,
+		Signature:             signature,
+	}, nil
+}
+
+// SigningRoot computes the root that must be signed for a deposit message.
+// Deposits are valid across forks, so their domain uses the genesis fork
+// version of the chain and a zero genesis validators root.
+func SigningRoot(message *phase0.DepositMessage, genesisForkVersion phase0.Version) (phase0.Root, error) {
+	if message == nil {
+		return phase0.Root{}, errors.New("no deposit message supplied")
+	}
+
+	domain, err := signing.ComputeDomain(signing.DomainDeposit, genesisForkVersion, phase0.Root{})
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return signing.ComputeSigningRoot(message, domain)
+}
+
+// DataSigningRoot computes the signing root for deposit data.  This is the
+// root of the deposit message contained in the data, not of the data itself.
+func DataSigningRoot(data *phase0.DepositData, genesisForkVersion phase0.Version) (phase0.Root, error) {
+	if data == nil {
+		return phase0.Root{}, errors.New("no deposit data supplied")
+	}
+
+	return SigningRoot(&phase0.DepositMessage{
+		PublicKey:             data.PublicKey,
+		WithdrawalCredentials: data.WithdrawalCredentials,
+		Amount:                data.Amount,
+	}, genesisForkVersion)
+}
+
+// VerifyProof returns true if the deposit's proof shows that its data is at
+// the given index of the deposit tree with the root in the supplied ETH1
+// data, as per process_deposit in the specification.
+func VerifyProof(deposit *phase0.Deposit, index uint64, eth1Data *phase0.ETH1Data) (bool, error) {
+	if deposit == nil || deposit.Data == nil {
+		return false, errors.New("no deposit supplied")
+	}
+	if eth1Data == nil {
+		return false, errors.New("no ETH1 data supplied")
+	}
+	if index >= 1<<depositContractTreeDepth {
+		return false, fmt.Errorf("deposit index %d too large", index)
+	}
+	if len(deposit.Proof) != depositContractTreeDepth+1 {
+		return false, fmt.Errorf("deposit proof has %d elements, expected %d", len(deposit.Proof), depositContractTreeDepth+1)
+	}
+
+	leaf, err := deposit.Data.HashTreeRoot()
+	if err != nil {
+		return false, errors.Join(errors.New("failed to calculate deposit data root"), err)
+	}
+
+	branch := make([]phase0.Root, len(deposit.Proof))
+	for i := range deposit.Proof {
+		if len(deposit.Proof[i]) != len(branch[i]) {
+			return false, fmt.Errorf("deposit proof element %d has incorrect length", i)
+		}
+		copy(branch[i][:], deposit.Proof[i])
+	}
+
+	// The extra level of the tree mixes in the deposit count.
+	generalizedIndex := uint64(1)<<(depositContractTreeDepth+1) | index
+
+	return proofs.VerifyMerkleBranch(leaf, branch, generalizedIndex, eth1Data.DepositRoot), nil
+}
+
+// DepositCountRoot returns the final element of a deposit proof, which is the
+// little-endian encoding of the number of deposits in the tree.
+func DepositCountRoot(depositCount uint64) phase0.Root {
+	var root phase0.Root
+	binary.LittleEndian.PutUint64(root[:], depositCount)
+
+	return root
+}
@@ -0,0 +1,162 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package depositutil_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/creds"
+	"github.com/attestantio/go-eth2-client/util/depositutil"
+	"github.com/stretchr/testify/require"
+)
+
+// hashPair returns the hash of two roots.
+func hashPair(a phase0.Root, b phase0.Root) phase0.Root {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// testDeposits creates deposits along with the ETH1 data for a tree containing them.
+func testDeposits(t *testing.T) ([]*phase0.Deposit, *phase0.ETH1Data) {
+	t.Helper()
+
+	data := make([]*phase0.DepositData, 2)
+	leaves := make([]phase0.Root, 2)
+	for i := range data {
+		message, err := depositutil.NewDepositMessage(phase0.BLSPubKey{byte(i + 1)},
+			creds.Execution(bellatrix.ExecutionAddress{byte(i + 1)}),
+			32000000000,
+		)
+		require.NoError(t, err)
+		data[i], err = depositutil.NewDepositData(message, phase0.BLSSignature{byte(i + 1)})
+		require.NoError(t, err)
+		leaves[i], err = data[i].HashTreeRoot()
+		require.NoError(t, err)
+	}
+
+	zeroHashes := make([]phase0.Root, 32)
+	for i := 1; i < len(zeroHashes); i++ {
+		zeroHashes[i] = hashPair(zeroHashes[i-1], zeroHashes[i-1])
+	}
+
+	node := hashPair(leaves[0], leaves[1])
+	for i := 1; i < 32; i++ {
+		node = hashPair(node, zeroHashes[i])
+	}
+	countRoot := depositutil.DepositCountRoot(2)
+	eth1Data := &phase0.ETH1Data{
+		DepositRoot:  hashPair(node, countRoot),
+		DepositCount: 2,
+		BlockHash:    make([]byte, 32),
+	}
+
+	deposits := make([]*phase0.Deposit, 2)
+	for i := range deposits {
+		proof := make([][]byte, 33)
+		sibling := leaves[1-i]
+		proof[0] = sibling[:]
+		for j := 1; j < 32; j++ {
+			proof[j] = zeroHashes[j][:]
+		}
+		proof[32] = countRoot[:]
+		deposits[i] = &phase0.Deposit{
+			Proof: proof,
+			Data:  data[i],
+		}
+	}
+
+	return deposits, eth1Data
+}
+
+func TestNewDepositMessage(t *testing.T) {
+	_, err := depositutil.NewDepositMessage(phase0.BLSPubKey{}, []byte{0x01}, 32000000000)
+	require.EqualError(t, err, "withdrawal credentials must be 32 bytes")
+
+	message, err := depositutil.NewDepositMessage(phase0.BLSPubKey{0x01}, creds.Compounding(bellatrix.ExecutionAddress{0x02}), 64000000000)
+	require.NoError(t, err)
+	require.Equal(t, phase0.BLSPubKey{0x01}, message.PublicKey)
+	require.Equal(t, phase0.Gwei(64000000000), message.Amount)
+
+	_, err = depositutil.NewDepositData(nil, phase0.BLSSignature{})
+	require.EqualError(t, err, "no deposit message supplied")
+
+	data, err := depositutil.NewDepositData(message, phase0.BLSSignature{0x03})
+	require.NoError(t, err)
+	require.Equal(t, message.WithdrawalCredentials, data.WithdrawalCredentials)
+	require.Equal(t, phase0.BLSSignature{0x03}, data.Signature)
+}
+
+func TestSigningRoot(t *testing.T) {
+	message, err := depositutil.NewDepositMessage(phase0.BLSPubKey{0x01}, creds.Execution(bellatrix.ExecutionAddress{0x02}), 32000000000)
+	require.NoError(t, err)
+	genesisForkVersion := phase0.Version{0x10, 0x00, 0x09, 0x10}
+
+	// Calculate the expected root directly from its definition.
+	var versionRoot phase0.Root
+	copy(versionRoot[:], genesisForkVersion[:])
+	forkDataRoot := hashPair(versionRoot, phase0.Root{})
+	var domain phase0.Root
+	domain[0] = 0x03
+	copy(domain[4:], forkDataRoot[:28])
+	messageRoot, err := message.HashTreeRoot()
+	require.NoError(t, err)
+	expected := hashPair(messageRoot, domain)
+
+	root, err := depositutil.SigningRoot(message, genesisForkVersion)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	data, err := depositutil.NewDepositData(message, phase0.BLSSignature{0x03})
+	require.NoError(t, err)
+	dataRoot, err := depositutil.DataSigningRoot(data, genesisForkVersion)
+	require.NoError(t, err)
+	require.Equal(t, expected, dataRoot)
+
+	_, err = depositutil.SigningRoot(nil, genesisForkVersion)
+	require.EqualError(t, err, "no deposit message supplied")
+}
+
+func TestVerifyProof(t *testing.T) {
+	deposits, eth1Data := testDeposits(t)
+
+	for i, deposit := range deposits {
+		valid, err := depositutil.VerifyProof(deposit, uint64(i), eth1Data)
+		require.NoError(t, err)
+		require.True(t, valid)
+	}
+
+	// Wrong index.
+	valid, err := depositutil.VerifyProof(deposits[0], 1, eth1Data)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	// Wrong root.
+	valid, err = depositutil.VerifyProof(deposits[0], 0, &phase0.ETH1Data{})
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	_, err = depositutil.VerifyProof(&phase0.Deposit{Data: deposits[0].Data, Proof: deposits[0].Proof[:32]}, 0, eth1Data)
+	require.EqualError(t, err, "deposit proof has 32 elements, expected 33")
+
+	_, err = depositutil.VerifyProof(deposits[0], 1<<32, eth1Data)
+	require.EqualError(t, err, "deposit index 4294967296 too large")
+
+	_, err = depositutil.VerifyProof(nil, 0, eth1Data)
+	require.EqualError(t, err, "no deposit supplied")
+
+	_, err = depositutil.VerifyProof(deposits[0], 0, nil)
+	require.EqualError(t, err, "no ETH1 data supplied")
+}
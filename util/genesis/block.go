@@ -0,0 +1,154 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genesis
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// Block returns the genesis block for a genesis state.  This is an empty
+// block at slot 0 that commits to the root of the state.
+func Block(state *spec.VersionedBeaconState) (*spec.VersionedBeaconBlock, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+
+	stateRoot, err := state.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate state root"), err)
+	}
+
+	body, err := emptyBody(state.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &spec.VersionedBeaconBlock{
+		Version: state.Version,
+	}
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		block.Phase0 = &phase0.BeaconBlock{
+			StateRoot: stateRoot,
+			Body:      body.(*phase0.BeaconBlockBody),
+		}
+	case spec.DataVersionAltair:
+		block.Altair = &altair.BeaconBlock{
+			StateRoot: stateRoot,
+			Body:      body.(*altair.BeaconBlockBody),
+		}
+	case spec.DataVersionBellatrix:
+		block.Bellatrix = &bellatrix.BeaconBlock{
+			StateRoot: stateRoot,
+			Body:      body.(*bellatrix.BeaconBlockBody),
+		}
+	case spec.DataVersionCapella:
+		block.Capella = &capella.BeaconBlock{
+			StateRoot: stateRoot,
+			Body:      body.(*capella.BeaconBlockBody),
+		}
+	case spec.DataVersionDeneb:
+		block.Deneb = &deneb.BeaconBlock{
+			StateRoot: stateRoot,
+			Body:      body.(*deneb.BeaconBlockBody),
+		}
+	case spec.DataVersionElectra:
+		block.Electra = &electra.BeaconBlock{
+			StateRoot: stateRoot,
+			Body:      body.(*electra.BeaconBlockBody),
+		}
+	case spec.DataVersionFulu:
+		block.Fulu = &fulu.BeaconBlock{
+			StateRoot: stateRoot,
+			Body:      body.(*fulu.BeaconBlockBody),
+		}
+	}
+
+	return block, nil
+}
+
+// emptyBody returns the default block body for the given fork.
+func emptyBody(version spec.DataVersion) (ssz.HashRoot, error) {
+	eth1Data := &phase0.ETH1Data{
+		BlockHash: make([]byte, 32),
+	}
+	syncAggregate := &altair.SyncAggregate{
+		SyncCommitteeBits: bitfield.NewBitvector512(),
+	}
+
+	switch version {
+	case spec.DataVersionPhase0:
+		return &phase0.BeaconBlockBody{
+			ETH1Data: eth1Data,
+		}, nil
+	case spec.DataVersionAltair:
+		return &altair.BeaconBlockBody{
+			ETH1Data:      eth1Data,
+			SyncAggregate: syncAggregate,
+		}, nil
+	case spec.DataVersionBellatrix:
+		return &bellatrix.BeaconBlockBody{
+			ETH1Data:         eth1Data,
+			SyncAggregate:    syncAggregate,
+			ExecutionPayload: &bellatrix.ExecutionPayload{},
+		}, nil
+	case spec.DataVersionCapella:
+		return &capella.BeaconBlockBody{
+			ETH1Data:         eth1Data,
+			SyncAggregate:    syncAggregate,
+			ExecutionPayload: &capella.ExecutionPayload{},
+		}, nil
+	case spec.DataVersionDeneb:
+		return &deneb.BeaconBlockBody{
+			ETH1Data:      eth1Data,
+			SyncAggregate: syncAggregate,
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BaseFeePerGas: uint256.NewInt(0),
+			},
+		}, nil
+	case spec.DataVersionElectra:
+		return &electra.BeaconBlockBody{
+			ETH1Data:      eth1Data,
+			SyncAggregate: syncAggregate,
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BaseFeePerGas: uint256.NewInt(0),
+			},
+			ExecutionRequests: &electra.ExecutionRequests{},
+		}, nil
+	case spec.DataVersionFulu:
+		return &fulu.BeaconBlockBody{
+			ETH1Data:      eth1Data,
+			SyncAggregate: syncAggregate,
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BaseFeePerGas: uint256.NewInt(0),
+			},
+			ExecutionRequests: &electra.ExecutionRequests{},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported version %v", version)
+	}
+}
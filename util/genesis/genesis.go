@@ -0,0 +1,277 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package genesis provides functions to build genesis states, for example
+// for local and test networks.
+package genesis
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+const (
+	// farFutureEpoch is FAR_FUTURE_EPOCH in the specification.
+	farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+	// depositContractLimit is the maximum number of deposits in the deposit contract.
+	depositContractLimit = uint64(1) << 32
+	// validatorRegistryLimit is VALIDATOR_REGISTRY_LIMIT in the specification.
+	validatorRegistryLimit = uint64(1) << 40
+	// unsetDepositRequestsStartIndex is UNSET_DEPOSIT_REQUESTS_START_INDEX in the specification.
+	unsetDepositRequestsStartIndex = uint64(0xffffffffffffffff)
+)
+
+// forks are the forks that can be used for a genesis state, in order.
+var forks = []spec.DataVersion{
+	spec.DataVersionPhase0,
+	spec.DataVersionAltair,
+	spec.DataVersionBellatrix,
+	spec.DataVersionCapella,
+	spec.DataVersionDeneb,
+	spec.DataVersionElectra,
+	spec.DataVersionFulu,
+}
+
+// Validator is a validator to include in the genesis state.
+type Validator struct {
+	PublicKey             phase0.BLSPubKey
+	WithdrawalCredentials []byte
+	Balance               phase0.Gwei
+}
+
+// builder holds the information common to genesis states of all forks.
+type builder struct {
+	config                *apiv1.SpecConfig
+	version               spec.DataVersion
+	genesisTime           uint64
+	eth1BlockHash         phase0.Hash32
+	fork                  *phase0.Fork
+	eth1Data              *phase0.ETH1Data
+	validators            []*phase0.Validator
+	balances              []phase0.Gwei
+	genesisValidatorsRoot phase0.Root
+	params                *parameters
+}
+
+// Build builds a genesis state.
+//
+// Validators are added directly to the registry, and activated if their
+// balance is sufficient, as per initialize_beacon_state_from_eth1 in the
+// specification.  The fork of the genesis state has both its previous and
+// current version set to the version of the genesis fork.
+func Build(params ...Parameter) (*spec.VersionedBeaconState, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	b, err := newBuilder(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.build()
+}
+
+func newBuilder(params *parameters) (*builder, error) {
+	b := &builder{
+		config:        apiv1.NewSpecConfig(params.spec),
+		version:       params.version,
+		genesisTime:   uint64(params.genesisTime.Unix()),
+		eth1BlockHash: params.eth1BlockHash,
+		params:        params,
+	}
+
+	if b.version == spec.DataVersionUnknown {
+		b.version = b.genesisFork()
+	}
+	if b.version < spec.DataVersionPhase0 || b.version > spec.DataVersionFulu {
+		return nil, fmt.Errorf("unsupported genesis version %v", b.version)
+	}
+
+	forkVersion, err := b.forkVersion()
+	if err != nil {
+		return nil, err
+	}
+	b.fork = &phase0.Fork{
+		PreviousVersion: forkVersion,
+		CurrentVersion:  forkVersion,
+		Epoch:           0,
+	}
+
+	depositRoot, err := depositDataRoot(params.deposits)
+	if err != nil {
+		return nil, err
+	}
+	b.eth1Data = &phase0.ETH1Data{
+		DepositRoot:  depositRoot,
+		DepositCount: uint64(len(params.deposits)),
+		BlockHash:    bytes.Clone(b.eth1BlockHash[:]),
+	}
+
+	if err := b.buildValidators(); err != nil {
+		return nil, err
+	}
+
+	b.genesisValidatorsRoot, err = validatorsRoot(b.validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// genesisFork returns the latest fork scheduled for the genesis epoch.
+func (b *builder) genesisFork() spec.DataVersion {
+	version := spec.DataVersionPhase0
+	for _, fork := range forks[1:] {
+		epoch, err := b.config.ForkEpoch(fork.String())
+		if err != nil || epoch != 0 {
+			break
+		}
+		version = fork
+	}
+
+	return version
+}
+
+// forkVersion returns the fork version of the genesis fork.
+func (b *builder) forkVersion() (phase0.Version, error) {
+	if b.version == spec.DataVersionPhase0 {
+		return b.config.GenesisForkVersion()
+	}
+
+	return b.config.ForkVersion(b.version.String())
+}
+
+// buildValidators creates the validator registry and balances from the deposits.
+// As per initialize_beacon_state_from_eth1 in the specification, a deposit for
+// an existing validator tops up its balance, and validators are activated based
+// on their balance once all deposits have been applied.
+func (b *builder) buildValidators() error {
+	increment, err := b.config.Gwei("EFFECTIVE_BALANCE_INCREMENT")
+	if err != nil {
+		return err
+	}
+	activationBalance, err := b.activationBalance()
+	if err != nil {
+		return err
+	}
+
+	b.validators = make([]*phase0.Validator, 0, len(b.params.deposits))
+	b.balances = make([]phase0.Gwei, 0, len(b.params.deposits))
+	indices := make(map[phase0.BLSPubKey]int, len(b.params.deposits))
+	for i, deposit := range b.params.deposits {
+		if len(deposit.WithdrawalCredentials) != 32 {
+			return fmt.Errorf("validator %d: withdrawal credentials must be 32 bytes", i)
+		}
+		if index, exists := indices[deposit.PublicKey]; exists {
+			// Top up the existing validator.
+			b.balances[index] += deposit.Amount
+
+			continue
+		}
+		indices[deposit.PublicKey] = len(b.validators)
+
+		b.validators = append(b.validators, &phase0.Validator{
+			PublicKey:                  deposit.PublicKey,
+			WithdrawalCredentials:      bytes.Clone(deposit.WithdrawalCredentials),
+			ActivationEligibilityEpoch: farFutureEpoch,
+			ActivationEpoch:            farFutureEpoch,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		})
+		b.balances = append(b.balances, deposit.Amount)
+	}
+
+	for i, validator := range b.validators {
+		maxEffectiveBalance, err := b.maxEffectiveBalance(validator)
+		if err != nil {
+			return err
+		}
+		balance := b.balances[i]
+		validator.EffectiveBalance = min(balance-balance%increment, maxEffectiveBalance)
+		if validator.EffectiveBalance >= activationBalance {
+			validator.ActivationEligibilityEpoch = 0
+			validator.ActivationEpoch = 0
+		}
+	}
+
+	return nil
+}
+
+// maxEffectiveBalance returns the maximum effective balance for the validator.
+func (b *builder) maxEffectiveBalance(validator *phase0.Validator) (phase0.Gwei, error) {
+	if b.version < spec.DataVersionElectra {
+		return b.config.MaxEffectiveBalance()
+	}
+	if validator.HasCompoundingCredentials() {
+		return b.config.MaxEffectiveBalanceElectra()
+	}
+
+	return b.config.MinActivationBalance()
+}
+
+// activationBalance returns the effective balance required for a validator to
+// be active at genesis.
+func (b *builder) activationBalance() (phase0.Gwei, error) {
+	if b.version < spec.DataVersionElectra {
+		return b.config.MaxEffectiveBalance()
+	}
+
+	return b.config.MinActivationBalance()
+}
+
+// depositDataRoot returns the root of the deposit data list.
+func depositDataRoot(deposits []*phase0.DepositData) (phase0.Root, error) {
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	for _, deposit := range deposits {
+		if err := deposit.HashTreeRootWith(hh); err != nil {
+			return phase0.Root{}, errors.Join(errors.New("failed to calculate deposit data root"), err)
+		}
+	}
+	hh.MerkleizeWithMixin(indx, uint64(len(deposits)), depositContractLimit)
+
+	root, err := hh.HashRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate deposit root"), err)
+	}
+
+	return root, nil
+}
+
+// validatorsRoot returns the root of the validator registry.
+func validatorsRoot(validators []*phase0.Validator) (phase0.Root, error) {
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	for _, validator := range validators {
+		if err := validator.HashTreeRootWith(hh); err != nil {
+			return phase0.Root{}, errors.Join(errors.New("failed to calculate validator root"), err)
+		}
+	}
+	hh.MerkleizeWithMixin(indx, uint64(len(validators)), validatorRegistryLimit)
+
+	root, err := hh.HashRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate genesis validators root"), err)
+	}
+
+	return root, nil
+}
@@ -0,0 +1,314 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genesis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/creds"
+	"github.com/attestantio/go-eth2-client/util/genesis"
+	"github.com/stretchr/testify/require"
+)
+
+// testSpec returns a mainnet preset configuration with all forks at genesis.
+func testSpec() map[string]any {
+	return map[string]any{
+		"SLOTS_PER_EPOCH":               uint64(32),
+		"SLOTS_PER_HISTORICAL_ROOT":     uint64(8192),
+		"EPOCHS_PER_HISTORICAL_VECTOR":  uint64(65536),
+		"EPOCHS_PER_SLASHINGS_VECTOR":   uint64(8192),
+		"SYNC_COMMITTEE_SIZE":           uint64(512),
+		"SHUFFLE_ROUND_COUNT":           uint64(90),
+		"MIN_SEED_LOOKAHEAD":            uint64(1),
+		"EFFECTIVE_BALANCE_INCREMENT":   uint64(1000000000),
+		"MAX_EFFECTIVE_BALANCE":         uint64(32000000000),
+		"MIN_ACTIVATION_BALANCE":        uint64(32000000000),
+		"MAX_EFFECTIVE_BALANCE_ELECTRA": uint64(2048000000000),
+		"GENESIS_FORK_VERSION":          phase0.Version{0x10, 0x00, 0x00, 0x38},
+		"ALTAIR_FORK_VERSION":           phase0.Version{0x20, 0x00, 0x00, 0x38},
+		"ALTAIR_FORK_EPOCH":             uint64(0),
+		"BELLATRIX_FORK_VERSION":        phase0.Version{0x30, 0x00, 0x00, 0x38},
+		"BELLATRIX_FORK_EPOCH":          uint64(0),
+		"CAPELLA_FORK_VERSION":          phase0.Version{0x40, 0x00, 0x00, 0x38},
+		"CAPELLA_FORK_EPOCH":            uint64(0),
+		"DENEB_FORK_VERSION":            phase0.Version{0x50, 0x00, 0x00, 0x38},
+		"DENEB_FORK_EPOCH":              uint64(0),
+		"ELECTRA_FORK_VERSION":          phase0.Version{0x60, 0x00, 0x00, 0x38},
+		"ELECTRA_FORK_EPOCH":            uint64(0),
+		"FULU_FORK_VERSION":             phase0.Version{0x70, 0x00, 0x00, 0x38},
+		"FULU_FORK_EPOCH":               uint64(100),
+	}
+}
+
+// testValidators returns validators with increasing public keys.
+func testValidators(count int) []*genesis.Validator {
+	validators := make([]*genesis.Validator, count)
+	for i := range validators {
+		validators[i] = &genesis.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i), byte(i >> 8), 0x01},
+			WithdrawalCredentials: creds.Execution(bellatrix.ExecutionAddress{byte(i)}),
+			Balance:               32000000000,
+		}
+	}
+
+	return validators
+}
+
+// aggregatePublicKeys is a stand-in for BLS aggregation.
+func aggregatePublicKeys(pubKeys []phase0.BLSPubKey) (phase0.BLSPubKey, error) {
+	var res phase0.BLSPubKey
+	for _, pubKey := range pubKeys {
+		for i := range res {
+			res[i] ^= pubKey[i]
+		}
+	}
+
+	return res, nil
+}
+
+func TestBuildParameters(t *testing.T) {
+	genesisTime := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name   string
+		params []genesis.Parameter
+		err    string
+	}{
+		{
+			name: "SpecMissing",
+			params: []genesis.Parameter{
+				genesis.WithGenesisTime(genesisTime),
+				genesis.WithValidators(testValidators(4)),
+			},
+			err: "problem with parameters\nno spec specified",
+		},
+		{
+			name: "GenesisTimeMissing",
+			params: []genesis.Parameter{
+				genesis.WithSpec(testSpec()),
+				genesis.WithValidators(testValidators(4)),
+			},
+			err: "problem with parameters\nno genesis time specified",
+		},
+		{
+			name: "ValidatorsMissing",
+			params: []genesis.Parameter{
+				genesis.WithSpec(testSpec()),
+				genesis.WithGenesisTime(genesisTime),
+			},
+			err: "problem with parameters\nno validators specified",
+		},
+		{
+			name: "AggregatePublicKeysMissing",
+			params: []genesis.Parameter{
+				genesis.WithSpec(testSpec()),
+				genesis.WithGenesisTime(genesisTime),
+				genesis.WithValidators(testValidators(4)),
+			},
+			err: "no aggregate public keys function specified",
+		},
+		{
+			name: "ExecutionPayloadHeaderWrongVersion",
+			params: []genesis.Parameter{
+				genesis.WithSpec(testSpec()),
+				genesis.WithGenesisTime(genesisTime),
+				genesis.WithValidators(testValidators(4)),
+				genesis.WithAggregatePublicKeysFunc(aggregatePublicKeys),
+				genesis.WithExecutionPayloadHeader(&spec.VersionedExecutionPayloadHeader{
+					Version:   spec.DataVersionBellatrix,
+					Bellatrix: &bellatrix.ExecutionPayloadHeader{},
+				}),
+			},
+			err: "execution payload header is not for electra",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := genesis.Build(test.params...)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestBuildElectra(t *testing.T) {
+	validators := testValidators(64)
+	// A compounding validator with more than the minimum activation balance.
+	validators[1].WithdrawalCredentials = creds.Compounding(bellatrix.ExecutionAddress{0x01})
+	validators[1].Balance = 64500000000
+	// An execution validator with more than the minimum activation balance.
+	validators[2].Balance = 40000000000
+	// A validator with insufficient balance to activate.
+	validators[3].Balance = 16000000000
+
+	eth1BlockHash := phase0.Hash32{0x01, 0x02, 0x03}
+	state, err := genesis.Build(
+		genesis.WithSpec(testSpec()),
+		genesis.WithGenesisTime(time.Unix(1700000000, 0)),
+		genesis.WithETH1BlockHash(eth1BlockHash),
+		genesis.WithValidators(validators),
+		genesis.WithAggregatePublicKeysFunc(aggregatePublicKeys),
+	)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionElectra, state.Version)
+	require.NotNil(t, state.Electra)
+
+	require.Equal(t, uint64(1700000000), state.Electra.GenesisTime)
+	require.Equal(t, phase0.Version{0x60, 0x00, 0x00, 0x38}, state.Electra.Fork.CurrentVersion)
+	require.Equal(t, uint64(64), state.Electra.ETH1Data.DepositCount)
+	require.Equal(t, uint64(64), state.Electra.ETH1DepositIndex)
+	require.Equal(t, uint64(0xffffffffffffffff), state.Electra.DepositRequestsStartIndex)
+	require.Equal(t, phase0.Root(eth1BlockHash), state.Electra.RANDAOMixes[100])
+	require.Len(t, state.Electra.Validators, 64)
+	require.Len(t, state.Electra.CurrentSyncCommittee.Pubkeys, 512)
+	require.Equal(t, state.Electra.CurrentSyncCommittee, state.Electra.NextSyncCommittee)
+
+	require.Equal(t, phase0.Gwei(64000000000), state.Electra.Validators[1].EffectiveBalance)
+	require.Equal(t, phase0.Gwei(64500000000), state.Electra.Balances[1])
+	require.Equal(t, phase0.Gwei(32000000000), state.Electra.Validators[2].EffectiveBalance)
+	require.Equal(t, phase0.Epoch(0), state.Electra.Validators[2].ActivationEpoch)
+	require.Equal(t, phase0.Gwei(16000000000), state.Electra.Validators[3].EffectiveBalance)
+	require.Equal(t, phase0.Epoch(0xffffffffffffffff), state.Electra.Validators[3].ActivationEpoch)
+
+	// Validators that are not active cannot be in the sync committee.
+	for _, pubKey := range state.Electra.CurrentSyncCommittee.Pubkeys {
+		require.NotEqual(t, validators[3].PublicKey, pubKey)
+	}
+
+	// The state must survive a round trip.
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	res := &spec.VersionedBeaconState{Version: spec.DataVersionElectra}
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, state, res)
+
+	block, err := genesis.Block(state)
+	require.NoError(t, err)
+	stateRoot, err := state.HashTreeRoot()
+	require.NoError(t, err)
+	blockStateRoot, err := block.StateRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(stateRoot), blockStateRoot)
+	bodyRoot, err := block.BodyRoot()
+	require.NoError(t, err)
+	require.Equal(t, state.Electra.LatestBlockHeader.BodyRoot, bodyRoot)
+}
+
+func TestBuildVersions(t *testing.T) {
+	for _, version := range []spec.DataVersion{
+		spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb,
+		spec.DataVersionElectra,
+		spec.DataVersionFulu,
+	} {
+		t.Run(version.String(), func(t *testing.T) {
+			state, err := genesis.Build(
+				genesis.WithSpec(testSpec()),
+				genesis.WithVersion(version),
+				genesis.WithGenesisTime(time.Unix(1700000000, 0)),
+				genesis.WithValidators(testValidators(16)),
+				genesis.WithAggregatePublicKeysFunc(aggregatePublicKeys),
+			)
+			require.NoError(t, err)
+			require.Equal(t, version, state.Version)
+
+			validators, err := state.Validators()
+			require.NoError(t, err)
+			require.Len(t, validators, 16)
+
+			block, err := genesis.Block(state)
+			require.NoError(t, err)
+			require.Equal(t, version, block.Version)
+			slot, err := block.Slot()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(0), slot)
+		})
+	}
+}
+
+func TestBuildTopUp(t *testing.T) {
+	validators := testValidators(3)
+	validators[0].Balance = 16000000000
+	validators[2].Balance = 16000000000
+	topUp := &genesis.Validator{
+		PublicKey:             validators[0].PublicKey,
+		WithdrawalCredentials: creds.Execution(bellatrix.ExecutionAddress{0xff}),
+		Balance:               16000000000,
+	}
+
+	for _, version := range []spec.DataVersion{spec.DataVersionPhase0, spec.DataVersionElectra} {
+		t.Run(version.String(), func(t *testing.T) {
+			state, err := genesis.Build(
+				genesis.WithSpec(testSpec()),
+				genesis.WithVersion(version),
+				genesis.WithGenesisTime(time.Unix(1700000000, 0)),
+				genesis.WithValidators(validators),
+				genesis.WithValidators([]*genesis.Validator{topUp}),
+				genesis.WithAggregatePublicKeysFunc(aggregatePublicKeys),
+			)
+			require.NoError(t, err)
+
+			// The repeat deposit tops up the first validator rather than adding another.
+			stateValidators, err := state.Validators()
+			require.NoError(t, err)
+			require.Len(t, stateValidators, 3)
+			balances, err := state.ValidatorBalances()
+			require.NoError(t, err)
+			require.Equal(t, []phase0.Gwei{32000000000, 32000000000, 16000000000}, balances)
+
+			// The summed balance is sufficient for activation, and the original
+			// withdrawal credentials are retained.
+			require.Equal(t, phase0.Gwei(32000000000), stateValidators[0].EffectiveBalance)
+			require.Equal(t, phase0.Epoch(0), stateValidators[0].ActivationEpoch)
+			require.Equal(t, validators[0].WithdrawalCredentials, stateValidators[0].WithdrawalCredentials)
+			require.Equal(t, phase0.Epoch(0xffffffffffffffff), stateValidators[2].ActivationEpoch)
+		})
+	}
+}
+
+func TestBuildVersionFromSpec(t *testing.T) {
+	config := testSpec()
+	config["ALTAIR_FORK_EPOCH"] = uint64(10)
+
+	state, err := genesis.Build(
+		genesis.WithSpec(config),
+		genesis.WithGenesisTime(time.Unix(1700000000, 0)),
+		genesis.WithValidators(testValidators(4)),
+	)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionPhase0, state.Version)
+	require.Equal(t, phase0.Version{0x10, 0x00, 0x00, 0x38}, state.Phase0.Fork.CurrentVersion)
+}
+
+func TestBuildFulu(t *testing.T) {
+	state, err := genesis.Build(
+		genesis.WithSpec(testSpec()),
+		genesis.WithVersion(spec.DataVersionFulu),
+		genesis.WithGenesisTime(time.Unix(1700000000, 0)),
+		genesis.WithValidators(testValidators(8)),
+		genesis.WithAggregatePublicKeysFunc(aggregatePublicKeys),
+	)
+	require.NoError(t, err)
+	require.Len(t, state.Fulu.ProposerLookahead, 64)
+	for _, index := range state.Fulu.ProposerLookahead {
+		require.Less(t, uint64(index), uint64(8))
+	}
+}
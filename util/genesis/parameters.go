@@ -0,0 +1,147 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genesis
+
+import (
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AggregatePublicKeysFunc aggregates BLS public keys.
+type AggregatePublicKeysFunc func(pubKeys []phase0.BLSPubKey) (phase0.BLSPubKey, error)
+
+type parameters struct {
+	spec                   map[string]any
+	version                spec.DataVersion
+	genesisTime            time.Time
+	eth1BlockHash          phase0.Hash32
+	deposits               []*phase0.DepositData
+	executionPayloadHeader *spec.VersionedExecutionPayloadHeader
+	aggregatePublicKeys    AggregatePublicKeysFunc
+}
+
+// Parameter is the interface for genesis parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithSpec sets the chain configuration, as returned by Spec().
+func WithSpec(spec map[string]any) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.spec = spec
+	})
+}
+
+// WithVersion sets the fork of the genesis state.  If not supplied this is
+// the latest fork scheduled for epoch 0 in the chain configuration.
+func WithVersion(version spec.DataVersion) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.version = version
+	})
+}
+
+// WithGenesisTime sets the genesis time of the chain.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithETH1BlockHash sets the hash of the execution block from which the chain
+// starts.  This seeds the RANDAO mixes of the genesis state.
+func WithETH1BlockHash(hash phase0.Hash32) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eth1BlockHash = hash
+	})
+}
+
+// WithDeposits adds deposits to the genesis state.  Deposit signatures are
+// not checked.
+func WithDeposits(deposits []*phase0.DepositData) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.deposits = append(p.deposits, deposits...)
+	})
+}
+
+// WithValidators adds validators to the genesis state.
+func WithValidators(validators []*Validator) Parameter {
+	return parameterFunc(func(p *parameters) {
+		for _, validator := range validators {
+			if validator == nil {
+				p.deposits = append(p.deposits, nil)
+
+				continue
+			}
+			p.deposits = append(p.deposits, &phase0.DepositData{
+				PublicKey:             validator.PublicKey,
+				WithdrawalCredentials: validator.WithdrawalCredentials,
+				Amount:                validator.Balance,
+			})
+		}
+	})
+}
+
+// WithExecutionPayloadHeader sets the header of the execution block from which
+// the chain starts, for Bellatrix and later genesis states.  If not supplied an
+// empty header is used.
+func WithExecutionPayloadHeader(header *spec.VersionedExecutionPayloadHeader) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.executionPayloadHeader = header
+	})
+}
+
+// WithAggregatePublicKeysFunc sets the function used to aggregate the public
+// keys of sync committees, for Altair and later genesis states.  This allows
+// the caller to use the BLS library of their choice.
+func WithAggregatePublicKeysFunc(aggregatePublicKeys AggregatePublicKeysFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.aggregatePublicKeys = aggregatePublicKeys
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.spec == nil {
+		return nil, errors.New("no spec specified")
+	}
+	if parameters.genesisTime.IsZero() {
+		return nil, errors.New("no genesis time specified")
+	}
+	if len(parameters.deposits) == 0 {
+		return nil, errors.New("no validators specified")
+	}
+	for _, deposit := range parameters.deposits {
+		if deposit == nil {
+			return nil, errors.New("nil validator specified")
+		}
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,128 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genesis
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// activeValidatorIndices returns the indices of validators active at the given epoch.
+func (b *builder) activeValidatorIndices(epoch phase0.Epoch) []phase0.ValidatorIndex {
+	indices := make([]phase0.ValidatorIndex, 0, len(b.validators))
+	for i, validator := range b.validators {
+		if validator.IsActive(epoch) {
+			indices = append(indices, phase0.ValidatorIndex(i))
+		}
+	}
+
+	return indices
+}
+
+// seed returns the seed for the given epoch and domain type, as per get_seed
+// in the specification.  All RANDAO mixes in the genesis state are the ETH1
+// block hash, so that is the mix used.
+func (b *builder) seed(epoch phase0.Epoch, domainType phase0.DomainType) [32]byte {
+	data := make([]byte, 0, 44)
+	data = append(data, domainType[:]...)
+	data = binary.LittleEndian.AppendUint64(data, uint64(epoch))
+	data = append(data, b.eth1BlockHash[:]...)
+
+	return sha256.Sum256(data)
+}
+
+// computeShuffledIndex returns the shuffled index, as per
+// compute_shuffled_index in the specification.
+func computeShuffledIndex(index uint64, indexCount uint64, seed [32]byte, rounds uint64) uint64 {
+	buf := make([]byte, 0, 37)
+	for round := uint64(0); round < rounds; round++ {
+		buf = append(buf[:0], seed[:]...)
+		buf = append(buf, byte(round))
+		pivotHash := sha256.Sum256(buf)
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % indexCount
+		flip := (pivot + indexCount - index) % indexCount
+		position := max(index, flip)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(position/256))
+		source := sha256.Sum256(buf)
+		if (source[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+
+	return index
+}
+
+// selectByBalance selects count validators from the candidates, weighted by
+// effective balance.  This is the selection used by compute_proposer_index
+// and get_next_sync_committee_indices in the specification.
+func (b *builder) selectByBalance(candidates []phase0.ValidatorIndex,
+	seed [32]byte,
+	count int,
+) (
+	[]phase0.ValidatorIndex,
+	error,
+) {
+	if len(candidates) == 0 {
+		return nil, errors.New("no active validators")
+	}
+
+	rounds, err := b.config.Uint64("SHUFFLE_ROUND_COUNT")
+	if err != nil {
+		return nil, err
+	}
+
+	var maxEffectiveBalance phase0.Gwei
+	if b.version >= spec.DataVersionElectra {
+		maxEffectiveBalance, err = b.config.MaxEffectiveBalanceElectra()
+	} else {
+		maxEffectiveBalance, err = b.config.MaxEffectiveBalance()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	total := uint64(len(candidates))
+	selected := make([]phase0.ValidatorIndex, 0, count)
+	buf := make([]byte, 0, 40)
+	for i := uint64(0); len(selected) < count; i++ {
+		candidate := candidates[computeShuffledIndex(i%total, total, seed, rounds)]
+
+		// Electra moved from a random byte to a random 16-bit value.
+		var randomValue, maxRandomValue uint64
+		buf = append(buf[:0], seed[:]...)
+		if b.version >= spec.DataVersionElectra {
+			buf = binary.LittleEndian.AppendUint64(buf, i/16)
+			randomBytes := sha256.Sum256(buf)
+			offset := i % 16 * 2
+			randomValue = uint64(binary.LittleEndian.Uint16(randomBytes[offset : offset+2]))
+			maxRandomValue = 1<<16 - 1
+		} else {
+			buf = binary.LittleEndian.AppendUint64(buf, i/32)
+			randomBytes := sha256.Sum256(buf)
+			randomValue = uint64(randomBytes[i%32])
+			maxRandomValue = 1<<8 - 1
+		}
+
+		effectiveBalance := uint64(b.validators[candidate].EffectiveBalance)
+		if effectiveBalance*maxRandomValue >= uint64(maxEffectiveBalance)*randomValue {
+			selected = append(selected, candidate)
+		}
+	}
+
+	return selected, nil
+}
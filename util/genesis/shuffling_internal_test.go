@@ -0,0 +1,58 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genesis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeShuffledIndex(t *testing.T) {
+	seed := [32]byte{0x01, 0x02, 0x03}
+	for _, count := range []uint64{1, 2, 10, 100} {
+		seen := make(map[uint64]struct{}, count)
+		for i := uint64(0); i < count; i++ {
+			index := computeShuffledIndex(i, count, seed, 90)
+			require.Less(t, index, count)
+			seen[index] = struct{}{}
+		}
+		// The shuffle must be a permutation.
+		require.Len(t, seen, int(count))
+	}
+}
+
+func TestComputeShuffledIndexVectors(t *testing.T) {
+	// Expected values were obtained by running the compute_shuffled_index
+	// pseudocode from the specification with the mainnet round count.
+	seed := [32]byte{0x01, 0x02, 0x03}
+
+	shuffled := make([]uint64, 10)
+	for i := range shuffled {
+		shuffled[i] = computeShuffledIndex(uint64(i), 10, seed, 90)
+	}
+	require.Equal(t, []uint64{1, 6, 7, 0, 5, 2, 4, 9, 3, 8}, shuffled)
+
+	// Indices above 255 use a different source hash.
+	for index, expected := range map[uint64]uint64{
+		0:   363,
+		1:   26,
+		255: 749,
+		256: 416,
+		511: 684,
+		999: 311,
+	} {
+		require.Equal(t, expected, computeShuffledIndex(index, 1000, seed, 90), index)
+	}
+}
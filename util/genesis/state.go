@@ -0,0 +1,465 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genesis
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// commonFields are the fields of the beacon state common to all forks.
+type commonFields struct {
+	latestBlockHeader *phase0.BeaconBlockHeader
+	blockRoots        []phase0.Root
+	stateRoots        []phase0.Root
+	randaoMixes       []phase0.Root
+	slashings         []phase0.Gwei
+}
+
+// build builds the genesis state for the fork.
+func (b *builder) build() (*spec.VersionedBeaconState, error) {
+	common, err := b.commonFields()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &spec.VersionedBeaconState{
+		Version: b.version,
+	}
+
+	if b.version == spec.DataVersionPhase0 {
+		state.Phase0 = &phase0.BeaconState{
+			GenesisTime:                 b.genesisTime,
+			GenesisValidatorsRoot:       b.genesisValidatorsRoot,
+			Fork:                        b.fork,
+			LatestBlockHeader:           common.latestBlockHeader,
+			BlockRoots:                  common.blockRoots,
+			StateRoots:                  common.stateRoots,
+			HistoricalRoots:             make([]phase0.Root, 0),
+			ETH1Data:                    b.eth1Data,
+			ETH1DataVotes:               make([]*phase0.ETH1Data, 0),
+			ETH1DepositIndex:            b.eth1Data.DepositCount,
+			Validators:                  b.validators,
+			Balances:                    b.balances,
+			RANDAOMixes:                 common.randaoMixes,
+			Slashings:                   common.slashings,
+			PreviousEpochAttestations:   make([]*phase0.PendingAttestation, 0),
+			CurrentEpochAttestations:    make([]*phase0.PendingAttestation, 0),
+			JustificationBits:           bitfield.NewBitvector4(),
+			PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+			FinalizedCheckpoint:         &phase0.Checkpoint{},
+		}
+
+		return state, nil
+	}
+
+	syncCommittee, err := b.syncCommittee()
+	if err != nil {
+		return nil, err
+	}
+
+	switch b.version {
+	case spec.DataVersionAltair:
+		state.Altair = &altair.BeaconState{
+			GenesisTime:                 b.genesisTime,
+			GenesisValidatorsRoot:       b.genesisValidatorsRoot,
+			Fork:                        b.fork,
+			LatestBlockHeader:           common.latestBlockHeader,
+			BlockRoots:                  common.blockRoots,
+			StateRoots:                  common.stateRoots,
+			HistoricalRoots:             make([]phase0.Root, 0),
+			ETH1Data:                    b.eth1Data,
+			ETH1DataVotes:               make([]*phase0.ETH1Data, 0),
+			ETH1DepositIndex:            b.eth1Data.DepositCount,
+			Validators:                  b.validators,
+			Balances:                    b.balances,
+			RANDAOMixes:                 common.randaoMixes,
+			Slashings:                   common.slashings,
+			PreviousEpochParticipation:  b.participation(),
+			CurrentEpochParticipation:   b.participation(),
+			JustificationBits:           bitfield.NewBitvector4(),
+			PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+			FinalizedCheckpoint:         &phase0.Checkpoint{},
+			InactivityScores:            make([]uint64, len(b.validators)),
+			CurrentSyncCommittee:        syncCommittee,
+			NextSyncCommittee:           syncCommittee,
+		}
+	case spec.DataVersionBellatrix:
+		header, err := b.bellatrixExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		state.Bellatrix = &bellatrix.BeaconState{
+			GenesisTime:                  b.genesisTime,
+			GenesisValidatorsRoot:        b.genesisValidatorsRoot,
+			Fork:                         b.fork,
+			LatestBlockHeader:            common.latestBlockHeader,
+			BlockRoots:                   common.blockRoots,
+			StateRoots:                   common.stateRoots,
+			HistoricalRoots:              make([]phase0.Root, 0),
+			ETH1Data:                     b.eth1Data,
+			ETH1DataVotes:                make([]*phase0.ETH1Data, 0),
+			ETH1DepositIndex:             b.eth1Data.DepositCount,
+			Validators:                   b.validators,
+			Balances:                     b.balances,
+			RANDAOMixes:                  common.randaoMixes,
+			Slashings:                    common.slashings,
+			PreviousEpochParticipation:   b.participation(),
+			CurrentEpochParticipation:    b.participation(),
+			JustificationBits:            bitfield.NewBitvector4(),
+			PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+			FinalizedCheckpoint:          &phase0.Checkpoint{},
+			InactivityScores:             make([]uint64, len(b.validators)),
+			CurrentSyncCommittee:         syncCommittee,
+			NextSyncCommittee:            syncCommittee,
+			LatestExecutionPayloadHeader: header,
+		}
+	case spec.DataVersionCapella:
+		header, err := b.capellaExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		state.Capella = &capella.BeaconState{
+			GenesisTime:                  b.genesisTime,
+			GenesisValidatorsRoot:        b.genesisValidatorsRoot,
+			Fork:                         b.fork,
+			LatestBlockHeader:            common.latestBlockHeader,
+			BlockRoots:                   common.blockRoots,
+			StateRoots:                   common.stateRoots,
+			HistoricalRoots:              make([]phase0.Root, 0),
+			ETH1Data:                     b.eth1Data,
+			ETH1DataVotes:                make([]*phase0.ETH1Data, 0),
+			ETH1DepositIndex:             b.eth1Data.DepositCount,
+			Validators:                   b.validators,
+			Balances:                     b.balances,
+			RANDAOMixes:                  common.randaoMixes,
+			Slashings:                    common.slashings,
+			PreviousEpochParticipation:   b.participation(),
+			CurrentEpochParticipation:    b.participation(),
+			JustificationBits:            bitfield.NewBitvector4(),
+			PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+			FinalizedCheckpoint:          &phase0.Checkpoint{},
+			InactivityScores:             make([]uint64, len(b.validators)),
+			CurrentSyncCommittee:         syncCommittee,
+			NextSyncCommittee:            syncCommittee,
+			LatestExecutionPayloadHeader: header,
+			HistoricalSummaries:          make([]*capella.HistoricalSummary, 0),
+		}
+	case spec.DataVersionDeneb:
+		header, err := b.denebExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		state.Deneb = &deneb.BeaconState{
+			GenesisTime:                  b.genesisTime,
+			GenesisValidatorsRoot:        b.genesisValidatorsRoot,
+			Fork:                         b.fork,
+			LatestBlockHeader:            common.latestBlockHeader,
+			BlockRoots:                   common.blockRoots,
+			StateRoots:                   common.stateRoots,
+			HistoricalRoots:              make([]phase0.Root, 0),
+			ETH1Data:                     b.eth1Data,
+			ETH1DataVotes:                make([]*phase0.ETH1Data, 0),
+			ETH1DepositIndex:             b.eth1Data.DepositCount,
+			Validators:                   b.validators,
+			Balances:                     b.balances,
+			RANDAOMixes:                  common.randaoMixes,
+			Slashings:                    common.slashings,
+			PreviousEpochParticipation:   b.participation(),
+			CurrentEpochParticipation:    b.participation(),
+			JustificationBits:            bitfield.NewBitvector4(),
+			PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+			FinalizedCheckpoint:          &phase0.Checkpoint{},
+			InactivityScores:             make([]uint64, len(b.validators)),
+			CurrentSyncCommittee:         syncCommittee,
+			NextSyncCommittee:            syncCommittee,
+			LatestExecutionPayloadHeader: header,
+			HistoricalSummaries:          make([]*capella.HistoricalSummary, 0),
+		}
+	case spec.DataVersionElectra:
+		header, err := b.denebExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		state.Electra = &electra.BeaconState{
+			GenesisTime:                  b.genesisTime,
+			GenesisValidatorsRoot:        b.genesisValidatorsRoot,
+			Fork:                         b.fork,
+			LatestBlockHeader:            common.latestBlockHeader,
+			BlockRoots:                   common.blockRoots,
+			StateRoots:                   common.stateRoots,
+			HistoricalRoots:              make([]phase0.Root, 0),
+			ETH1Data:                     b.eth1Data,
+			ETH1DataVotes:                make([]*phase0.ETH1Data, 0),
+			ETH1DepositIndex:             b.eth1Data.DepositCount,
+			Validators:                   b.validators,
+			Balances:                     b.balances,
+			RANDAOMixes:                  common.randaoMixes,
+			Slashings:                    common.slashings,
+			PreviousEpochParticipation:   b.participation(),
+			CurrentEpochParticipation:    b.participation(),
+			JustificationBits:            bitfield.NewBitvector4(),
+			PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+			FinalizedCheckpoint:          &phase0.Checkpoint{},
+			InactivityScores:             make([]uint64, len(b.validators)),
+			CurrentSyncCommittee:         syncCommittee,
+			NextSyncCommittee:            syncCommittee,
+			LatestExecutionPayloadHeader: header,
+			HistoricalSummaries:          make([]*capella.HistoricalSummary, 0),
+			DepositRequestsStartIndex:    unsetDepositRequestsStartIndex,
+			PendingDeposits:              make([]*electra.PendingDeposit, 0),
+			PendingPartialWithdrawals:    make([]*electra.PendingPartialWithdrawal, 0),
+			PendingConsolidations:        make([]*electra.PendingConsolidation, 0),
+		}
+	case spec.DataVersionFulu:
+		header, err := b.denebExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		proposerLookahead, err := b.proposerLookahead()
+		if err != nil {
+			return nil, err
+		}
+		state.Fulu = &fulu.BeaconState{
+			GenesisTime:                  b.genesisTime,
+			GenesisValidatorsRoot:        b.genesisValidatorsRoot,
+			Fork:                         b.fork,
+			LatestBlockHeader:            common.latestBlockHeader,
+			BlockRoots:                   common.blockRoots,
+			StateRoots:                   common.stateRoots,
+			HistoricalRoots:              make([]phase0.Root, 0),
+			ETH1Data:                     b.eth1Data,
+			ETH1DataVotes:                make([]*phase0.ETH1Data, 0),
+			ETH1DepositIndex:             b.eth1Data.DepositCount,
+			Validators:                   b.validators,
+			Balances:                     b.balances,
+			RANDAOMixes:                  common.randaoMixes,
+			Slashings:                    common.slashings,
+			PreviousEpochParticipation:   b.participation(),
+			CurrentEpochParticipation:    b.participation(),
+			JustificationBits:            bitfield.NewBitvector4(),
+			PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+			FinalizedCheckpoint:          &phase0.Checkpoint{},
+			InactivityScores:             make([]uint64, len(b.validators)),
+			CurrentSyncCommittee:         syncCommittee,
+			NextSyncCommittee:            syncCommittee,
+			LatestExecutionPayloadHeader: header,
+			HistoricalSummaries:          make([]*capella.HistoricalSummary, 0),
+			DepositRequestsStartIndex:    unsetDepositRequestsStartIndex,
+			PendingDeposits:              make([]*electra.PendingDeposit, 0),
+			PendingPartialWithdrawals:    make([]*electra.PendingPartialWithdrawal, 0),
+			PendingConsolidations:        make([]*electra.PendingConsolidation, 0),
+			ProposerLookahead:            proposerLookahead,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported genesis version %v", b.version)
+	}
+
+	return state, nil
+}
+
+// commonFields creates the fields common to all forks.
+func (b *builder) commonFields() (*commonFields, error) {
+	slotsPerHistoricalRoot, err := b.config.Uint64("SLOTS_PER_HISTORICAL_ROOT")
+	if err != nil {
+		return nil, err
+	}
+	epochsPerHistoricalVector, err := b.config.Uint64("EPOCHS_PER_HISTORICAL_VECTOR")
+	if err != nil {
+		return nil, err
+	}
+	epochsPerSlashingsVector, err := b.config.Uint64("EPOCHS_PER_SLASHINGS_VECTOR")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := emptyBody(b.version)
+	if err != nil {
+		return nil, err
+	}
+	bodyRoot, err := body.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate genesis block body root"), err)
+	}
+
+	randaoMixes := make([]phase0.Root, epochsPerHistoricalVector)
+	for i := range randaoMixes {
+		randaoMixes[i] = phase0.Root(b.eth1BlockHash)
+	}
+
+	return &commonFields{
+		latestBlockHeader: &phase0.BeaconBlockHeader{
+			BodyRoot: bodyRoot,
+		},
+		blockRoots:  make([]phase0.Root, slotsPerHistoricalRoot),
+		stateRoots:  make([]phase0.Root, slotsPerHistoricalRoot),
+		randaoMixes: randaoMixes,
+		slashings:   make([]phase0.Gwei, epochsPerSlashingsVector),
+	}, nil
+}
+
+// participation returns empty participation flags for all validators.
+func (b *builder) participation() []altair.ParticipationFlags {
+	return make([]altair.ParticipationFlags, len(b.validators))
+}
+
+// syncCommittee returns the sync committee for the genesis state, as per
+// get_next_sync_committee in the specification.  At genesis the current and
+// next sync committees are the same.
+func (b *builder) syncCommittee() (*altair.SyncCommittee, error) {
+	if b.params.aggregatePublicKeys == nil {
+		return nil, errors.New("no aggregate public keys function specified")
+	}
+
+	syncCommitteeSize, err := b.config.Uint64("SYNC_COMMITTEE_SIZE")
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := phase0.Epoch(1)
+	indices, err := b.selectByBalance(b.activeValidatorIndices(epoch),
+		b.seed(epoch, signing.DomainSyncCommittee),
+		int(syncCommitteeSize),
+	)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to select sync committee"), err)
+	}
+
+	pubKeys := make([]phase0.BLSPubKey, len(indices))
+	for i, index := range indices {
+		pubKeys[i] = b.validators[index].PublicKey
+	}
+	aggregatePubKey, err := b.params.aggregatePublicKeys(pubKeys)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to aggregate sync committee public keys"), err)
+	}
+
+	return &altair.SyncCommittee{
+		Pubkeys:         pubKeys,
+		AggregatePubkey: aggregatePubKey,
+	}, nil
+}
+
+// proposerLookahead returns the proposers for the first epochs of the chain,
+// as per initialize_proposer_lookahead in the specification.
+func (b *builder) proposerLookahead() ([]phase0.ValidatorIndex, error) {
+	minSeedLookahead, err := b.config.Uint64("MIN_SEED_LOOKAHEAD")
+	if err != nil {
+		return nil, err
+	}
+	slotsPerEpoch, err := b.config.SlotsPerEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	lookahead := make([]phase0.ValidatorIndex, 0, (minSeedLookahead+1)*slotsPerEpoch)
+	buf := make([]byte, 0, 40)
+	for epoch := phase0.Epoch(0); uint64(epoch) <= minSeedLookahead; epoch++ {
+		indices := b.activeValidatorIndices(epoch)
+		epochSeed := b.seed(epoch, signing.DomainBeaconProposer)
+		startSlot := uint64(epoch) * slotsPerEpoch
+		for slot := startSlot; slot < startSlot+slotsPerEpoch; slot++ {
+			buf = append(buf[:0], epochSeed[:]...)
+			buf = binary.LittleEndian.AppendUint64(buf, slot)
+			proposer, err := b.selectByBalance(indices, sha256.Sum256(buf), 1)
+			if err != nil {
+				return nil, errors.Join(fmt.Errorf("failed to select proposer for slot %d", slot), err)
+			}
+			lookahead = append(lookahead, proposer[0])
+		}
+	}
+
+	return lookahead, nil
+}
+
+// bellatrixExecutionPayloadHeader returns the execution payload header for a Bellatrix genesis state.
+func (b *builder) bellatrixExecutionPayloadHeader() (*bellatrix.ExecutionPayloadHeader, error) {
+	header := b.params.executionPayloadHeader
+	if header == nil {
+		return &bellatrix.ExecutionPayloadHeader{
+			ExtraData: make([]byte, 0),
+		}, nil
+	}
+	if header.Version != b.version || header.Bellatrix == nil {
+		return nil, fmt.Errorf("execution payload header is not for %v", b.version)
+	}
+
+	return header.Bellatrix, nil
+}
+
+// capellaExecutionPayloadHeader returns the execution payload header for a Capella genesis state.
+func (b *builder) capellaExecutionPayloadHeader() (*capella.ExecutionPayloadHeader, error) {
+	header := b.params.executionPayloadHeader
+	if header == nil {
+		return &capella.ExecutionPayloadHeader{
+			ExtraData: make([]byte, 0),
+		}, nil
+	}
+	if header.Version != b.version || header.Capella == nil {
+		return nil, fmt.Errorf("execution payload header is not for %v", b.version)
+	}
+
+	return header.Capella, nil
+}
+
+// denebExecutionPayloadHeader returns the execution payload header for a
+// Deneb, Electra or Fulu genesis state.
+func (b *builder) denebExecutionPayloadHeader() (*deneb.ExecutionPayloadHeader, error) {
+	header := b.params.executionPayloadHeader
+	if header == nil {
+		return &deneb.ExecutionPayloadHeader{
+			ExtraData:     make([]byte, 0),
+			BaseFeePerGas: uint256.NewInt(0),
+		}, nil
+	}
+	if header.Version != b.version {
+		return nil, fmt.Errorf("execution payload header is not for %v", b.version)
+	}
+
+	var res *deneb.ExecutionPayloadHeader
+	switch b.version {
+	case spec.DataVersionDeneb:
+		res = header.Deneb
+	case spec.DataVersionElectra:
+		res = header.Electra
+	case spec.DataVersionFulu:
+		res = header.Fulu
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no %v execution payload header", b.version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// Field indices of beacon state fields, unchanged across forks.
+const (
+	StateFieldValidators                   = 11
+	StateFieldBalances                     = 12
+	StateFieldCurrentJustifiedCheckpoint   = 19
+	StateFieldFinalizedCheckpoint          = 20
+	StateFieldLatestExecutionPayloadHeader = 24
+)
+
+// Field indices of beacon block body fields, unchanged across forks.
+const (
+	BodyFieldExecutionPayload   = 9
+	BodyFieldBlobKZGCommitments = 11
+)
+
+// validatorRegistryLimit is the maximum number of validators, given by VALIDATOR_REGISTRY_LIMIT.
+const validatorRegistryLimit = 1099511627776
+
+// stateFields are the number of fields in the beacon state for each version.
+var stateFields = map[spec.DataVersion]uint64{
+	spec.DataVersionPhase0:    21,
+	spec.DataVersionAltair:    24,
+	spec.DataVersionBellatrix: 25,
+	spec.DataVersionCapella:   28,
+	spec.DataVersionDeneb:     28,
+	spec.DataVersionElectra:   37,
+	spec.DataVersionFulu:      38,
+}
+
+// bodyFields are the number of fields in the beacon block body for each version.
+var bodyFields = map[spec.DataVersion]uint64{
+	spec.DataVersionPhase0:    8,
+	spec.DataVersionAltair:    9,
+	spec.DataVersionBellatrix: 10,
+	spec.DataVersionCapella:   11,
+	spec.DataVersionDeneb:     12,
+	spec.DataVersionElectra:   13,
+	spec.DataVersionFulu:      13,
+}
+
+// BeaconStateFieldIndex returns the generalized index of the given field of the beacon state.
+func BeaconStateFieldIndex(version spec.DataVersion, field uint64) (uint64, error) {
+	fields, exists := stateFields[version]
+	if !exists {
+		return 0, errors.New("unsupported version")
+	}
+	if field >= fields {
+		return 0, errors.New("field out of range")
+	}
+
+	return ContainerFieldIndex(fields, field), nil
+}
+
+// BeaconBlockBodyFieldIndex returns the generalized index of the given field of the beacon block body.
+func BeaconBlockBodyFieldIndex(version spec.DataVersion, field uint64) (uint64, error) {
+	fields, exists := bodyFields[version]
+	if !exists {
+		return 0, errors.New("unsupported version")
+	}
+	if field >= fields {
+		return 0, errors.New("field out of range")
+	}
+
+	return ContainerFieldIndex(fields, field), nil
+}
+
+// FinalizedCheckpointIndex returns the generalized index of the finalized checkpoint in the beacon state.
+func FinalizedCheckpointIndex(version spec.DataVersion) (uint64, error) {
+	return BeaconStateFieldIndex(version, StateFieldFinalizedCheckpoint)
+}
+
+// FinalizedRootIndex returns the generalized index of the finalized checkpoint's root in the beacon state.
+func FinalizedRootIndex(version spec.DataVersion) (uint64, error) {
+	index, err := FinalizedCheckpointIndex(version)
+	if err != nil {
+		return 0, err
+	}
+
+	// The root is the second of the checkpoint's two fields.
+	return Concat(index, ContainerFieldIndex(2, 1)), nil
+}
+
+// ValidatorIndex returns the generalized index of the given validator in the beacon state.
+func ValidatorIndex(version spec.DataVersion, validatorIndex uint64) (uint64, error) {
+	index, err := BeaconStateFieldIndex(version, StateFieldValidators)
+	if err != nil {
+		return 0, err
+	}
+
+	return Concat(index, ListElementIndex(validatorRegistryLimit, validatorIndex)), nil
+}
+
+// BalanceIndex returns the generalized index of the chunk holding the given validator's
+// balance in the beacon state.  Each chunk holds four balances, with the balance of
+// the validator at offset 8*(validatorIndex%4) within the chunk.
+func BalanceIndex(version spec.DataVersion, validatorIndex uint64) (uint64, error) {
+	index, err := BeaconStateFieldIndex(version, StateFieldBalances)
+	if err != nil {
+		return 0, err
+	}
+
+	return Concat(index, PackedListChunkIndex(validatorRegistryLimit, 8, validatorIndex)), nil
+}
+
+// LatestExecutionPayloadHeaderIndex returns the generalized index of the latest execution
+// payload header in the beacon state.
+func LatestExecutionPayloadHeaderIndex(version spec.DataVersion) (uint64, error) {
+	if version < spec.DataVersionBellatrix {
+		return 0, errors.New("no execution payload header prior to bellatrix")
+	}
+
+	return BeaconStateFieldIndex(version, StateFieldLatestExecutionPayloadHeader)
+}
+
+// ExecutionPayloadIndex returns the generalized index of the execution payload in the
+// beacon block body.
+func ExecutionPayloadIndex(version spec.DataVersion) (uint64, error) {
+	if version < spec.DataVersionBellatrix {
+		return 0, errors.New("no execution payload prior to bellatrix")
+	}
+
+	return BeaconBlockBodyFieldIndex(version, BodyFieldExecutionPayload)
+}
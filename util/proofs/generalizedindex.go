@@ -0,0 +1,73 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"math/bits"
+)
+
+// nextPowerOfTwo returns the smallest power of two greater than or equal to the value.
+func nextPowerOfTwo(value uint64) uint64 {
+	if value <= 1 {
+		return 1
+	}
+
+	return 1 << bits.Len64(value-1)
+}
+
+// ContainerFieldIndex returns the generalized index of the given field of a
+// container with the given number of fields, relative to the container's root.
+func ContainerFieldIndex(numFields uint64, field uint64) uint64 {
+	return nextPowerOfTwo(numFields) + field
+}
+
+// VectorElementIndex returns the generalized index of the given element of a
+// vector of composite elements, relative to the vector's root.
+func VectorElementIndex(length uint64, element uint64) uint64 {
+	return nextPowerOfTwo(length) + element
+}
+
+// ListElementIndex returns the generalized index of the given element of a
+// list of composite elements with the given limit, relative to the list's root.
+func ListElementIndex(limit uint64, element uint64) uint64 {
+	// The data is the left child of the list's root, the length the right child.
+	return 2*nextPowerOfTwo(limit) + element
+}
+
+// PackedListChunkIndex returns the generalized index of the chunk containing
+// the given element of a list of basic elements of the given size in bytes
+// with the given limit, relative to the list's root.
+func PackedListChunkIndex(limit uint64, elementSize uint64, element uint64) uint64 {
+	chunks := (limit*elementSize + 31) / 32
+
+	return 2*nextPowerOfTwo(chunks) + element*elementSize/32
+}
+
+// ListLengthIndex returns the generalized index of the length of a list,
+// relative to the list's root.
+func ListLengthIndex() uint64 {
+	return 3
+}
+
+// Concat concatenates generalized indices, where each is relative to the node
+// at the previous index, as per concat_generalized_indices.
+func Concat(indices ...uint64) uint64 {
+	res := uint64(1)
+	for _, index := range indices {
+		depth := Depth(index)
+		res = res<<depth | (index ^ 1<<depth)
+	}
+
+	return res
+}
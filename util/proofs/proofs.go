@@ -0,0 +1,175 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proofs generates and verifies Merkle proofs over SSZ containers such as
+// beacon states and beacon block bodies.
+package proofs
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Provable is an SSZ object from which proofs can be generated.
+type Provable interface {
+	GetTree() (*ssz.Node, error)
+}
+
+// Proof is a Merkle proof for a single leaf.
+type Proof struct {
+	// GeneralizedIndex is the generalized index of the leaf.
+	GeneralizedIndex uint64
+	// Leaf is the value of the leaf.
+	Leaf phase0.Root
+	// Branch is the sibling hashes from the leaf up to the root.
+	Branch []phase0.Root
+}
+
+// Verify returns true if the proof is valid for the given root.
+func (p *Proof) Verify(root phase0.Root) bool {
+	return VerifyMerkleBranch(p.Leaf, p.Branch, p.GeneralizedIndex, root)
+}
+
+// MultiProof is a Merkle proof for multiple leaves.
+type MultiProof struct {
+	// GeneralizedIndices are the generalized indices of the leaves.
+	GeneralizedIndices []uint64
+	// Leaves are the values of the leaves.
+	Leaves []phase0.Root
+	// Hashes are the additional hashes required to compute the root.
+	Hashes []phase0.Root
+}
+
+// Verify returns true if the multiproof is valid for the given root.
+func (p *MultiProof) Verify(root phase0.Root) (bool, error) {
+	indices := make([]int, len(p.GeneralizedIndices))
+	for i := range p.GeneralizedIndices {
+		indices[i] = int(p.GeneralizedIndices[i])
+	}
+
+	return ssz.VerifyMultiproof(root[:], rootsToBytes(p.Hashes), rootsToBytes(p.Leaves), indices)
+}
+
+// Prove generates a proof for the given generalized index of the object.
+func Prove(obj Provable, generalizedIndex uint64) (*Proof, error) {
+	if generalizedIndex == 0 {
+		return nil, errors.New("generalized index cannot be 0")
+	}
+
+	tree, err := obj.GetTree()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to generate tree"), err)
+	}
+
+	proof, err := tree.Prove(int(generalizedIndex))
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to generate proof for generalized index %d", generalizedIndex), err)
+	}
+
+	return &Proof{
+		GeneralizedIndex: generalizedIndex,
+		Leaf:             phase0.Root(proof.Leaf),
+		Branch:           bytesToRoots(proof.Hashes),
+	}, nil
+}
+
+// ProveMulti generates a multiproof for the given generalized indices of the object.
+func ProveMulti(obj Provable, generalizedIndices []uint64) (*MultiProof, error) {
+	if len(generalizedIndices) == 0 {
+		return nil, errors.New("no generalized indices supplied")
+	}
+
+	tree, err := obj.GetTree()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to generate tree"), err)
+	}
+
+	indices := make([]int, len(generalizedIndices))
+	for i := range generalizedIndices {
+		if generalizedIndices[i] == 0 {
+			return nil, errors.New("generalized index cannot be 0")
+		}
+		indices[i] = int(generalizedIndices[i])
+	}
+
+	proof, err := tree.ProveMulti(indices)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to generate multiproof"), err)
+	}
+
+	// Leaves that are intermediate nodes have no value, so use their hash.
+	for i := range indices {
+		node, err := tree.Get(indices[i])
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to obtain node for generalized index %d", indices[i]), err)
+		}
+		proof.Leaves[i] = node.Hash()
+	}
+
+	return &MultiProof{
+		GeneralizedIndices: generalizedIndices,
+		Leaves:             bytesToRoots(proof.Leaves),
+		Hashes:             bytesToRoots(proof.Hashes),
+	}, nil
+}
+
+// VerifyMerkleBranch returns true if the branch proves the leaf at the given
+// generalized index against the root, as per is_valid_merkle_branch.
+func VerifyMerkleBranch(leaf phase0.Root, branch []phase0.Root, generalizedIndex uint64, root phase0.Root) bool {
+	if generalizedIndex == 0 || len(branch) != Depth(generalizedIndex) {
+		return false
+	}
+
+	node := leaf
+	var buf [64]byte
+	for i := range branch {
+		if (generalizedIndex>>i)&1 == 1 {
+			copy(buf[:32], branch[i][:])
+			copy(buf[32:], node[:])
+		} else {
+			copy(buf[:32], node[:])
+			copy(buf[32:], branch[i][:])
+		}
+		node = sha256.Sum256(buf[:])
+	}
+
+	return node == root
+}
+
+// Depth returns the depth of the generalized index in its tree.
+func Depth(generalizedIndex uint64) int {
+	return bits.Len64(generalizedIndex) - 1
+}
+
+func bytesToRoots(data [][]byte) []phase0.Root {
+	roots := make([]phase0.Root, len(data))
+	for i := range data {
+		copy(roots[i][:], data[i])
+	}
+
+	return roots
+}
+
+func rootsToBytes(roots []phase0.Root) [][]byte {
+	data := make([][]byte, len(roots))
+	for i := range roots {
+		data[i] = roots[i][:]
+	}
+
+	return data
+}
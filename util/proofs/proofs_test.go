@@ -0,0 +1,192 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/proofs"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testState() *phase0.BeaconState {
+	state := &phase0.BeaconState{
+		Slot:              100,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		ETH1Data: &phase0.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{Epoch: 2, Root: phase0.Root{0x02}},
+		FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x01}},
+	}
+	for i := 0; i < 6; i++ {
+		state.Validators = append(state.Validators, &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ExitEpoch:             0xffffffffffffffff,
+		})
+		state.Balances = append(state.Balances, phase0.Gwei(32000000000+i))
+	}
+
+	return state
+}
+
+func TestGeneralizedIndices(t *testing.T) {
+	index, err := proofs.FinalizedRootIndex(spec.DataVersionDeneb)
+	require.NoError(t, err)
+	require.Equal(t, uint64(105), index)
+
+	index, err = proofs.FinalizedRootIndex(spec.DataVersionElectra)
+	require.NoError(t, err)
+	require.Equal(t, uint64(169), index)
+
+	index, err = proofs.ExecutionPayloadIndex(spec.DataVersionDeneb)
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), index)
+
+	index, err = proofs.BeaconBlockBodyFieldIndex(spec.DataVersionElectra, proofs.BodyFieldBlobKZGCommitments)
+	require.NoError(t, err)
+	require.Equal(t, uint64(27), index)
+
+	_, err = proofs.ExecutionPayloadIndex(spec.DataVersionAltair)
+	require.EqualError(t, err, "no execution payload prior to bellatrix")
+	_, err = proofs.BeaconStateFieldIndex(spec.DataVersionPhase0, 21)
+	require.EqualError(t, err, "field out of range")
+	_, err = proofs.BeaconStateFieldIndex(spec.DataVersionUnknown, 0)
+	require.EqualError(t, err, "unsupported version")
+
+	require.Equal(t, uint64(1), proofs.Concat())
+	require.Equal(t, uint64(105), proofs.Concat(52, 3))
+	require.Equal(t, uint64(27*2*4096+5), proofs.Concat(27, proofs.ListElementIndex(4096, 5)))
+	require.Equal(t, 17, proofs.Depth(27*2*4096+5))
+}
+
+func TestStateProofs(t *testing.T) {
+	state := testState()
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	t.Run("FinalizedRoot", func(t *testing.T) {
+		index, err := proofs.FinalizedRootIndex(spec.DataVersionPhase0)
+		require.NoError(t, err)
+		proof, err := proofs.Prove(state, index)
+		require.NoError(t, err)
+		require.Equal(t, state.FinalizedCheckpoint.Root, proof.Leaf)
+		require.True(t, proof.Verify(root))
+
+		proof.Branch[0][0] ^= 0x01
+		require.False(t, proof.Verify(root))
+	})
+
+	t.Run("FinalizedCheckpoint", func(t *testing.T) {
+		index, err := proofs.FinalizedCheckpointIndex(spec.DataVersionPhase0)
+		require.NoError(t, err)
+		proof, err := proofs.Prove(state, index)
+		require.NoError(t, err)
+		expected, err := state.FinalizedCheckpoint.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, phase0.Root(expected), proof.Leaf)
+		require.True(t, proof.Verify(root))
+	})
+
+	t.Run("Validator", func(t *testing.T) {
+		index, err := proofs.ValidatorIndex(spec.DataVersionPhase0, 3)
+		require.NoError(t, err)
+		proof, err := proofs.Prove(state, index)
+		require.NoError(t, err)
+		expected, err := state.Validators[3].HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, phase0.Root(expected), proof.Leaf)
+		require.True(t, proof.Verify(root))
+		require.False(t, proofs.VerifyMerkleBranch(proof.Leaf, proof.Branch, index+1, root))
+	})
+
+	t.Run("Balance", func(t *testing.T) {
+		index, err := proofs.BalanceIndex(spec.DataVersionPhase0, 5)
+		require.NoError(t, err)
+		proof, err := proofs.Prove(state, index)
+		require.NoError(t, err)
+		require.Equal(t, uint64(state.Balances[5]), binary.LittleEndian.Uint64(proof.Leaf[8:16]))
+		require.True(t, proof.Verify(root))
+	})
+
+	t.Run("Multi", func(t *testing.T) {
+		finalizedRootIndex, err := proofs.FinalizedRootIndex(spec.DataVersionPhase0)
+		require.NoError(t, err)
+		validatorIndex, err := proofs.ValidatorIndex(spec.DataVersionPhase0, 1)
+		require.NoError(t, err)
+		justifiedIndex, err := proofs.BeaconStateFieldIndex(spec.DataVersionPhase0, proofs.StateFieldCurrentJustifiedCheckpoint)
+		require.NoError(t, err)
+
+		proof, err := proofs.ProveMulti(state, []uint64{finalizedRootIndex, validatorIndex, justifiedIndex})
+		require.NoError(t, err)
+		require.Len(t, proof.Leaves, 3)
+		require.Equal(t, state.FinalizedCheckpoint.Root, proof.Leaves[0])
+		valid, err := proof.Verify(root)
+		require.NoError(t, err)
+		require.True(t, valid)
+
+		valid, err = proof.Verify(phase0.Root{0x01})
+		require.NoError(t, err)
+		require.False(t, valid)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := proofs.Prove(state, 0)
+		require.EqualError(t, err, "generalized index cannot be 0")
+		_, err = proofs.ProveMulti(state, nil)
+		require.EqualError(t, err, "no generalized indices supplied")
+	})
+}
+
+func TestBodyProofs(t *testing.T) {
+	body := &deneb.BeaconBlockBody{
+		ETH1Data: &phase0.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		SyncAggregate: &altair.SyncAggregate{
+			SyncCommitteeBits: bitfield.NewBitvector512(),
+		},
+		ExecutionPayload: &deneb.ExecutionPayload{
+			BlockNumber:   12,
+			BaseFeePerGas: uint256.NewInt(7),
+		},
+	}
+	root, err := body.HashTreeRoot()
+	require.NoError(t, err)
+
+	index, err := proofs.ExecutionPayloadIndex(spec.DataVersionDeneb)
+	require.NoError(t, err)
+	proof, err := proofs.Prove(body, index)
+	require.NoError(t, err)
+	expected, err := body.ExecutionPayload.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), proof.Leaf)
+	require.True(t, proof.Verify(root))
+}
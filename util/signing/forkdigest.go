@@ -0,0 +1,435 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// farFutureEpoch is the epoch used for forks that are not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// forkOrder is the order in which the known forks take place, used to order
+// forks that are scheduled for the same epoch.
+var forkOrder = map[string]int{
+	"GENESIS":   0,
+	"ALTAIR":    1,
+	"BELLATRIX": 2,
+	"CAPELLA":   3,
+	"DENEB":     4,
+	"ELECTRA":   5,
+	"FULU":      6,
+}
+
+// ENRForkID is the fork information advertised in the eth2 field of a node record.
+type ENRForkID struct {
+	ForkDigest      phase0.ForkDigest
+	NextForkVersion phase0.Version
+	NextForkEpoch   phase0.Epoch
+}
+
+// ComputeForkDataRoot computes the fork data root for the given fork version and
+// genesis validators root, as defined by compute_fork_data_root in the specification.
+func ComputeForkDataRoot(currentVersion phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.Root, error) {
+	forkData := &phase0.ForkData{
+		CurrentVersion:        currentVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate fork data root"), err)
+	}
+
+	return root, nil
+}
+
+// ComputeForkDigest computes the fork digest for the given fork version and genesis
+// validators root, as defined by compute_fork_digest in the specification prior
+// to Fulu.
+func ComputeForkDigest(currentVersion phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.ForkDigest, error) {
+	root, err := ComputeForkDataRoot(currentVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+
+	var digest phase0.ForkDigest
+	copy(digest[:], root[:])
+
+	return digest, nil
+}
+
+// ComputeBlobParametersForkDigest computes the fork digest for the given fork version
+// and genesis validators root, modified by the blob parameters in force, as defined
+// by compute_fork_digest in the specification from Fulu onwards.
+func ComputeBlobParametersForkDigest(currentVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+	blobParametersEpoch phase0.Epoch,
+	maxBlobsPerBlock uint64,
+) (
+	phase0.ForkDigest,
+	error,
+) {
+	root, err := ComputeForkDataRoot(currentVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+
+	blobParameters := make([]byte, 16)
+	binary.LittleEndian.PutUint64(blobParameters[0:8], uint64(blobParametersEpoch))
+	binary.LittleEndian.PutUint64(blobParameters[8:16], maxBlobsPerBlock)
+	blobParametersHash := sha256.Sum256(blobParameters)
+
+	var digest phase0.ForkDigest
+	for i := range digest {
+		digest[i] = root[i] ^ blobParametersHash[i]
+	}
+
+	return digest, nil
+}
+
+// specFork is a fork obtained from the specification.
+type specFork struct {
+	name    string
+	version phase0.Version
+	epoch   phase0.Epoch
+}
+
+// ForkDigestAtEpoch computes the fork digest at the given epoch from the fork
+// versions and epochs in the supplied specification, as returned by Spec().
+// From Fulu onwards the digest is modified by the blob parameters in force at
+// the epoch, as given by BLOB_SCHEDULE.
+func ForkDigestAtEpoch(specData map[string]any,
+	genesisValidatorsRoot phase0.Root,
+	epoch phase0.Epoch,
+) (
+	phase0.ForkDigest,
+	error,
+) {
+	forks, err := specForks(specData)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+
+	return forkDigest(specData, forkAtEpoch(forks, epoch), epoch, genesisValidatorsRoot)
+}
+
+// ForkID computes the ENR fork ID at the given epoch from the fork versions and
+// epochs in the supplied specification, as returned by Spec().  Changes to the
+// blob parameters from Fulu onwards are considered to be forks; as they do not
+// change the fork version the next fork version for them is the current version.
+func ForkID(specData map[string]any,
+	genesisValidatorsRoot phase0.Root,
+	epoch phase0.Epoch,
+) (
+	*ENRForkID,
+	error,
+) {
+	forks, err := specForks(specData)
+	if err != nil {
+		return nil, err
+	}
+
+	current := forkAtEpoch(forks, epoch)
+	digest, err := forkDigest(specData, current, epoch, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	forkID := &ENRForkID{
+		ForkDigest:      digest,
+		NextForkVersion: current.version,
+		NextForkEpoch:   farFutureEpoch,
+	}
+
+	nextEpoch, exists, err := nextForkEpoch(specData, forks, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		forkID.NextForkVersion = forkAtEpoch(forks, nextEpoch).version
+		forkID.NextForkEpoch = nextEpoch
+	}
+
+	return forkID, nil
+}
+
+// NextForkDigest computes the digest of the first fork scheduled after the given
+// epoch from the supplied specification, as returned by Spec(), along with the
+// epoch of that fork.  Changes to the blob parameters from Fulu onwards are
+// considered to be forks.  It returns an error if no fork is scheduled.
+func NextForkDigest(specData map[string]any,
+	genesisValidatorsRoot phase0.Root,
+	epoch phase0.Epoch,
+) (
+	phase0.ForkDigest,
+	phase0.Epoch,
+	error,
+) {
+	forks, err := specForks(specData)
+	if err != nil {
+		return phase0.ForkDigest{}, 0, err
+	}
+
+	nextEpoch, exists, err := nextForkEpoch(specData, forks, epoch)
+	if err != nil {
+		return phase0.ForkDigest{}, 0, err
+	}
+	if !exists {
+		return phase0.ForkDigest{}, 0, errors.New("no fork scheduled")
+	}
+
+	digest, err := forkDigest(specData, forkAtEpoch(forks, nextEpoch), nextEpoch, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.ForkDigest{}, 0, err
+	}
+
+	return digest, nextEpoch, nil
+}
+
+// forkAtEpoch returns the fork in force at the given epoch.
+func forkAtEpoch(forks []*specFork, epoch phase0.Epoch) *specFork {
+	current := forks[0]
+	for _, fork := range forks {
+		if fork.epoch > epoch {
+			break
+		}
+		current = fork
+	}
+
+	return current
+}
+
+// nextForkEpoch returns the first epoch after the given epoch at which either a
+// fork takes place or, from Fulu onwards, the blob parameters change.
+func nextForkEpoch(specData map[string]any,
+	forks []*specFork,
+	epoch phase0.Epoch,
+) (
+	phase0.Epoch,
+	bool,
+	error,
+) {
+	next := farFutureEpoch
+	for _, fork := range forks {
+		if fork.epoch > epoch {
+			next = fork.epoch
+
+			break
+		}
+	}
+
+	fuluEpoch, fuluScheduled := scheduledFuluEpoch(specData)
+	if fuluScheduled {
+		schedule, err := blobSchedule(specData)
+		if err != nil {
+			return 0, false, err
+		}
+		for _, entry := range schedule {
+			if entry.epoch > epoch && entry.epoch >= fuluEpoch && entry.epoch < next {
+				next = entry.epoch
+			}
+		}
+	}
+
+	return next, next != farFutureEpoch, nil
+}
+
+// specForks returns the scheduled forks in the specification, in epoch order.
+func specForks(specData map[string]any) ([]*specFork, error) {
+	genesisVersion, isVersion := specData["GENESIS_FORK_VERSION"].(phase0.Version)
+	if !isVersion {
+		return nil, errors.New("genesis fork version not found in specification")
+	}
+
+	forks := []*specFork{{name: "GENESIS", version: genesisVersion}}
+	for key, value := range specData {
+		if !strings.HasSuffix(key, "_FORK_VERSION") || key == "GENESIS_FORK_VERSION" {
+			continue
+		}
+		version, isVersion := value.(phase0.Version)
+		if !isVersion {
+			continue
+		}
+		name := strings.TrimSuffix(key, "_FORK_VERSION")
+		epoch, isEpoch := specData[name+"_FORK_EPOCH"].(uint64)
+		if !isEpoch {
+			return nil, fmt.Errorf("fork epoch for %s not found in specification", name)
+		}
+		if phase0.Epoch(epoch) == farFutureEpoch {
+			// Fork not scheduled.
+			continue
+		}
+		forks = append(forks, &specFork{name: name, version: version, epoch: phase0.Epoch(epoch)})
+	}
+
+	sort.Slice(forks, func(i, j int) bool {
+		if forks[i].epoch != forks[j].epoch {
+			return forks[i].epoch < forks[j].epoch
+		}
+
+		return forkBefore(forks[i], forks[j])
+	})
+
+	return forks, nil
+}
+
+// forkBefore returns true if fork a takes place before fork b when both are
+// scheduled for the same epoch.  Unknown forks follow the known forks, and are
+// ordered by their fork versions.
+func forkBefore(a *specFork, b *specFork) bool {
+	orderA, knownA := forkOrder[a.name]
+	if !knownA {
+		orderA = len(forkOrder)
+	}
+	orderB, knownB := forkOrder[b.name]
+	if !knownB {
+		orderB = len(forkOrder)
+	}
+	if orderA != orderB {
+		return orderA < orderB
+	}
+	if cmp := bytes.Compare(a.version[:], b.version[:]); cmp != 0 {
+		return cmp < 0
+	}
+
+	return a.name < b.name
+}
+
+// forkDigest computes the digest for the given fork at the given epoch, as
+// defined by compute_fork_digest in the specification.
+func forkDigest(specData map[string]any,
+	fork *specFork,
+	epoch phase0.Epoch,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.ForkDigest,
+	error,
+) {
+	fuluEpoch, fuluScheduled := scheduledFuluEpoch(specData)
+	if !fuluScheduled || epoch < fuluEpoch {
+		return ComputeForkDigest(fork.version, genesisValidatorsRoot)
+	}
+
+	parameters, err := blobParameters(specData, epoch)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+
+	return ComputeBlobParametersForkDigest(fork.version, genesisValidatorsRoot, parameters.epoch, parameters.maxBlobsPerBlock)
+}
+
+// scheduledFuluEpoch returns the Fulu fork epoch, and true if Fulu is scheduled.
+func scheduledFuluEpoch(specData map[string]any) (phase0.Epoch, bool) {
+	fuluEpoch, exists := specData["FULU_FORK_EPOCH"].(uint64)
+	if !exists || phase0.Epoch(fuluEpoch) == farFutureEpoch {
+		return 0, false
+	}
+
+	return phase0.Epoch(fuluEpoch), true
+}
+
+// blobParametersEntry is an entry in the blob schedule.
+type blobParametersEntry struct {
+	epoch            phase0.Epoch
+	maxBlobsPerBlock uint64
+}
+
+// blobParameters returns the blob parameters in force at the given epoch, as
+// defined by get_blob_parameters in the specification.
+func blobParameters(specData map[string]any, epoch phase0.Epoch) (*blobParametersEntry, error) {
+	schedule, err := blobSchedule(specData)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(schedule) - 1; i >= 0; i-- {
+		if epoch >= schedule[i].epoch {
+			return schedule[i], nil
+		}
+	}
+
+	electraEpoch, epochExists := specData["ELECTRA_FORK_EPOCH"].(uint64)
+	maxBlobs, maxBlobsExists := specData["MAX_BLOBS_PER_BLOCK_ELECTRA"].(uint64)
+	if !epochExists || !maxBlobsExists {
+		return nil, errors.New("electra blob parameters not found in specification")
+	}
+
+	return &blobParametersEntry{epoch: phase0.Epoch(electraEpoch), maxBlobsPerBlock: maxBlobs}, nil
+}
+
+// blobSchedule returns the blob schedule in the specification, in epoch order.
+// The schedule is optional, so an empty schedule is returned if it is absent.
+func blobSchedule(specData map[string]any) ([]*blobParametersEntry, error) {
+	var entries []map[string]any
+	switch schedule := specData["BLOB_SCHEDULE"].(type) {
+	case nil:
+		return []*blobParametersEntry{}, nil
+	case []map[string]any:
+		entries = schedule
+	case []any:
+		entries = make([]map[string]any, 0, len(schedule))
+		for _, entry := range schedule {
+			value, isMap := entry.(map[string]any)
+			if !isMap {
+				return nil, errors.New("invalid blob schedule entry in specification")
+			}
+			entries = append(entries, value)
+		}
+	default:
+		return nil, errors.New("invalid blob schedule in specification")
+	}
+
+	schedule := make([]*blobParametersEntry, 0, len(entries))
+	for _, entry := range entries {
+		epoch, err := blobScheduleValue(entry, "EPOCH")
+		if err != nil {
+			return nil, err
+		}
+		maxBlobs, err := blobScheduleValue(entry, "MAX_BLOBS_PER_BLOCK")
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, &blobParametersEntry{epoch: phase0.Epoch(epoch), maxBlobsPerBlock: maxBlobs})
+	}
+	sort.Slice(schedule, func(i, j int) bool {
+		return schedule[i].epoch < schedule[j].epoch
+	})
+
+	return schedule, nil
+}
+
+// blobScheduleValue returns the named value from a blob schedule entry.
+func blobScheduleValue(entry map[string]any, name string) (uint64, error) {
+	switch value := entry[name].(type) {
+	case uint64:
+		return value, nil
+	case string:
+		res, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, errors.Join(fmt.Errorf("invalid %s in blob schedule", name), err)
+		}
+
+		return res, nil
+	default:
+		return 0, fmt.Errorf("%s not found in blob schedule entry", name)
+	}
+}
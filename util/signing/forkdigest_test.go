@@ -0,0 +1,231 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeForkDigest(t *testing.T) {
+	genesisValidatorsRoot := phase0.Root{0x01, 0x02}
+	version := phase0.Version{0x04, 0x00, 0x00, 0x00}
+	forkDataRoot := hashPair(version[:], genesisValidatorsRoot[:])
+
+	root, err := signing.ComputeForkDataRoot(version, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(forkDataRoot), root)
+
+	digest, err := signing.ComputeForkDigest(version, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ForkDigest{forkDataRoot[0], forkDataRoot[1], forkDataRoot[2], forkDataRoot[3]}, digest)
+
+	blobParameters := make([]byte, 16)
+	binary.LittleEndian.PutUint64(blobParameters[0:8], 5)
+	binary.LittleEndian.PutUint64(blobParameters[8:16], 9)
+	blobParametersHash := sha256.Sum256(blobParameters)
+	digest, err = signing.ComputeBlobParametersForkDigest(version, genesisValidatorsRoot, 5, 9)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ForkDigest{
+		forkDataRoot[0] ^ blobParametersHash[0],
+		forkDataRoot[1] ^ blobParametersHash[1],
+		forkDataRoot[2] ^ blobParametersHash[2],
+		forkDataRoot[3] ^ blobParametersHash[3],
+	}, digest)
+}
+
+func TestForkDigestsFromSpec(t *testing.T) {
+	genesisValidatorsRoot := phase0.Root{0x01}
+	genesisVersion := phase0.Version{0x00, 0x00, 0x00, 0x00}
+	altairVersion := phase0.Version{0x01, 0x00, 0x00, 0x00}
+	electraVersion := phase0.Version{0x05, 0x00, 0x00, 0x00}
+	fuluVersion := phase0.Version{0x06, 0x00, 0x00, 0x00}
+	specData := map[string]any{
+		"GENESIS_FORK_VERSION":        genesisVersion,
+		"ALTAIR_FORK_VERSION":         altairVersion,
+		"ALTAIR_FORK_EPOCH":           uint64(10),
+		"ELECTRA_FORK_VERSION":        electraVersion,
+		"ELECTRA_FORK_EPOCH":          uint64(20),
+		"FULU_FORK_VERSION":           fuluVersion,
+		"FULU_FORK_EPOCH":             uint64(30),
+		"GLOAS_FORK_VERSION":          phase0.Version{0x07, 0x00, 0x00, 0x00},
+		"GLOAS_FORK_EPOCH":            uint64(0xffffffffffffffff),
+		"MAX_BLOBS_PER_BLOCK_ELECTRA": uint64(9),
+	}
+
+	genesisDigest, err := signing.ComputeForkDigest(genesisVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	electraDigest, err := signing.ComputeForkDigest(electraVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	fuluDigest, err := signing.ComputeBlobParametersForkDigest(fuluVersion, genesisValidatorsRoot, 20, 9)
+	require.NoError(t, err)
+
+	digest, err := signing.ForkDigestAtEpoch(specData, genesisValidatorsRoot, 5)
+	require.NoError(t, err)
+	require.Equal(t, genesisDigest, digest)
+
+	digest, err = signing.ForkDigestAtEpoch(specData, genesisValidatorsRoot, 35)
+	require.NoError(t, err)
+	require.Equal(t, fuluDigest, digest)
+
+	forkID, err := signing.ForkID(specData, genesisValidatorsRoot, 25)
+	require.NoError(t, err)
+	require.Equal(t, &signing.ENRForkID{
+		ForkDigest:      electraDigest,
+		NextForkVersion: fuluVersion,
+		NextForkEpoch:   30,
+	}, forkID)
+
+	forkID, err = signing.ForkID(specData, genesisValidatorsRoot, 30)
+	require.NoError(t, err)
+	require.Equal(t, &signing.ENRForkID{
+		ForkDigest:      fuluDigest,
+		NextForkVersion: fuluVersion,
+		NextForkEpoch:   0xffffffffffffffff,
+	}, forkID)
+
+	digest, epoch, err := signing.NextForkDigest(specData, genesisValidatorsRoot, 25)
+	require.NoError(t, err)
+	require.Equal(t, fuluDigest, digest)
+	require.Equal(t, phase0.Epoch(30), epoch)
+
+	_, _, err = signing.NextForkDigest(specData, genesisValidatorsRoot, 30)
+	require.EqualError(t, err, "no fork scheduled")
+
+	_, err = signing.ForkDigestAtEpoch(map[string]any{}, genesisValidatorsRoot, 0)
+	require.EqualError(t, err, "genesis fork version not found in specification")
+}
+
+func TestForkDigestsFromSpecSameEpoch(t *testing.T) {
+	genesisValidatorsRoot := phase0.Root{0x01}
+	denebVersion := phase0.Version{0x04, 0x00, 0x00, 0x00}
+	electraVersion := phase0.Version{0x05, 0x00, 0x00, 0x00}
+	specData := map[string]any{
+		"GENESIS_FORK_VERSION":   phase0.Version{0x00, 0x00, 0x00, 0x00},
+		"ALTAIR_FORK_VERSION":    phase0.Version{0x01, 0x00, 0x00, 0x00},
+		"ALTAIR_FORK_EPOCH":      uint64(0),
+		"BELLATRIX_FORK_VERSION": phase0.Version{0x02, 0x00, 0x00, 0x00},
+		"BELLATRIX_FORK_EPOCH":   uint64(0),
+		"CAPELLA_FORK_VERSION":   phase0.Version{0x03, 0x00, 0x00, 0x00},
+		"CAPELLA_FORK_EPOCH":     uint64(0),
+		"DENEB_FORK_VERSION":     denebVersion,
+		"DENEB_FORK_EPOCH":       uint64(0),
+		"ELECTRA_FORK_VERSION":   electraVersion,
+		"ELECTRA_FORK_EPOCH":     uint64(5),
+	}
+
+	denebDigest, err := signing.ComputeForkDigest(denebVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+
+	// Map iteration order is random, so repeat to catch nondeterministic ordering.
+	for i := 0; i < 32; i++ {
+		digest, err := signing.ForkDigestAtEpoch(specData, genesisValidatorsRoot, 0)
+		require.NoError(t, err)
+		require.Equal(t, denebDigest, digest)
+
+		forkID, err := signing.ForkID(specData, genesisValidatorsRoot, 0)
+		require.NoError(t, err)
+		require.Equal(t, &signing.ENRForkID{
+			ForkDigest:      denebDigest,
+			NextForkVersion: electraVersion,
+			NextForkEpoch:   5,
+		}, forkID)
+	}
+}
+
+func TestForkDigestsFromSpecBlobSchedule(t *testing.T) {
+	// Expected digests were obtained by running the compute_fork_digest
+	// pseudocode from the Fulu specification with the same configuration.
+	fuluVersion := phase0.Version{0x06, 0x00, 0x00, 0x00}
+	specData := map[string]any{
+		"GENESIS_FORK_VERSION":        phase0.Version{0x00, 0x00, 0x00, 0x00},
+		"ELECTRA_FORK_VERSION":        phase0.Version{0x05, 0x00, 0x00, 0x00},
+		"ELECTRA_FORK_EPOCH":          uint64(9),
+		"FULU_FORK_VERSION":           fuluVersion,
+		"FULU_FORK_EPOCH":             uint64(100),
+		"MAX_BLOBS_PER_BLOCK_ELECTRA": uint64(9),
+		"BLOB_SCHEDULE": []map[string]any{
+			{"EPOCH": uint64(9), "MAX_BLOBS_PER_BLOCK": uint64(9)},
+			{"EPOCH": uint64(100), "MAX_BLOBS_PER_BLOCK": uint64(100)},
+			{"EPOCH": uint64(150), "MAX_BLOBS_PER_BLOCK": uint64(175)},
+			{"EPOCH": uint64(200), "MAX_BLOBS_PER_BLOCK": uint64(200)},
+			{"EPOCH": uint64(250), "MAX_BLOBS_PER_BLOCK": uint64(275)},
+			{"EPOCH": uint64(300), "MAX_BLOBS_PER_BLOCK": uint64(300)},
+		},
+	}
+
+	tests := []struct {
+		genesisValidatorsRoot phase0.Root
+		epoch                 phase0.Epoch
+		expected              phase0.ForkDigest
+	}{
+		{phase0.Root{}, 0, phase0.ForkDigest{0xf5, 0xa5, 0xfd, 0x42}},
+		{phase0.Root{}, 9, phase0.ForkDigest{0xc8, 0xb9, 0xe6, 0xac}},
+		{phase0.Root{}, 99, phase0.ForkDigest{0xc8, 0xb9, 0xe6, 0xac}},
+		{phase0.Root{}, 100, phase0.ForkDigest{0xdf, 0x67, 0x55, 0x7b}},
+		{phase0.Root{}, 149, phase0.ForkDigest{0xdf, 0x67, 0x55, 0x7b}},
+		{phase0.Root{}, 150, phase0.ForkDigest{0x8a, 0xb3, 0x8b, 0x59}},
+		{phase0.Root{}, 200, phase0.ForkDigest{0xd9, 0xb8, 0x14, 0x38}},
+		{phase0.Root{}, 299, phase0.ForkDigest{0x4e, 0xf3, 0x2a, 0x62}},
+		{phase0.Root{}, 1000, phase0.ForkDigest{0xca, 0x10, 0x0d, 0x64}},
+		{phase0.Root{0x01}, 0, phase0.ForkDigest{0xcb, 0x59, 0x28, 0x44}},
+		{phase0.Root{0x01}, 100, phase0.ForkDigest{0xe2, 0xd3, 0x4f, 0x47}},
+		{phase0.Root{0x01}, 250, phase0.ForkDigest{0x73, 0x47, 0x30, 0x5e}},
+		{phase0.Root{0x01}, 300, phase0.ForkDigest{0xf7, 0xa4, 0x17, 0x58}},
+	}
+	for _, test := range tests {
+		digest, err := signing.ForkDigestAtEpoch(specData, test.genesisValidatorsRoot, test.epoch)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, digest, "epoch %d", test.epoch)
+	}
+
+	// Changes to the blob parameters are reported as the next fork.
+	digest, epoch, err := signing.NextForkDigest(specData, phase0.Root{}, 120)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(150), epoch)
+	require.Equal(t, phase0.ForkDigest{0x8a, 0xb3, 0x8b, 0x59}, digest)
+
+	_, _, err = signing.NextForkDigest(specData, phase0.Root{}, 300)
+	require.EqualError(t, err, "no fork scheduled")
+
+	forkID, err := signing.ForkID(specData, phase0.Root{}, 50)
+	require.NoError(t, err)
+	require.Equal(t, &signing.ENRForkID{
+		ForkDigest:      phase0.ForkDigest{0xc8, 0xb9, 0xe6, 0xac},
+		NextForkVersion: fuluVersion,
+		NextForkEpoch:   100,
+	}, forkID)
+
+	forkID, err = signing.ForkID(specData, phase0.Root{}, 120)
+	require.NoError(t, err)
+	require.Equal(t, &signing.ENRForkID{
+		ForkDigest:      phase0.ForkDigest{0xdf, 0x67, 0x55, 0x7b},
+		NextForkVersion: fuluVersion,
+		NextForkEpoch:   150,
+	}, forkID)
+
+	// Schedule values supplied as strings are accepted.
+	specData["BLOB_SCHEDULE"] = []any{
+		map[string]any{"EPOCH": "100", "MAX_BLOBS_PER_BLOCK": "100"},
+		map[string]any{"EPOCH": "150", "MAX_BLOBS_PER_BLOCK": "175"},
+	}
+	digest, err = signing.ForkDigestAtEpoch(specData, phase0.Root{}, 160)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ForkDigest{0x8a, 0xb3, 0x8b, 0x59}, digest)
+}
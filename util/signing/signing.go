@@ -0,0 +1,170 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing provides functions to compute domains and signing roots.
+package signing
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Domain types defined by the specification.
+var (
+	DomainBeaconProposer              = phase0.DomainType{0x00, 0x00, 0x00, 0x00}
+	DomainBeaconAttester              = phase0.DomainType{0x01, 0x00, 0x00, 0x00}
+	DomainRandao                      = phase0.DomainType{0x02, 0x00, 0x00, 0x00}
+	DomainDeposit                     = phase0.DomainType{0x03, 0x00, 0x00, 0x00}
+	DomainVoluntaryExit               = phase0.DomainType{0x04, 0x00, 0x00, 0x00}
+	DomainSelectionProof              = phase0.DomainType{0x05, 0x00, 0x00, 0x00}
+	DomainAggregateAndProof           = phase0.DomainType{0x06, 0x00, 0x00, 0x00}
+	DomainSyncCommittee               = phase0.DomainType{0x07, 0x00, 0x00, 0x00}
+	DomainSyncCommitteeSelectionProof = phase0.DomainType{0x08, 0x00, 0x00, 0x00}
+	DomainContributionAndProof        = phase0.DomainType{0x09, 0x00, 0x00, 0x00}
+	DomainBLSToExecutionChange        = phase0.DomainType{0x0a, 0x00, 0x00, 0x00}
+	DomainApplicationBuilder          = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+)
+
+// ComputeDomain computes the domain for the given domain type, fork version
+// and genesis validators root, as defined by compute_domain in the specification.
+// Domains that are valid across forks, such as deposit and builder domains, use
+// the genesis fork version and a zero genesis validators root.
+func ComputeDomain(domainType phase0.DomainType,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Domain,
+	error,
+) {
+	root, err := ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Domain{}, err
+	}
+
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], root[:])
+
+	return domain, nil
+}
+
+// ComputeDomainAtEpoch computes the domain for the given domain type at the
+// given epoch, selecting the fork version from the supplied fork.
+func ComputeDomainAtEpoch(domainType phase0.DomainType,
+	fork *phase0.Fork,
+	epoch phase0.Epoch,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Domain,
+	error,
+) {
+	if fork == nil {
+		return phase0.Domain{}, errors.New("no fork supplied")
+	}
+
+	forkVersion := fork.CurrentVersion
+	if epoch < fork.Epoch {
+		forkVersion = fork.PreviousVersion
+	}
+
+	return ComputeDomain(domainType, forkVersion, genesisValidatorsRoot)
+}
+
+// ComputeSigningRoot computes the root to sign for the given object and domain,
+// as defined by compute_signing_root in the specification.
+func ComputeSigningRoot(object ssz.HashRoot, domain phase0.Domain) (phase0.Root, error) {
+	if object == nil {
+		return phase0.Root{}, errors.New("no object supplied")
+	}
+	objectRoot, err := object.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate object root"), err)
+	}
+
+	return signingRoot(objectRoot, domain)
+}
+
+// BlockSigningRoot computes the root to sign for the given block.
+func BlockSigningRoot(block *spec.VersionedSignedBeaconBlock,
+	fork *phase0.Fork,
+	genesisValidatorsRoot phase0.Root,
+	slotsPerEpoch uint64,
+) (
+	phase0.Root,
+	error,
+) {
+	if block == nil {
+		return phase0.Root{}, errors.New("no block supplied")
+	}
+	if slotsPerEpoch == 0 {
+		return phase0.Root{}, errors.New("no slots per epoch supplied")
+	}
+	slot, err := block.Slot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to obtain block slot"), err)
+	}
+	blockRoot, err := block.Root()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to obtain block root"), err)
+	}
+
+	domain, err := ComputeDomainAtEpoch(DomainBeaconProposer, fork, phase0.Epoch(uint64(slot)/slotsPerEpoch), genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return signingRoot(blockRoot, domain)
+}
+
+// AttestationSigningRoot computes the root to sign for the given attestation.
+func AttestationSigningRoot(attestation *spec.VersionedAttestation,
+	fork *phase0.Fork,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	if attestation == nil {
+		return phase0.Root{}, errors.New("no attestation supplied")
+	}
+	data, err := attestation.Data()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to obtain attestation data"), err)
+	}
+	if data.Target == nil {
+		return phase0.Root{}, errors.New("attestation target missing")
+	}
+
+	domain, err := ComputeDomainAtEpoch(DomainBeaconAttester, fork, data.Target.Epoch, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return ComputeSigningRoot(data, domain)
+}
+
+func signingRoot(objectRoot phase0.Root, domain phase0.Domain) (phase0.Root, error) {
+	signingData := &phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate signing root"), err)
+	}
+
+	return root, nil
+}
@@ -0,0 +1,146 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/stretchr/testify/require"
+)
+
+// hashPair returns the hash of two 32-byte chunks.
+func hashPair(a []byte, b []byte) [32]byte {
+	chunks := make([]byte, 64)
+	copy(chunks, a)
+	copy(chunks[32:], b)
+
+	return sha256.Sum256(chunks)
+}
+
+// expectedDomain computes a domain directly from its definition.
+func expectedDomain(domainType phase0.DomainType, forkVersion phase0.Version, genesisValidatorsRoot phase0.Root) phase0.Domain {
+	forkDataRoot := hashPair(forkVersion[:], genesisValidatorsRoot[:])
+
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	return domain
+}
+
+func TestComputeDomain(t *testing.T) {
+	genesisValidatorsRoot := phase0.Root{0x4b, 0x36, 0x3d, 0xb9}
+	forkVersion := phase0.Version{0x05, 0x00, 0x00, 0x00}
+
+	domain, err := signing.ComputeDomain(signing.DomainBeaconAttester, forkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, expectedDomain(signing.DomainBeaconAttester, forkVersion, genesisValidatorsRoot), domain)
+}
+
+func TestComputeDomainAtEpoch(t *testing.T) {
+	genesisValidatorsRoot := phase0.Root{0x01}
+	fork := &phase0.Fork{
+		PreviousVersion: phase0.Version{0x04, 0x00, 0x00, 0x00},
+		CurrentVersion:  phase0.Version{0x05, 0x00, 0x00, 0x00},
+		Epoch:           10,
+	}
+
+	_, err := signing.ComputeDomainAtEpoch(signing.DomainRandao, nil, 9, genesisValidatorsRoot)
+	require.EqualError(t, err, "no fork supplied")
+
+	domain, err := signing.ComputeDomainAtEpoch(signing.DomainRandao, fork, 9, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, expectedDomain(signing.DomainRandao, fork.PreviousVersion, genesisValidatorsRoot), domain)
+
+	domain, err = signing.ComputeDomainAtEpoch(signing.DomainRandao, fork, 10, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, expectedDomain(signing.DomainRandao, fork.CurrentVersion, genesisValidatorsRoot), domain)
+}
+
+func TestComputeSigningRoot(t *testing.T) {
+	domain := phase0.Domain{0x01, 0x02}
+	checkpoint := &phase0.Checkpoint{Epoch: 5, Root: phase0.Root{0x03}}
+	checkpointRoot, err := checkpoint.HashTreeRoot()
+	require.NoError(t, err)
+
+	_, err = signing.ComputeSigningRoot(nil, domain)
+	require.EqualError(t, err, "no object supplied")
+
+	root, err := signing.ComputeSigningRoot(checkpoint, domain)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(hashPair(checkpointRoot[:], domain[:])), root)
+}
+
+func TestAttestationSigningRoot(t *testing.T) {
+	genesisValidatorsRoot := phase0.Root{0x01}
+	fork := &phase0.Fork{
+		PreviousVersion: phase0.Version{0x04, 0x00, 0x00, 0x00},
+		CurrentVersion:  phase0.Version{0x05, 0x00, 0x00, 0x00},
+		Epoch:           10,
+	}
+	data := &phase0.AttestationData{
+		Slot:   352,
+		Source: &phase0.Checkpoint{Epoch: 9},
+		Target: &phase0.Checkpoint{Epoch: 11},
+	}
+	dataRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	domain := expectedDomain(signing.DomainBeaconAttester, fork.CurrentVersion, genesisValidatorsRoot)
+
+	_, err = signing.AttestationSigningRoot(&spec.VersionedAttestation{Version: spec.DataVersionDeneb}, fork, genesisValidatorsRoot)
+	require.EqualError(t, err, "failed to obtain attestation data\nno Deneb attestation")
+
+	root, err := signing.AttestationSigningRoot(&spec.VersionedAttestation{
+		Version: spec.DataVersionDeneb,
+		Deneb:   &phase0.Attestation{Data: data},
+	}, fork, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(hashPair(dataRoot[:], domain[:])), root)
+}
+
+func TestBlockSigningRoot(t *testing.T) {
+	genesisValidatorsRoot := phase0.Root{0x01}
+	fork := &phase0.Fork{
+		PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+		CurrentVersion:  phase0.Version{0x00, 0x00, 0x00, 0x00},
+	}
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot:          64,
+				ProposerIndex: 1,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+				},
+			},
+		},
+	}
+	blockRoot, err := block.Root()
+	require.NoError(t, err)
+	domain := expectedDomain(signing.DomainBeaconProposer, fork.CurrentVersion, genesisValidatorsRoot)
+
+	_, err = signing.BlockSigningRoot(block, fork, genesisValidatorsRoot, 0)
+	require.EqualError(t, err, "no slots per epoch supplied")
+
+	root, err := signing.BlockSigningRoot(block, fork, genesisValidatorsRoot, 32)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(hashPair(blockRoot[:], domain[:])), root)
+}
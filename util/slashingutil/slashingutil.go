@@ -0,0 +1,181 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slashingutil provides functions to detect slashable messages and
+// to build slashings from them.
+package slashingutil
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ErrNotSlashable is returned when a slashing is requested for a pair of
+// messages that are not slashable.
+var ErrNotSlashable = errors.New("not slashable")
+
+// IsSlashableAttestationData returns true if the two pieces of attestation data
+// are a double vote, or if the first surrounds the second, as defined by
+// is_slashable_attestation_data in the specification.
+func IsSlashableAttestationData(data1 *phase0.AttestationData, data2 *phase0.AttestationData) bool {
+	if data1 == nil || data2 == nil ||
+		data1.Source == nil || data1.Target == nil ||
+		data2.Source == nil || data2.Target == nil {
+		return false
+	}
+
+	// Double vote.
+	if data1.Target.Epoch == data2.Target.Epoch {
+		root1, err := data1.HashTreeRoot()
+		if err != nil {
+			return false
+		}
+		root2, err := data2.HashTreeRoot()
+		if err != nil {
+			return false
+		}
+
+		return root1 != root2
+	}
+
+	// Surround vote.
+	return data1.Source.Epoch < data2.Source.Epoch && data2.Target.Epoch < data1.Target.Epoch
+}
+
+// IsSlashableAttestationPair returns true if the two attestations are a double
+// or surround vote in either order, and share at least one attesting validator.
+func IsSlashableAttestationPair(a *phase0.IndexedAttestation, b *phase0.IndexedAttestation) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	return isSlashablePair(a.Data, a.AttestingIndices, b.Data, b.AttestingIndices)
+}
+
+// IsSlashableElectraAttestationPair returns true if the two Electra attestations
+// are a double or surround vote in either order, and share at least one
+// attesting validator.
+func IsSlashableElectraAttestationPair(a *electra.IndexedAttestation, b *electra.IndexedAttestation) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	return isSlashablePair(a.Data, a.AttestingIndices, b.Data, b.AttestingIndices)
+}
+
+// SlashableIndices returns the validator indices present in both lists of
+// attesting indices, in ascending order.
+func SlashableIndices(indices1 []uint64, indices2 []uint64) []phase0.ValidatorIndex {
+	present := make(map[uint64]struct{}, len(indices1))
+	for _, index := range indices1 {
+		present[index] = struct{}{}
+	}
+
+	res := make([]phase0.ValidatorIndex, 0)
+	seen := make(map[uint64]struct{})
+	for _, index := range indices2 {
+		if _, exists := present[index]; !exists {
+			continue
+		}
+		if _, exists := seen[index]; exists {
+			continue
+		}
+		seen[index] = struct{}{}
+		res = append(res, phase0.ValidatorIndex(index))
+	}
+	slices.Sort(res)
+
+	return res
+}
+
+// NewAttesterSlashing creates an attester slashing from a slashable pair of
+// attestations, ordering them as required by the specification.
+func NewAttesterSlashing(a *phase0.IndexedAttestation, b *phase0.IndexedAttestation) (*phase0.AttesterSlashing, error) {
+	if !IsSlashableAttestationPair(a, b) {
+		return nil, ErrNotSlashable
+	}
+	if !IsSlashableAttestationData(a.Data, b.Data) {
+		a, b = b, a
+	}
+
+	return &phase0.AttesterSlashing{
+		Attestation1: a,
+		Attestation2: b,
+	}, nil
+}
+
+// NewElectraAttesterSlashing creates an Electra attester slashing from a slashable
+// pair of attestations, ordering them as required by the specification.
+func NewElectraAttesterSlashing(a *electra.IndexedAttestation, b *electra.IndexedAttestation) (*electra.AttesterSlashing, error) {
+	if !IsSlashableElectraAttestationPair(a, b) {
+		return nil, ErrNotSlashable
+	}
+	if !IsSlashableAttestationData(a.Data, b.Data) {
+		a, b = b, a
+	}
+
+	return &electra.AttesterSlashing{
+		Attestation1: a,
+		Attestation2: b,
+	}, nil
+}
+
+// IsSlashableProposalPair returns true if the two signed headers are different
+// proposals from the same proposer for the same slot.  Block headers are common
+// to all forks.
+func IsSlashableProposalPair(a *phase0.SignedBeaconBlockHeader, b *phase0.SignedBeaconBlockHeader) bool {
+	if a == nil || b == nil || a.Message == nil || b.Message == nil {
+		return false
+	}
+	if a.Message.Slot != b.Message.Slot || a.Message.ProposerIndex != b.Message.ProposerIndex {
+		return false
+	}
+
+	root1, err := a.Message.HashTreeRoot()
+	if err != nil {
+		return false
+	}
+	root2, err := b.Message.HashTreeRoot()
+	if err != nil {
+		return false
+	}
+
+	return root1 != root2
+}
+
+// NewProposerSlashing creates a proposer slashing from a slashable pair of signed headers.
+func NewProposerSlashing(a *phase0.SignedBeaconBlockHeader, b *phase0.SignedBeaconBlockHeader) (*phase0.ProposerSlashing, error) {
+	if !IsSlashableProposalPair(a, b) {
+		return nil, ErrNotSlashable
+	}
+
+	return &phase0.ProposerSlashing{
+		SignedHeader1: a,
+		SignedHeader2: b,
+	}, nil
+}
+
+func isSlashablePair(data1 *phase0.AttestationData,
+	indices1 []uint64,
+	data2 *phase0.AttestationData,
+	indices2 []uint64,
+) bool {
+	if !IsSlashableAttestationData(data1, data2) && !IsSlashableAttestationData(data2, data1) {
+		return false
+	}
+
+	return len(SlashableIndices(indices1, indices2)) > 0
+}
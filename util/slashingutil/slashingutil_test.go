@@ -0,0 +1,168 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slashingutil_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/slashingutil"
+	"github.com/stretchr/testify/require"
+)
+
+func attestationData(source phase0.Epoch, target phase0.Epoch, root byte) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            phase0.Slot(target * 32),
+		BeaconBlockRoot: phase0.Root{root},
+		Source:          &phase0.Checkpoint{Epoch: source},
+		Target:          &phase0.Checkpoint{Epoch: target},
+	}
+}
+
+func TestIsSlashableAttestationPair(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         *phase0.IndexedAttestation
+		b         *phase0.IndexedAttestation
+		slashable bool
+	}{
+		{
+			name: "Nil",
+			a:    &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(1, 2, 0x01)},
+		},
+		{
+			name: "Identical",
+			a:    &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(1, 2, 0x01)},
+			b:    &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(1, 2, 0x01)},
+		},
+		{
+			name:      "DoubleVote",
+			a:         &phase0.IndexedAttestation{AttestingIndices: []uint64{1, 2}, Data: attestationData(1, 2, 0x01)},
+			b:         &phase0.IndexedAttestation{AttestingIndices: []uint64{2, 3}, Data: attestationData(1, 2, 0x02)},
+			slashable: true,
+		},
+		{
+			name: "DoubleVoteNoCommonValidator",
+			a:    &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(1, 2, 0x01)},
+			b:    &phase0.IndexedAttestation{AttestingIndices: []uint64{2}, Data: attestationData(1, 2, 0x02)},
+		},
+		{
+			name:      "Surrounds",
+			a:         &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(1, 5, 0x01)},
+			b:         &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(2, 4, 0x01)},
+			slashable: true,
+		},
+		{
+			name:      "Surrounded",
+			a:         &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(2, 4, 0x01)},
+			b:         &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(1, 5, 0x01)},
+			slashable: true,
+		},
+		{
+			name: "Consecutive",
+			a:    &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(1, 2, 0x01)},
+			b:    &phase0.IndexedAttestation{AttestingIndices: []uint64{1}, Data: attestationData(2, 3, 0x01)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.slashable, slashingutil.IsSlashableAttestationPair(test.a, test.b))
+
+			slashing, err := slashingutil.NewAttesterSlashing(test.a, test.b)
+			if !test.slashable {
+				require.ErrorIs(t, err, slashingutil.ErrNotSlashable)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, slashingutil.IsSlashableAttestationData(slashing.Attestation1.Data, slashing.Attestation2.Data))
+		})
+	}
+}
+
+func TestNewElectraAttesterSlashing(t *testing.T) {
+	a := &electra.IndexedAttestation{AttestingIndices: []uint64{1, 5}, Data: attestationData(2, 4, 0x01)}
+	b := &electra.IndexedAttestation{AttestingIndices: []uint64{5, 7}, Data: attestationData(1, 5, 0x01)}
+
+	slashing, err := slashingutil.NewElectraAttesterSlashing(a, b)
+	require.NoError(t, err)
+	require.Equal(t, b, slashing.Attestation1)
+	require.Equal(t, a, slashing.Attestation2)
+	require.Equal(t, []phase0.ValidatorIndex{5}, slashingutil.SlashableIndices(a.AttestingIndices, b.AttestingIndices))
+
+	_, err = slashingutil.NewElectraAttesterSlashing(a, a)
+	require.ErrorIs(t, err, slashingutil.ErrNotSlashable)
+}
+
+func TestIsSlashableProposalPair(t *testing.T) {
+	header := func(slot phase0.Slot, proposer phase0.ValidatorIndex, root byte) *phase0.SignedBeaconBlockHeader {
+		return &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposer,
+				BodyRoot:      phase0.Root{root},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		a         *phase0.SignedBeaconBlockHeader
+		b         *phase0.SignedBeaconBlockHeader
+		slashable bool
+	}{
+		{
+			name: "Nil",
+			a:    header(1, 1, 0x01),
+		},
+		{
+			name: "Identical",
+			a:    header(1, 1, 0x01),
+			b:    header(1, 1, 0x01),
+		},
+		{
+			name: "DifferentSlot",
+			a:    header(1, 1, 0x01),
+			b:    header(2, 1, 0x02),
+		},
+		{
+			name: "DifferentProposer",
+			a:    header(1, 1, 0x01),
+			b:    header(1, 2, 0x02),
+		},
+		{
+			name:      "Slashable",
+			a:         header(1, 1, 0x01),
+			b:         header(1, 1, 0x02),
+			slashable: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.slashable, slashingutil.IsSlashableProposalPair(test.a, test.b))
+
+			slashing, err := slashingutil.NewProposerSlashing(test.a, test.b)
+			if test.slashable {
+				require.NoError(t, err)
+				require.Equal(t, test.a, slashing.SignedHeader1)
+				require.Equal(t, test.b, slashing.SignedHeader2)
+			} else {
+				require.ErrorIs(t, err, slashingutil.ErrNotSlashable)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validatorwatcher
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+type parameters struct {
+	service consensusclient.Service
+	indices []phase0.ValidatorIndex
+	pubKeys []phase0.BLSPubKey
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithService sets the service from which validators are obtained.  The
+// service must provide validators, genesis and spec.
+func WithService(service consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithIndices sets the indices of the validators to watch.
+func WithIndices(indices []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.indices = indices
+	})
+}
+
+// WithPubKeys sets the public keys of the validators to watch.  Validators
+// that are not yet known to the chain are picked up once their deposits are
+// processed.
+func WithPubKeys(pubKeys []phase0.BLSPubKey) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.pubKeys = pubKeys
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	if _, isProvider := parameters.service.(consensusclient.ValidatorsProvider); !isProvider {
+		return nil, errors.New("service does not provide validators")
+	}
+	if _, isProvider := parameters.service.(consensusclient.GenesisProvider); !isProvider {
+		return nil, errors.New("service does not provide genesis")
+	}
+	if _, isProvider := parameters.service.(consensusclient.SpecProvider); !isProvider {
+		return nil, errors.New("service does not provide spec")
+	}
+	if len(parameters.indices) == 0 && len(parameters.pubKeys) == 0 {
+		return nil, errors.New("no validators specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,308 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validatorwatcher watches a set of validators and notifies
+// subscribers when their states change.  It takes a snapshot of the
+// validators at the start of each epoch, and sends an event for each
+// transition between snapshots.
+package validatorwatcher
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EventType is the type of a validator event.
+type EventType int
+
+const (
+	// EventTypePending is sent when a validator is first seen, or its pending state changes.
+	EventTypePending EventType = iota
+	// EventTypeActivated is sent when a validator becomes active.
+	EventTypeActivated
+	// EventTypeExiting is sent when an active validator starts to exit.
+	EventTypeExiting
+	// EventTypeSlashed is sent when a validator is slashed.
+	EventTypeSlashed
+	// EventTypeExited is sent when a validator exits.
+	EventTypeExited
+	// EventTypeWithdrawable is sent when a validator's balance becomes withdrawable.
+	EventTypeWithdrawable
+	// EventTypeWithdrawn is sent when a validator's balance has been withdrawn.
+	EventTypeWithdrawn
+)
+
+var eventTypeStrings = [...]string{
+	"pending",
+	"activated",
+	"exiting",
+	"slashed",
+	"exited",
+	"withdrawable",
+	"withdrawn",
+}
+
+// String returns a string representation of the event type.
+func (e EventType) String() string {
+	if int(e) < 0 || int(e) >= len(eventTypeStrings) {
+		return "unknown"
+	}
+
+	return eventTypeStrings[e]
+}
+
+// Event is provided to subscribers when a validator's state changes.
+type Event struct {
+	// Type is the type of the event.
+	Type EventType
+	// Epoch is the epoch at which the change was observed.
+	Epoch phase0.Epoch
+	// Index is the index of the validator.
+	Index phase0.ValidatorIndex
+	// PubKey is the public key of the validator.
+	PubKey phase0.BLSPubKey
+	// Previous is the state of the validator prior to the change.
+	Previous apiv1.ValidatorState
+	// Current is the state of the validator after the change.
+	Current apiv1.ValidatorState
+	// Validator is the validator after the change.
+	Validator *apiv1.Validator
+}
+
+// Service watches validators.
+type Service struct {
+	validatorsProvider consensusclient.ValidatorsProvider
+	chainTime          *chaintime.Service
+	indices            []phase0.ValidatorIndex
+	pubKeys            []phase0.BLSPubKey
+
+	// refreshMu serialises refreshes.
+	refreshMu sync.Mutex
+
+	mu          sync.RWMutex
+	validators  map[phase0.ValidatorIndex]*apiv1.Validator
+	handlers    map[uint64]func(*Event)
+	nextHandler uint64
+}
+
+// New creates a new validator watcher service.  The service refreshes the
+// validators at the start of each epoch until the context is cancelled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithGenesisProvider(parameters.service.(consensusclient.GenesisProvider)),
+		chaintime.WithSpecProvider(parameters.service.(consensusclient.SpecProvider)),
+	)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to create chain time service"), err)
+	}
+
+	s := &Service{
+		validatorsProvider: parameters.service.(consensusclient.ValidatorsProvider),
+		chainTime:          chainTime,
+		indices:            parameters.indices,
+		pubKeys:            parameters.pubKeys,
+		handlers:           make(map[uint64]func(*Event)),
+	}
+
+	if err := s.Refresh(ctx); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain initial validators"), err)
+	}
+
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// Validators provides the latest snapshot of the watched validators.
+func (s *Service) Validators() map[phase0.ValidatorIndex]*apiv1.Validator {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator, len(s.validators))
+	for index, validator := range s.validators {
+		res[index] = validator
+	}
+
+	return res
+}
+
+// Subscribe registers a handler that is called with each validator event.
+// Handlers should return promptly.  The returned function removes the handler.
+func (s *Service) Subscribe(handler func(*Event)) func() {
+	s.mu.Lock()
+	id := s.nextHandler
+	s.nextHandler++
+	s.handlers[id] = handler
+	s.mu.Unlock()
+
+	return func() {
+		s.mu.Lock()
+		delete(s.handlers, id)
+		s.mu.Unlock()
+	}
+}
+
+// Refresh takes a new snapshot of the watched validators, and notifies
+// subscribers of any changes since the previous snapshot.  It is called
+// automatically at the start of each epoch.
+func (s *Service) Refresh(ctx context.Context) error {
+	// Hold the lock whilst fetching, so that an older snapshot cannot be
+	// processed after a newer one.
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	response, err := s.validatorsProvider.Validators(ctx, &api.ValidatorsOpts{
+		State:   "head",
+		Indices: s.indices,
+		PubKeys: s.pubKeys,
+	})
+	if err != nil {
+		return err
+	}
+	if response.Data == nil {
+		return errors.New("validators response missing data")
+	}
+
+	events := s.process(response.Data, s.chainTime.CurrentEpoch())
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.mu.RLock()
+	handlers := make([]func(*Event), 0, len(s.handlers))
+	for _, handler := range s.handlers {
+		handlers = append(handlers, handler)
+	}
+	s.mu.RUnlock()
+
+	for _, event := range events {
+		for _, handler := range handlers {
+			handler(event)
+		}
+	}
+
+	return nil
+}
+
+// run refreshes the validators at the start of each epoch until the context is cancelled.
+func (s *Service) run(ctx context.Context) {
+	for {
+		nextEpochStart := s.chainTime.EpochStartTime(s.chainTime.CurrentEpoch() + 1)
+		timer := time.NewTimer(time.Until(nextEpochStart))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.C:
+			// Errors are transient; the next epoch will try again.
+			_ = s.Refresh(ctx)
+		}
+	}
+}
+
+// process updates the snapshot with the given validators, returning the resultant events.
+func (s *Service) process(validators map[phase0.ValidatorIndex]*apiv1.Validator,
+	epoch phase0.Epoch,
+) []*Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous := s.validators
+	s.validators = validators
+	if previous == nil {
+		// First snapshot; establish the baseline.
+		return nil
+	}
+
+	events := make([]*Event, 0)
+	for index, validator := range validators {
+		if validator == nil || validator.Validator == nil {
+			continue
+		}
+		prior, exists := previous[index]
+		if exists && (prior == nil || prior.Validator == nil) {
+			exists = false
+		}
+
+		newEvent := func(eventType EventType) *Event {
+			event := &Event{
+				Type:      eventType,
+				Epoch:     epoch,
+				Index:     index,
+				PubKey:    validator.Validator.PublicKey,
+				Previous:  apiv1.ValidatorStateUnknown,
+				Current:   validator.Status,
+				Validator: validator,
+			}
+			if exists {
+				event.Previous = prior.Status
+			}
+
+			return event
+		}
+
+		if validator.Validator.Slashed && (!exists || !prior.Validator.Slashed) {
+			events = append(events, newEvent(EventTypeSlashed))
+		}
+		if exists && prior.Status == validator.Status {
+			continue
+		}
+		if eventType, isEvent := stateEventType(validator.Status); isEvent {
+			events = append(events, newEvent(eventType))
+		}
+	}
+
+	// Provide events in a consistent order.
+	slices.SortStableFunc(events, func(a, b *Event) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+
+	return events
+}
+
+// stateEventType returns the event type for a validator entering the given state.
+func stateEventType(state apiv1.ValidatorState) (EventType, bool) {
+	switch state {
+	case apiv1.ValidatorStatePendingInitialized, apiv1.ValidatorStatePendingQueued:
+		return EventTypePending, true
+	case apiv1.ValidatorStateActiveOngoing:
+		return EventTypeActivated, true
+	case apiv1.ValidatorStateActiveExiting:
+		return EventTypeExiting, true
+	case apiv1.ValidatorStateExitedUnslashed, apiv1.ValidatorStateExitedSlashed:
+		return EventTypeExited, true
+	case apiv1.ValidatorStateWithdrawalPossible:
+		return EventTypeWithdrawable, true
+	case apiv1.ValidatorStateWithdrawalDone:
+		return EventTypeWithdrawn, true
+	default:
+		// Active slashed is covered by the slashed event.
+		return 0, false
+	}
+}
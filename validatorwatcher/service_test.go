@@ -0,0 +1,272 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validatorwatcher_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/validatorwatcher"
+	"github.com/stretchr/testify/require"
+)
+
+// chain is a scripted set of validators.
+type chain struct {
+	mu         sync.Mutex
+	validators map[phase0.ValidatorIndex]*apiv1.Validator
+	err        error
+	delay      time.Duration
+}
+
+// set sets the state of a validator.
+func (c *chain) set(index phase0.ValidatorIndex, state apiv1.ValidatorState, slashed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.validators[index] = &apiv1.Validator{
+		Index:  index,
+		Status: state,
+		Validator: &phase0.Validator{
+			PublicKey: phase0.BLSPubKey{byte(index)},
+			Slashed:   slashed,
+		},
+	}
+}
+
+func newChain(ctx context.Context, t *testing.T) (*chain, consensusclient.Service) {
+	t.Helper()
+
+	c := &chain{
+		validators: make(map[phase0.ValidatorIndex]*apiv1.Validator),
+	}
+
+	service, err := mock.New(ctx,
+		mock.WithValidatorsFunc(func(_ context.Context, opts *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+			c.mu.Lock()
+			if c.err != nil {
+				c.mu.Unlock()
+
+				return nil, c.err
+			}
+
+			res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+			for _, index := range opts.Indices {
+				if validator, exists := c.validators[index]; exists {
+					res[index] = validator
+				}
+			}
+			delay := c.delay
+			c.mu.Unlock()
+
+			// Simulate a slow response.
+			time.Sleep(delay)
+
+			return &api.Response[map[phase0.ValidatorIndex]*apiv1.Validator]{Data: res, Metadata: map[string]any{}}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	return c, service
+}
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, service := newChain(ctx, t)
+
+	tests := []struct {
+		name   string
+		params []validatorwatcher.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			params: []validatorwatcher.Parameter{
+				validatorwatcher.WithIndices([]phase0.ValidatorIndex{1}),
+			},
+			err: "problem with parameters\nno service specified",
+		},
+		{
+			name: "ValidatorsMissing",
+			params: []validatorwatcher.Parameter{
+				validatorwatcher.WithService(service),
+			},
+			err: "problem with parameters\nno validators specified",
+		},
+		{
+			name: "Indices",
+			params: []validatorwatcher.Parameter{
+				validatorwatcher.WithService(service),
+				validatorwatcher.WithIndices([]phase0.ValidatorIndex{1}),
+			},
+		},
+		{
+			name: "PubKeys",
+			params: []validatorwatcher.Parameter{
+				validatorwatcher.WithService(service),
+				validatorwatcher.WithPubKeys([]phase0.BLSPubKey{{0x01}}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := validatorwatcher.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInitialError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, service := newChain(ctx, t)
+	c.err = errors.New("mock error")
+
+	_, err := validatorwatcher.New(ctx,
+		validatorwatcher.WithService(service),
+		validatorwatcher.WithIndices([]phase0.ValidatorIndex{1}),
+	)
+	require.EqualError(t, err, "failed to obtain initial validators\nmock error")
+}
+
+func TestTransitions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, service := newChain(ctx, t)
+	c.set(1, apiv1.ValidatorStatePendingQueued, false)
+	c.set(2, apiv1.ValidatorStateActiveOngoing, false)
+
+	s, err := validatorwatcher.New(ctx,
+		validatorwatcher.WithService(service),
+		validatorwatcher.WithIndices([]phase0.ValidatorIndex{1, 2, 3}),
+	)
+	require.NoError(t, err)
+	require.Len(t, s.Validators(), 2)
+
+	events := make([]*validatorwatcher.Event, 0)
+	unsubscribe := s.Subscribe(func(event *validatorwatcher.Event) {
+		events = append(events, event)
+	})
+
+	// No changes.
+	require.NoError(t, s.Refresh(ctx))
+	require.Empty(t, events)
+
+	// Activation, and a new validator.
+	c.set(1, apiv1.ValidatorStateActiveOngoing, false)
+	c.set(3, apiv1.ValidatorStatePendingInitialized, false)
+	require.NoError(t, s.Refresh(ctx))
+	require.Len(t, events, 2)
+	require.Equal(t, validatorwatcher.EventTypeActivated, events[0].Type)
+	require.Equal(t, phase0.ValidatorIndex(1), events[0].Index)
+	require.Equal(t, apiv1.ValidatorStatePendingQueued, events[0].Previous)
+	require.Equal(t, apiv1.ValidatorStateActiveOngoing, events[0].Current)
+	require.Equal(t, validatorwatcher.EventTypePending, events[1].Type)
+	require.Equal(t, apiv1.ValidatorStateUnknown, events[1].Previous)
+
+	// Voluntary exit.
+	c.set(1, apiv1.ValidatorStateActiveExiting, false)
+	require.NoError(t, s.Refresh(ctx))
+	require.Len(t, events, 3)
+	require.Equal(t, validatorwatcher.EventTypeExiting, events[2].Type)
+
+	// Slashing.
+	c.set(2, apiv1.ValidatorStateActiveSlashed, true)
+	require.NoError(t, s.Refresh(ctx))
+	require.Len(t, events, 4)
+	require.Equal(t, validatorwatcher.EventTypeSlashed, events[3].Type)
+	require.Equal(t, phase0.ValidatorIndex(2), events[3].Index)
+
+	// Slashed validator exits, without a further slashed event.
+	c.set(2, apiv1.ValidatorStateExitedSlashed, true)
+	require.NoError(t, s.Refresh(ctx))
+	require.Len(t, events, 5)
+	require.Equal(t, validatorwatcher.EventTypeExited, events[4].Type)
+
+	// Errors leave the snapshot unchanged.
+	c.err = errors.New("mock error")
+	require.EqualError(t, s.Refresh(ctx), "mock error")
+	require.Equal(t, apiv1.ValidatorStateExitedSlashed, s.Validators()[2].Status)
+	c.err = nil
+
+	unsubscribe()
+	c.set(1, apiv1.ValidatorStateExitedUnslashed, false)
+	require.NoError(t, s.Refresh(ctx))
+	require.Len(t, events, 5)
+}
+
+func TestConcurrentRefresh(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, service := newChain(ctx, t)
+	c.set(1, apiv1.ValidatorStatePendingQueued, false)
+
+	s, err := validatorwatcher.New(ctx,
+		validatorwatcher.WithService(service),
+		validatorwatcher.WithIndices([]phase0.ValidatorIndex{1}),
+	)
+	require.NoError(t, err)
+
+	var eventsMu sync.Mutex
+	events := make([]*validatorwatcher.Event, 0)
+	s.Subscribe(func(event *validatorwatcher.Event) {
+		eventsMu.Lock()
+		events = append(events, event)
+		eventsMu.Unlock()
+	})
+
+	// Start a slow refresh, which obtains the current state.
+	c.mu.Lock()
+	c.delay = 100 * time.Millisecond
+	c.mu.Unlock()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, s.Refresh(ctx))
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// The validator activates, and a fast refresh starts whilst the slow refresh is outstanding.
+	c.mu.Lock()
+	c.delay = 0
+	c.mu.Unlock()
+	c.set(1, apiv1.ValidatorStateActiveOngoing, false)
+	require.NoError(t, s.Refresh(ctx))
+	wg.Wait()
+
+	// The snapshot from the slow refresh does not replace that of the fast refresh.
+	require.Equal(t, apiv1.ValidatorStateActiveOngoing, s.Validators()[1].Status)
+	eventsMu.Lock()
+	defer eventsMu.Unlock()
+	require.Len(t, events, 1)
+	require.Equal(t, validatorwatcher.EventTypeActivated, events[0].Type)
+}